@@ -9,9 +9,12 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/filesystem"
 	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
 	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	commands_attach "github.com/buildbarn/bonanza/pkg/bazelclient/commands/attach"
 	commands_build "github.com/buildbarn/bonanza/pkg/bazelclient/commands/build"
+	commands_clean "github.com/buildbarn/bonanza/pkg/bazelclient/commands/clean"
 	commands_info "github.com/buildbarn/bonanza/pkg/bazelclient/commands/info"
 	commands_license "github.com/buildbarn/bonanza/pkg/bazelclient/commands/license"
+	commands_mod "github.com/buildbarn/bonanza/pkg/bazelclient/commands/mod"
 	commands_version "github.com/buildbarn/bonanza/pkg/bazelclient/commands/version"
 	"github.com/buildbarn/bonanza/pkg/bazelclient/logging"
 )
@@ -20,7 +23,7 @@ func main() {
 	// Logger we need to use before flags have been parsed. As
 	// enabling/disabling colors is controlled via a flag, leave
 	// colors disabled.
-	startupLogger := logging.NewConsoleLogger(os.Stderr, &logging.NoEscapeSequences)
+	startupLogger := logging.NewConsoleLogger(os.Stderr, &logging.NoEscapeSequences, 0, nil)
 
 	rootDirectory, err := filesystem.NewLocalDirectory(&path.RootBuilder)
 	if err != nil {
@@ -53,7 +56,7 @@ func main() {
 		workspacePathStr = parent
 	}
 
-	cmd, err := arguments.Parse(
+	startupFlags, cmd, err := arguments.Parse(
 		os.Args[1:],
 		rootDirectory,
 		path.LocalFormat,
@@ -66,14 +69,20 @@ func main() {
 	}
 
 	switch typedCmd := cmd.(type) {
+	case *arguments.AttachCommand:
+		commands_attach.DoAttach(typedCmd, startupFlags, workspacePath)
 	case *arguments.BuildCommand:
-		commands_build.DoBuild(typedCmd, workspacePath)
+		commands_build.DoBuild(typedCmd, startupFlags, workspacePath)
+	case *arguments.CleanCommand:
+		commands_clean.DoClean(typedCmd, startupFlags, workspacePath)
 	case *arguments.HelpCommand:
 		panic("HELP")
 	case *arguments.InfoCommand:
-		commands_info.DoInfo(typedCmd, workspacePath)
+		commands_info.DoInfo(typedCmd, startupFlags, workspacePath)
 	case *arguments.LicenseCommand:
 		commands_license.DoLicense()
+	case *arguments.ModCommand:
+		commands_mod.DoMod(typedCmd, startupFlags, workspacePath)
 	case *arguments.VersionCommand:
 		commands_version.DoVersion(typedCmd)
 	default:
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"runtime"
+	"sync"
 	"time"
 
 	re_filesystem "github.com/buildbarn/bb-remote-execution/pkg/filesystem"
@@ -127,6 +128,7 @@ func main() {
 				remoteexecution_pb.NewExecutionClient(executionGRPCClient),
 				executionClientPrivateKey,
 				executionClientCertificateChain,
+				clock.SystemClock,
 			),
 		}
 		client, err := remoteworker.NewClient(
@@ -188,18 +190,89 @@ func (e *builderExecutor) Execute(ctx context.Context, action *model_build_pb.Ac
 			Status: status.Convert(util.StatusWrap(err, "Invalid build specification encoder")).Proto(),
 		}, 0, remoteworker_pb.CurrentState_Completed_FAILED
 	}
+
+	// Forward diagnostics to the client, so that output of print()
+	// is displayed while the build is in progress. Only diagnostics
+	// that were added since the previous event are sent.
+	progressTracker := model_analysis.NewProgressTracker()
+	diagnosticsCollector := model_analysis.NewDiagnosticsCollector(func(diagnostics []*model_build_pb.Diagnostic) {
+		firstDiagnosticIndex := len(diagnostics) - 1
+		executionEvents <- &model_build_pb.Event{
+			Diagnostics:          diagnostics[firstDiagnosticIndex:],
+			Progress:             progressTracker.GetProgress(),
+			FirstDiagnosticIndex: uint64(firstDiagnosticIndex),
+		}
+	})
+
+	// Periodically report the progress of the build, so that the
+	// client can display which operations are running. Diagnostics
+	// have already been sent when they were reported, so they are
+	// omitted. Reporting needs to stop before returning, as the
+	// caller closes the channel of execution events afterwards.
+	progressReportingDone := make(chan struct{})
+	var progressReportingWait sync.WaitGroup
+	progressReportingWait.Add(1)
+	go func() {
+		defer progressReportingWait.Done()
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-progressReportingDone:
+				return
+			case <-ticker.C:
+				select {
+				case <-progressReportingDone:
+					return
+				case executionEvents <- &model_build_pb.Event{
+					Progress: progressTracker.GetProgress(),
+				}:
+				}
+			}
+		}
+	}()
+	defer func() {
+		close(progressReportingDone)
+		progressReportingWait.Wait()
+	}()
+
+	// Attach the invocation ID and build request ID of the client to
+	// all actions executed by the builder, so that the scheduler can
+	// group them by build.
+	ctx, err = remoteexecution.NewOutgoingContextWithRequestMetadata(ctx, &remoteexecution_pb.RequestMetadata{
+		ToolInvocationId:        action.InvocationId,
+		CorrelatedInvocationsId: action.BuildRequestId,
+	})
+	if err != nil {
+		return &model_build_pb.Result{
+			Status: status.Convert(err).Proto(),
+		}, 0, remoteworker_pb.CurrentState_Completed_FAILED
+	}
+
+	// Either perform a build, or answer a query against the module
+	// graph that was requested through "bazel mod".
+	var key proto.Message = &model_analysis_pb.BuildResult_Key{}
+	if action.ModuleQuery != nil {
+		key = &model_analysis_pb.ModuleQueryResult_Key{
+			Query: action.ModuleQuery,
+		}
+	}
 	value, err := evaluation.FullyComputeValue(
 		ctx,
-		model_analysis.NewTypedComputer(model_analysis.NewBaseComputer(
-			objectDownloader,
-			buildSpecificationReference,
-			buildSpecificationEncoder,
-			e.httpClient,
-			e.filePool,
-			e.cacheDirectory,
-			e.executionClient,
-		)),
-		model_core.NewSimpleMessage[proto.Message](&model_analysis_pb.BuildResult_Key{}),
+		progressTracker.NewComputer(
+			model_analysis.NewTypedComputer(model_analysis.NewBaseComputer(
+				objectDownloader,
+				buildSpecificationReference,
+				buildSpecificationEncoder,
+				e.httpClient,
+				e.filePool,
+				e.cacheDirectory,
+				e.executionClient,
+				action.ExecutionPriority,
+				diagnosticsCollector,
+			)),
+		),
+		model_core.NewSimpleMessage[proto.Message](key),
 		func(references []object.LocalReference, objectContentsWalkers []dag.ObjectContentsWalker) error {
 			for i, reference := range references {
 				if err := dag.UploadDAG(
@@ -223,10 +296,26 @@ func (e *builderExecutor) Execute(ctx context.Context, action *model_build_pb.Ac
 	)
 	if err != nil {
 		return &model_build_pb.Result{
-			Status: status.Convert(err).Proto(),
+			Status:      status.Convert(err).Proto(),
+			Diagnostics: diagnosticsCollector.GetDiagnostics(),
+		}, 0, remoteworker_pb.CurrentState_Completed_FAILED
+	}
+	switch resultValue := value.Message.(type) {
+	case *model_analysis_pb.BuildResult_Value:
+		return &model_build_pb.Result{
+			Diagnostics:    diagnosticsCollector.GetDiagnostics(),
+			ModuleLockfile: resultValue.ModuleLockfile,
+			Targets:        resultValue.Targets,
+		}, 0, remoteworker_pb.CurrentState_Completed_SUCCEEDED
+	case *model_analysis_pb.ModuleQueryResult_Value:
+		return &model_build_pb.Result{
+			Diagnostics:       diagnosticsCollector.GetDiagnostics(),
+			ModuleQueryResult: resultValue.Result,
+		}, 0, remoteworker_pb.CurrentState_Completed_SUCCEEDED
+	default:
+		return &model_build_pb.Result{
+			Status:      status.New(codes.Internal, "Build result has an unexpected type").Proto(),
+			Diagnostics: diagnosticsCollector.GetDiagnostics(),
 		}, 0, remoteworker_pb.CurrentState_Completed_FAILED
 	}
-	return &model_build_pb.Result{
-		Status: status.Newf(codes.Internal, "TODO: %s", value).Proto(),
-	}, 0, remoteworker_pb.CurrentState_Completed_FAILED
 }
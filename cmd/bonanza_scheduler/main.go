@@ -7,6 +7,7 @@ import (
 	"os"
 	"time"
 
+	auth_configuration "github.com/buildbarn/bb-storage/pkg/auth/configuration"
 	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/global"
 	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
@@ -43,7 +44,7 @@ func main() {
 		// Create an action router that is responsible for analyzing
 		// incoming execution requests and determining how they are
 		// scheduled.
-		actionRouter, err := routing.NewActionRouterFromConfiguration(configuration.ActionRouter)
+		actionRouter, err := routing.NewActionRouterFromConfiguration(configuration.ActionRouter, dependenciesGroup)
 		if err != nil {
 			return util.StatusWrap(err, "Failed to create action router")
 		}
@@ -53,6 +54,20 @@ func main() {
 			return util.StatusWrap(err, "Invalid platform queue with no workers timeout")
 		}
 
+		authorizerFactory := auth_configuration.DefaultAuthorizerFactory
+		executeAuthorizer, err := authorizerFactory.NewAuthorizerFromConfiguration(configuration.ExecuteAuthorizer, grpcClientFactory)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create execute authorizer")
+		}
+		modifyDrainsAuthorizer, err := authorizerFactory.NewAuthorizerFromConfiguration(configuration.ModifyDrainsAuthorizer, grpcClientFactory)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create modify drains authorizer")
+		}
+		killOperationsAuthorizer, err := authorizerFactory.NewAuthorizerFromConfiguration(configuration.KillOperationsAuthorizer, grpcClientFactory)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create kill operations authorizer")
+		}
+
 		// Create in-memory build queue.
 		generator := random.NewFastSingleThreadedGenerator()
 		buildQueue := scheduler.NewInMemoryBuildQueue(
@@ -75,6 +90,9 @@ func main() {
 				VerificationPrivateKeyRefreshInterval: time.Hour,
 			},
 			actionRouter,
+			executeAuthorizer,
+			modifyDrainsAuthorizer,
+			killOperationsAuthorizer,
 		)
 
 		// Create predeclared platform queues.
@@ -83,7 +101,7 @@ func main() {
 			for publicKeyIndex, pkixPublicKey := range platformQueue.PkixPublicKeys {
 				publicKey, err := x509.ParsePKIXPublicKey(pkixPublicKey)
 				if err != nil {
-					return util.StatusWrapfWithCode(err, codes.InvalidArgument, "Invalid PKIX public key at index %d of platform at index %d: %s", publicKeyIndex, platformQueueIndex)
+					return util.StatusWrapfWithCode(err, codes.InvalidArgument, "Invalid PKIX public key at index %d of platform at index %d", publicKeyIndex, platformQueueIndex)
 				}
 				ecdhPublicKey, ok := publicKey.(*ecdh.PublicKey)
 				if !ok {
@@ -95,15 +113,20 @@ func main() {
 			workerInvocationStickinessLimits := make([]time.Duration, 0, len(platformQueue.WorkerInvocationStickinessLimits))
 			for i, d := range platformQueue.WorkerInvocationStickinessLimits {
 				if err := d.CheckValid(); err != nil {
-					return util.StatusWrapf(err, "Invalid worker invocation stickiness limit at index %d: %s", i)
+					return util.StatusWrapf(err, "Invalid worker invocation stickiness limit at index %d", i)
 				}
 				workerInvocationStickinessLimits = append(workerInvocationStickinessLimits, d.AsDuration())
 			}
 
+			maximumQueuedBackgroundLearningOperations := platformQueue.MaximumQueuedBackgroundLearningOperations
+			if maximumQueuedBackgroundLearningOperations < 0 {
+				return status.Errorf(codes.InvalidArgument, "Maximum number of queued background learning operations of platform at index %d cannot be negative", platformQueueIndex)
+			}
+
 			if err := buildQueue.RegisterPredeclaredPlatformQueue(
 				publicKeys,
 				workerInvocationStickinessLimits,
-				int(platformQueue.MaximumQueuedBackgroundLearningOperations),
+				int(maximumQueuedBackgroundLearningOperations),
 				platformQueue.BackgroundLearningOperationPriority,
 				platformQueue.SizeClasses,
 			); err != nil {
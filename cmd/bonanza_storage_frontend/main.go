@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 
+	auth_configuration "github.com/buildbarn/bb-storage/pkg/auth/configuration"
 	"github.com/buildbarn/bb-storage/pkg/global"
 	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
@@ -14,11 +15,13 @@ import (
 	tag_pb "github.com/buildbarn/bonanza/pkg/proto/storage/tag"
 	"github.com/buildbarn/bonanza/pkg/storage/dag"
 	"github.com/buildbarn/bonanza/pkg/storage/object"
+	object_authorizing "github.com/buildbarn/bonanza/pkg/storage/object/authorizing"
 	object_grpc "github.com/buildbarn/bonanza/pkg/storage/object/grpc"
 	object_leaserenewing "github.com/buildbarn/bonanza/pkg/storage/object/leaserenewing"
 	object_mirrored "github.com/buildbarn/bonanza/pkg/storage/object/mirrored"
 	object_sharded "github.com/buildbarn/bonanza/pkg/storage/object/sharded"
 	"github.com/buildbarn/bonanza/pkg/storage/tag"
+	tag_authorizing "github.com/buildbarn/bonanza/pkg/storage/tag/authorizing"
 	tag_grpc "github.com/buildbarn/bonanza/pkg/storage/tag/grpc"
 	tag_leaserenewing "github.com/buildbarn/bonanza/pkg/storage/tag/leaserenewing"
 	tag_mirrored "github.com/buildbarn/bonanza/pkg/storage/tag/mirrored"
@@ -44,6 +47,16 @@ func main() {
 			return util.StatusWrap(err, "Failed to apply global configuration options")
 		}
 
+		authorizerFactory := auth_configuration.DefaultAuthorizerFactory
+		getAuthorizer, err := authorizerFactory.NewAuthorizerFromConfiguration(configuration.GetAuthorizer, grpcClientFactory)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create get authorizer")
+		}
+		putAuthorizer, err := authorizerFactory.NewAuthorizerFromConfiguration(configuration.PutAuthorizer, grpcClientFactory)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create put authorizer")
+		}
+
 		if configuration.MaximumUnfinalizedParentsLimit == nil {
 			return status.Error(codes.InvalidArgument, "No maximum unfinalized parents limit provided")
 		}
@@ -87,20 +100,24 @@ func main() {
 				// Services for downloading DAGs.
 				object_pb.RegisterDownloaderServer(
 					s,
-					object.NewDownloaderServer(objectDownloader),
+					object.NewDownloaderServer(
+						object_authorizing.NewAuthorizingDownloader(objectDownloader, getAuthorizer),
+					),
 				)
 				tag_pb.RegisterResolverServer(
 					s,
-					tag.NewResolverServer(tagResolver),
+					tag.NewResolverServer(
+						tag_authorizing.NewAuthorizingResolver(tagResolver, getAuthorizer),
+					),
 				)
 
 				// Services for uploading DAGs.
 				dag_pb.RegisterUploaderServer(
 					s,
 					dag.NewUploaderServer(
-						objectUploader,
+						object_authorizing.NewAuthorizingUploader(objectUploader, putAuthorizer),
 						semaphore.NewWeighted(configuration.ObjectStoreConcurrency),
-						tagUpdater,
+						tag_authorizing.NewAuthorizingUpdater(tagUpdater, putAuthorizer),
 						configuration.MaximumUnfinalizedDagsCount,
 						maximumUnfinalizedParentsLimit,
 					),
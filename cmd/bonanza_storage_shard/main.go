@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 
+	auth_configuration "github.com/buildbarn/bb-storage/pkg/auth/configuration"
 	"github.com/buildbarn/bb-storage/pkg/global"
 	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
@@ -12,10 +13,12 @@ import (
 	object_pb "github.com/buildbarn/bonanza/pkg/proto/storage/object"
 	tag_pb "github.com/buildbarn/bonanza/pkg/proto/storage/tag"
 	"github.com/buildbarn/bonanza/pkg/storage/object"
+	object_authorizing "github.com/buildbarn/bonanza/pkg/storage/object/authorizing"
 	object_leasemarshaling "github.com/buildbarn/bonanza/pkg/storage/object/leasemarshaling"
 	object_local "github.com/buildbarn/bonanza/pkg/storage/object/local"
 	object_namespacemapping "github.com/buildbarn/bonanza/pkg/storage/object/namespacemapping"
 	"github.com/buildbarn/bonanza/pkg/storage/tag"
+	tag_authorizing "github.com/buildbarn/bonanza/pkg/storage/tag/authorizing"
 	tag_leasemarshaling "github.com/buildbarn/bonanza/pkg/storage/tag/leasemarshaling"
 	tag_local "github.com/buildbarn/bonanza/pkg/storage/tag/local"
 
@@ -38,6 +41,16 @@ func main() {
 			return util.StatusWrap(err, "Failed to apply global configuration options")
 		}
 
+		authorizerFactory := auth_configuration.DefaultAuthorizerFactory
+		getAuthorizer, err := authorizerFactory.NewAuthorizerFromConfiguration(configuration.GetAuthorizer, grpcClientFactory)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create get authorizer")
+		}
+		putAuthorizer, err := authorizerFactory.NewAuthorizerFromConfiguration(configuration.PutAuthorizer, grpcClientFactory)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create put authorizer")
+		}
+
 		objectStore := object_local.NewLocalStore()
 		tagStore := tag_local.NewLocalStore()
 		leaseMarshaler := object_local.LocalLeaseMarshaler
@@ -48,34 +61,46 @@ func main() {
 				object_pb.RegisterDownloaderServer(
 					s,
 					object.NewDownloaderServer(
-						object_namespacemapping.NewNamespaceRemovingDownloader[object.GlobalReference](
-							objectStore,
+						object_authorizing.NewAuthorizingDownloader(
+							object_namespacemapping.NewNamespaceRemovingDownloader[object.GlobalReference](
+								objectStore,
+							),
+							getAuthorizer,
 						),
 					),
 				)
 				object_pb.RegisterUploaderServer(
 					s,
 					object.NewUploaderServer(
-						object_leasemarshaling.NewLeaseMarshalingUploader(
-							object_namespacemapping.NewNamespaceRemovingUploader[object.GlobalReference](
-								objectStore,
+						object_authorizing.NewAuthorizingUploader(
+							object_leasemarshaling.NewLeaseMarshalingUploader(
+								object_namespacemapping.NewNamespaceRemovingUploader[object.GlobalReference](
+									objectStore,
+								),
+								leaseMarshaler,
 							),
-							leaseMarshaler,
+							putAuthorizer,
 						),
 					),
 				)
 				tag_pb.RegisterResolverServer(
 					s,
 					tag.NewResolverServer(
-						tagStore,
+						tag_authorizing.NewAuthorizingResolver(
+							tagStore,
+							getAuthorizer,
+						),
 					),
 				)
 				tag_pb.RegisterUpdaterServer(
 					s,
 					tag.NewUpdaterServer(
-						tag_leasemarshaling.NewLeaseMarshalingUpdater(
-							tagStore,
-							leaseMarshaler,
+						tag_authorizing.NewAuthorizingUpdater(
+							tag_leasemarshaling.NewLeaseMarshalingUpdater(
+								tagStore,
+								leaseMarshaler,
+							),
+							putAuthorizer,
 						),
 					),
 				)
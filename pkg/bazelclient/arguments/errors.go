@@ -79,6 +79,24 @@ func (e FlagInvalidEnumValueError) Error() string {
 	return sb.String()
 }
 
+type FlagInvalidFloat64ValueError struct {
+	Flag  string
+	Value string
+}
+
+func (e FlagInvalidFloat64ValueError) Error() string {
+	return fmt.Sprintf("flag %s only accepts floating point numbers, not %#v", e.Flag, e.Value)
+}
+
+type FlagInvalidInt32ValueError struct {
+	Flag  string
+	Value string
+}
+
+func (e FlagInvalidInt32ValueError) Error() string {
+	return fmt.Sprintf("flag %s only accepts 32-bit integers, not %#v", e.Flag, e.Value)
+}
+
 type ConfigValueNotRecognizedError struct {
 	Config string
 }
@@ -19,6 +19,11 @@ var enumTypes = map[string][]string{
 		"no",
 		"auto",
 	},
+	"Curses": {
+		"yes",
+		"no",
+		"auto",
+	},
 	"HelpVerbosity": {
 		"long",
 		"medium",
@@ -30,6 +35,10 @@ var enumTypes = map[string][]string{
 		"refresh",
 		"error",
 	},
+	"ModOutput": {
+		"text",
+		"json",
+	},
 }
 
 var startupFlags = []flag{
@@ -52,6 +61,16 @@ var startupFlags = []flag{
 			defaultValue: false,
 		},
 	},
+	{
+		longName:    "output_base",
+		description: "If set, specifies the output location to which all build output will be written. Otherwise, the location will be ${OUTPUT_ROOT}/_blaze_${USER}/${MD5_OF_WORKSPACE_ROOT}. Note: If you specify a different option from one to the next Bazel invocation for this value, you'll likely start up a new, additional Bazel server. Bazel starts exactly one server per specified output base. Typically there is one output base per workspace - however, with this option you may have multiple output bases per workspace and thereby run multiple builds for the same client on the same machine concurrently. See 'bazel help shutdown' on how to shutdown a Bazel server.",
+		flagType:    stringFlagType{},
+	},
+	{
+		longName:    "output_user_root",
+		description: "The user-specific directory beneath which all build outputs are written; by default, this is a function of $USER, but by specifying a constant, build outputs can be shared between collaborating users.",
+		flagType:    stringFlagType{},
+	},
 	{
 		longName:    "system_rc",
 		description: "Whether or not to look for the system-wide bazelrc.",
@@ -69,6 +88,21 @@ var startupFlags = []flag{
 }
 
 var commonFlags = []flag{
+	{
+		longName:    "bes_backend",
+		description: "A URI of a Build Event Service (BES) backend endpoint to which build events are uploaded. The supported schemas are grpc, grpcs (grpc with TLS enabled) and unix (local UNIX sockets). If empty, build events are not uploaded.",
+		flagType:    stringFlagType{},
+	},
+	{
+		longName:    "build_event_binary_file",
+		description: "If non-empty, write a varint delimited binary representation of the build event protocol to that file.",
+		flagType:    stringFlagType{},
+	},
+	{
+		longName:    "build_event_json_file",
+		description: "If non-empty, write a JSON serialisation of the build event protocol to that file.",
+		flagType:    stringFlagType{},
+	},
 	{
 		longName:    "build_request_id",
 		description: "Unique identifier, in UUID format, for the build being run.",
@@ -87,6 +121,14 @@ var commonFlags = []flag{
 			defaultValue: "auto",
 		},
 	},
+	{
+		longName:    "curses",
+		description: "Use terminal cursor controls to minimize scrolling output.",
+		flagType: enumFlagType{
+			enumType:     "Curses",
+			defaultValue: "auto",
+		},
+	},
 	{
 		longName:    "ignore_dev_dependency",
 		description: "If true, Bazel ignores `bazel_dep` and `use_extension` declared as `dev_dependency` in the MODULE.bazel of the root module. Note that, those dev dependencies are always ignored in the MODULE.bazel if it's not the root module regardless of the value of this flag.",
@@ -117,6 +159,11 @@ var commonFlags = []flag{
 		description: "Specifies the registries to use to locate Bazel module dependencies. The order is important: modules will be looked up in earlier registries first, and only fall back to later registries when they're missing from the earlier ones.",
 		flagType:    stringListFlagType{},
 	},
+	{
+		longName:    "remote_build_queue_state",
+		description: "A URI of the bonanza_scheduler endpoint that offers the build queue state service. If set, builds are killed explicitly when interrupted. Otherwise, the scheduler abandons builds once it notices that no clients are waiting for them. The supported schemas are grpc, grpcs (grpc with TLS enabled) and unix (local UNIX sockets). Specify grpc:// or unix: schema to disable TLS.",
+		flagType:    stringFlagType{},
+	},
 	{
 		longName:    "remote_cache",
 		description: "A URI of a bonanza_storage_frontend endpoint. The supported schemas are grpc, grpcs (grpc with TLS enabled) and unix (local UNIX sockets). Specify grpc:// or unix: schema to disable TLS.",
@@ -134,6 +181,11 @@ var commonFlags = []flag{
 		description: "A 128, 192 or 256 bit AES key that is used to encrypt files and directories prior to uploading them to storage.",
 		flagType:    stringFlagType{},
 	},
+	{
+		longName:    "remote_execution_priority",
+		description: "The relative priority of the build and the actions it executes remotely. The semantics of the particular priority values are server-dependent.",
+		flagType:    int32FlagType{},
+	},
 	{
 		longName:    "remote_executor",
 		description: "A URI of a bonanza_scheduler endpoint. The supported schemas are grpc, grpcs (grpc with TLS enabled) and unix (local UNIX sockets). Specify grpc:// or unix: schema to disable TLS.",
@@ -159,17 +211,43 @@ var commonFlags = []flag{
 		description: "Value to pass as instance_name in the remote execution API.",
 		flagType:    stringFlagType{},
 	},
+	{
+		longName:    "repo_env",
+		description: "Specifies additional environment variables to be available only for repository rules. Note that repository rules see the full environment anyway, but in this way configuration information can be passed to repositories through options without invalidating the action graph.",
+		flagType:    stringListFlagType{},
+	},
 	{
 		longName:    "repo_platform",
 		description: "A label of a platform() target that is used to determine the platform that is used to execute repository rules and module extensions. If this argument is not provided, repository rules and module extensions cannot be evaluated.",
 		flagType:    stringFlagType{},
 	},
+	{
+		longName:    "show_progress_rate_limit",
+		description: "Minimum number of seconds between progress messages in the output.",
+		flagType: float64FlagType{
+			defaultValue: 0.2,
+		},
+	},
 }
 
 var commands = map[string]command{
+	"attach": {
+		ancestor:       "build",
+		takesArguments: true,
+	},
 	"build": {
 		ancestor: "common",
 		flags: []flag{
+			{
+				longName:    "action_env",
+				description: "Specifies the set of environment variables available to actions with target configuration. Variables can be either specified by name, in which case the value will be taken from the invocation environment, or by the name=value pair which sets the value independent of the invocation environment. This option can be used multiple times; for options given for the same variable, the latest wins, options for different variables accumulate.",
+				flagType:    stringListFlagType{},
+			},
+			{
+				longName:    "host_platform",
+				description: "The label of a platform rule that describes the host system.",
+				flagType:    stringFlagType{},
+			},
 			{
 				longName:    "keep_going",
 				shortName:   "k",
@@ -188,6 +266,15 @@ var commands = map[string]command{
 	},
 	"clean": {
 		ancestor: "build",
+		flags: []flag{
+			{
+				longName:    "expunge",
+				description: "If true, clean removes the entire working tree for this bazel instance, which includes all bazel-created temporary and build output files, and stops the bazel server if it is running.",
+				flagType: boolFlagType{
+					defaultValue: false,
+				},
+			},
+		},
 	},
 	"help": {
 		ancestor: "common",
@@ -236,6 +323,20 @@ var commands = map[string]command{
 	"license": {
 		ancestor: "common",
 	},
+	"mod": {
+		ancestor: "build",
+		flags: []flag{
+			{
+				longName:    "output",
+				description: "The format in which the query results should be printed. Allowed values for query are: text, json.",
+				flagType: enumFlagType{
+					enumType:     "ModOutput",
+					defaultValue: "text",
+				},
+			},
+		},
+		takesArguments: true,
+	},
 	"run": {
 		ancestor: "build",
 		flags: []flag{
@@ -170,6 +170,134 @@ func (ft expansionFlagType) emitStartupParser(longName string) {
 	panic("TODO")
 }
 
+type float64FlagType struct {
+	defaultValue float64
+}
+
+func (ft float64FlagType) emitStructField(longName string) {
+	fmt.Printf("%s float64\n", toSymbolName(longName, true))
+}
+
+func (ft float64FlagType) emitDefaultInitializer(longName string) {
+	fmt.Printf("f.%s = %#v\n", toSymbolName(longName, true), ft.defaultValue)
+}
+
+func (ft float64FlagType) emitLongNameParser(flagSetName, longName string) {
+	fmt.Printf("case %#v:\n", "--"+longName)
+	fmt.Printf("  var out *float64\n")
+	fmt.Printf("  if flags := cmd.get%sFlags(); flags != nil {\n", toSymbolName(flagSetName, true))
+	fmt.Printf("    out = &flags.%s\n", toSymbolName(longName, true))
+	fmt.Printf("  } else if mustApply {\n")
+	fmt.Printf("    return FlagNotApplicableError{Flag: longOptionName}\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  if assignmentIndex < 0 {\n")
+	fmt.Printf("    if len(*currentArgs) == 0 {\n")
+	fmt.Printf("      return FlagMissingValueError{Flag: longOptionName}\n")
+	fmt.Printf("    }\n")
+	fmt.Printf("    optionValue = (*currentArgs)[0]\n")
+	fmt.Printf("    (*currentArgs) = (*currentArgs)[1:]\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  if err := parseFloat64(optionValue, out, longOptionName); err != nil {\n")
+	fmt.Printf("    return err\n")
+	fmt.Printf("  }\n")
+}
+
+func (ft float64FlagType) emitShortNameParser(flagSetName, longName, shortName string) {
+	fmt.Printf("case %#v:\n", "-"+shortName)
+	fmt.Printf("  var out *float64\n")
+	fmt.Printf("  if flags := cmd.get%sFlags(); flags != nil {\n", toSymbolName(flagSetName, true))
+	fmt.Printf("    out = &flags.%s\n", toSymbolName(longName, true))
+	fmt.Printf("  } else if mustApply {\n")
+	fmt.Printf("    return FlagNotApplicableError{Flag: shortOptionName}\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  if len(*currentArgs) == 0 {\n")
+	fmt.Printf("    return FlagMissingValueError{Flag: shortOptionName}\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  optionValue := (*currentArgs)[0]\n")
+	fmt.Printf("  (*currentArgs) = (*currentArgs)[1:]\n")
+	fmt.Printf("  if err := parseFloat64(optionValue, out, shortOptionName); err != nil {\n")
+	fmt.Printf("    return err\n")
+	fmt.Printf("  }\n")
+}
+
+func (ft float64FlagType) emitStartupParser(longName string) {
+	fmt.Printf("case %#v:\n", "--"+longName)
+	fmt.Printf("  if assignmentIndex < 0 {\n")
+	fmt.Printf("    if argsIndex == len(args) {\n")
+	fmt.Printf("      return nil, 0, FlagMissingValueError{Flag: longOptionName}\n")
+	fmt.Printf("    }\n")
+	fmt.Printf("    optionValue = args[argsIndex]\n")
+	fmt.Printf("    argsIndex++\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  if err := parseFloat64(optionValue, &flags.%s, longOptionName); err != nil {\n", toSymbolName(longName, true))
+	fmt.Printf("    return nil, 0, err\n")
+	fmt.Printf("  }\n")
+}
+
+type int32FlagType struct {
+	defaultValue int32
+}
+
+func (ft int32FlagType) emitStructField(longName string) {
+	fmt.Printf("%s int32\n", toSymbolName(longName, true))
+}
+
+func (ft int32FlagType) emitDefaultInitializer(longName string) {
+	fmt.Printf("f.%s = %#v\n", toSymbolName(longName, true), ft.defaultValue)
+}
+
+func (ft int32FlagType) emitLongNameParser(flagSetName, longName string) {
+	fmt.Printf("case %#v:\n", "--"+longName)
+	fmt.Printf("  var out *int32\n")
+	fmt.Printf("  if flags := cmd.get%sFlags(); flags != nil {\n", toSymbolName(flagSetName, true))
+	fmt.Printf("    out = &flags.%s\n", toSymbolName(longName, true))
+	fmt.Printf("  } else if mustApply {\n")
+	fmt.Printf("    return FlagNotApplicableError{Flag: longOptionName}\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  if assignmentIndex < 0 {\n")
+	fmt.Printf("    if len(*currentArgs) == 0 {\n")
+	fmt.Printf("      return FlagMissingValueError{Flag: longOptionName}\n")
+	fmt.Printf("    }\n")
+	fmt.Printf("    optionValue = (*currentArgs)[0]\n")
+	fmt.Printf("    (*currentArgs) = (*currentArgs)[1:]\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  if err := parseInt32(optionValue, out, longOptionName); err != nil {\n")
+	fmt.Printf("    return err\n")
+	fmt.Printf("  }\n")
+}
+
+func (ft int32FlagType) emitShortNameParser(flagSetName, longName, shortName string) {
+	fmt.Printf("case %#v:\n", "-"+shortName)
+	fmt.Printf("  var out *int32\n")
+	fmt.Printf("  if flags := cmd.get%sFlags(); flags != nil {\n", toSymbolName(flagSetName, true))
+	fmt.Printf("    out = &flags.%s\n", toSymbolName(longName, true))
+	fmt.Printf("  } else if mustApply {\n")
+	fmt.Printf("    return FlagNotApplicableError{Flag: shortOptionName}\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  if len(*currentArgs) == 0 {\n")
+	fmt.Printf("    return FlagMissingValueError{Flag: shortOptionName}\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  optionValue := (*currentArgs)[0]\n")
+	fmt.Printf("  (*currentArgs) = (*currentArgs)[1:]\n")
+	fmt.Printf("  if err := parseInt32(optionValue, out, shortOptionName); err != nil {\n")
+	fmt.Printf("    return err\n")
+	fmt.Printf("  }\n")
+}
+
+func (ft int32FlagType) emitStartupParser(longName string) {
+	fmt.Printf("case %#v:\n", "--"+longName)
+	fmt.Printf("  if assignmentIndex < 0 {\n")
+	fmt.Printf("    if argsIndex == len(args) {\n")
+	fmt.Printf("      return nil, 0, FlagMissingValueError{Flag: longOptionName}\n")
+	fmt.Printf("    }\n")
+	fmt.Printf("    optionValue = args[argsIndex]\n")
+	fmt.Printf("    argsIndex++\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  if err := parseInt32(optionValue, &flags.%s, longOptionName); err != nil {\n", toSymbolName(longName, true))
+	fmt.Printf("    return nil, 0, err\n")
+	fmt.Printf("  }\n")
+}
+
 type stringFlagType struct {
 	defaultValue string
 }
@@ -207,7 +335,15 @@ func (ft stringFlagType) emitShortNameParser(flagSetName, longName, shortName st
 }
 
 func (ft stringFlagType) emitStartupParser(longName string) {
-	panic("TODO")
+	fmt.Printf("case %#v:\n", "--"+longName)
+	fmt.Printf("  if assignmentIndex < 0 {\n")
+	fmt.Printf("    if argsIndex == len(args) {\n")
+	fmt.Printf("      return nil, 0, FlagMissingValueError{Flag: longOptionName}\n")
+	fmt.Printf("    }\n")
+	fmt.Printf("    optionValue = args[argsIndex]\n")
+	fmt.Printf("    argsIndex++\n")
+	fmt.Printf("  }\n")
+	fmt.Printf("  flags.%s = optionValue\n", toSymbolName(longName, true))
 }
 
 type stringListFlagType struct{}
@@ -5,22 +5,30 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
 )
 
-func Parse(args []string, rootDirectory filesystem.Directory, pathFormat path.Format, workspacePath, homeDirectoryPath, workingDirectoryPath path.Parser) (Command, error) {
+// Parse the command line arguments of bonanza_bazel, taking the
+// contents of any bazelrc files into account. Both the startup flags
+// and the command are returned, as the former affect the behavior of
+// all commands (e.g., the location of the output base).
+func Parse(args []string, rootDirectory filesystem.Directory, pathFormat path.Format, workspacePath, homeDirectoryPath, workingDirectoryPath path.Parser) (*StartupFlags, Command, error) {
 	startupFlags, argsParsed, err := ParseStartupFlags(args)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	args = args[argsParsed:]
 
 	bazelRCPaths, err := GetBazelRCPaths(startupFlags, pathFormat, workspacePath, homeDirectoryPath, workingDirectoryPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	configurationDirectives, err := ParseBazelRCFiles(bazelRCPaths, rootDirectory, pathFormat, workspacePath, workingDirectoryPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return ParseCommandAndArguments(configurationDirectives, args)
+	command, err := ParseCommandAndArguments(configurationDirectives, args)
+	if err != nil {
+		return nil, nil, err
+	}
+	return startupFlags, command, nil
 }
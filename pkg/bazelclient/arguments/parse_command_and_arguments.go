@@ -1,5 +1,9 @@
 package arguments
 
+import (
+	"strconv"
+)
+
 type Command interface {
 	Reset()
 }
@@ -67,3 +71,31 @@ func parseBool(hasValue bool, value string, out *bool, flagName string) error {
 	}
 	return nil
 }
+
+func parseFloat64(value string, out *float64, flagName string) error {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return FlagInvalidFloat64ValueError{
+			Flag:  flagName,
+			Value: value,
+		}
+	}
+	if out != nil {
+		*out = v
+	}
+	return nil
+}
+
+func parseInt32(value string, out *int32, flagName string) error {
+	v, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return FlagInvalidInt32ValueError{
+			Flag:  flagName,
+			Value: value,
+		}
+	}
+	if out != nil {
+		*out = int32(v)
+	}
+	return nil
+}
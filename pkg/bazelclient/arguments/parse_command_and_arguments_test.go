@@ -190,6 +190,83 @@ func TestParseCommandAndArguments(t *testing.T) {
 			require.EqualError(t, err, "flag --nokeep_going does not take a value")
 		})
 
+		t.Run("RemoteExecutionPriorityNegative", func(t *testing.T) {
+			command, err := arguments.ParseCommandAndArguments(
+				arguments.ConfigurationDirectives{},
+				[]string{
+					"build",
+					"--remote_execution_priority=-10",
+					"//...",
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, int32(-10), command.(*arguments.BuildCommand).CommonFlags.RemoteExecutionPriority)
+		})
+
+		t.Run("RemoteExecutionPriorityOutOfRange", func(t *testing.T) {
+			_, err := arguments.ParseCommandAndArguments(
+				arguments.ConfigurationDirectives{},
+				[]string{
+					"build",
+					"--remote_execution_priority",
+					"4294967296",
+					"//...",
+				},
+			)
+			require.EqualError(t, err, "flag --remote_execution_priority only accepts 32-bit integers, not \"4294967296\"")
+		})
+
+		t.Run("ShowProgressRateLimitDefault", func(t *testing.T) {
+			command, err := arguments.ParseCommandAndArguments(
+				arguments.ConfigurationDirectives{},
+				[]string{
+					"build",
+					"//...",
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, 0.2, command.(*arguments.BuildCommand).CommonFlags.ShowProgressRateLimit)
+		})
+
+		t.Run("ShowProgressRateLimitEquals", func(t *testing.T) {
+			command, err := arguments.ParseCommandAndArguments(
+				arguments.ConfigurationDirectives{},
+				[]string{
+					"build",
+					"--show_progress_rate_limit=1.5",
+					"//...",
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, 1.5, command.(*arguments.BuildCommand).CommonFlags.ShowProgressRateLimit)
+		})
+
+		t.Run("ShowProgressRateLimitSpace", func(t *testing.T) {
+			command, err := arguments.ParseCommandAndArguments(
+				arguments.ConfigurationDirectives{},
+				[]string{
+					"build",
+					"--show_progress_rate_limit",
+					"5",
+					"//...",
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, 5.0, command.(*arguments.BuildCommand).CommonFlags.ShowProgressRateLimit)
+		})
+
+		t.Run("ShowProgressRateLimitInvalid", func(t *testing.T) {
+			_, err := arguments.ParseCommandAndArguments(
+				arguments.ConfigurationDirectives{},
+				[]string{
+					"build",
+					"--show_progress_rate_limit=fast",
+					"//...",
+				},
+			)
+			require.EqualError(t, err, "flag --show_progress_rate_limit only accepts floating point numbers, not \"fast\"")
+		})
+
 		t.Run("PositiveNegativePatterns", func(t *testing.T) {
 			command, err := arguments.ParseCommandAndArguments(
 				arguments.ConfigurationDirectives{},
@@ -62,6 +62,9 @@ func TestParseStartupFlags(t *testing.T) {
 			"/home/alice/.bazelrc",
 			"--bazelrc=/home/bob/.bazelrc",
 			"--home_rc=1",
+			"--output_base",
+			"/home/alice/.cache/bazel",
+			"--output_user_root=/home/alice/.cache",
 			"--nosystem_rc",
 			"--workspace_rc=0",
 			"test",
@@ -75,9 +78,11 @@ func TestParseStartupFlags(t *testing.T) {
 			},
 			HomeRc:           true,
 			IgnoreAllRcFiles: false,
+			OutputBase:       "/home/alice/.cache/bazel",
+			OutputUserRoot:   "/home/alice/.cache",
 			SystemRc:         false,
 			WorkspaceRc:      false,
 		}, flags)
-		require.Equal(t, 6, argsConsumed)
+		require.Equal(t, 9, argsConsumed)
 	})
 }
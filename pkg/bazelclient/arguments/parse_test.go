@@ -18,9 +18,10 @@ func TestParse(t *testing.T) {
 	t.Run("Build", func(t *testing.T) {
 		rootDirectory := NewMockDirectory(ctrl)
 
-		command, err := arguments.Parse(
+		startupFlags, command, err := arguments.Parse(
 			[]string{
 				"--ignore_all_rc_files",
+				"--output_base=/home/bob/.cache/myproject",
 				"build",
 				"--keep_going",
 				"//...",
@@ -32,11 +33,20 @@ func TestParse(t *testing.T) {
 			/* workingDirectoryPath = */ path.UNIXFormat.NewParser("/home/bob/myproject/src"),
 		)
 		require.NoError(t, err)
+		require.Equal(t, &arguments.StartupFlags{
+			HomeRc:           true,
+			IgnoreAllRcFiles: true,
+			OutputBase:       "/home/bob/.cache/myproject",
+			SystemRc:         true,
+			WorkspaceRc:      true,
+		}, startupFlags)
 		require.Equal(t, &arguments.BuildCommand{
 			CommonFlags: arguments.CommonFlags{
 				Color:                  arguments.Color_Auto,
+				Curses:                 arguments.Curses_Auto,
 				LockfileMode:           arguments.LockfileMode_Update,
 				RemoteCacheCompression: true,
+				ShowProgressRateLimit:  0.2,
 			},
 			BuildFlags: arguments.BuildFlags{
 				KeepGoing: true,
@@ -101,7 +111,7 @@ func TestParse(t *testing.T) {
 		srcDirectory.EXPECT().
 			Close()
 
-		command, err := arguments.Parse(
+		_, command, err := arguments.Parse(
 			[]string{
 				"version",
 			},
@@ -0,0 +1,262 @@
+package buildevents
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	buildeventstream_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/buildeventstream"
+
+	build_pb "google.golang.org/genproto/googleapis/devtools/build/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maximumReconnectAttempts is the maximum number of times a BES sink
+// attempts to reestablish its stream after the connection to the
+// backend was lost, without any events being acknowledged in between.
+const maximumReconnectAttempts = 5
+
+// besStream holds the state of a single stream to the Build Event
+// Service backend. Acknowledgements sent over the stream are received
+// by a separate goroutine.
+type besStream struct {
+	client build_pb.PublishBuildEvent_PublishBuildToolEventStreamClient
+
+	// Closed when the goroutine receiving acknowledgements
+	// terminates. Once closed, err contains the error with which
+	// the stream failed, or nil if the stream was closed after all
+	// events were acknowledged.
+	done chan struct{}
+	err  error
+}
+
+type besSink struct {
+	ctx      context.Context
+	client   build_pb.PublishBuildEventClient
+	clock    clock.Clock
+	stream   *besStream
+	streamID *build_pb.StreamId
+
+	sequenceNumber int64
+
+	lock              sync.Mutex
+	unacknowledged    []*build_pb.PublishBuildToolEventStreamRequest
+	reconnectAttempts int
+}
+
+// NewBESSink creates a Sink that uploads build events to a Build Event
+// Service (BES) backend, using the same protocol as Bazel's
+// --bes_backend flag.
+//
+// Events are retained until the backend acknowledges them.
+// Acknowledgements are received while events are being sent, so that
+// the number of retained events remains small. If the stream fails
+// with a transient error, a new stream is created to which all
+// unacknowledged events are sent once more.
+func NewBESSink(ctx context.Context, client build_pb.PublishBuildEventClient, clock clock.Clock, invocationID, buildRequestID string) (Sink, error) {
+	s := &besSink{
+		ctx:    ctx,
+		client: client,
+		clock:  clock,
+		streamID: &build_pb.StreamId{
+			BuildId:      buildRequestID,
+			InvocationId: invocationID,
+			Component:    build_pb.StreamId_TOOL,
+		},
+	}
+	if err := s.startStream(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// startStream creates a new stream, and launches a goroutine for
+// receiving acknowledgements sent over it.
+func (s *besSink) startStream() error {
+	client, err := s.client.PublishBuildToolEventStream(s.ctx)
+	if err != nil {
+		return err
+	}
+	stream := &besStream{
+		client: client,
+		done:   make(chan struct{}),
+	}
+	s.stream = stream
+	go s.receiveAcknowledgements(stream)
+	return nil
+}
+
+// receiveAcknowledgements receives acknowledgements from the backend
+// until the stream terminates. Events that are acknowledged are removed
+// from the list of unacknowledged events, so that they are not sent
+// again when reconnecting.
+func (s *besSink) receiveAcknowledgements(stream *besStream) {
+	defer close(stream.done)
+	for {
+		response, err := stream.client.Recv()
+		if err != nil {
+			if err == io.EOF {
+				s.lock.Lock()
+				unacknowledged := len(s.unacknowledged)
+				s.lock.Unlock()
+				if unacknowledged > 0 {
+					stream.err = status.Errorf(codes.Internal, "Build event service closed the stream without acknowledging %d events", unacknowledged)
+				}
+			} else {
+				stream.err = err
+			}
+			return
+		}
+
+		s.lock.Lock()
+		acknowledged := 0
+		for acknowledged < len(s.unacknowledged) && s.unacknowledged[acknowledged].OrderedBuildEvent.SequenceNumber <= response.SequenceNumber {
+			acknowledged++
+		}
+		if acknowledged > 0 {
+			s.unacknowledged = s.unacknowledged[acknowledged:]
+			s.reconnectAttempts = 0
+		}
+		s.lock.Unlock()
+	}
+}
+
+// awaitStreamTermination waits for the current stream to terminate,
+// and returns the error with which it failed. Streams should not
+// terminate while events are still being sent, meaning that clean
+// termination is reported as an error as well.
+func (s *besSink) awaitStreamTermination() error {
+	<-s.stream.done
+	if err := s.stream.err; err != nil {
+		return err
+	}
+	return status.Error(codes.Internal, "Build event service closed the stream while events were still being sent")
+}
+
+// sendUnacknowledged sends all events that have not been acknowledged
+// by the backend over the current stream.
+func (s *besSink) sendUnacknowledged() error {
+	s.lock.Lock()
+	unacknowledged := s.unacknowledged
+	s.lock.Unlock()
+	for _, request := range unacknowledged {
+		if err := s.stream.client.Send(request); err != nil {
+			if !errors.Is(err, io.EOF) {
+				return err
+			}
+			// The stream has failed. The actual error is
+			// returned by Recv().
+			return s.awaitStreamTermination()
+		}
+	}
+	return nil
+}
+
+// reconnect to the backend after the current stream failed, for as
+// long as it fails with transient errors. All events that have not
+// been acknowledged are sent over the new stream.
+func (s *besSink) reconnect(err error) error {
+	for {
+		s.lock.Lock()
+		reconnectAttempts := s.reconnectAttempts
+		if status.Code(err) != codes.Unavailable || reconnectAttempts >= maximumReconnectAttempts {
+			s.lock.Unlock()
+			return err
+		}
+		reconnectAttempts++
+		s.reconnectAttempts = reconnectAttempts
+		s.lock.Unlock()
+
+		timer, t := s.clock.NewTimer(time.Duration(reconnectAttempts) * time.Second)
+		select {
+		case <-s.ctx.Done():
+			timer.Stop()
+			return util.StatusFromContext(s.ctx)
+		case <-t:
+		}
+		if err = s.startStream(); err == nil {
+			if err = s.sendUnacknowledged(); err == nil {
+				return nil
+			}
+		}
+	}
+}
+
+func (s *besSink) publish(event *build_pb.BuildEvent) error {
+	// Sequence numbers of events within a stream start at 1.
+	s.sequenceNumber++
+	request := &build_pb.PublishBuildToolEventStreamRequest{
+		OrderedBuildEvent: &build_pb.OrderedBuildEvent{
+			StreamId:       s.streamID,
+			SequenceNumber: s.sequenceNumber,
+			Event:          event,
+		},
+	}
+	s.lock.Lock()
+	s.unacknowledged = append(s.unacknowledged, request)
+	s.lock.Unlock()
+
+	// If the stream already failed, reconnect immediately. This
+	// causes the event to be sent over the new stream.
+	select {
+	case <-s.stream.done:
+		return s.reconnect(s.awaitStreamTermination())
+	default:
+	}
+	if err := s.stream.client.Send(request); err != nil {
+		if !errors.Is(err, io.EOF) {
+			return err
+		}
+		return s.reconnect(s.awaitStreamTermination())
+	}
+	return nil
+}
+
+func (s *besSink) Send(event *buildeventstream_pb.BuildEvent) error {
+	bazelEvent, err := anypb.New(event)
+	if err != nil {
+		return err
+	}
+	return s.publish(&build_pb.BuildEvent{
+		EventTime: timestamppb.New(s.clock.Now()),
+		Event: &build_pb.BuildEvent_BazelEvent{
+			BazelEvent: bazelEvent,
+		},
+	})
+}
+
+func (s *besSink) Close() error {
+	if err := s.publish(&build_pb.BuildEvent{
+		EventTime: timestamppb.New(s.clock.Now()),
+		Event: &build_pb.BuildEvent_ComponentStreamFinished{
+			ComponentStreamFinished: &build_pb.BuildEvent_BuildComponentStreamFinished{
+				Type: build_pb.BuildEvent_BuildComponentStreamFinished_FINISHED,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	// Close the sending side of the stream and wait for the
+	// backend to acknowledge all events. If the stream fails, send
+	// all unacknowledged events over a new stream.
+	for {
+		if err := s.stream.client.CloseSend(); err != nil {
+			return err
+		}
+		<-s.stream.done
+		if s.stream.err == nil {
+			return nil
+		}
+		if err := s.reconnect(s.stream.err); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,351 @@
+package buildevents_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/buildevents"
+	buildeventstream_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/buildeventstream"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+	build_pb "google.golang.org/genproto/googleapis/devtools/build/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBESSink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	streamID := &build_pb.StreamId{
+		BuildId:      "4a5c7b87-8d8e-4a26-9a49-a1d6b2d9e5e4",
+		InvocationId: "b8c10fe7-0d4a-4b0f-9c0e-4a6d52ce4b8e",
+		Component:    build_pb.StreamId_TOOL,
+	}
+	eventTime := time.Unix(1700000000, 0)
+
+	event1 := &buildeventstream_pb.BuildEvent{
+		Id: getTestProgressID(0),
+		Payload: &buildeventstream_pb.BuildEvent_Progress{
+			Progress: &buildeventstream_pb.Progress{},
+		},
+	}
+	event2 := newTestBuildFinishedEvent()
+
+	// getRequest returns the request that is expected to be sent
+	// for a given build event. Passing nil yields the request that
+	// marks the end of the stream.
+	getRequest := func(sequenceNumber int64, event *buildeventstream_pb.BuildEvent) *build_pb.PublishBuildToolEventStreamRequest {
+		buildEvent := &build_pb.BuildEvent{
+			EventTime: timestamppb.New(eventTime),
+		}
+		if event == nil {
+			buildEvent.Event = &build_pb.BuildEvent_ComponentStreamFinished{
+				ComponentStreamFinished: &build_pb.BuildEvent_BuildComponentStreamFinished{
+					Type: build_pb.BuildEvent_BuildComponentStreamFinished_FINISHED,
+				},
+			}
+		} else {
+			bazelEvent, err := anypb.New(event)
+			require.NoError(t, err)
+			buildEvent.Event = &build_pb.BuildEvent_BazelEvent{
+				BazelEvent: bazelEvent,
+			}
+		}
+		return &build_pb.PublishBuildToolEventStreamRequest{
+			OrderedBuildEvent: &build_pb.OrderedBuildEvent{
+				StreamId:       streamID,
+				SequenceNumber: sequenceNumber,
+				Event:          buildEvent,
+			},
+		}
+	}
+	getResponse := func(sequenceNumber int64) *build_pb.PublishBuildToolEventStreamResponse {
+		return &build_pb.PublishBuildToolEventStreamResponse{
+			StreamId:       streamID,
+			SequenceNumber: sequenceNumber,
+		}
+	}
+
+	// newSink creates a BES sink, and returns the stream that was
+	// created as part of it.
+	newSink := func(ctx context.Context, client *MockPublishBuildEventClient, mockClock *MockClock) (buildevents.Sink, *MockPublishBuildEvent_PublishBuildToolEventStreamClient) {
+		mockClock.EXPECT().Now().Return(eventTime).AnyTimes()
+		stream := NewMockPublishBuildEvent_PublishBuildToolEventStreamClient(ctrl)
+		client.EXPECT().PublishBuildToolEventStream(ctx).Return(stream, nil)
+		sink, err := buildevents.NewBESSink(ctx, client, mockClock, streamID.InvocationId, streamID.BuildId)
+		require.NoError(t, err)
+		return sink, stream
+	}
+
+	// expectBackoff sets up an expectation for a timer that is
+	// used to back off before reconnecting.
+	expectBackoff := func(mockClock *MockClock, d time.Duration) {
+		timer := NewMockTimer(ctrl)
+		mockClock.EXPECT().NewTimer(d).DoAndReturn(func(d time.Duration) (clock.Timer, <-chan time.Time) {
+			t := make(chan time.Time, 1)
+			t <- eventTime.Add(d)
+			return timer, t
+		})
+	}
+
+	// Acknowledgements are received by a separate goroutine. Let
+	// calls to Recv() block until the events they acknowledge have
+	// been sent, or until the stream is closed.
+	signal := func(ch chan struct{}) func(any) {
+		return func(any) { close(ch) }
+	}
+	recvAfter := func(ch <-chan struct{}, response *build_pb.PublishBuildToolEventStreamResponse, err error) func() (*build_pb.PublishBuildToolEventStreamResponse, error) {
+		return func() (*build_pb.PublishBuildToolEventStreamResponse, error) {
+			<-ch
+			return response, err
+		}
+	}
+
+	t.Run("StreamCreationFailure", func(t *testing.T) {
+		client := NewMockPublishBuildEventClient(ctrl)
+		client.EXPECT().PublishBuildToolEventStream(gomock.Any()).
+			Return(nil, status.Error(codes.Unavailable, "Server offline"))
+
+		_, err := buildevents.NewBESSink(context.Background(), client, NewMockClock(ctrl), streamID.InvocationId, streamID.BuildId)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// Events should be sent with sequence numbers
+		// starting at one, followed by an event marking the
+		// end of the stream. Close() should wait for all
+		// events to be acknowledged.
+		client := NewMockPublishBuildEventClient(ctrl)
+		mockClock := NewMockClock(ctrl)
+		sink, stream := newSink(context.Background(), client, mockClock)
+		sent1 := make(chan struct{})
+		closed := make(chan struct{})
+		gomock.InOrder(
+			stream.EXPECT().Send(testutil.EqProto(t, getRequest(1, event1))).Do(signal(sent1)),
+			stream.EXPECT().Send(testutil.EqProto(t, getRequest(2, event2))),
+			stream.EXPECT().Send(testutil.EqProto(t, getRequest(3, nil))),
+			stream.EXPECT().CloseSend().Do(func() { close(closed) }),
+		)
+		gomock.InOrder(
+			stream.EXPECT().Recv().DoAndReturn(recvAfter(sent1, getResponse(1), nil)),
+			stream.EXPECT().Recv().DoAndReturn(recvAfter(closed, getResponse(3), nil)),
+			stream.EXPECT().Recv().Return(nil, io.EOF),
+		)
+
+		require.NoError(t, sink.Send(event1))
+		require.NoError(t, sink.Send(event2))
+		require.NoError(t, sink.Close())
+	})
+
+	t.Run("Reconnect", func(t *testing.T) {
+		// If the stream fails with a transient error, a new
+		// stream should be created immediately. Only events
+		// that were not acknowledged should be sent over it,
+		// using their original sequence numbers.
+		ctx := context.Background()
+		client := NewMockPublishBuildEventClient(ctrl)
+		mockClock := NewMockClock(ctrl)
+		sink, stream1 := newSink(ctx, client, mockClock)
+		sent1 := make(chan struct{})
+		failed := make(chan struct{})
+		gomock.InOrder(
+			stream1.EXPECT().Send(testutil.EqProto(t, getRequest(1, event1))).Do(signal(sent1)),
+			stream1.EXPECT().Send(testutil.EqProto(t, getRequest(2, event2))).Do(signal(failed)).Return(io.EOF),
+		)
+		gomock.InOrder(
+			stream1.EXPECT().Recv().DoAndReturn(recvAfter(sent1, getResponse(1), nil)),
+			stream1.EXPECT().Recv().DoAndReturn(recvAfter(failed, nil, status.Error(codes.Unavailable, "Connection reset by peer"))),
+		)
+		expectBackoff(mockClock, time.Second)
+		stream2 := NewMockPublishBuildEvent_PublishBuildToolEventStreamClient(ctrl)
+		closed := make(chan struct{})
+		gomock.InOrder(
+			client.EXPECT().PublishBuildToolEventStream(ctx).Return(stream2, nil),
+			stream2.EXPECT().Send(testutil.EqProto(t, getRequest(2, event2))),
+			stream2.EXPECT().Send(testutil.EqProto(t, getRequest(3, nil))),
+			stream2.EXPECT().CloseSend().Do(func() { close(closed) }),
+		)
+		gomock.InOrder(
+			stream2.EXPECT().Recv().DoAndReturn(recvAfter(closed, getResponse(3), nil)),
+			stream2.EXPECT().Recv().Return(nil, io.EOF),
+		)
+
+		require.NoError(t, sink.Send(event1))
+		require.NoError(t, sink.Send(event2))
+		require.NoError(t, sink.Close())
+	})
+
+	t.Run("ReconnectWhileClosing", func(t *testing.T) {
+		// Streams may also fail while waiting for events to be
+		// acknowledged. The events should be sent over a new
+		// stream, which is closed once more.
+		ctx := context.Background()
+		client := NewMockPublishBuildEventClient(ctrl)
+		mockClock := NewMockClock(ctrl)
+		sink, stream1 := newSink(ctx, client, mockClock)
+		closed1 := make(chan struct{})
+		gomock.InOrder(
+			stream1.EXPECT().Send(testutil.EqProto(t, getRequest(1, event1))),
+			stream1.EXPECT().Send(testutil.EqProto(t, getRequest(2, nil))),
+			stream1.EXPECT().CloseSend().Do(func() { close(closed1) }),
+		)
+		stream1.EXPECT().Recv().DoAndReturn(recvAfter(closed1, nil, status.Error(codes.Unavailable, "Connection reset by peer")))
+		expectBackoff(mockClock, time.Second)
+		stream2 := NewMockPublishBuildEvent_PublishBuildToolEventStreamClient(ctrl)
+		closed2 := make(chan struct{})
+		gomock.InOrder(
+			client.EXPECT().PublishBuildToolEventStream(ctx).Return(stream2, nil),
+			stream2.EXPECT().Send(testutil.EqProto(t, getRequest(1, event1))),
+			stream2.EXPECT().Send(testutil.EqProto(t, getRequest(2, nil))),
+			stream2.EXPECT().CloseSend().Do(func() { close(closed2) }),
+		)
+		gomock.InOrder(
+			stream2.EXPECT().Recv().DoAndReturn(recvAfter(closed2, getResponse(2), nil)),
+			stream2.EXPECT().Recv().Return(nil, io.EOF),
+		)
+
+		require.NoError(t, sink.Send(event1))
+		require.NoError(t, sink.Close())
+	})
+
+	t.Run("RetryBudgetExhausted", func(t *testing.T) {
+		// Reconnecting should stop after five attempts,
+		// with the backoff increasing every time. Both
+		// failures to create a stream and failures of the
+		// stream itself count as attempts.
+		ctx := context.Background()
+		client := NewMockPublishBuildEventClient(ctrl)
+		mockClock := NewMockClock(ctrl)
+		sink, stream := newSink(ctx, client, mockClock)
+		failed := make(chan struct{})
+		stream.EXPECT().Send(testutil.EqProto(t, getRequest(1, nil))).Do(signal(failed)).Return(io.EOF)
+		stream.EXPECT().Recv().DoAndReturn(recvAfter(failed, nil, status.Error(codes.Unavailable, "Connection reset by peer")))
+		for i := 1; i <= 5; i++ {
+			expectBackoff(mockClock, time.Duration(i)*time.Second)
+			if i%2 == 0 {
+				client.EXPECT().PublishBuildToolEventStream(ctx).
+					Return(nil, status.Error(codes.Unavailable, "Server offline"))
+			} else {
+				stream := NewMockPublishBuildEvent_PublishBuildToolEventStreamClient(ctrl)
+				closed := make(chan struct{})
+				gomock.InOrder(
+					client.EXPECT().PublishBuildToolEventStream(ctx).Return(stream, nil),
+					stream.EXPECT().Send(testutil.EqProto(t, getRequest(1, nil))),
+					stream.EXPECT().CloseSend().Do(func() { close(closed) }),
+				)
+				stream.EXPECT().Recv().DoAndReturn(recvAfter(closed, nil, status.Error(codes.Unavailable, "Connection reset by peer")))
+			}
+		}
+
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Connection reset by peer"), sink.Close())
+	})
+
+	t.Run("RetryBudgetReset", func(t *testing.T) {
+		// Receiving acknowledgements should reset the number
+		// of reconnection attempts.
+		ctx := context.Background()
+		client := NewMockPublishBuildEventClient(ctrl)
+		mockClock := NewMockClock(ctrl)
+		sink, stream1 := newSink(ctx, client, mockClock)
+		closed1 := make(chan struct{})
+		gomock.InOrder(
+			stream1.EXPECT().Send(testutil.EqProto(t, getRequest(1, event1))),
+			stream1.EXPECT().Send(testutil.EqProto(t, getRequest(2, nil))),
+			stream1.EXPECT().CloseSend().Do(func() { close(closed1) }),
+		)
+		stream1.EXPECT().Recv().DoAndReturn(recvAfter(closed1, nil, status.Error(codes.Unavailable, "Connection reset by peer")))
+		expectBackoff(mockClock, time.Second)
+		stream2 := NewMockPublishBuildEvent_PublishBuildToolEventStreamClient(ctrl)
+		closed2 := make(chan struct{})
+		gomock.InOrder(
+			client.EXPECT().PublishBuildToolEventStream(ctx).Return(stream2, nil),
+			stream2.EXPECT().Send(testutil.EqProto(t, getRequest(1, event1))),
+			stream2.EXPECT().Send(testutil.EqProto(t, getRequest(2, nil))),
+			stream2.EXPECT().CloseSend().Do(func() { close(closed2) }),
+		)
+		gomock.InOrder(
+			stream2.EXPECT().Recv().DoAndReturn(recvAfter(closed2, getResponse(1), nil)),
+			stream2.EXPECT().Recv().Return(nil, status.Error(codes.Unavailable, "Connection reset by peer")),
+		)
+		expectBackoff(mockClock, time.Second)
+		stream3 := NewMockPublishBuildEvent_PublishBuildToolEventStreamClient(ctrl)
+		closed3 := make(chan struct{})
+		gomock.InOrder(
+			client.EXPECT().PublishBuildToolEventStream(ctx).Return(stream3, nil),
+			stream3.EXPECT().Send(testutil.EqProto(t, getRequest(2, nil))),
+			stream3.EXPECT().CloseSend().Do(func() { close(closed3) }),
+		)
+		gomock.InOrder(
+			stream3.EXPECT().Recv().DoAndReturn(recvAfter(closed3, getResponse(2), nil)),
+			stream3.EXPECT().Recv().Return(nil, io.EOF),
+		)
+
+		require.NoError(t, sink.Send(event1))
+		require.NoError(t, sink.Close())
+	})
+
+	t.Run("NonRetriableError", func(t *testing.T) {
+		// Errors other than UNAVAILABLE should be returned
+		// immediately, without reconnecting.
+		client := NewMockPublishBuildEventClient(ctrl)
+		mockClock := NewMockClock(ctrl)
+		sink, stream := newSink(context.Background(), client, mockClock)
+		failed := make(chan struct{})
+		stream.EXPECT().Send(testutil.EqProto(t, getRequest(1, nil))).Do(signal(failed)).Return(io.EOF)
+		stream.EXPECT().Recv().DoAndReturn(recvAfter(failed, nil, status.Error(codes.PermissionDenied, "Not authorized to publish build events")))
+
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Not authorized to publish build events"), sink.Close())
+	})
+
+	t.Run("MissingAcknowledgements", func(t *testing.T) {
+		// Backends should acknowledge all events before
+		// closing the stream.
+		client := NewMockPublishBuildEventClient(ctrl)
+		mockClock := NewMockClock(ctrl)
+		sink, stream := newSink(context.Background(), client, mockClock)
+		closed := make(chan struct{})
+		gomock.InOrder(
+			stream.EXPECT().Send(testutil.EqProto(t, getRequest(1, event1))),
+			stream.EXPECT().Send(testutil.EqProto(t, getRequest(2, nil))),
+			stream.EXPECT().CloseSend().Do(func() { close(closed) }),
+		)
+		gomock.InOrder(
+			stream.EXPECT().Recv().DoAndReturn(recvAfter(closed, getResponse(1), nil)),
+			stream.EXPECT().Recv().Return(nil, io.EOF),
+		)
+
+		require.NoError(t, sink.Send(event1))
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Build event service closed the stream without acknowledging 1 events"), sink.Close())
+	})
+
+	t.Run("ContextCanceled", func(t *testing.T) {
+		// Backing off should be interrupted if the context
+		// is canceled.
+		ctx, cancel := context.WithCancel(context.Background())
+		client := NewMockPublishBuildEventClient(ctrl)
+		mockClock := NewMockClock(ctrl)
+		sink, stream := newSink(ctx, client, mockClock)
+		closed := make(chan struct{})
+		gomock.InOrder(
+			stream.EXPECT().Send(testutil.EqProto(t, getRequest(1, nil))),
+			stream.EXPECT().CloseSend().Do(func() { close(closed) }),
+		)
+		stream.EXPECT().Recv().DoAndReturn(func() (*build_pb.PublishBuildToolEventStreamResponse, error) {
+			<-closed
+			cancel()
+			return nil, status.Error(codes.Unavailable, "Connection reset by peer")
+		})
+		timer := NewMockTimer(ctrl)
+		mockClock.EXPECT().NewTimer(time.Second).Return(timer, nil)
+		timer.EXPECT().Stop().Return(true)
+
+		testutil.RequireEqualStatus(t, status.Error(codes.Canceled, "context canceled"), sink.Close())
+	})
+}
@@ -0,0 +1,70 @@
+package buildevents
+
+import (
+	"bufio"
+	"io"
+	"os"
+
+	buildeventstream_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/buildeventstream"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type fileSink struct {
+	file    *os.File
+	writer  *bufio.Writer
+	marshal func(w io.Writer, event *buildeventstream_pb.BuildEvent) error
+}
+
+func newFileSink(path string, marshal func(w io.Writer, event *buildeventstream_pb.BuildEvent) error) (Sink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o666)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{
+		file:    f,
+		writer:  bufio.NewWriter(f),
+		marshal: marshal,
+	}, nil
+}
+
+// NewJSONFileSink creates a Sink that writes build events to a file,
+// using the same format as Bazel's --build_event_json_file flag. Every
+// line in the file contains a single build event in JSON format.
+func NewJSONFileSink(path string) (Sink, error) {
+	return newFileSink(path, func(w io.Writer, event *buildeventstream_pb.BuildEvent) error {
+		data, err := protojson.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(data, '\n')); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+// NewBinaryFileSink creates a Sink that writes build events to a file,
+// using the same format as Bazel's --build_event_binary_file flag.
+// Build events are stored in binary form, each prefixed with its size
+// encoded as a varint.
+func NewBinaryFileSink(path string) (Sink, error) {
+	return newFileSink(path, func(w io.Writer, event *buildeventstream_pb.BuildEvent) error {
+		_, err := protodelim.MarshalTo(w, event)
+		return err
+	})
+}
+
+func (s *fileSink) Send(event *buildeventstream_pb.BuildEvent) error {
+	return s.marshal(s.writer, event)
+}
+
+func (s *fileSink) Close() error {
+	errFlush := s.writer.Flush()
+	errClose := s.file.Close()
+	if errFlush != nil {
+		return errFlush
+	}
+	return errClose
+}
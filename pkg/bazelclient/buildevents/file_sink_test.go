@@ -0,0 +1,109 @@
+package buildevents_test
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/buildevents"
+	buildeventstream_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/buildeventstream"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFileSink(t *testing.T) {
+	events := []*buildeventstream_pb.BuildEvent{
+		{
+			Id: getTestProgressID(0),
+			Children: []*buildeventstream_pb.BuildEventId{
+				getTestProgressID(1),
+			},
+			Payload: &buildeventstream_pb.BuildEvent_Progress{
+				Progress: &buildeventstream_pb.Progress{
+					Stderr: "WARNING: foo\n",
+				},
+			},
+		},
+		newTestBuildFinishedEvent(),
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+		// Every line should contain a single event.
+		path := filepath.Join(t.TempDir(), "events.json")
+		sink, err := buildevents.NewJSONFileSink(path)
+		require.NoError(t, err)
+		for _, event := range events {
+			require.NoError(t, sink.Send(event))
+		}
+		require.NoError(t, sink.Close())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		lines := strings.Split(string(data), "\n")
+		require.Len(t, lines, len(events)+1)
+		require.Empty(t, lines[len(events)])
+		for i, event := range events {
+			var parsedEvent buildeventstream_pb.BuildEvent
+			require.NoError(t, protojson.Unmarshal([]byte(lines[i]), &parsedEvent))
+			testutil.RequireEqualProto(t, event, &parsedEvent)
+		}
+	})
+
+	t.Run("Binary", func(t *testing.T) {
+		// Every event should be prefixed with its size,
+		// encoded as a varint.
+		path := filepath.Join(t.TempDir(), "events.bin")
+		sink, err := buildevents.NewBinaryFileSink(path)
+		require.NoError(t, err)
+		for _, event := range events {
+			require.NoError(t, sink.Send(event))
+		}
+		require.NoError(t, sink.Close())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		var expectedData []byte
+		for _, event := range events {
+			eventData, err := proto.Marshal(event)
+			require.NoError(t, err)
+			expectedData = protowire.AppendVarint(expectedData, uint64(len(eventData)))
+			expectedData = append(expectedData, eventData...)
+		}
+		require.Equal(t, expectedData, data)
+
+		r := bufio.NewReader(bytes.NewReader(data))
+		for _, event := range events {
+			var parsedEvent buildeventstream_pb.BuildEvent
+			require.NoError(t, protodelim.UnmarshalFrom(r, &parsedEvent))
+			testutil.RequireEqualProto(t, event, &parsedEvent)
+		}
+		_, err = r.ReadByte()
+		require.Error(t, err)
+	})
+
+	t.Run("Truncation", func(t *testing.T) {
+		// Existing files should be truncated.
+		path := filepath.Join(t.TempDir(), "events.json")
+		require.NoError(t, os.WriteFile(path, []byte("Hello world\n"), 0o666))
+		sink, err := buildevents.NewJSONFileSink(path)
+		require.NoError(t, err)
+		require.NoError(t, sink.Close())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Empty(t, data)
+	})
+
+	t.Run("NonexistentDirectory", func(t *testing.T) {
+		_, err := buildevents.NewBinaryFileSink(filepath.Join(t.TempDir(), "nonexistent", "events.bin"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,248 @@
+package buildevents
+
+import (
+	"strings"
+	"time"
+
+	buildeventstream_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/buildeventstream"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Publisher of build events, using the same format as Bazel's Build
+// Event Protocol (BEP). Publisher is responsible for chaining events,
+// ensuring that every event apart from the initial BuildStarted event
+// is announced as a child of an event that was published previously.
+//
+// Errors returned by sinks don't cause subsequent events to be
+// discarded, as build events are not essential for performing a build.
+// The first error that occurred is returned by BuildFinished().
+type Publisher struct {
+	sinks []Sink
+	err   error
+
+	progressCount    int32
+	patternID        *buildeventstream_pb.BuildEventId
+	patternPublished bool
+}
+
+// NewPublisher creates a Publisher that sends build events to a list of
+// sinks. If the list of sinks is empty, all events are discarded.
+func NewPublisher(sinks []Sink) *Publisher {
+	return &Publisher{
+		sinks: sinks,
+	}
+}
+
+func (p *Publisher) send(event *buildeventstream_pb.BuildEvent) {
+	for _, sink := range p.sinks {
+		if err := sink.Send(event); err != nil && p.err == nil {
+			p.err = err
+		}
+	}
+}
+
+func getProgressID(count int32) *buildeventstream_pb.BuildEventId {
+	return &buildeventstream_pb.BuildEventId{
+		Id: &buildeventstream_pb.BuildEventId_Progress{
+			Progress: &buildeventstream_pb.BuildEventId_ProgressId{
+				OpaqueCount: count,
+			},
+		},
+	}
+}
+
+var buildFinishedID = &buildeventstream_pb.BuildEventId{
+	Id: &buildeventstream_pb.BuildEventId_BuildFinished{
+		BuildFinished: &buildeventstream_pb.BuildEventId_BuildFinishedId{},
+	},
+}
+
+// BuildStarted publishes the initial event of the stream. If target
+// patterns are provided, the stream will contain events for the
+// targets that were matched by them.
+func (p *Publisher) BuildStarted(buildStarted *buildeventstream_pb.BuildStarted, targetPatterns []string) {
+	children := []*buildeventstream_pb.BuildEventId{getProgressID(0)}
+	if len(targetPatterns) > 0 {
+		p.patternID = &buildeventstream_pb.BuildEventId{
+			Id: &buildeventstream_pb.BuildEventId_Pattern{
+				Pattern: &buildeventstream_pb.BuildEventId_PatternExpandedId{
+					Pattern: targetPatterns,
+				},
+			},
+		}
+		children = append(children, p.patternID)
+	}
+	children = append(children, buildFinishedID)
+
+	p.send(&buildeventstream_pb.BuildEvent{
+		Id: &buildeventstream_pb.BuildEventId{
+			Id: &buildeventstream_pb.BuildEventId_Started{
+				Started: &buildeventstream_pb.BuildEventId_BuildStartedId{},
+			},
+		},
+		Children: children,
+		Payload: &buildeventstream_pb.BuildEvent_Started{
+			Started: buildStarted,
+		},
+	})
+}
+
+// Progress publishes output that was written to the terminal since the
+// previous call.
+func (p *Publisher) Progress(stdout, stderr string) {
+	p.send(&buildeventstream_pb.BuildEvent{
+		Id:       getProgressID(p.progressCount),
+		Children: []*buildeventstream_pb.BuildEventId{getProgressID(p.progressCount + 1)},
+		Payload: &buildeventstream_pb.BuildEvent_Progress{
+			Progress: &buildeventstream_pb.Progress{
+				Stdout: stdout,
+				Stderr: stderr,
+			},
+		},
+	})
+	p.progressCount++
+}
+
+func (p *Publisher) publishPatternExpanded(children []*buildeventstream_pb.BuildEventId) {
+	if p.patternID != nil && !p.patternPublished {
+		p.send(&buildeventstream_pb.BuildEvent{
+			Id:       p.patternID,
+			Children: children,
+			Payload: &buildeventstream_pb.BuildEvent_Expanded{
+				Expanded: &buildeventstream_pb.PatternExpanded{},
+			},
+		})
+		p.patternPublished = true
+	}
+}
+
+// TargetsCompleted publishes events for all top-level targets that
+// were matched by the target patterns. If the build failed, all of
+// these targets are reported as having failed, as the builder does not
+// report which of the targets caused the build to fail.
+//
+// As the builder currently only builds targets and does not run tests,
+// test targets of successful builds are reported as having no test
+// status.
+func (p *Publisher) TargetsCompleted(targets []*model_build_pb.Target, buildSucceeded bool) {
+	if p.patternID == nil || p.patternPublished {
+		return
+	}
+
+	configuredIDs := make([]*buildeventstream_pb.BuildEventId, 0, len(targets))
+	for _, target := range targets {
+		configuredIDs = append(configuredIDs, &buildeventstream_pb.BuildEventId{
+			Id: &buildeventstream_pb.BuildEventId_TargetConfigured{
+				TargetConfigured: &buildeventstream_pb.BuildEventId_TargetConfiguredId{
+					Label: target.Label,
+				},
+			},
+		})
+	}
+	p.publishPatternExpanded(configuredIDs)
+
+	for i, target := range targets {
+		// Bazel requires that the names of test rules end with
+		// "_test", and that other rules don't.
+		var targetKind string
+		if target.RuleName != "" {
+			targetKind = target.RuleName + " rule"
+		}
+		isTest := buildSucceeded && strings.HasSuffix(target.RuleName, "_test")
+
+		configurationID := &buildeventstream_pb.BuildEventId_ConfigurationId{
+			Id: target.ConfigurationId,
+		}
+		completedID := &buildeventstream_pb.BuildEventId{
+			Id: &buildeventstream_pb.BuildEventId_TargetCompleted{
+				TargetCompleted: &buildeventstream_pb.BuildEventId_TargetCompletedId{
+					Label:         target.Label,
+					Configuration: configurationID,
+				},
+			},
+		}
+		p.send(&buildeventstream_pb.BuildEvent{
+			Id:       configuredIDs[i],
+			Children: []*buildeventstream_pb.BuildEventId{completedID},
+			Payload: &buildeventstream_pb.BuildEvent_Configured{
+				Configured: &buildeventstream_pb.TargetConfigured{
+					TargetKind: targetKind,
+					Tag:        target.Tags,
+				},
+			},
+		})
+
+		var completedChildren []*buildeventstream_pb.BuildEventId
+		var testResultID *buildeventstream_pb.BuildEventId
+		if isTest {
+			testResultID = &buildeventstream_pb.BuildEventId{
+				Id: &buildeventstream_pb.BuildEventId_TestResult{
+					TestResult: &buildeventstream_pb.BuildEventId_TestResultId{
+						Label:         target.Label,
+						Configuration: configurationID,
+						Run:           1,
+						Shard:         1,
+						Attempt:       1,
+					},
+				},
+			}
+			completedChildren = append(completedChildren, testResultID)
+		}
+		p.send(&buildeventstream_pb.BuildEvent{
+			Id:       completedID,
+			Children: completedChildren,
+			Payload: &buildeventstream_pb.BuildEvent_Completed{
+				Completed: &buildeventstream_pb.TargetComplete{
+					Success: buildSucceeded,
+					Tag:     target.Tags,
+				},
+			},
+		})
+
+		if isTest {
+			p.send(&buildeventstream_pb.BuildEvent{
+				Id: testResultID,
+				Payload: &buildeventstream_pb.BuildEvent_TestResult{
+					TestResult: &buildeventstream_pb.TestResult{
+						Status:        buildeventstream_pb.TestStatus_NO_STATUS,
+						StatusDetails: "test was built, but not executed",
+					},
+				},
+			})
+		}
+	}
+}
+
+// BuildFinished publishes the final events of the stream and closes
+// all sinks. Any events that were announced previously, but have not
+// been published, are published without any children. This ensures
+// that the stream remains well-formed if the build fails.
+func (p *Publisher) BuildFinished(exitCode *buildeventstream_pb.BuildFinished_ExitCode, finishTime time.Time) error {
+	p.publishPatternExpanded(nil)
+	p.send(&buildeventstream_pb.BuildEvent{
+		Id: getProgressID(p.progressCount),
+		Payload: &buildeventstream_pb.BuildEvent_Progress{
+			Progress: &buildeventstream_pb.Progress{},
+		},
+	})
+	p.send(&buildeventstream_pb.BuildEvent{
+		Id:          buildFinishedID,
+		LastMessage: true,
+		Payload: &buildeventstream_pb.BuildEvent_Finished{
+			Finished: &buildeventstream_pb.BuildFinished{
+				ExitCode:   exitCode,
+				FinishTime: timestamppb.New(finishTime),
+			},
+		},
+	})
+
+	for _, sink := range p.sinks {
+		if err := sink.Close(); err != nil && p.err == nil {
+			p.err = err
+		}
+	}
+	p.sinks = nil
+	return p.err
+}
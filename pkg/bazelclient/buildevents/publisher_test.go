@@ -0,0 +1,435 @@
+package buildevents_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/buildevents"
+	buildeventstream_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/buildeventstream"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func getTestProgressID(count int32) *buildeventstream_pb.BuildEventId {
+	return &buildeventstream_pb.BuildEventId{
+		Id: &buildeventstream_pb.BuildEventId_Progress{
+			Progress: &buildeventstream_pb.BuildEventId_ProgressId{
+				OpaqueCount: count,
+			},
+		},
+	}
+}
+
+var (
+	testBuildStartedID = &buildeventstream_pb.BuildEventId{
+		Id: &buildeventstream_pb.BuildEventId_Started{
+			Started: &buildeventstream_pb.BuildEventId_BuildStartedId{},
+		},
+	}
+	testBuildFinishedID = &buildeventstream_pb.BuildEventId{
+		Id: &buildeventstream_pb.BuildEventId_BuildFinished{
+			BuildFinished: &buildeventstream_pb.BuildEventId_BuildFinishedId{},
+		},
+	}
+	testPatternID = &buildeventstream_pb.BuildEventId{
+		Id: &buildeventstream_pb.BuildEventId_Pattern{
+			Pattern: &buildeventstream_pb.BuildEventId_PatternExpandedId{
+				Pattern: []string{"//..."},
+			},
+		},
+	}
+	testExitCodeSuccess = &buildeventstream_pb.BuildFinished_ExitCode{
+		Name: "SUCCESS",
+	}
+	testFinishTime = time.Unix(1700000000, 0)
+)
+
+func getTestTargetConfiguredID(label string) *buildeventstream_pb.BuildEventId {
+	return &buildeventstream_pb.BuildEventId{
+		Id: &buildeventstream_pb.BuildEventId_TargetConfigured{
+			TargetConfigured: &buildeventstream_pb.BuildEventId_TargetConfiguredId{
+				Label: label,
+			},
+		},
+	}
+}
+
+func getTestTargetCompletedID(label string) *buildeventstream_pb.BuildEventId {
+	return &buildeventstream_pb.BuildEventId{
+		Id: &buildeventstream_pb.BuildEventId_TargetCompleted{
+			TargetCompleted: &buildeventstream_pb.BuildEventId_TargetCompletedId{
+				Label: label,
+				Configuration: &buildeventstream_pb.BuildEventId_ConfigurationId{
+					Id: "default",
+				},
+			},
+		},
+	}
+}
+
+func newTestBuildFinishedEvent() *buildeventstream_pb.BuildEvent {
+	return &buildeventstream_pb.BuildEvent{
+		Id:          testBuildFinishedID,
+		LastMessage: true,
+		Payload: &buildeventstream_pb.BuildEvent_Finished{
+			Finished: &buildeventstream_pb.BuildFinished{
+				ExitCode:   testExitCodeSuccess,
+				FinishTime: timestamppb.New(testFinishTime),
+			},
+		},
+	}
+}
+
+func TestPublisher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	buildStarted := &buildeventstream_pb.BuildStarted{
+		Uuid:    "b8c10fe7-0d4a-4b0f-9c0e-4a6d52ce4b8e",
+		Command: "build",
+	}
+
+	for _, tc := range []struct {
+		name    string
+		publish func(p *buildevents.Publisher)
+		events  []*buildeventstream_pb.BuildEvent
+	}{
+		{
+			// If no target patterns are provided, the stream
+			// should only consist of progress events.
+			name: "NoTargetPatterns",
+			publish: func(p *buildevents.Publisher) {
+				p.BuildStarted(buildStarted, nil)
+				p.TargetsCompleted(nil, true)
+			},
+			events: []*buildeventstream_pb.BuildEvent{
+				{
+					Id: testBuildStartedID,
+					Children: []*buildeventstream_pb.BuildEventId{
+						getTestProgressID(0),
+						testBuildFinishedID,
+					},
+					Payload: &buildeventstream_pb.BuildEvent_Started{
+						Started: buildStarted,
+					},
+				},
+				{
+					Id: getTestProgressID(0),
+					Payload: &buildeventstream_pb.BuildEvent_Progress{
+						Progress: &buildeventstream_pb.Progress{},
+					},
+				},
+				newTestBuildFinishedEvent(),
+			},
+		},
+		{
+			// Every progress event should announce the next
+			// one, with the final one being published by
+			// BuildFinished() without any children.
+			name: "Progress",
+			publish: func(p *buildevents.Publisher) {
+				p.BuildStarted(buildStarted, nil)
+				p.Progress("", "WARNING: foo\n")
+				p.Progress("", "WARNING: bar\n")
+			},
+			events: []*buildeventstream_pb.BuildEvent{
+				{
+					Id: testBuildStartedID,
+					Children: []*buildeventstream_pb.BuildEventId{
+						getTestProgressID(0),
+						testBuildFinishedID,
+					},
+					Payload: &buildeventstream_pb.BuildEvent_Started{
+						Started: buildStarted,
+					},
+				},
+				{
+					Id:       getTestProgressID(0),
+					Children: []*buildeventstream_pb.BuildEventId{getTestProgressID(1)},
+					Payload: &buildeventstream_pb.BuildEvent_Progress{
+						Progress: &buildeventstream_pb.Progress{
+							Stderr: "WARNING: foo\n",
+						},
+					},
+				},
+				{
+					Id:       getTestProgressID(1),
+					Children: []*buildeventstream_pb.BuildEventId{getTestProgressID(2)},
+					Payload: &buildeventstream_pb.BuildEvent_Progress{
+						Progress: &buildeventstream_pb.Progress{
+							Stderr: "WARNING: bar\n",
+						},
+					},
+				},
+				{
+					Id: getTestProgressID(2),
+					Payload: &buildeventstream_pb.BuildEvent_Progress{
+						Progress: &buildeventstream_pb.Progress{},
+					},
+				},
+				newTestBuildFinishedEvent(),
+			},
+		},
+		{
+			// Targets matched by the target patterns should
+			// be announced by the PatternExpanded event, and
+			// be followed by TargetConfigured and
+			// TargetComplete events. Test targets should
+			// announce a TestResult event.
+			name: "TargetsCompleted",
+			publish: func(p *buildevents.Publisher) {
+				p.BuildStarted(buildStarted, []string{"//..."})
+				p.TargetsCompleted([]*model_build_pb.Target{
+					{
+						Label:           "@@//:foo",
+						RuleName:        "cc_library",
+						ConfigurationId: "default",
+					},
+					{
+						Label:           "@@//:foo_test",
+						RuleName:        "cc_test",
+						Tags:            []string{"manual"},
+						ConfigurationId: "default",
+					},
+				}, true)
+			},
+			events: []*buildeventstream_pb.BuildEvent{
+				{
+					Id: testBuildStartedID,
+					Children: []*buildeventstream_pb.BuildEventId{
+						getTestProgressID(0),
+						testPatternID,
+						testBuildFinishedID,
+					},
+					Payload: &buildeventstream_pb.BuildEvent_Started{
+						Started: buildStarted,
+					},
+				},
+				{
+					Id: testPatternID,
+					Children: []*buildeventstream_pb.BuildEventId{
+						getTestTargetConfiguredID("@@//:foo"),
+						getTestTargetConfiguredID("@@//:foo_test"),
+					},
+					Payload: &buildeventstream_pb.BuildEvent_Expanded{
+						Expanded: &buildeventstream_pb.PatternExpanded{},
+					},
+				},
+				{
+					Id:       getTestTargetConfiguredID("@@//:foo"),
+					Children: []*buildeventstream_pb.BuildEventId{getTestTargetCompletedID("@@//:foo")},
+					Payload: &buildeventstream_pb.BuildEvent_Configured{
+						Configured: &buildeventstream_pb.TargetConfigured{
+							TargetKind: "cc_library rule",
+						},
+					},
+				},
+				{
+					Id: getTestTargetCompletedID("@@//:foo"),
+					Payload: &buildeventstream_pb.BuildEvent_Completed{
+						Completed: &buildeventstream_pb.TargetComplete{
+							Success: true,
+						},
+					},
+				},
+				{
+					Id:       getTestTargetConfiguredID("@@//:foo_test"),
+					Children: []*buildeventstream_pb.BuildEventId{getTestTargetCompletedID("@@//:foo_test")},
+					Payload: &buildeventstream_pb.BuildEvent_Configured{
+						Configured: &buildeventstream_pb.TargetConfigured{
+							TargetKind: "cc_test rule",
+							Tag:        []string{"manual"},
+						},
+					},
+				},
+				{
+					Id: getTestTargetCompletedID("@@//:foo_test"),
+					Children: []*buildeventstream_pb.BuildEventId{{
+						Id: &buildeventstream_pb.BuildEventId_TestResult{
+							TestResult: &buildeventstream_pb.BuildEventId_TestResultId{
+								Label: "@@//:foo_test",
+								Configuration: &buildeventstream_pb.BuildEventId_ConfigurationId{
+									Id: "default",
+								},
+								Run:     1,
+								Shard:   1,
+								Attempt: 1,
+							},
+						},
+					}},
+					Payload: &buildeventstream_pb.BuildEvent_Completed{
+						Completed: &buildeventstream_pb.TargetComplete{
+							Success: true,
+							Tag:     []string{"manual"},
+						},
+					},
+				},
+				{
+					Id: &buildeventstream_pb.BuildEventId{
+						Id: &buildeventstream_pb.BuildEventId_TestResult{
+							TestResult: &buildeventstream_pb.BuildEventId_TestResultId{
+								Label: "@@//:foo_test",
+								Configuration: &buildeventstream_pb.BuildEventId_ConfigurationId{
+									Id: "default",
+								},
+								Run:     1,
+								Shard:   1,
+								Attempt: 1,
+							},
+						},
+					},
+					Payload: &buildeventstream_pb.BuildEvent_TestResult{
+						TestResult: &buildeventstream_pb.TestResult{
+							Status:        buildeventstream_pb.TestStatus_NO_STATUS,
+							StatusDetails: "test was built, but not executed",
+						},
+					},
+				},
+				{
+					Id: getTestProgressID(0),
+					Payload: &buildeventstream_pb.BuildEvent_Progress{
+						Progress: &buildeventstream_pb.Progress{},
+					},
+				},
+				newTestBuildFinishedEvent(),
+			},
+		},
+		{
+			// If the build failed, all targets should be
+			// reported as having failed. Test targets should
+			// not announce TestResult events, as they were
+			// not built.
+			name: "TargetsFailed",
+			publish: func(p *buildevents.Publisher) {
+				p.BuildStarted(buildStarted, []string{"//..."})
+				p.TargetsCompleted([]*model_build_pb.Target{
+					{
+						Label:           "@@//:foo_test",
+						RuleName:        "cc_test",
+						ConfigurationId: "default",
+					},
+				}, false)
+			},
+			events: []*buildeventstream_pb.BuildEvent{
+				{
+					Id: testBuildStartedID,
+					Children: []*buildeventstream_pb.BuildEventId{
+						getTestProgressID(0),
+						testPatternID,
+						testBuildFinishedID,
+					},
+					Payload: &buildeventstream_pb.BuildEvent_Started{
+						Started: buildStarted,
+					},
+				},
+				{
+					Id: testPatternID,
+					Children: []*buildeventstream_pb.BuildEventId{
+						getTestTargetConfiguredID("@@//:foo_test"),
+					},
+					Payload: &buildeventstream_pb.BuildEvent_Expanded{
+						Expanded: &buildeventstream_pb.PatternExpanded{},
+					},
+				},
+				{
+					Id:       getTestTargetConfiguredID("@@//:foo_test"),
+					Children: []*buildeventstream_pb.BuildEventId{getTestTargetCompletedID("@@//:foo_test")},
+					Payload: &buildeventstream_pb.BuildEvent_Configured{
+						Configured: &buildeventstream_pb.TargetConfigured{
+							TargetKind: "cc_test rule",
+						},
+					},
+				},
+				{
+					Id: getTestTargetCompletedID("@@//:foo_test"),
+					Payload: &buildeventstream_pb.BuildEvent_Completed{
+						Completed: &buildeventstream_pb.TargetComplete{},
+					},
+				},
+				{
+					Id: getTestProgressID(0),
+					Payload: &buildeventstream_pb.BuildEvent_Progress{
+						Progress: &buildeventstream_pb.Progress{},
+					},
+				},
+				newTestBuildFinishedEvent(),
+			},
+		},
+		{
+			// If the build fails before the targets are
+			// known, the announced PatternExpanded event
+			// should still be published to keep the stream
+			// well-formed.
+			name: "BuildFailedBeforeTargetsCompleted",
+			publish: func(p *buildevents.Publisher) {
+				p.BuildStarted(buildStarted, []string{"//..."})
+			},
+			events: []*buildeventstream_pb.BuildEvent{
+				{
+					Id: testBuildStartedID,
+					Children: []*buildeventstream_pb.BuildEventId{
+						getTestProgressID(0),
+						testPatternID,
+						testBuildFinishedID,
+					},
+					Payload: &buildeventstream_pb.BuildEvent_Started{
+						Started: buildStarted,
+					},
+				},
+				{
+					Id: testPatternID,
+					Payload: &buildeventstream_pb.BuildEvent_Expanded{
+						Expanded: &buildeventstream_pb.PatternExpanded{},
+					},
+				},
+				{
+					Id: getTestProgressID(0),
+					Payload: &buildeventstream_pb.BuildEvent_Progress{
+						Progress: &buildeventstream_pb.Progress{},
+					},
+				},
+				newTestBuildFinishedEvent(),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := NewMockSink(ctrl)
+			calls := make([]any, 0, len(tc.events)+1)
+			for _, event := range tc.events {
+				calls = append(calls, sink.EXPECT().Send(testutil.EqProto(t, event)))
+			}
+			calls = append(calls, sink.EXPECT().Close())
+			gomock.InOrder(calls...)
+
+			p := buildevents.NewPublisher([]buildevents.Sink{sink})
+			tc.publish(p)
+			require.NoError(t, p.BuildFinished(testExitCodeSuccess, testFinishTime))
+		})
+	}
+
+	t.Run("SinkFailure", func(t *testing.T) {
+		// Errors returned by sinks should not cause events
+		// to be discarded. The first error should be
+		// returned by BuildFinished().
+		sink1 := NewMockSink(ctrl)
+		sink1.EXPECT().Send(gomock.Any()).
+			Return(status.Error(codes.Unavailable, "Server offline"))
+		sink1.EXPECT().Send(gomock.Any()).Times(2)
+		sink1.EXPECT().Close().Return(status.Error(codes.Internal, "Failed to flush"))
+		sink2 := NewMockSink(ctrl)
+		sink2.EXPECT().Send(gomock.Any()).Times(3)
+		sink2.EXPECT().Close()
+
+		p := buildevents.NewPublisher([]buildevents.Sink{sink1, sink2})
+		p.BuildStarted(buildStarted, nil)
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Server offline"),
+			p.BuildFinished(testExitCodeSuccess, testFinishTime))
+	})
+}
@@ -0,0 +1,13 @@
+package buildevents
+
+import (
+	buildeventstream_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/buildeventstream"
+)
+
+// Sink of build events that are generated by Publisher. Sinks may
+// write events to a file, or upload them to a Build Event Service
+// (BES).
+type Sink interface {
+	Send(event *buildeventstream_pb.BuildEvent) error
+	Close() error
+}
@@ -0,0 +1,25 @@
+package attach
+
+import (
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/commands"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/commands/build"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/logging"
+
+	"google.golang.org/grpc/status"
+)
+
+func DoAttach(args *arguments.AttachCommand, startupFlags *arguments.StartupFlags, workspacePath path.Parser) {
+	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
+	commands.ValidateInsideWorkspace(logger, "attach", workspacePath)
+
+	if len(args.Arguments) != 1 {
+		logger.Fatal("The \"attach\" command requires the name of the operation of the build to reattach to")
+	}
+	result := build.AttachToBuild(logger, startupFlags, &args.CommonFlags, args.Arguments[0], workspacePath)
+	if err := status.ErrorProto(result.Status); err != nil {
+		logger.Fatal("Failed to perform build: ", err)
+	}
+	logger.Infof("Build completed successfully, %d targets", len(result.Targets))
+}
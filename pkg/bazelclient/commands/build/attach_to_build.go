@@ -0,0 +1,77 @@
+package build
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/sha256"
+	"crypto/x509"
+	"os"
+	"os/signal"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/commands"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/logging"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	"github.com/buildbarn/bonanza/pkg/remoteexecution"
+)
+
+// AttachToBuild reattaches to a build that was previously started
+// using "bonanza_bazel build", but whose client got disconnected before
+// the build completed. Progress and diagnostics are displayed as if
+// the build was started by the current invocation.
+//
+// Similar to PerformBuild(), checking the status of the result is left
+// to the caller.
+func AttachToBuild(logger logging.Logger, startupFlags *arguments.StartupFlags, commonFlags *arguments.CommonFlags, operationName string, workspacePath path.Parser) *model_build_pb.Result {
+	outputBase, err := commands.GetOutputBase(startupFlags, workspacePath)
+	if err != nil {
+		logger.Fatal("Failed to determine output base: ", err)
+	}
+	pendingBuild, err := loadPendingBuild(outputBase, operationName)
+	if err != nil {
+		logger.Fatal("Failed to load state of pending build: ", err)
+	}
+
+	builderPublicKey, err := x509.ParsePKIXPublicKey(pendingBuild.BuilderPkixPublicKey)
+	if err != nil {
+		logger.Fatal("Failed to parse builder public key of pending build: ", err)
+	}
+	builderECDHPublicKey, ok := builderPublicKey.(*ecdh.PublicKey)
+	if !ok {
+		logger.Fatal("Builder public key of pending build is not an ECDH public key")
+	}
+	operation := remoteexecution.Operation{
+		Name:                  operationName,
+		PlatformECDHPublicKey: builderECDHPublicKey,
+	}
+	if copy(operation.ActionCiphertextDigest[:], pendingBuild.ActionCiphertextSha256) != sha256.Size {
+		logger.Fatal("Action ciphertext SHA-256 hash of pending build has an incorrect size")
+	}
+
+	buildEventPublisher, err := newBuildEventPublisher(commonFlags, pendingBuild.InvocationId, pendingBuild.BuildRequestId)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	publishBuildStarted(logger, buildEventPublisher, pendingBuild.InvocationId, pendingBuild.StartTime.AsTime(), "build", pendingBuild.TargetPatterns, workspacePath)
+
+	logger.Infof("Attaching to build %s", operationName)
+	logger.SetProgress(&logging.Progress{Phase: logging.PhaseAnalyzing})
+	ctx, stopInterruptHandling := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stopInterruptHandling()
+	var result model_build_pb.Result
+	var errBuild error
+	awaitBuild(
+		ctx,
+		logger,
+		commonFlags,
+		workspacePath,
+		outputBase,
+		buildEventPublisher,
+		newBuilderExecutionClient(logger, commonFlags).WaitAction(ctx, &operation, &result, &errBuild),
+		&operationName,
+		&result,
+		&errBuild,
+	)
+	return &result
+}
@@ -0,0 +1,74 @@
+package build
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/buildevents"
+	buildeventstream_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/buildeventstream"
+
+	build_pb "google.golang.org/genproto/googleapis/devtools/build/v1"
+)
+
+// Exit codes that are reported in the BuildFinished build event. These
+// use the same names and values as Bazel's ExitCode class.
+var (
+	exitCodeSuccess = &buildeventstream_pb.BuildFinished_ExitCode{
+		Name: "SUCCESS",
+		Code: 0,
+	}
+	exitCodeBuildFailure = &buildeventstream_pb.BuildFinished_ExitCode{
+		Name: "BUILD_FAILURE",
+		Code: 1,
+	}
+	exitCodeInterrupted = &buildeventstream_pb.BuildFinished_ExitCode{
+		Name: "INTERRUPTED",
+		Code: 8,
+	}
+	exitCodeRemoteError = &buildeventstream_pb.BuildFinished_ExitCode{
+		Name: "REMOTE_ERROR",
+		Code: 34,
+	}
+)
+
+// newBuildEventPublisher creates a Publisher that writes build events
+// to the files and Build Event Service (BES) backend that are provided
+// through --build_event_json_file, --build_event_binary_file and
+// --bes_backend.
+func newBuildEventPublisher(commonFlags *arguments.CommonFlags, invocationID, buildRequestID string) (*buildevents.Publisher, error) {
+	var sinks []buildevents.Sink
+	if path := commonFlags.BuildEventJsonFile; path != "" {
+		sink, err := buildevents.NewJSONFileSink(path)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Failed to create --build_event_json_file=%#v", path)
+		}
+		sinks = append(sinks, sink)
+	}
+	if path := commonFlags.BuildEventBinaryFile; path != "" {
+		sink, err := buildevents.NewBinaryFileSink(path)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Failed to create --build_event_binary_file=%#v", path)
+		}
+		sinks = append(sinks, sink)
+	}
+	if endpoint := commonFlags.BesBackend; endpoint != "" {
+		besClient, err := newGRPCClient(endpoint, commonFlags)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Failed to create gRPC client for --bes_backend=%#v", endpoint)
+		}
+		sink, err := buildevents.NewBESSink(
+			context.Background(),
+			build_pb.NewPublishBuildEventClient(besClient),
+			clock.SystemClock,
+			invocationID,
+			buildRequestID,
+		)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Failed to start build event stream for --bes_backend=%#v", endpoint)
+		}
+		sinks = append(sinks, sink)
+	}
+	return buildevents.NewPublisher(sinks), nil
+}
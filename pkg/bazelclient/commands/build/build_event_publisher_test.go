@@ -0,0 +1,70 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	buildeventstream_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/buildeventstream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBuildEventPublisher(t *testing.T) {
+	t.Run("NoSinks", func(t *testing.T) {
+		// Without any flags set, events should be discarded.
+		p, err := newBuildEventPublisher(&arguments.CommonFlags{}, "invocation", "build-request")
+		require.NoError(t, err)
+		p.BuildStarted(&buildeventstream_pb.BuildStarted{}, []string{"//..."})
+		require.NoError(t, p.BuildFinished(exitCodeSuccess, time.Unix(1700000000, 0)))
+	})
+
+	t.Run("FileSinks", func(t *testing.T) {
+		// Both --build_event_json_file and
+		// --build_event_binary_file should receive all events.
+		directory := t.TempDir()
+		jsonPath := filepath.Join(directory, "events.json")
+		binaryPath := filepath.Join(directory, "events.bin")
+		p, err := newBuildEventPublisher(&arguments.CommonFlags{
+			BuildEventJsonFile:   jsonPath,
+			BuildEventBinaryFile: binaryPath,
+		}, "invocation", "build-request")
+		require.NoError(t, err)
+		p.BuildStarted(&buildeventstream_pb.BuildStarted{}, nil)
+		require.NoError(t, p.BuildFinished(exitCodeSuccess, time.Unix(1700000000, 0)))
+
+		jsonData, err := os.ReadFile(jsonPath)
+		require.NoError(t, err)
+		require.Contains(t, string(jsonData), "\"lastMessage\":true")
+		binaryData, err := os.ReadFile(binaryPath)
+		require.NoError(t, err)
+		require.NotEmpty(t, binaryData)
+	})
+
+	t.Run("JSONFileCreationFailure", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "nonexistent", "events.json")
+		_, err := newBuildEventPublisher(&arguments.CommonFlags{
+			BuildEventJsonFile: path,
+		}, "invocation", "build-request")
+		require.ErrorContains(t, err, "Failed to create --build_event_json_file=")
+	})
+
+	t.Run("BinaryFileCreationFailure", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "nonexistent", "events.bin")
+		_, err := newBuildEventPublisher(&arguments.CommonFlags{
+			BuildEventBinaryFile: path,
+		}, "invocation", "build-request")
+		require.ErrorContains(t, err, "Failed to create --build_event_binary_file=")
+	})
+}
+
+func TestBuildEventExitCodes(t *testing.T) {
+	// Exit codes should match the ones used by Bazel, as tools
+	// that process build events depend on them.
+	testutil.RequireEqualProto(t, &buildeventstream_pb.BuildFinished_ExitCode{Name: "SUCCESS", Code: 0}, exitCodeSuccess)
+	testutil.RequireEqualProto(t, &buildeventstream_pb.BuildFinished_ExitCode{Name: "BUILD_FAILURE", Code: 1}, exitCodeBuildFailure)
+	testutil.RequireEqualProto(t, &buildeventstream_pb.BuildFinished_ExitCode{Name: "INTERRUPTED", Code: 8}, exitCodeInterrupted)
+	testutil.RequireEqualProto(t, &buildeventstream_pb.BuildFinished_ExitCode{Name: "REMOTE_ERROR", Code: 34}, exitCodeRemoteError)
+}
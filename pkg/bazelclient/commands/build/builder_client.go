@@ -0,0 +1,117 @@
+package build
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/x509"
+	"encoding/base64"
+	"os"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/logging"
+	buildqueuestate_pb "github.com/buildbarn/bonanza/pkg/proto/buildqueuestate"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	remoteexecution_pb "github.com/buildbarn/bonanza/pkg/proto/remoteexecution"
+	"github.com/buildbarn/bonanza/pkg/remoteexecution"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// builderExecutionClient is the type of the client that is used to
+// submit builds to bonanza_builder through the scheduler.
+type builderExecutionClient = remoteexecution.Client[*model_build_pb.Action, model_build_pb.Event, *model_build_pb.Event, *model_build_pb.Result]
+
+// newBuilderExecutionClient creates a client for submitting builds to
+// bonanza_builder, using the scheduler and credentials provided through
+// --remote_executor*.
+func newBuilderExecutionClient(logger logging.Logger, commonFlags *arguments.CommonFlags) *builderExecutionClient {
+	clientPrivateKeyData, err := os.ReadFile(commonFlags.RemoteExecutorClientPrivateKey)
+	if err != nil {
+		logger.Fatalf("Failed to read --remote_executor_client_private_key=%#v: %s", commonFlags.RemoteExecutorClientPrivateKey, err)
+	}
+	clientPrivateKey, err := remoteexecution.ParseECDHPrivateKey(clientPrivateKeyData)
+	if err != nil {
+		logger.Fatalf("Failed to parse --remote_executor_client_private_key=%#v: %s", commonFlags.RemoteExecutorClientPrivateKey, err)
+	}
+
+	clientCertificateChainData, err := os.ReadFile(commonFlags.RemoteExecutorClientCertificateChain)
+	if err != nil {
+		logger.Fatalf("Failed to read --remote_executor_client_certificate_chain=%#v: %s", commonFlags.RemoteExecutorClientCertificateChain, err)
+	}
+	clientCertificateChain, err := remoteexecution.ParseCertificateChain(clientCertificateChainData)
+	if err != nil {
+		logger.Fatalf("Failed to parse --remote_executor_client_certificate_chain=%#v: %s", commonFlags.RemoteExecutorClientCertificateChain, err)
+	}
+
+	remoteExecutorClient, err := newGRPCClient(commonFlags.RemoteExecutor, commonFlags)
+	if err != nil {
+		logger.Fatalf("Failed to create gRPC client for --remote_executor=%#v: %s", commonFlags.RemoteExecutor, err)
+	}
+	return remoteexecution.NewClient[*model_build_pb.Action, model_build_pb.Event, *model_build_pb.Result](
+		remoteexecution_pb.NewExecutionClient(remoteExecutorClient),
+		clientPrivateKey,
+		clientCertificateChain,
+		clock.SystemClock,
+	)
+}
+
+// getBuilderPublicKey returns the public key of the builder to which
+// builds are sent, as provided through
+// --remote_executor_builder_pkix_public_key.
+func getBuilderPublicKey(logger logging.Logger, commonFlags *arguments.CommonFlags) *ecdh.PublicKey {
+	builderPKIXPublicKey, err := base64.StdEncoding.DecodeString(commonFlags.RemoteExecutorBuilderPkixPublicKey)
+	if err != nil {
+		logger.Fatalf("Failed to base64 decode --remote_executor_builder_pkix_public_key: %s", err)
+	}
+	builderPublicKey, err := x509.ParsePKIXPublicKey(builderPKIXPublicKey)
+	if err != nil {
+		logger.Fatalf("Failed to parse --remote_executor_builder_pkix_public_key: %s", err)
+	}
+	builderECDHPublicKey, ok := builderPublicKey.(*ecdh.PublicKey)
+	if !ok {
+		logger.Fatalf("--remote_executor_builder_pkix_public_key is not an ECDH public key")
+	}
+	return builderECDHPublicKey
+}
+
+// killBuild requests that the scheduler kills a build that is in
+// progress, using the build queue state service provided through
+// --remote_build_queue_state. If no build queue state service is
+// provided, it returns false, meaning that the scheduler only stops the
+// build after noticing that no clients are waiting for it.
+func killBuild(commonFlags *arguments.CommonFlags, operationName string) (bool, error) {
+	endpoint := commonFlags.RemoteBuildQueueState
+	if endpoint == "" {
+		return false, nil
+	}
+	buildQueueStateClient, err := newGRPCClient(endpoint, commonFlags)
+	if err != nil {
+		return false, util.StatusWrapf(err, "Failed to create gRPC client for --remote_build_queue_state=%#v", endpoint)
+	}
+	if err := killOperation(buildqueuestate_pb.NewBuildQueueStateClient(buildQueueStateClient), operationName); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// killOperation requests that the scheduler kills a single operation,
+// causing it to complete with status code CANCELLED.
+func killOperation(buildQueueStateClient buildqueuestate_pb.BuildQueueStateClient, operationName string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := buildQueueStateClient.KillOperations(ctx, &buildqueuestate_pb.KillOperationsRequest{
+		Filter: &buildqueuestate_pb.KillOperationsRequest_Filter{
+			Type: &buildqueuestate_pb.KillOperationsRequest_Filter_OperationName{
+				OperationName: operationName,
+			},
+		},
+		Status: status.New(codes.Canceled, "Build was interrupted by the user").Proto(),
+	}); err != nil {
+		return util.StatusWrapf(err, "Failed to kill operation %#v", operationName)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package build
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	buildqueuestate_pb "github.com/buildbarn/bonanza/pkg/proto/buildqueuestate"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestKillBuild(t *testing.T) {
+	t.Run("NoBuildQueueState", func(t *testing.T) {
+		// Without --remote_build_queue_state, builds cannot be
+		// killed explicitly.
+		killed, err := killBuild(&arguments.CommonFlags{}, "7ec2c5a4-8ebd-4a3c-a0b5-2f3ef5d4d3b0")
+		require.NoError(t, err)
+		require.False(t, killed)
+	})
+}
+
+func TestKillOperation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	expectedRequest := &buildqueuestate_pb.KillOperationsRequest{
+		Filter: &buildqueuestate_pb.KillOperationsRequest_Filter{
+			Type: &buildqueuestate_pb.KillOperationsRequest_Filter_OperationName{
+				OperationName: "7ec2c5a4-8ebd-4a3c-a0b5-2f3ef5d4d3b0",
+			},
+		},
+		Status: status.New(codes.Canceled, "Build was interrupted by the user").Proto(),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// Only the operation of the build should be killed.
+		buildQueueStateClient := NewMockBuildQueueStateClient(ctrl)
+		buildQueueStateClient.EXPECT().KillOperations(gomock.Any(), testutil.EqProto(t, expectedRequest)).
+			Return(&emptypb.Empty{}, nil)
+
+		require.NoError(t, killOperation(buildQueueStateClient, "7ec2c5a4-8ebd-4a3c-a0b5-2f3ef5d4d3b0"))
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		buildQueueStateClient := NewMockBuildQueueStateClient(ctrl)
+		buildQueueStateClient.EXPECT().KillOperations(gomock.Any(), testutil.EqProto(t, expectedRequest)).
+			Return(nil, status.Error(codes.PermissionDenied, "Not authorized to kill operations"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.PermissionDenied, "Failed to kill operation \"7ec2c5a4-8ebd-4a3c-a0b5-2f3ef5d4d3b0\": Not authorized to kill operations"),
+			killOperation(buildQueueStateClient, "7ec2c5a4-8ebd-4a3c-a0b5-2f3ef5d4d3b0"),
+		)
+	})
+}
@@ -1,6 +1,7 @@
 package build
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdh"
 	"crypto/x509"
@@ -8,24 +9,33 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"iter"
 	"maps"
 	"math"
 	"net/url"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"runtime"
 	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/buildbarn/bb-storage/pkg/filesystem"
 	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
 	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/buildevents"
 	"github.com/buildbarn/bonanza/pkg/bazelclient/commands"
 	"github.com/buildbarn/bonanza/pkg/bazelclient/logging"
 	"github.com/buildbarn/bonanza/pkg/label"
 	model_core "github.com/buildbarn/bonanza/pkg/model/core"
 	model_encoding "github.com/buildbarn/bonanza/pkg/model/encoding"
 	model_filesystem "github.com/buildbarn/bonanza/pkg/model/filesystem"
+	buildeventstream_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/buildeventstream"
+	pendingbuild_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/pendingbuild"
 	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
 	model_encoding_pb "github.com/buildbarn/bonanza/pkg/proto/model/encoding"
 	model_filesystem_pb "github.com/buildbarn/bonanza/pkg/proto/model/filesystem"
@@ -38,14 +48,17 @@ import (
 	"github.com/buildbarn/bonanza/pkg/storage/object"
 	"github.com/google/uuid"
 
+	"go.starlark.net/starlark"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func newGRPCClient(endpoint string, commonFlags *arguments.CommonFlags) (*grpc.ClientConn, error) {
@@ -73,35 +86,164 @@ func newGRPCClient(endpoint string, commonFlags *arguments.CommonFlags) (*grpc.C
 	return grpc.NewClient(target, grpc.WithTransportCredentials(clientCredentials))
 }
 
-type localCapturableDirectoryOptions[TFile model_core.ReferenceMetadata] struct {
-	fileParameters *model_filesystem.FileCreationParameters
-	capturer       model_filesystem.FileMerkleTreeCapturer[TFile]
+type localCapturableDirectoryOptions struct {
+	fileParameters    *model_filesystem.FileCreationParameters
+	fileContentsCache *fileContentsCache
+	outputBase        string
 }
 
-type localCapturableDirectory[TDirectory, TFile model_core.ReferenceMetadata] struct {
+// localCapturableModule contains state that is shared by all
+// directories belonging to a single module that is being captured.
+type localCapturableModule struct {
+	name         label.Module
+	isRootModule bool
+	ignorer      *model_filesystem.DirectoryIgnorer
+
+	// Keys under which the Merkle trees of directories are stored
+	// in the file contents cache, indexed by the path of the
+	// directory relative to the root directory of the module.
+	directoryCacheKeys map[string][]byte
+
+	lock            sync.Mutex
+	skippedSymlinks []string
+}
+
+type localCapturableDirectory struct {
 	filesystem.DirectoryCloser
-	options *localCapturableDirectoryOptions[TFile]
+	options      *localCapturableDirectoryOptions
+	module       *localCapturableModule
+	relativePath string
+	depth        uint32
+}
+
+// getChildPath returns the path of a child of the directory, relative
+// to the root directory of the module.
+func (d *localCapturableDirectory) getChildPath(name path.Component) string {
+	if d.relativePath == "" {
+		return name.String()
+	}
+	return d.relativePath + "/" + name.String()
+}
+
+// symlinkEscapesModule returns true if the target of a symbolic link
+// contained in the directory resolves to a location outside the root
+// directory of the module. Such symbolic links cannot be uploaded, as
+// the builder is unable to follow them.
+func (d *localCapturableDirectory) symlinkEscapesModule(name path.Component) bool {
+	targetParser, err := d.Readlink(name)
+	if err != nil {
+		// Let CreateDirectoryMerkleTree() report the error.
+		return false
+	}
+	escapementCounter := model_filesystem.NewEscapementCountingScopeWalker()
+	_, scopeWalker := path.EmptyBuilder.Join(escapementCounter)
+	if err := path.Resolve(targetParser, scopeWalker); err != nil {
+		return false
+	}
+	levels := escapementCounter.GetLevels()
+	return levels == nil || levels.Value > d.depth
+}
+
+// symlinkPointsIntoOutputBase returns true if the target of a symbolic
+// link contained in the directory is an absolute path that resolves to
+// a location inside the output base. This is used to detect the
+// convenience symlinks created by CreateConvenienceSymlinks().
+func (d *localCapturableDirectory) symlinkPointsIntoOutputBase(name path.Component) bool {
+	targetParser, err := d.Readlink(name)
+	if err != nil {
+		return false
+	}
+	targetBuilder, scopeWalker := path.EmptyBuilder.Join(path.NewAbsoluteScopeWalker(path.VoidComponentWalker))
+	if err := path.Resolve(targetParser, scopeWalker); err != nil {
+		return false
+	}
+	target, err := path.LocalFormat.GetString(targetBuilder)
+	if err != nil {
+		return false
+	}
+	relativeTarget, err := filepath.Rel(d.options.outputBase, target)
+	return err == nil && filepath.IsLocal(relativeTarget)
+}
+
+func (d *localCapturableDirectory) ReadDir() ([]filesystem.FileInfo, error) {
+	entries, err := d.DirectoryCloser.ReadDir()
+	if err != nil {
+		return nil, err
+	}
+
+	// Omit directories that are ignored through .bazelignore or
+	// REPO.bazel, and symbolic links that escape the module.
+	filteredEntries := entries[:0]
+	for _, entry := range entries {
+		name := entry.Name()
+		switch entry.Type() {
+		case filesystem.FileTypeDirectory:
+			if d.module.ignorer.IsIgnored(d.getChildPath(name)) {
+				continue
+			}
+		case filesystem.FileTypeSymlink:
+			childPath := d.getChildPath(name)
+			if d.module.ignorer.IsIgnored(childPath) {
+				continue
+			}
+			if d.symlinkEscapesModule(name) {
+				// Convenience symlinks pointing into
+				// the output base are skipped silently.
+				if d.module.isRootModule && d.depth == 0 && d.symlinkPointsIntoOutputBase(name) {
+					continue
+				}
+				d.module.lock.Lock()
+				d.module.skippedSymlinks = append(d.module.skippedSymlinks, childPath)
+				d.module.lock.Unlock()
+				continue
+			}
+		}
+		filteredEntries = append(filteredEntries, entry)
+	}
+	return filteredEntries, nil
 }
 
-func (d *localCapturableDirectory[TDirectory, TFile]) EnterCapturableDirectory(name path.Component) (*model_filesystem.CreatedDirectory[TDirectory], model_filesystem.CapturableDirectory[TDirectory, TFile], error) {
+func (d *localCapturableDirectory) enterLocalCapturableDirectory(name path.Component) (*localCapturableDirectory, error) {
 	child, err := d.DirectoryCloser.EnterDirectory(name)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	return nil, &localCapturableDirectory[TDirectory, TFile]{
+	return &localCapturableDirectory{
 		DirectoryCloser: child,
 		options:         d.options,
+		module:          d.module,
+		relativePath:    d.getChildPath(name),
+		depth:           d.depth + 1,
 	}, nil
 }
 
-func (d *localCapturableDirectory[TDirectory, TFile]) OpenForFileMerkleTreeCreation(name path.Component) (model_filesystem.CapturableFile[TFile], error) {
+func (d *localCapturableDirectory) EnterCapturableDirectory(name path.Component) (*model_filesystem.CreatedDirectory[model_filesystem.CapturedObject], model_filesystem.CapturableDirectory[model_filesystem.CapturedObject, model_core.NoopReferenceMetadata], error) {
+	// Skip traversal of the directory if its Merkle tree was
+	// computed by a previous invocation, and none of its
+	// descendants have changed since.
+	childPath := d.getChildPath(name)
+	if key, ok := d.module.directoryCacheKeys[childPath]; ok {
+		if createdDirectory, ok := d.options.fileContentsCache.lookupDirectory(d.module.name.String()+"/"+childPath, key); ok {
+			return createdDirectory, nil, nil
+		}
+	}
+
+	child, err := d.enterLocalCapturableDirectory(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, child, nil
+}
+
+func (d *localCapturableDirectory) OpenForFileMerkleTreeCreation(name path.Component) (model_filesystem.CapturableFile[model_core.NoopReferenceMetadata], error) {
 	f, err := d.OpenRead(name)
 	if err != nil {
 		return nil, err
 	}
-	return &localCapturableFile[TFile]{
+	return &localCapturableFile{
 		file:    f,
 		options: d.options,
+		path:    d.module.name.String() + "/" + d.getChildPath(name),
 	}, nil
 }
 
@@ -119,33 +261,75 @@ func (d localCapturedDirectory) EnterCapturedDirectory(name path.Component) (mod
 	}, nil
 }
 
-type localCapturableFile[TFile model_core.ReferenceMetadata] struct {
+type localCapturableFile struct {
 	file    filesystem.FileReader
-	options *localCapturableDirectoryOptions[TFile]
+	options *localCapturableDirectoryOptions
+	path    string
 }
 
-func (f *localCapturableFile[TFile]) CreateFileMerkleTree(ctx context.Context) (model_core.PatchedMessage[*model_filesystem_pb.FileContents, TFile], error) {
+func (f *localCapturableFile) CreateFileMerkleTree(ctx context.Context) (model_core.PatchedMessage[*model_filesystem_pb.FileContents, model_core.NoopReferenceMetadata], error) {
 	defer f.Discard()
-	return model_filesystem.CreateFileMerkleTree(
+
+	// Skip reading the file if its Merkle tree was computed by a
+	// previous invocation, and the file has not changed since.
+	cache := f.options.fileContentsCache
+	cacheKey, cacheable := getFileContentsCacheKey(f.file)
+	if cacheable {
+		if fileContents, ok := cache.lookup(f.path, cacheKey); ok {
+			return fileContents, nil
+		}
+	}
+
+	fileContents, err := model_filesystem.CreateFileMerkleTree(
 		ctx,
 		f.options.fileParameters,
 		io.NewSectionReader(f.file, 0, math.MaxInt64),
-		f.options.capturer,
+		model_filesystem.NoopFileMerkleTreeCapturer,
 	)
+	if err != nil || !fileContents.IsSet() || !cacheable {
+		return fileContents, err
+	}
+	return model_core.NewPatchedMessage(
+		fileContents.Message,
+		model_core.MapReferenceMessagePatcherMetadata(
+			fileContents.Patcher,
+			func(reference object.LocalReference, metadata model_core.NoopReferenceMetadata) model_core.NoopReferenceMetadata {
+				cache.insert(f.path, cacheKey, reference)
+				return metadata
+			},
+		),
+	), nil
 }
 
-func (f *localCapturableFile[TFile]) Discard() {
+func (f *localCapturableFile) Discard() {
 	f.file.Close()
 	f.file = nil
 }
 
-func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
+func DoBuild(args *arguments.BuildCommand, startupFlags *arguments.StartupFlags, workspacePath path.Parser) {
 	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
 	commands.ValidateInsideWorkspace(logger, "build", workspacePath)
 
-	remoteCacheClient, err := newGRPCClient(args.CommonFlags.RemoteCache, &args.CommonFlags)
+	result := PerformBuild(logger, startupFlags, &args.CommonFlags, &args.BuildFlags, args.Arguments, nil, workspacePath)
+	if err := status.ErrorProto(result.Status); err != nil {
+		logger.Fatal("Failed to perform build: ", err)
+	}
+	logger.Infof("Build completed successfully, %d targets", len(result.Targets))
+}
+
+// PerformBuild uploads the sources of all modules in the workspace to
+// storage, and requests that the builder evaluates them. If a module
+// query is provided, the builder only inspects the module graph
+// instead of building the provided target patterns.
+//
+// Diagnostics reported by the builder are logged, and the lockfile
+// returned by the builder is written into the workspace. Checking the
+// status of the result is left to the caller.
+func PerformBuild(logger logging.Logger, startupFlags *arguments.StartupFlags, commonFlags *arguments.CommonFlags, buildFlags *arguments.BuildFlags, targetPatterns []string, moduleQuery *model_build_pb.ModuleQuery, workspacePath path.Parser) *model_build_pb.Result {
+	startTime := time.Now()
+	remoteCacheClient, err := newGRPCClient(commonFlags.RemoteCache, commonFlags)
 	if err != nil {
-		logger.Fatalf("Failed to create gRPC client for --remote_cache=%#v: %s", args.CommonFlags.RemoteCache, err)
+		logger.Fatalf("Failed to create gRPC client for --remote_cache=%#v: %s", commonFlags.RemoteCache, err)
 	}
 
 	// Determine the names and paths of all modules that are present
@@ -172,6 +356,9 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		string(moduleDotBazelContents),
 		label.MustNewCanonicalLabel("@@main+//:MODULE.bazel"),
 		path.LocalFormat,
+		// Output of print() is discarded, as the builder
+		// reports it when evaluating MODULE.bazel again.
+		func(thread *starlark.Thread, msg string) {},
 		moduleDotBazelHandler,
 	); err != nil {
 		logger.Fatal("Failed to parse MODULE.bazel: ", err)
@@ -182,7 +369,7 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 	}
 
 	// Augment results with modules provided to --override_module.
-	for _, overrideModule := range args.CommonFlags.OverrideModule {
+	for _, overrideModule := range commonFlags.OverrideModule {
 		fields := strings.SplitN(overrideModule, "=", 2)
 		if len(fields) != 2 {
 			logger.Fatal("Module overrides must use the format ${module_name}=${path}")
@@ -204,7 +391,7 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 	// resulting objects, and whether they are compressed and
 	// encrypted.
 	referenceFormat := object.MustNewReferenceFormat(object_pb.ReferenceFormat_SHA256_V1)
-	encryptionKeyBytes, err := base64.StdEncoding.DecodeString(args.CommonFlags.RemoteEncryptionKey)
+	encryptionKeyBytes, err := base64.StdEncoding.DecodeString(commonFlags.RemoteEncryptionKey)
 	if err != nil {
 		logger.Fatalf("Failed to base64 decode value of --remote_encryption_key: %s", err)
 	}
@@ -216,7 +403,7 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		},
 	}}
 	var chunkEncoders []*model_encoding_pb.BinaryEncoder
-	if args.CommonFlags.RemoteCacheCompression {
+	if commonFlags.RemoteCacheCompression {
 		chunkEncoders = append(chunkEncoders, &model_encoding_pb.BinaryEncoder{
 			Encoder: &model_encoding_pb.BinaryEncoder_LzwCompressing{
 				LzwCompressing: &emptypb.Empty{},
@@ -250,13 +437,36 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		logger.Fatal("Invalid file creation parameters: ", err)
 	}
 
+	// Load the cache containing Merkle trees of files and
+	// directories that were computed by previous invocations, so
+	// that only files and directories that changed need to be
+	// scanned.
+	outputBase, err := commands.GetOutputBase(startupFlags, workspacePath)
+	if err != nil {
+		logger.Fatal("Failed to determine output base: ", err)
+	}
+	fileContentsCacheParametersDigest, err := getFileContentsCacheParametersDigest(referenceFormat, fileParametersMessage, directoryParametersMessage)
+	if err != nil {
+		logger.Fatal("Failed to compute file contents cache parameters digest: ", err)
+	}
+	fileContentsCache, err := loadFileContentsCache(outputBase, referenceFormat, fileContentsCacheParametersDigest)
+	if err != nil {
+		logger.Warning("Failed to load file contents cache, rescanning all module sources: ", err)
+	}
+	capturableDirectoryOptions := &localCapturableDirectoryOptions{
+		fileParameters:    fileParameters,
+		fileContentsCache: fileContentsCache,
+		outputBase:        outputBase,
+	}
+
 	// Construct Merkle trees for all modules that need to be
 	// uploaded to storage.
-	logger.Info("Scanning module sources")
+	logger.SetProgress(&logging.Progress{Phase: logging.PhaseScanning})
 	group, groupCtx := errgroup.WithContext(context.Background())
 	moduleRootDirectories := make([]model_filesystem.CapturedDirectory, 0, len(moduleNames))
 	createdModuleRootDirectories := make([]model_filesystem.CreatedDirectory[model_filesystem.CapturedObject], len(moduleNames))
 	createMerkleTreesConcurrency := semaphore.NewWeighted(int64(runtime.NumCPU()))
+	capturableModules := make([]localCapturableModule, len(moduleNames))
 	group.Go(func() error {
 		for i, moduleName := range moduleNames {
 			modulePath := modulePaths[moduleName]
@@ -267,18 +477,30 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 			moduleRootDirectories = append(moduleRootDirectories, localCapturedDirectory{
 				DirectoryCloser: moduleRootDirectory,
 			})
+			ignorer, err := newModuleDirectoryIgnorer(moduleRootDirectory, moduleName, moduleName == rootModuleName, referenceFormat)
+			if err != nil {
+				return util.StatusWrapf(err, "Failed to determine ignored directories of module %#v", moduleName.String())
+			}
+			capturableModules[i] = localCapturableModule{
+				name:               moduleName,
+				isRootModule:       moduleName == rootModuleName,
+				ignorer:            ignorer,
+				directoryCacheKeys: map[string][]byte{},
+			}
+			capturableModuleRootDirectory := &localCapturableDirectory{
+				DirectoryCloser: moduleRootDirectory,
+				options:         capturableDirectoryOptions,
+				module:          &capturableModules[i],
+			}
+			if _, _, err := capturableModuleRootDirectory.computeDirectoryCacheKey(fileContentsCacheKey{}); err != nil {
+				return util.StatusWrapf(err, "Failed to compute directory cache keys for module %#v", moduleName.String())
+			}
 			if err := model_filesystem.CreateDirectoryMerkleTree(
 				groupCtx,
 				createMerkleTreesConcurrency,
 				group,
 				directoryParameters,
-				&localCapturableDirectory[model_filesystem.CapturedObject, model_core.NoopReferenceMetadata]{
-					DirectoryCloser: moduleRootDirectory,
-					options: &localCapturableDirectoryOptions[model_core.NoopReferenceMetadata]{
-						fileParameters: fileParameters,
-						capturer:       model_filesystem.NoopFileMerkleTreeCapturer,
-					},
-				},
+				capturableModuleRootDirectory,
 				model_filesystem.FileDiscardingDirectoryMerkleTreeCapturer,
 				&createdModuleRootDirectories[i],
 			); err != nil {
@@ -290,40 +512,73 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 	if err := group.Wait(); err != nil {
 		logger.Fatal(err)
 	}
+	for i, moduleName := range moduleNames {
+		if err := fileContentsCache.insertDirectories(moduleName.String(), capturableModules[i].directoryCacheKeys, &createdModuleRootDirectories[i], directoryParameters.DirectoryAccessParameters); err != nil {
+			logger.Warning(fmt.Sprintf("Failed to cache directories of module %#v: %s", moduleName.String(), err))
+		}
+	}
+	if err := fileContentsCache.save(outputBase); err != nil {
+		logger.Warning("Failed to save file contents cache: ", err)
+	}
+	for i := range capturableModules {
+		// Symbolic links are reported both while computing
+		// directory cache keys and while creating Merkle trees.
+		if skippedSymlinks := capturableModules[i].skippedSymlinks; len(skippedSymlinks) > 0 {
+			slices.Sort(skippedSymlinks)
+			skippedSymlinks = slices.Compact(skippedSymlinks)
+			logger.Warning(fmt.Sprintf(
+				"Skipped %d symbolic link(s) in module %#v that escape the module's root directory, such as %#v",
+				len(skippedSymlinks),
+				capturableModules[i].name.String(),
+				skippedSymlinks[0],
+			))
+		}
+	}
 
 	// Construct a BuildSpecification message that lists all the
 	// modules and contains all of the flags to instruct what needs
 	// to be built.
 	buildSpecification := model_build_pb.BuildSpecification{
 		RootModuleName:                  rootModuleName.String(),
-		TargetPatterns:                  args.Arguments,
+		TargetPatterns:                  targetPatterns,
 		DirectoryCreationParameters:     directoryParametersMessage,
 		FileCreationParameters:          fileParametersMessage,
-		IgnoreRootModuleDevDependencies: args.CommonFlags.IgnoreDevDependency,
-		BuiltinsModuleNames:             args.CommonFlags.BuiltinsModule,
-		RepoPlatform:                    args.CommonFlags.RepoPlatform,
+		IgnoreRootModuleDevDependencies: commonFlags.IgnoreDevDependency,
+		BuiltinsModuleNames:             commonFlags.BuiltinsModule,
+		TargetPlatform:                  buildFlags.Platforms,
+		HostPlatform:                    buildFlags.HostPlatform,
+		RepoPlatform:                    commonFlags.RepoPlatform,
 		CommandEncoders:                 defaultEncoders,
 	}
-	switch args.CommonFlags.LockfileMode {
+	switch commonFlags.LockfileMode {
 	case arguments.LockfileMode_Off:
 	case arguments.LockfileMode_Update:
 		buildSpecification.UseLockfile = &model_build_pb.UseLockfile{}
 	case arguments.LockfileMode_Refresh:
 		buildSpecification.UseLockfile = &model_build_pb.UseLockfile{
-			Error: true,
+			MaximumCacheDuration: &durationpb.Duration{Seconds: 3600},
 		}
 	case arguments.LockfileMode_Error:
 		buildSpecification.UseLockfile = &model_build_pb.UseLockfile{
-			MaximumCacheDuration: &durationpb.Duration{Seconds: 3600},
+			Error: true,
 		}
 	default:
 		panic("unknown lockfile mode")
 	}
-	if len(args.CommonFlags.Registry) > 0 {
-		buildSpecification.ModuleRegistryUrls = args.CommonFlags.Registry
+	if len(commonFlags.Registry) > 0 {
+		buildSpecification.ModuleRegistryUrls = commonFlags.Registry
 	} else {
 		buildSpecification.ModuleRegistryUrls = []string{"https://bcr.bazel.build/"}
 	}
+
+	// Capture environment variables that should be visible to
+	// repository rules and module extensions.
+	repoEnvironmentVariables, err := getRepoEnvironmentVariables(buildFlags.ActionEnv, commonFlags.RepoEnv, os.LookupEnv)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	buildSpecification.RepoEnvironmentVariables = repoEnvironmentVariables
+
 	buildSpecificationPatcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
 
 	for i, moduleName := range moduleNames {
@@ -379,8 +634,8 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		logger.Fatal("Failed to create build specification object: ", err)
 	}
 
-	logger.Info("Uploading module sources")
-	instanceName := object.NewInstanceName(args.CommonFlags.RemoteInstanceName)
+	uploadProgress := newUploadProgress(logger)
+	instanceName := object.NewInstanceName(commonFlags.RemoteInstanceName)
 	buildSpecificationReference := buildSpecificationObject.GetReference()
 	if err := dag.UploadDAG(
 		context.Background(),
@@ -389,9 +644,11 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 			InstanceName:   instanceName,
 			LocalReference: buildSpecificationReference,
 		},
-		dag.NewSimpleObjectContentsWalker(
-			buildSpecificationObject,
-			buildSpecificationWalkers,
+		uploadProgress.newObjectContentsWalker(
+			dag.NewSimpleObjectContentsWalker(
+				buildSpecificationObject,
+				buildSpecificationWalkers,
+			),
 		),
 		semaphore.NewWeighted(10),
 		object.NewLimit(&object_pb.Limit{
@@ -402,49 +659,11 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		logger.Fatal("Failed to upload workspace directory: ", err)
 	}
 
-	clientPrivateKeyData, err := os.ReadFile(args.CommonFlags.RemoteExecutorClientPrivateKey)
-	if err != nil {
-		logger.Fatalf("Failed to read --remote_executor_client_private_key=%#v: %s", args.CommonFlags.RemoteExecutorClientPrivateKey, err)
-	}
-	clientPrivateKey, err := remoteexecution.ParseECDHPrivateKey(clientPrivateKeyData)
-	if err != nil {
-		logger.Fatalf("Failed to parse --remote_executor_client_private_key=%#v: %s", args.CommonFlags.RemoteExecutorClientPrivateKey, err)
-	}
-
-	clientCertificateChainData, err := os.ReadFile(args.CommonFlags.RemoteExecutorClientCertificateChain)
-	if err != nil {
-		logger.Fatalf("Failed to read --remote_executor_client_certificate_chain=%#v: %s", args.CommonFlags.RemoteExecutorClientCertificateChain, err)
-	}
-	clientCertificateChain, err := remoteexecution.ParseCertificateChain(clientCertificateChainData)
-	if err != nil {
-		logger.Fatalf("Failed to parse --remote_executor_client_certificate_chain=%#v: %s", args.CommonFlags.RemoteExecutorClientCertificateChain, err)
-	}
-
-	remoteExecutorClient, err := newGRPCClient(args.CommonFlags.RemoteExecutor, &args.CommonFlags)
-	if err != nil {
-		logger.Fatalf("Failed to create gRPC client for --remote_executor=%#v: %s", args.CommonFlags.RemoteExecutor, err)
-	}
-	builderClient := remoteexecution.NewClient[*model_build_pb.Action, emptypb.Empty, *model_build_pb.Result](
-		remoteexecution_pb.NewExecutionClient(remoteExecutorClient),
-		clientPrivateKey,
-		clientCertificateChain,
-	)
-
-	builderPKIXPublicKey, err := base64.StdEncoding.DecodeString(args.CommonFlags.RemoteExecutorBuilderPkixPublicKey)
-	if err != nil {
-		logger.Fatalf("Failed to base64 decode --remote_executor_builder_pkix_public_key: %s", err)
-	}
-	builderPublicKey, err := x509.ParsePKIXPublicKey(builderPKIXPublicKey)
-	if err != nil {
-		logger.Fatalf("Failed to parse --remote_executor_builder_pkix_public_key: %s", err)
-	}
-	builderECDHPublicKey, ok := builderPublicKey.(*ecdh.PublicKey)
-	if !ok {
-		logger.Fatalf("--remote_executor_builder_pkix_public_key is not an ECDH public key")
-	}
+	builderClient := newBuilderExecutionClient(logger, commonFlags)
+	builderECDHPublicKey := getBuilderPublicKey(logger, commonFlags)
 
 	var invocationID uuid.UUID
-	if v := args.CommonFlags.InvocationId; v == "" {
+	if v := commonFlags.InvocationId; v == "" {
 		invocationID = uuid.Must(uuid.NewRandom())
 	} else {
 		invocationID, err = uuid.Parse(v)
@@ -453,7 +672,7 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		}
 	}
 	var buildRequestID uuid.UUID
-	if v := args.CommonFlags.BuildRequestId; v == "" {
+	if v := commonFlags.BuildRequestId; v == "" {
 		buildRequestID = uuid.Must(uuid.NewRandom())
 	} else {
 		buildRequestID, err = uuid.Parse(v)
@@ -462,12 +681,44 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 		}
 	}
 
-	logger.Info("Performing build")
+	// Announce the start of the build to consumers of the Build
+	// Event Protocol (BEP).
+	buildEventPublisher, err := newBuildEventPublisher(commonFlags, invocationID.String(), buildRequestID.String())
+	if err != nil {
+		logger.Fatal(err)
+	}
+	command := "build"
+	if moduleQuery != nil {
+		command = "mod"
+	}
+	publishBuildStarted(logger, buildEventPublisher, invocationID.String(), startTime, command, targetPatterns, workspacePath)
+
+	// Create the bazel-* convenience symlinks in the workspace
+	// directory, pointing into the output base.
+	if moduleQuery == nil {
+		workspaceDirectoryPath, err := commands.GetWorkspaceDirectory(workspacePath)
+		if err != nil {
+			logger.Fatal("Failed to obtain workspace path: ", err)
+		}
+		if err := commands.CreateConvenienceSymlinks(outputBase, workspaceDirectoryPath); err != nil {
+			logger.Warning("Failed to create convenience symlinks: ", err)
+		}
+	}
+
+	// Submit the build to the builder. As long as the build is in
+	// progress, store its state in the output base, so that it can be
+	// reattached to if the connection to the scheduler is lost.
+	logger.SetProgress(&logging.Progress{Phase: logging.PhaseAnalyzing})
+	ctx, stopInterruptHandling := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stopInterruptHandling()
+	var operationName string
 	var result model_build_pb.Result
 	var errBuild error
-	for event := range builderClient.RunAction(
-		context.Background(),
+	events := submitBuild(
+		ctx,
+		builderClient,
 		builderECDHPublicKey,
+		commonFlags,
 		&model_build_pb.Action{
 			InvocationId:   invocationID.String(),
 			BuildRequestId: buildRequestID.String(),
@@ -477,20 +728,269 @@ func DoBuild(args *arguments.BuildCommand, workspacePath path.Parser) {
 			}.ToProto(),
 			BuildSpecificationReference: buildSpecificationReference.GetRawReference(),
 			BuildSpecificationEncoders:  defaultEncoders,
+			ModuleQuery:                 moduleQuery,
 		},
-		&remoteexecution_pb.Action_AdditionalData{
-			ExecutionTimeout: &durationpb.Duration{Seconds: 24 * 60 * 60},
+		func(operation *remoteexecution.Operation) {
+			operationName = operation.Name
+			if moduleQuery != nil {
+				// Module queries complete quickly, and
+				// their results can only be displayed by
+				// the mod command.
+				return
+			}
+			builderPKIXPublicKey, err := x509.MarshalPKIXPublicKey(operation.PlatformECDHPublicKey)
+			if err == nil {
+				err = savePendingBuild(outputBase, &pendingbuild_pb.PendingBuild{
+					OperationName:          operation.Name,
+					BuilderPkixPublicKey:   builderPKIXPublicKey,
+					ActionCiphertextSha256: operation.ActionCiphertextDigest[:],
+					TargetPatterns:         targetPatterns,
+					InvocationId:           invocationID.String(),
+					BuildRequestId:         buildRequestID.String(),
+					StartTime:              timestamppb.New(startTime),
+				})
+			}
+			if err != nil {
+				logger.Warning("Failed to save state of pending build, meaning it cannot be reattached to: ", err)
+			}
 		},
 		&result,
 		&errBuild,
-	) {
-		logger.Info(event)
+	)
+	awaitBuild(ctx, logger, commonFlags, workspacePath, outputBase, buildEventPublisher, events, &operationName, &result, &errBuild)
+	return &result
+}
+
+// submitBuild submits a build to the builder through the scheduler.
+// The invocation ID and build request ID of the build are attached to
+// the call as request metadata, so that the scheduler can group
+// operations belonging to the same build. The build is executed with
+// the priority provided through --remote_execution_priority.
+func submitBuild(ctx context.Context, builderClient *builderExecutionClient, builderECDHPublicKey *ecdh.PublicKey, commonFlags *arguments.CommonFlags, action *model_build_pb.Action, onOperationCreated func(operation *remoteexecution.Operation), result *model_build_pb.Result, errOut *error) iter.Seq[*model_build_pb.Event] {
+	executeCtx, err := remoteexecution.NewOutgoingContextWithRequestMetadata(ctx, &remoteexecution_pb.RequestMetadata{
+		ToolInvocationId:        action.InvocationId,
+		CorrelatedInvocationsId: action.BuildRequestId,
+	})
+	if err != nil {
+		*errOut = err
+		return func(func(*model_build_pb.Event) bool) {}
+	}
+
+	// Both the scheduler and the builder need to be aware of the
+	// priority. The former uses it to schedule the build itself,
+	// while the latter uses it to schedule actions of the build.
+	action.ExecutionPriority = commonFlags.RemoteExecutionPriority
+	return builderClient.RunAction(
+		executeCtx,
+		builderECDHPublicKey,
+		action,
+		&remoteexecution_pb.Action_AdditionalData{
+			ExecutionTimeout: &durationpb.Duration{Seconds: 24 * 60 * 60},
+		},
+		commonFlags.RemoteExecutionPriority,
+		onOperationCreated,
+		result,
+		errOut,
+	)
+}
+
+// publishBuildStarted announces the start of the build to consumers of
+// the Build Event Protocol (BEP).
+func publishBuildStarted(logger logging.Logger, buildEventPublisher *buildevents.Publisher, invocationID string, startTime time.Time, command string, targetPatterns []string, workspacePath path.Parser) {
+	workingDirectory, err := os.Getwd()
+	if err != nil {
+		logger.Fatal("Failed to obtain working directory: ", err)
 	}
-	if errBuild != nil {
-		logger.Fatal("Failed to perform build: ", errBuild)
+	workspaceDirectoryPath, err := commands.GetWorkspaceDirectory(workspacePath)
+	if err != nil {
+		logger.Fatal("Failed to resolve workspace directory: ", err)
+	}
+	buildEventPublisher.BuildStarted(
+		&buildeventstream_pb.BuildStarted{
+			Uuid:               invocationID,
+			StartTime:          timestamppb.New(startTime),
+			Command:            command,
+			WorkingDirectory:   workingDirectory,
+			WorkspaceDirectory: workspaceDirectoryPath,
+		},
+		targetPatterns,
+	)
+}
+
+// awaitBuild processes the events of a build that has been submitted
+// to the builder, displaying its progress and diagnostics until it
+// completes. If the provided context is canceled because the user
+// interrupted the build, the build is killed.
+//
+// Upon successful completion, the lockfile returned by the builder is
+// written into the workspace. Checking the status of the result is left to the
+// caller.
+func awaitBuild(ctx context.Context, logger logging.Logger, commonFlags *arguments.CommonFlags, workspacePath path.Parser, outputBase string, buildEventPublisher *buildevents.Publisher, events iter.Seq[*model_build_pb.Event], operationName *string, result *model_build_pb.Result, errBuild *error) {
+	diagnosticsLogged := 0
+	for event := range events {
+		logDiagnostics(logger, buildEventPublisher, int(event.FirstDiagnosticIndex), event.Diagnostics, &diagnosticsLogged)
+		logBuilderProgress(logger, event.Progress)
+	}
+	logger.SetProgress(nil)
+	logDiagnostics(logger, buildEventPublisher, 0, result.Diagnostics, &diagnosticsLogged)
+
+	removeState := func() {
+		if *operationName != "" {
+			if err := removePendingBuild(outputBase, *operationName); err != nil {
+				logger.Warning("Failed to remove state of pending build: ", err)
+			}
+		}
 	}
-	if err := status.FromProto(result.Status); err != nil {
+	if err := *errBuild; err != nil {
+		if ctx.Err() != nil {
+			// The user interrupted the build. Closing the
+			// stream does not stop the build immediately, as
+			// the scheduler permits clients to reattach. Kill
+			// the build explicitly if possible.
+			if *operationName != "" {
+				if killed, err := killBuild(commonFlags, *operationName); err != nil {
+					logger.Warning(err)
+				} else if !killed {
+					logger.Info("Set --remote_build_queue_state to kill interrupted builds immediately. The scheduler now stops the build once it notices that no clients are waiting for it")
+				}
+			}
+			removeState()
+			if err := buildEventPublisher.BuildFinished(exitCodeInterrupted, time.Now()); err != nil {
+				logger.Warning("Failed to publish build events: ", err)
+			}
+			logger.FatalWithExitCode(int(exitCodeInterrupted.Code), "Build interrupted")
+		}
+
+		if err := buildEventPublisher.BuildFinished(exitCodeRemoteError, time.Now()); err != nil {
+			logger.Warning("Failed to publish build events: ", err)
+		}
+		if *operationName != "" && status.Code(err) == codes.Unavailable {
+			logger.Fatalf("Failed to perform build: %s. The build may still be in progress. Run \"bonanza_bazel attach %s\" to reattach to it", err, *operationName)
+		}
+		removeState()
 		logger.Fatal("Failed to perform build: ", err)
 	}
-	logger.Info(result)
+	removeState()
+
+	buildSucceeded := status.ErrorProto(result.Status) == nil
+	buildEventPublisher.TargetsCompleted(result.Targets, buildSucceeded)
+	exitCode := exitCodeSuccess
+	if !buildSucceeded {
+		exitCode = exitCodeBuildFailure
+	}
+	if err := buildEventPublisher.BuildFinished(exitCode, time.Now()); err != nil {
+		logger.Warning("Failed to publish build events: ", err)
+	}
+
+	// Write the lockfile provided by the builder into the
+	// workspace. The builder only provides a lockfile if the build
+	// succeeded, meaning that failed builds leave any existing
+	// lockfile untouched.
+	if moduleLockfile := result.ModuleLockfile; len(moduleLockfile) > 0 && commonFlags.LockfileMode != arguments.LockfileMode_Error {
+		if err := WriteWorkspaceFile(workspacePath, path.MustNewComponent("MODULE.bazel.lock"), moduleLockfile); err != nil {
+			logger.Fatal("Failed to write MODULE.bazel.lock: ", err)
+		}
+	}
+}
+
+// WriteWorkspaceFile writes the contents of a file such as
+// MODULE.bazel.lock into the workspace. The file is left untouched if
+// its contents are unchanged, so that its modification time is
+// preserved.
+func WriteWorkspaceFile(workspacePath path.Parser, name path.Component, contents []byte) error {
+	workspaceDirectory, err := filesystem.NewLocalDirectory(workspacePath)
+	if err != nil {
+		return err
+	}
+	defer workspaceDirectory.Close()
+
+	if f, err := workspaceDirectory.OpenRead(name); err == nil {
+		existingContents, err := io.ReadAll(io.NewSectionReader(f, 0, math.MaxInt64))
+		f.Close()
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(existingContents, contents) {
+			return nil
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	f, err := workspaceDirectory.OpenWrite(name, filesystem.CreateReuse(0o666))
+	if err != nil {
+		return err
+	}
+	if err := f.Truncate(0); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.WriteAt(contents, 0); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// logDiagnostics writes diagnostics reported by the builder to the
+// terminal, and publishes them as progress build events. Diagnostics
+// that were logged previously are skipped.
+//
+// If events were discarded by the scheduler, diagnostics may be
+// received with a gap. These are not logged immediately, as that would
+// cause them to be displayed out of order. Instead, they are logged
+// once the result containing all diagnostics is received.
+func logDiagnostics(logger logging.Logger, buildEventPublisher *buildevents.Publisher, firstDiagnosticIndex int, diagnostics []*model_build_pb.Diagnostic, diagnosticsLogged *int) {
+	if firstDiagnosticIndex > *diagnosticsLogged {
+		return
+	}
+	var stderr strings.Builder
+	for _, diagnostic := range diagnostics[min(*diagnosticsLogged-firstDiagnosticIndex, len(diagnostics)):] {
+		message := diagnostic.Message
+		if diagnostic.Location != "" {
+			message = diagnostic.Location + ": " + message
+		}
+		switch diagnostic.Kind {
+		case model_build_pb.Diagnostic_WARNING:
+			logger.Warning(message)
+			stderr.WriteString("WARNING: ")
+		default:
+			logger.Debug(message)
+			stderr.WriteString("DEBUG: ")
+		}
+		stderr.WriteString(message)
+		stderr.WriteByte('\n')
+	}
+	*diagnosticsLogged = max(*diagnosticsLogged, firstDiagnosticIndex+len(diagnostics))
+	if stderr.Len() > 0 {
+		buildEventPublisher.Progress("", stderr.String())
+	}
+}
+
+// logBuilderProgress displays the progress of the build reported by
+// the builder. The build is considered to be in the execution phase as
+// soon as the builder starts executing actions.
+func logBuilderProgress(logger logging.Logger, progress *model_build_pb.Progress) {
+	if progress == nil {
+		return
+	}
+	loggedProgress := logging.Progress{
+		Phase:             logging.PhaseAnalyzing,
+		ConfiguredTargets: progress.ConfiguredTargets,
+		CompletedActions:  progress.CompletedActions,
+		RunningOperations: make([]logging.RunningOperation, 0, len(progress.RunningOperations)),
+	}
+	for _, operation := range progress.RunningOperations {
+		if operation.IsAction {
+			loggedProgress.RunningActions++
+		}
+		loggedProgress.RunningOperations = append(loggedProgress.RunningOperations, logging.RunningOperation{
+			Description: operation.Description,
+			StartTime:   operation.StartTime.AsTime(),
+		})
+	}
+	if loggedProgress.CompletedActions > 0 || loggedProgress.RunningActions > 0 {
+		loggedProgress.Phase = logging.PhaseExecuting
+	}
+	logger.SetProgress(&loggedProgress)
 }
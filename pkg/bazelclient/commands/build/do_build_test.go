@@ -0,0 +1,340 @@
+package build
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/buildevents"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/commands"
+	model_filesystem "github.com/buildbarn/bonanza/pkg/model/filesystem"
+	buildeventstream_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/buildeventstream"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	remoteexecution_pb "github.com/buildbarn/bonanza/pkg/proto/remoteexecution"
+	"github.com/buildbarn/bonanza/pkg/remoteexecution"
+	"github.com/secure-io/siv-go"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestAwaitBuild(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	// awaitBuildWithResult calls awaitBuild for a build that
+	// completed without any errors in the transport, returning the
+	// build events that were published.
+	awaitBuildWithResult := func(t *testing.T, commonFlags *arguments.CommonFlags, workspacePath string, result *model_build_pb.Result) []*buildeventstream_pb.BuildEvent {
+		logger := NewMockLogger(ctrl)
+		logger.EXPECT().SetProgress(nil)
+
+		var events []*buildeventstream_pb.BuildEvent
+		sink := NewMockSink(ctrl)
+		sink.EXPECT().Send(gomock.Any()).DoAndReturn(func(event *buildeventstream_pb.BuildEvent) error {
+			events = append(events, event)
+			return nil
+		}).AnyTimes()
+		sink.EXPECT().Close()
+
+		operationName := ""
+		var errBuild error
+		awaitBuild(
+			ctx,
+			logger,
+			commonFlags,
+			path.LocalFormat.NewParser(workspacePath),
+			t.TempDir(),
+			buildevents.NewPublisher([]buildevents.Sink{sink}),
+			slices.Values([]*model_build_pb.Event(nil)),
+			&operationName,
+			result,
+			&errBuild,
+		)
+		return events
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// A build that completed without an error status
+		// should be reported as succeeded.
+		events := awaitBuildWithResult(t, &arguments.CommonFlags{}, t.TempDir(), &model_build_pb.Result{})
+		require.NotEmpty(t, events)
+		testutil.RequireEqualProto(t, &buildeventstream_pb.BuildFinished_ExitCode{
+			Name: "SUCCESS",
+			Code: 0,
+		}, events[len(events)-1].GetFinished().GetExitCode())
+		require.True(t, events[len(events)-1].LastMessage)
+	})
+
+	t.Run("BuildFailure", func(t *testing.T) {
+		events := awaitBuildWithResult(t, &arguments.CommonFlags{}, t.TempDir(), &model_build_pb.Result{
+			Status: status.New(codes.FailedPrecondition, "Target //:foo failed to build").Proto(),
+		})
+		require.NotEmpty(t, events)
+		testutil.RequireEqualProto(t, &buildeventstream_pb.BuildFinished_ExitCode{
+			Name: "BUILD_FAILURE",
+			Code: 1,
+		}, events[len(events)-1].GetFinished().GetExitCode())
+		require.True(t, events[len(events)-1].LastMessage)
+	})
+
+	t.Run("ModuleLockfileWrittenOnSuccess", func(t *testing.T) {
+		// The lockfile computed by the builder should be
+		// written into the workspace.
+		workspacePath := t.TempDir()
+		awaitBuildWithResult(t, &arguments.CommonFlags{
+			LockfileMode: arguments.LockfileMode_Update,
+		}, workspacePath, &model_build_pb.Result{
+			ModuleLockfile: []byte("{\n  \"lockFileVersion\": 18\n}\n"),
+		})
+		moduleLockfile, err := os.ReadFile(filepath.Join(workspacePath, "MODULE.bazel.lock"))
+		require.NoError(t, err)
+		require.Equal(t, "{\n  \"lockFileVersion\": 18\n}\n", string(moduleLockfile))
+	})
+
+	t.Run("ModuleLockfileModeError", func(t *testing.T) {
+		// With --lockfile_mode=error, the lockfile in the
+		// workspace should be left untouched.
+		workspacePath := t.TempDir()
+		awaitBuildWithResult(t, &arguments.CommonFlags{
+			LockfileMode: arguments.LockfileMode_Error,
+		}, workspacePath, &model_build_pb.Result{
+			ModuleLockfile: []byte("{\n  \"lockFileVersion\": 18\n}\n"),
+		})
+		_, err := os.Stat(filepath.Join(workspacePath, "MODULE.bazel.lock"))
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Interrupted", func(t *testing.T) {
+		// If the user interrupts the build, the process should
+		// terminate with the same exit code as the one that is
+		// reported through the Build Event Protocol.
+		logger := NewMockLogger(ctrl)
+		logger.EXPECT().SetProgress(nil)
+		logger.EXPECT().Info("Set --remote_build_queue_state to kill interrupted builds immediately. The scheduler now stops the build once it notices that no clients are waiting for it")
+		logger.EXPECT().FatalWithExitCode(8, "Build interrupted").Do(func(exitCode int, v ...any) {
+			panic("Process terminated")
+		})
+
+		var events []*buildeventstream_pb.BuildEvent
+		sink := NewMockSink(ctrl)
+		sink.EXPECT().Send(gomock.Any()).DoAndReturn(func(event *buildeventstream_pb.BuildEvent) error {
+			events = append(events, event)
+			return nil
+		}).AnyTimes()
+		sink.EXPECT().Close()
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		operationName := "7ec2c5a4-8ebd-4a3c-a0b5-2f3ef5d4d3b0"
+		errBuild := util.StatusFromContext(ctx)
+		require.PanicsWithValue(t, "Process terminated", func() {
+			awaitBuild(
+				ctx,
+				logger,
+				&arguments.CommonFlags{},
+				path.LocalFormat.NewParser(t.TempDir()),
+				t.TempDir(),
+				buildevents.NewPublisher([]buildevents.Sink{sink}),
+				slices.Values([]*model_build_pb.Event(nil)),
+				&operationName,
+				&model_build_pb.Result{},
+				&errBuild,
+			)
+		})
+		require.NotEmpty(t, events)
+		testutil.RequireEqualProto(t, &buildeventstream_pb.BuildFinished_ExitCode{
+			Name: "INTERRUPTED",
+			Code: 8,
+		}, events[len(events)-1].GetFinished().GetExitCode())
+	})
+}
+
+func TestLogDiagnostics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Events only contain diagnostics emitted since the previous
+	// event, while the result contains all of them.
+	diagnostics := []*model_build_pb.Diagnostic{
+		{Kind: model_build_pb.Diagnostic_DEBUG, Location: "@@main+//:BUILD.bazel:1:6", Message: "a"},
+		{Kind: model_build_pb.Diagnostic_WARNING, Message: "b"},
+		{Kind: model_build_pb.Diagnostic_DEBUG, Message: "c"},
+		{Kind: model_build_pb.Diagnostic_DEBUG, Message: "d"},
+	}
+	logger := NewMockLogger(ctrl)
+	buildEventPublisher := buildevents.NewPublisher(nil)
+	diagnosticsLogged := 0
+
+	logger.EXPECT().Debug("@@main+//:BUILD.bazel:1:6: a")
+	logDiagnostics(logger, buildEventPublisher, 0, diagnostics[:1], &diagnosticsLogged)
+	logger.EXPECT().Warning("b")
+	logDiagnostics(logger, buildEventPublisher, 1, diagnostics[1:2], &diagnosticsLogged)
+	require.Equal(t, 2, diagnosticsLogged)
+
+	// Events without any diagnostics, such as the ones that only
+	// report progress, should not cause anything to be logged.
+	logDiagnostics(logger, buildEventPublisher, 0, nil, &diagnosticsLogged)
+
+	// If an event was discarded by the scheduler, diagnostics
+	// following it should not be logged immediately, as that would
+	// cause them to be logged out of order.
+	logDiagnostics(logger, buildEventPublisher, 3, diagnostics[3:], &diagnosticsLogged)
+	require.Equal(t, 2, diagnosticsLogged)
+
+	// The result should cause all diagnostics that were not logged
+	// previously to be logged, in order.
+	gomock.InOrder(
+		logger.EXPECT().Debug("c"),
+		logger.EXPECT().Debug("d"),
+	)
+	logDiagnostics(logger, buildEventPublisher, 0, diagnostics, &diagnosticsLogged)
+	require.Equal(t, 4, diagnosticsLogged)
+}
+
+func TestSubmitBuild(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	clientPrivateKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	builderPrivateKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	executionClient := NewMockExecutionClient(ctrl)
+	builderClient := remoteexecution.NewClient[*model_build_pb.Action, model_build_pb.Event, *model_build_pb.Result](
+		executionClient,
+		clientPrivateKey,
+		nil,
+		clock.SystemClock,
+	)
+
+	// The request metadata should be attached to the call, and
+	// both the scheduler and the builder should be informed about
+	// the priority provided through --remote_execution_priority.
+	executionClient.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *remoteexecution_pb.ExecuteRequest, opts ...grpc.CallOption) (remoteexecution_pb.Execution_ExecuteClient, error) {
+			md, ok := metadata.FromOutgoingContext(ctx)
+			require.True(t, ok)
+			requestMetadata, err := remoteexecution.GetRequestMetadataFromIncomingContext(metadata.NewIncomingContext(ctx, md))
+			require.NoError(t, err)
+			testutil.RequireEqualProto(t, &remoteexecution_pb.RequestMetadata{
+				ToolInvocationId:        "a4f0b7c1-3c8e-4d2a-9f55-1e6f0a7b2c3d",
+				CorrelatedInvocationsId: "5d2e8f4a-6b1c-4e7d-8a9f-0c3b2d1e4f5a",
+			}, requestMetadata)
+
+			require.Equal(t, int32(-10), request.Priority)
+
+			sharedSecret, err := builderPrivateKey.ECDH(clientPrivateKey.PublicKey())
+			require.NoError(t, err)
+			sharedSecret[0] ^= 1
+			actionAEAD, err := siv.NewGCM(sharedSecret)
+			require.NoError(t, err)
+			additionalData, err := proto.Marshal(request.Action.AdditionalData)
+			require.NoError(t, err)
+			actionData, err := actionAEAD.Open(nil, request.Action.Nonce, request.Action.Ciphertext, additionalData)
+			require.NoError(t, err)
+			var actionAny anypb.Any
+			require.NoError(t, proto.Unmarshal(actionData, &actionAny))
+			var action model_build_pb.Action
+			require.NoError(t, actionAny.UnmarshalTo(&action))
+			testutil.RequireEqualProto(t, &model_build_pb.Action{
+				InvocationId:      "a4f0b7c1-3c8e-4d2a-9f55-1e6f0a7b2c3d",
+				BuildRequestId:    "5d2e8f4a-6b1c-4e7d-8a9f-0c3b2d1e4f5a",
+				ExecutionPriority: -10,
+			}, &action)
+
+			return nil, status.Error(codes.Internal, "Scheduler not available")
+		})
+
+	var result model_build_pb.Result
+	var errBuild error
+	for range submitBuild(
+		context.Background(),
+		builderClient,
+		builderPrivateKey.PublicKey(),
+		&arguments.CommonFlags{
+			RemoteExecutionPriority: -10,
+		},
+		&model_build_pb.Action{
+			InvocationId:   "a4f0b7c1-3c8e-4d2a-9f55-1e6f0a7b2c3d",
+			BuildRequestId: "5d2e8f4a-6b1c-4e7d-8a9f-0c3b2d1e4f5a",
+		},
+		/* onOperationCreated = */ nil,
+		&result,
+		&errBuild,
+	) {
+		t.Fatal("No events should have been yielded")
+	}
+	testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Scheduler not available"), errBuild)
+}
+
+func TestLocalCapturableDirectoryReadDir(t *testing.T) {
+	workspaceDirectory := filepath.Join(t.TempDir(), "project")
+	require.NoError(t, os.Mkdir(workspaceDirectory, 0o777))
+	outputBase := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(workspaceDirectory, "MODULE.bazel"), nil, 0o666))
+	require.NoError(t, commands.CreateConvenienceSymlinks(outputBase, workspaceDirectory))
+	require.NoError(t, os.Symlink("MODULE.bazel", filepath.Join(workspaceDirectory, "link")))
+	require.NoError(t, os.Symlink(filepath.Join(outputBase, "execroot"), filepath.Join(workspaceDirectory, "execroot")))
+	require.NoError(t, os.Symlink(filepath.Join(outputBase+"2", "execroot"), filepath.Join(workspaceDirectory, "bazel-other")))
+
+	// readDir returns the names of the entries of the workspace
+	// directory that are captured, and the symbolic links that were
+	// skipped because they escape the module.
+	readDir := func(t *testing.T, isRootModule bool) ([]string, []string) {
+		directory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(workspaceDirectory))
+		require.NoError(t, err)
+		defer directory.Close()
+
+		module := &localCapturableModule{
+			isRootModule: isRootModule,
+			ignorer:      &model_filesystem.DirectoryIgnorer{},
+		}
+		entries, err := (&localCapturableDirectory{
+			DirectoryCloser: directory,
+			options: &localCapturableDirectoryOptions{
+				outputBase: outputBase,
+			},
+			module: module,
+		}).ReadDir()
+		require.NoError(t, err)
+		names := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			names = append(names, entry.Name().String())
+		}
+		slices.Sort(module.skippedSymlinks)
+		return names, module.skippedSymlinks
+	}
+
+	t.Run("RootModule", func(t *testing.T) {
+		// Symbolic links in the root directory of the root
+		// module that point into the output base should be
+		// skipped silently, regardless of their names. Other
+		// symbolic links that escape the module should be
+		// reported, even if their names start with "bazel-".
+		names, skippedSymlinks := readDir(t, true)
+		require.Equal(t, []string{"MODULE.bazel", "link"}, names)
+		require.Equal(t, []string{"bazel-other"}, skippedSymlinks)
+	})
+
+	t.Run("OtherModule", func(t *testing.T) {
+		// Other modules don't contain convenience symlinks.
+		names, skippedSymlinks := readDir(t, false)
+		require.Equal(t, []string{"MODULE.bazel", "link"}, names)
+		require.Equal(t, []string{"bazel-bin", "bazel-other", "bazel-out", "bazel-project", "bazel-testlogs", "execroot"}, skippedSymlinks)
+	})
+}
@@ -0,0 +1,571 @@
+package build
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_filesystem "github.com/buildbarn/bonanza/pkg/model/filesystem"
+	filecontentscache_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/filecontentscache"
+	model_filesystem_pb "github.com/buildbarn/bonanza/pkg/proto/model/filesystem"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fileContentsCacheFilename is the name of the file in the output base
+// in which fileContentsCache is stored.
+const fileContentsCacheFilename = "file_contents_cache"
+
+// fileContentsCacheKey contains the properties of a file that are
+// compared to determine whether a file has changed since its Merkle
+// tree was computed.
+type fileContentsCacheKey struct {
+	inodeNumber      uint64
+	sizeBytes        uint64
+	modificationTime time.Time
+	changeTime       time.Time
+}
+
+// getFileContentsCacheKey obtains the properties of an opened file that
+// are used as a key by fileContentsCache.
+func getFileContentsCacheKey(f filesystem.FileReader) (fileContentsCacheKey, bool) {
+	statter, ok := f.(interface{ Stat() (os.FileInfo, error) })
+	if !ok {
+		return fileContentsCacheKey{}, false
+	}
+	fileInfo, err := statter.Stat()
+	if err != nil {
+		return fileContentsCacheKey{}, false
+	}
+	inodeNumber, changeTime, ok := getInodeNumberAndChangeTime(fileInfo)
+	if !ok {
+		return fileContentsCacheKey{}, false
+	}
+	return fileContentsCacheKey{
+		inodeNumber:      inodeNumber,
+		sizeBytes:        uint64(fileInfo.Size()),
+		modificationTime: fileInfo.ModTime(),
+		changeTime:       changeTime,
+	}, true
+}
+
+// writeFileContentsCacheKey writes the properties contained in a
+// fileContentsCacheKey to a hasher, so that they can be incorporated
+// into the key of the directory containing the file.
+func writeFileContentsCacheKey(w io.Writer, key fileContentsCacheKey) {
+	var data [32]byte
+	binary.LittleEndian.PutUint64(data[0:], key.inodeNumber)
+	binary.LittleEndian.PutUint64(data[8:], key.sizeBytes)
+	binary.LittleEndian.PutUint64(data[16:], uint64(key.modificationTime.UnixNano()))
+	binary.LittleEndian.PutUint64(data[24:], uint64(key.changeTime.UnixNano()))
+	w.Write(data[:])
+}
+
+// fileContentsCache is a persistent cache of the references of Merkle
+// trees of files and directories contained in modules that are
+// uploaded by DoBuild(). It allows reading and chunking of files, and
+// creation of directory messages to be skipped if they have not
+// changed since the previous invocation.
+//
+// The timestamps of a directory only change when entries are added,
+// removed or renamed, not when files contained in it are modified.
+// Directories are therefore keyed on the properties of the directory
+// itself and the keys of all of its children, meaning that a directory
+// is considered to be changed if any of its descendants changed. In
+// addition to the Directory messages, all Directory and Leaves objects
+// referenced by them are cached, so that they can be uploaded if they
+// are no longer present in storage.
+//
+// Only files and directories that are encountered during the current
+// invocation are retained when the cache is saved, thereby preventing
+// the cache from growing indefinitely.
+type fileContentsCache struct {
+	referenceFormat  object.ReferenceFormat
+	parametersDigest []byte
+	scanStartTime    time.Time
+
+	lock                sync.Mutex
+	previousFiles       map[string]*filecontentscache_pb.FileContentsCache_File
+	currentFiles        map[string]*filecontentscache_pb.FileContentsCache_File
+	previousDirectories map[string]*filecontentscache_pb.FileContentsCache_Directory
+	currentDirectories  map[string]*filecontentscache_pb.FileContentsCache_Directory
+	previousObjects     map[object.LocalReference][]byte
+	currentObjects      map[object.LocalReference][]byte
+}
+
+// getFileContentsCacheParametersDigest computes a digest of all
+// parameters that affect the Merkle trees of files and directories.
+// Cache entries are only valid if this digest remains unchanged.
+func getFileContentsCacheParametersDigest(referenceFormat object.ReferenceFormat, fileParametersMessage, directoryParametersMessage proto.Message) ([]byte, error) {
+	hasher := sha256.New()
+	hasher.Write([]byte(referenceFormat.ToProto().String()))
+	for _, parametersMessage := range []proto.Message{fileParametersMessage, directoryParametersMessage} {
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(parametersMessage)
+		if err != nil {
+			return nil, err
+		}
+		hasher.Write([]byte{0})
+		hasher.Write(data)
+	}
+	return hasher.Sum(nil), nil
+}
+
+// loadFileContentsCache loads the file contents cache from the output
+// base. If no cache exists, or if it was created using different file
+// creation parameters, an empty cache is returned. An empty cache is
+// also returned if loading fails, so that callers may continue after
+// reporting the error.
+func loadFileContentsCache(outputBase string, referenceFormat object.ReferenceFormat, parametersDigest []byte) (*fileContentsCache, error) {
+	c := &fileContentsCache{
+		referenceFormat:  referenceFormat,
+		parametersDigest: parametersDigest,
+		scanStartTime:    time.Now(),
+		previousFiles:    map[string]*filecontentscache_pb.FileContentsCache_File{},
+		currentFiles:     map[string]*filecontentscache_pb.FileContentsCache_File{},
+
+		previousDirectories: map[string]*filecontentscache_pb.FileContentsCache_Directory{},
+		currentDirectories:  map[string]*filecontentscache_pb.FileContentsCache_Directory{},
+		previousObjects:     map[object.LocalReference][]byte{},
+		currentObjects:      map[object.LocalReference][]byte{},
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputBase, fileContentsCacheFilename))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return c, nil
+		}
+		return c, err
+	}
+	var cache filecontentscache_pb.FileContentsCache
+	if err := proto.Unmarshal(data, &cache); err != nil {
+		return c, err
+	}
+	if bytes.Equal(cache.FileCreationParametersDigest, parametersDigest) {
+		for _, file := range cache.Files {
+			c.previousFiles[file.Path] = file
+		}
+		for _, directory := range cache.Directories {
+			c.previousDirectories[directory.Path] = directory
+		}
+		for _, cachedObject := range cache.Objects {
+			reference, err := referenceFormat.NewLocalReference(cachedObject.Reference)
+			if err != nil {
+				clear(c.previousDirectories)
+				return c, err
+			}
+			c.previousObjects[reference] = cachedObject.Data
+		}
+	}
+	return c, nil
+}
+
+// isStable returns true if a file or directory was not modified after
+// scanning started. Files and directories that were modified recently
+// should not be cached, as the resolution of timestamps may be too
+// coarse to detect subsequent modifications.
+func (c *fileContentsCache) isStable(key fileContentsCacheKey) bool {
+	return key.modificationTime.Before(c.scanStartTime) && key.changeTime.Before(c.scanStartTime)
+}
+
+// retain the cache entry of a file, so that it is preserved when the
+// cache is saved. The entry is only retained and returned if the file
+// has not changed since it was cached.
+func (c *fileContentsCache) retain(filePath string, key fileContentsCacheKey) (*filecontentscache_pb.FileContentsCache_File, bool) {
+	c.lock.Lock()
+	file, ok := c.previousFiles[filePath]
+	c.lock.Unlock()
+	if !ok ||
+		file.InodeNumber != key.inodeNumber ||
+		file.SizeBytes != key.sizeBytes ||
+		!file.ModificationTime.AsTime().Equal(key.modificationTime) ||
+		!file.ChangeTime.AsTime().Equal(key.changeTime) {
+		return nil, false
+	}
+
+	c.lock.Lock()
+	c.currentFiles[filePath] = file
+	c.lock.Unlock()
+	return file, true
+}
+
+// lookup the reference of the Merkle tree of a file. A reference is
+// only returned if the file has not changed since it was cached.
+func (c *fileContentsCache) lookup(filePath string, key fileContentsCacheKey) (model_core.PatchedMessage[*model_filesystem_pb.FileContents, model_core.NoopReferenceMetadata], bool) {
+	file, ok := c.retain(filePath, key)
+	if !ok {
+		return model_core.PatchedMessage[*model_filesystem_pb.FileContents, model_core.NoopReferenceMetadata]{}, false
+	}
+	reference, err := c.referenceFormat.NewLocalReference(file.Reference)
+	if err != nil {
+		return model_core.PatchedMessage[*model_filesystem_pb.FileContents, model_core.NoopReferenceMetadata]{}, false
+	}
+
+	patcher := model_core.NewReferenceMessagePatcher[model_core.NoopReferenceMetadata]()
+	return model_core.NewPatchedMessage(
+		&model_filesystem_pb.FileContents{
+			Reference:      patcher.AddReference(reference, model_core.NoopReferenceMetadata{}),
+			TotalSizeBytes: key.sizeBytes,
+		},
+		patcher,
+	), true
+}
+
+// insert the reference of a newly computed Merkle tree of a file into
+// the cache.
+func (c *fileContentsCache) insert(filePath string, key fileContentsCacheKey, reference object.LocalReference) {
+	if !c.isStable(key) {
+		return
+	}
+
+	file := &filecontentscache_pb.FileContentsCache_File{
+		Path:             filePath,
+		InodeNumber:      key.inodeNumber,
+		SizeBytes:        key.sizeBytes,
+		ModificationTime: timestamppb.New(key.modificationTime),
+		ChangeTime:       timestamppb.New(key.changeTime),
+		Reference:        reference.GetRawReference(),
+	}
+	c.lock.Lock()
+	c.currentFiles[filePath] = file
+	c.lock.Unlock()
+}
+
+// getCapturedObject reconstructs a Directory or Leaves object that is
+// referenced by a cached directory, including all of the Directory and
+// Leaves objects referenced by it. References to objects that are not
+// part of the cache belong to files, whose contents are not captured.
+func (c *fileContentsCache) getCapturedObject(reference object.LocalReference) (model_filesystem.CapturedObject, error) {
+	data, ok := c.previousObjects[reference]
+	if !ok {
+		return model_filesystem.CapturedObject{}, nil
+	}
+	contents, err := object.NewContentsFromFullData(reference, data)
+	if err != nil {
+		return model_filesystem.CapturedObject{}, err
+	}
+	children := make([]model_filesystem.CapturedObject, contents.GetDegree())
+	for i := range children {
+		children[i], err = c.getCapturedObject(contents.GetOutgoingReference(i))
+		if err != nil {
+			return model_filesystem.CapturedObject{}, err
+		}
+	}
+	return model_filesystem.CapturedObject{
+		Contents: contents,
+		Children: children,
+	}, nil
+}
+
+// lookupDirectory returns the Merkle tree of a directory. A Merkle tree
+// is only returned if neither the directory nor any of its descendants
+// changed since it was cached.
+func (c *fileContentsCache) lookupDirectory(directoryPath string, key []byte) (*model_filesystem.CreatedDirectory[model_filesystem.CapturedObject], bool) {
+	c.lock.Lock()
+	directory, ok := c.previousDirectories[directoryPath]
+	c.lock.Unlock()
+	if !ok || !bytes.Equal(directory.Key, key) {
+		return nil, false
+	}
+
+	var message model_filesystem_pb.Directory
+	if err := proto.Unmarshal(directory.Directory, &message); err != nil {
+		return nil, false
+	}
+	outgoingReferences := make(object.OutgoingReferencesList, 0, len(directory.OutgoingReferences))
+	capturedObjects := make([]model_filesystem.CapturedObject, 0, len(directory.OutgoingReferences))
+	for _, rawReference := range directory.OutgoingReferences {
+		reference, err := c.referenceFormat.NewLocalReference(rawReference)
+		if err != nil {
+			return nil, false
+		}
+		capturedObject, err := c.getCapturedObject(reference)
+		if err != nil {
+			return nil, false
+		}
+		outgoingReferences = append(outgoingReferences, reference)
+		capturedObjects = append(capturedObjects, capturedObject)
+	}
+	createdDirectory, err := model_filesystem.NewCreatedDirectoryBare(
+		model_core.NewPatchedMessageFromExisting(
+			model_core.Message[*model_filesystem_pb.Directory]{
+				Message:            &message,
+				OutgoingReferences: outgoingReferences,
+			},
+			func(index int) model_filesystem.CapturedObject {
+				return capturedObjects[index]
+			},
+		),
+	)
+	if err != nil {
+		return nil, false
+	}
+	return createdDirectory, true
+}
+
+// insertCapturedObjectLocked inserts a Directory or Leaves object, and
+// all of the Directory and Leaves objects it references into the
+// cache.
+func (c *fileContentsCache) insertCapturedObjectLocked(capturedObject model_filesystem.CapturedObject) {
+	if capturedObject.Contents == nil {
+		return
+	}
+	reference := capturedObject.Contents.GetReference()
+	if _, ok := c.currentObjects[reference]; ok {
+		return
+	}
+	c.currentObjects[reference] = capturedObject.Contents.GetFullData()
+	for _, child := range capturedObject.Children {
+		c.insertCapturedObjectLocked(child)
+	}
+}
+
+// insertDirectory inserts the Merkle tree of a single directory into
+// the cache. As the Directory message may be inlined into its parent,
+// only the outgoing references that are used by the Directory message
+// are retained.
+func (c *fileContentsCache) insertDirectory(directoryPath string, key []byte, directory model_core.Message[*model_filesystem_pb.Directory], capturedObjects []model_filesystem.CapturedObject) error {
+	patchedDirectory := model_core.NewPatchedMessageFromExisting(
+		directory,
+		func(index int) model_filesystem.CapturedObject {
+			return capturedObjects[index]
+		},
+	)
+	compactDirectory, compactCapturedObjects := patchedDirectory.SortAndSetReferences()
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(compactDirectory.Message)
+	if err != nil {
+		return err
+	}
+	degree := compactDirectory.OutgoingReferences.GetDegree()
+	cachedDirectory := &filecontentscache_pb.FileContentsCache_Directory{
+		Path:               directoryPath,
+		Key:                key,
+		Directory:          data,
+		OutgoingReferences: make([][]byte, 0, degree),
+	}
+	for i := 0; i < degree; i++ {
+		cachedDirectory.OutgoingReferences = append(cachedDirectory.OutgoingReferences, compactDirectory.OutgoingReferences.GetOutgoingReference(i).GetRawReference())
+	}
+
+	c.lock.Lock()
+	c.currentDirectories[directoryPath] = cachedDirectory
+	for _, capturedObject := range compactCapturedObjects {
+		c.insertCapturedObjectLocked(capturedObject)
+	}
+	c.lock.Unlock()
+	return nil
+}
+
+// insertChildDirectories recursively traverses the Merkle tree of a
+// directory, and inserts all child directories for which a key was
+// computed into the cache.
+func (c *fileContentsCache) insertChildDirectories(moduleName, relativePath string, directory model_core.Message[*model_filesystem_pb.Directory], capturedObjects []model_filesystem.CapturedObject, directoryCacheKeys map[string][]byte, directoryParameters *model_filesystem.DirectoryAccessParameters) error {
+	for _, childDirectory := range directory.Message.Directories {
+		childRelativePath := childDirectory.Name
+		if relativePath != "" {
+			childRelativePath = relativePath + "/" + childDirectory.Name
+		}
+
+		var childMessage model_core.Message[*model_filesystem_pb.Directory]
+		var childCapturedObjects []model_filesystem.CapturedObject
+		switch contents := childDirectory.Contents.(type) {
+		case *model_filesystem_pb.DirectoryNode_ContentsExternal:
+			index, err := model_core.GetIndexFromReferenceMessage(contents.ContentsExternal.Reference, directory.OutgoingReferences.GetDegree())
+			if err != nil {
+				return fmt.Errorf("invalid reference index for directory %#v: %w", childRelativePath, err)
+			}
+			capturedObject := capturedObjects[index]
+			if capturedObject.Contents == nil {
+				return fmt.Errorf("contents of directory %#v were not captured", childRelativePath)
+			}
+			decodedDirectory, err := directoryParameters.DecodeDirectory(capturedObject.Contents)
+			if err != nil {
+				return fmt.Errorf("failed to decode directory %#v: %w", childRelativePath, err)
+			}
+			childMessage = model_core.Message[*model_filesystem_pb.Directory]{
+				Message:            decodedDirectory,
+				OutgoingReferences: capturedObject.Contents,
+			}
+			childCapturedObjects = capturedObject.Children
+		case *model_filesystem_pb.DirectoryNode_ContentsInline:
+			childMessage = model_core.Message[*model_filesystem_pb.Directory]{
+				Message:            contents.ContentsInline,
+				OutgoingReferences: directory.OutgoingReferences,
+			}
+			childCapturedObjects = capturedObjects
+		default:
+			return fmt.Errorf("invalid contents type for directory %#v", childRelativePath)
+		}
+
+		if key, ok := directoryCacheKeys[childRelativePath]; ok {
+			if err := c.insertDirectory(moduleName+"/"+childRelativePath, key, childMessage, childCapturedObjects); err != nil {
+				return fmt.Errorf("failed to insert directory %#v: %w", childRelativePath, err)
+			}
+		}
+		if err := c.insertChildDirectories(moduleName, childRelativePath, childMessage, childCapturedObjects, directoryCacheKeys, directoryParameters); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertDirectories inserts the Merkle trees of all directories
+// contained in a module into the cache. This method needs to be called
+// after the Merkle tree of the module's root directory has been fully
+// created.
+func (c *fileContentsCache) insertDirectories(moduleName string, directoryCacheKeys map[string][]byte, rootDirectory *model_filesystem.CreatedDirectory[model_filesystem.CapturedObject], directoryParameters *model_filesystem.DirectoryAccessParameters) error {
+	rootMessage, rootCapturedObjects := rootDirectory.Message.SortAndSetReferences()
+	return c.insertChildDirectories(moduleName, "", rootMessage, rootCapturedObjects, directoryCacheKeys, directoryParameters)
+}
+
+// save the cache in the output base, only retaining entries for files
+// and directories that were encountered during the current invocation.
+func (c *fileContentsCache) save(outputBase string) error {
+	c.lock.Lock()
+	cache := filecontentscache_pb.FileContentsCache{
+		FileCreationParametersDigest: c.parametersDigest,
+		Files:                        make([]*filecontentscache_pb.FileContentsCache_File, 0, len(c.currentFiles)),
+		Directories:                  make([]*filecontentscache_pb.FileContentsCache_Directory, 0, len(c.currentDirectories)),
+		Objects:                      make([]*filecontentscache_pb.FileContentsCache_Object, 0, len(c.currentObjects)),
+	}
+	for _, file := range c.currentFiles {
+		cache.Files = append(cache.Files, file)
+	}
+	for _, directory := range c.currentDirectories {
+		cache.Directories = append(cache.Directories, directory)
+	}
+	for reference, data := range c.currentObjects {
+		cache.Objects = append(cache.Objects, &filecontentscache_pb.FileContentsCache_Object{
+			Reference: reference.GetRawReference(),
+			Data:      data,
+		})
+	}
+	c.lock.Unlock()
+	slices.SortFunc(cache.Files, func(a, b *filecontentscache_pb.FileContentsCache_File) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	slices.SortFunc(cache.Directories, func(a, b *filecontentscache_pb.FileContentsCache_Directory) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	slices.SortFunc(cache.Objects, func(a, b *filecontentscache_pb.FileContentsCache_Object) int {
+		return bytes.Compare(a.Reference, b.Reference)
+	})
+
+	data, err := proto.Marshal(&cache)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(outputBase, 0o777); err != nil {
+		return err
+	}
+
+	// Write the cache atomically, so that interrupted invocations
+	// don't leave a corrupted cache behind.
+	cachePath := filepath.Join(outputBase, fileContentsCacheFilename)
+	temporaryPath := cachePath + ".tmp"
+	if err := os.WriteFile(temporaryPath, data, 0o666); err != nil {
+		return err
+	}
+	return os.Rename(temporaryPath, cachePath)
+}
+
+// computeDirectoryCacheKey computes the key under which the Merkle tree
+// of a directory is stored in the file contents cache. The key is
+// derived from the properties of the directory itself, and the names,
+// types and keys of all of its children. Keys of all directories
+// underneath the module's root directory are stored in the module, so
+// that EnterCapturableDirectory() can look them up.
+//
+// No key is stored for directories containing files or directories
+// that cannot be cached, for example because they were modified after
+// scanning started. These directories are always traversed.
+func (d *localCapturableDirectory) computeDirectoryCacheKey(identity fileContentsCacheKey) ([]byte, bool, error) {
+	entries, err := d.ReadDir()
+	if err != nil {
+		return nil, false, err
+	}
+
+	cache := d.options.fileContentsCache
+	cacheable := cache.isStable(identity)
+	hasher := sha256.New()
+	writeFileContentsCacheKey(hasher, identity)
+	for _, entry := range entries {
+		name := entry.Name()
+		hasher.Write([]byte(name.String()))
+		hasher.Write([]byte{0, byte(entry.Type())})
+		switch entry.Type() {
+		case filesystem.FileTypeDirectory:
+			childIdentity, ok := d.getChildFileContentsCacheKey(name)
+			child, err := d.enterLocalCapturableDirectory(name)
+			if err != nil {
+				return nil, false, err
+			}
+			childKey, childCacheable, err := child.computeDirectoryCacheKey(childIdentity)
+			child.Close()
+			if err != nil {
+				return nil, false, err
+			}
+			cacheable = cacheable && ok && childCacheable
+			hasher.Write(childKey)
+		case filesystem.FileTypeRegularFile:
+			if entry.IsExecutable() {
+				hasher.Write([]byte{1})
+			} else {
+				hasher.Write([]byte{0})
+			}
+			key, ok := d.getChildFileContentsCacheKey(name)
+			if ok {
+				// Retain the cache entry of the file,
+				// as its Merkle tree is not looked up
+				// if the Merkle tree of the directory
+				// is obtained from the cache.
+				cache.retain(d.module.name.String()+"/"+d.getChildPath(name), key)
+			}
+			cacheable = cacheable && ok && cache.isStable(key)
+			writeFileContentsCacheKey(hasher, key)
+		case filesystem.FileTypeSymlink:
+			targetParser, err := d.Readlink(name)
+			if err != nil {
+				return nil, false, err
+			}
+			targetBuilder, scopeWalker := path.EmptyBuilder.Join(path.VoidScopeWalker)
+			if err := path.Resolve(targetParser, scopeWalker); err != nil {
+				return nil, false, err
+			}
+			hasher.Write([]byte(targetBuilder.GetUNIXString()))
+			hasher.Write([]byte{0})
+		}
+	}
+
+	key := hasher.Sum(nil)
+	if cacheable && d.depth > 0 {
+		d.module.directoryCacheKeys[d.relativePath] = key
+	}
+	return key, cacheable, nil
+}
+
+// getChildFileContentsCacheKey obtains the properties of a file or
+// directory contained in the directory that are used as a key by the
+// file contents cache.
+func (d *localCapturableDirectory) getChildFileContentsCacheKey(name path.Component) (fileContentsCacheKey, bool) {
+	f, err := d.OpenRead(name)
+	if err != nil {
+		return fileContentsCacheKey{}, false
+	}
+	defer f.Close()
+	return getFileContentsCacheKey(f)
+}
@@ -0,0 +1,17 @@
+package build
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// getInodeNumberAndChangeTime extracts properties from file metadata
+// that are used by fileContentsCache to detect changes to files.
+func getInodeNumberAndChangeTime(fileInfo os.FileInfo) (uint64, time.Time, bool) {
+	stat, ok := fileInfo.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, time.Time{}, false
+	}
+	return uint64(stat.Ino), time.Unix(stat.Ctim.Unix()), true
+}
@@ -0,0 +1,16 @@
+//go:build !darwin && !freebsd && !linux
+
+package build
+
+import (
+	"os"
+	"time"
+)
+
+// getInodeNumberAndChangeTime extracts properties from file metadata
+// that are used by fileContentsCache to detect changes to files. On
+// this platform these properties are not available, meaning that
+// change detection relies on the file's size and modification time.
+func getInodeNumberAndChangeTime(fileInfo os.FileInfo) (uint64, time.Time, bool) {
+	return 0, time.Time{}, true
+}
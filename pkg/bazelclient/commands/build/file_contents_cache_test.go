@@ -0,0 +1,439 @@
+package build
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_filesystem "github.com/buildbarn/bonanza/pkg/model/filesystem"
+	model_filesystem_pb "github.com/buildbarn/bonanza/pkg/proto/model/filesystem"
+	object_pb "github.com/buildbarn/bonanza/pkg/proto/storage/object"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestGetFileContentsCacheKey(t *testing.T) {
+	directoryPath := t.TempDir()
+	directory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(directoryPath))
+	require.NoError(t, err)
+	defer directory.Close()
+
+	getKey := func(t *testing.T) fileContentsCacheKey {
+		f, err := directory.OpenRead(path.MustNewComponent("file"))
+		require.NoError(t, err)
+		defer f.Close()
+		key, ok := getFileContentsCacheKey(f)
+		require.True(t, ok)
+		return key
+	}
+
+	filePath := filepath.Join(directoryPath, "file")
+	require.NoError(t, os.WriteFile(filePath, []byte("Hello"), 0o666))
+	require.NoError(t, os.Chtimes(filePath, time.Time{}, time.Unix(1700000000, 0)))
+	key1 := getKey(t)
+	require.Equal(t, uint64(5), key1.sizeBytes)
+	require.Equal(t, time.Unix(1700000000, 0), key1.modificationTime)
+
+	t.Run("Unchanged", func(t *testing.T) {
+		// Opening the same file again should yield the same key.
+		require.Equal(t, key1, getKey(t))
+	})
+
+	t.Run("ContentsChanged", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filePath, []byte("Hello world"), 0o666))
+		require.NoError(t, os.Chtimes(filePath, time.Time{}, time.Unix(1700000000, 0)))
+		key2 := getKey(t)
+		require.NotEqual(t, key1, key2)
+		require.Equal(t, uint64(11), key2.sizeBytes)
+	})
+}
+
+func TestFileContentsCache(t *testing.T) {
+	referenceFormat := object.MustNewReferenceFormat(object_pb.ReferenceFormat_SHA256_V1)
+	parametersDigest := []byte("parameters")
+	reference := object.MustNewSHA256V1LocalReference("4c817b0522489e65d00d339d41b4e2b2ec2081be15d1775195be822dd9a9c7f0", 28, 0, 0, 0)
+	key := fileContentsCacheKey{
+		inodeNumber:      123,
+		sizeBytes:        28,
+		modificationTime: time.Unix(1700000000, 0),
+		changeTime:       time.Unix(1700000001, 0),
+	}
+
+	// newPopulatedOutputBase returns an output base containing a
+	// cache with a single entry for "main+/file".
+	newPopulatedOutputBase := func(t *testing.T) string {
+		outputBase := t.TempDir()
+		c, err := loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		c.insert("main+/file", key, reference)
+		require.NoError(t, c.save(outputBase))
+		return outputBase
+	}
+
+	requireHit := func(t *testing.T, c *fileContentsCache, key fileContentsCacheKey) {
+		fileContents, ok := c.lookup("main+/file", key)
+		require.True(t, ok)
+		m, _ := fileContents.SortAndSetReferences()
+		require.Equal(t, key.sizeBytes, m.Message.TotalSizeBytes)
+		require.Equal(t, 1, m.OutgoingReferences.GetDegree())
+		require.Equal(t, reference, m.OutgoingReferences.GetOutgoingReference(0))
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		// If no cache has been written, the cache should be
+		// empty. This should not be considered an error.
+		c, err := loadFileContentsCache(t.TempDir(), referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		_, ok := c.lookup("main+/file", key)
+		require.False(t, ok)
+	})
+
+	t.Run("Hit", func(t *testing.T) {
+		// Files whose inode number, size, modification time and
+		// change time are unchanged should be returned from the
+		// cache.
+		c, err := loadFileContentsCache(newPopulatedOutputBase(t), referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		requireHit(t, c, key)
+	})
+
+	t.Run("Miss", func(t *testing.T) {
+		// A change to any of the properties of the file should
+		// cause it to be read again.
+		outputBase := newPopulatedOutputBase(t)
+		for name, changedKey := range map[string]fileContentsCacheKey{
+			"InodeNumber": {
+				inodeNumber:      124,
+				sizeBytes:        key.sizeBytes,
+				modificationTime: key.modificationTime,
+				changeTime:       key.changeTime,
+			},
+			"SizeBytes": {
+				inodeNumber:      key.inodeNumber,
+				sizeBytes:        29,
+				modificationTime: key.modificationTime,
+				changeTime:       key.changeTime,
+			},
+			"ModificationTime": {
+				inodeNumber:      key.inodeNumber,
+				sizeBytes:        key.sizeBytes,
+				modificationTime: key.modificationTime.Add(time.Nanosecond),
+				changeTime:       key.changeTime,
+			},
+			"ChangeTime": {
+				inodeNumber:      key.inodeNumber,
+				sizeBytes:        key.sizeBytes,
+				modificationTime: key.modificationTime,
+				changeTime:       key.changeTime.Add(time.Nanosecond),
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				c, err := loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+				require.NoError(t, err)
+				_, ok := c.lookup("main+/file", changedKey)
+				require.False(t, ok)
+				_, ok = c.lookup("main+/other", key)
+				require.False(t, ok)
+			})
+		}
+	})
+
+	t.Run("ParametersChanged", func(t *testing.T) {
+		// Changes to the file creation parameters invalidate
+		// all entries.
+		c, err := loadFileContentsCache(newPopulatedOutputBase(t), referenceFormat, []byte("other parameters"))
+		require.NoError(t, err)
+		_, ok := c.lookup("main+/file", key)
+		require.False(t, ok)
+	})
+
+	t.Run("RecentlyModified", func(t *testing.T) {
+		// Files modified after scanning started should not be
+		// cached, as subsequent modifications may not cause
+		// their timestamps to change.
+		outputBase := t.TempDir()
+		c, err := loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		recentKey := key
+		recentKey.modificationTime = c.scanStartTime
+		c.insert("main+/file", recentKey, reference)
+		require.NoError(t, c.save(outputBase))
+
+		c, err = loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		_, ok := c.lookup("main+/file", recentKey)
+		require.False(t, ok)
+	})
+
+	t.Run("UnusedEntriesDropped", func(t *testing.T) {
+		// Entries of files that were not encountered by an
+		// invocation should not be retained.
+		outputBase := newPopulatedOutputBase(t)
+		c, err := loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		require.NoError(t, c.save(outputBase))
+
+		c, err = loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		_, ok := c.lookup("main+/file", key)
+		require.False(t, ok)
+	})
+
+	t.Run("UsedEntriesRetained", func(t *testing.T) {
+		outputBase := newPopulatedOutputBase(t)
+		c, err := loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		requireHit(t, c, key)
+		require.NoError(t, c.save(outputBase))
+
+		c, err = loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		requireHit(t, c, key)
+	})
+
+	t.Run("Corrupted", func(t *testing.T) {
+		// Caches that cannot be parsed should be reported, but
+		// still yield an empty cache that can be used and
+		// saved, thereby replacing the corrupted cache.
+		outputBase := t.TempDir()
+		cachePath := filepath.Join(outputBase, fileContentsCacheFilename)
+		require.NoError(t, os.WriteFile(cachePath, []byte("\xff\xff\xff\xff"), 0o666))
+		c, err := loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.Error(t, err)
+		_, ok := c.lookup("main+/file", key)
+		require.False(t, ok)
+
+		c.insert("main+/file", key, reference)
+		require.NoError(t, c.save(outputBase))
+		c, err = loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		requireHit(t, c, key)
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		outputBase := newPopulatedOutputBase(t)
+		cachePath := filepath.Join(outputBase, fileContentsCacheFilename)
+		data, err := os.ReadFile(cachePath)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(cachePath, data[:len(data)-1], 0o666))
+
+		c, err := loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.Error(t, err)
+		_, ok := c.lookup("main+/file", key)
+		require.False(t, ok)
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		// Files are scanned in parallel, meaning lookups and
+		// insertions may happen concurrently. All of them
+		// should be retained.
+		outputBase := t.TempDir()
+		c, err := loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		for i := 0; i < 100; i++ {
+			c.insert(fmt.Sprintf("main+/file%d", i), key, reference)
+		}
+		require.NoError(t, c.save(outputBase))
+
+		c, err = loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		var wg sync.WaitGroup
+		var hits [100]bool
+		for i := 0; i < 200; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				filePath := fmt.Sprintf("main+/file%d", i)
+				if i < len(hits) {
+					_, hits[i] = c.lookup(filePath, key)
+				} else {
+					c.insert(filePath, key, reference)
+				}
+			}(i)
+		}
+		wg.Wait()
+		for _, hit := range hits {
+			require.True(t, hit)
+		}
+		require.NoError(t, c.save(outputBase))
+
+		c, err = loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		for i := 0; i < 200; i++ {
+			_, ok := c.lookup(fmt.Sprintf("main+/file%d", i), key)
+			require.True(t, ok)
+		}
+	})
+}
+
+func TestFileContentsCacheDirectories(t *testing.T) {
+	referenceFormat := object.MustNewReferenceFormat(object_pb.ReferenceFormat_SHA256_V1)
+	parametersDigest := []byte("parameters")
+	fileReference := object.MustNewSHA256V1LocalReference("4c817b0522489e65d00d339d41b4e2b2ec2081be15d1775195be822dd9a9c7f0", 28, 0, 0, 0)
+	leavesContents, err := referenceFormat.NewContents([]object.LocalReference{fileReference}, []byte("leaves"))
+	require.NoError(t, err)
+	directoryKey := []byte("directory key")
+
+	// newPopulatedOutputBase returns an output base containing a
+	// cache with a single entry for directory "main+/sub", whose
+	// leaves are stored in a separate object.
+	newPopulatedOutputBase := func(t *testing.T) string {
+		outputBase := t.TempDir()
+		c, err := loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+
+		patcher := model_core.NewReferenceMessagePatcher[model_filesystem.CapturedObject]()
+		rootDirectory, err := model_filesystem.NewCreatedDirectoryBare(
+			model_core.NewPatchedMessage(
+				&model_filesystem_pb.Directory{
+					Leaves: &model_filesystem_pb.Directory_LeavesInline{
+						LeavesInline: &model_filesystem_pb.Leaves{},
+					},
+					Directories: []*model_filesystem_pb.DirectoryNode{{
+						Name: "sub",
+						Contents: &model_filesystem_pb.DirectoryNode_ContentsInline{
+							ContentsInline: &model_filesystem_pb.Directory{
+								Leaves: &model_filesystem_pb.Directory_LeavesExternal{
+									LeavesExternal: &model_filesystem_pb.LeavesReference{
+										Reference: patcher.AddReference(
+											leavesContents.GetReference(),
+											model_filesystem.CapturedObject{
+												Contents: leavesContents,
+												Children: []model_filesystem.CapturedObject{{}},
+											},
+										),
+										MaximumSymlinkEscapementLevels: &wrapperspb.UInt32Value{},
+									},
+								},
+							},
+						},
+					}},
+				},
+				patcher,
+			),
+		)
+		require.NoError(t, err)
+		require.NoError(t, c.insertDirectories("main+", map[string][]byte{"sub": directoryKey}, rootDirectory, nil))
+		require.NoError(t, c.save(outputBase))
+		return outputBase
+	}
+
+	requireHit := func(t *testing.T, c *fileContentsCache) {
+		createdDirectory, ok := c.lookupDirectory("main+/sub", directoryKey)
+		require.True(t, ok)
+		m, capturedObjects := createdDirectory.Message.SortAndSetReferences()
+		require.Equal(t, 1, m.OutgoingReferences.GetDegree())
+		require.Equal(t, leavesContents.GetReference(), m.OutgoingReferences.GetOutgoingReference(0))
+
+		// The leaves object should have been restored from the
+		// cache, so that it can be uploaded if needed.
+		require.Len(t, capturedObjects, 1)
+		require.Equal(t, leavesContents.GetFullData(), capturedObjects[0].Contents.GetFullData())
+		require.Equal(t, []model_filesystem.CapturedObject{{}}, capturedObjects[0].Children)
+	}
+
+	t.Run("Hit", func(t *testing.T) {
+		c, err := loadFileContentsCache(newPopulatedOutputBase(t), referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		requireHit(t, c)
+	})
+
+	t.Run("Miss", func(t *testing.T) {
+		// Directories whose key changed because one of its
+		// descendants changed should be traversed again.
+		c, err := loadFileContentsCache(newPopulatedOutputBase(t), referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		_, ok := c.lookupDirectory("main+/sub", []byte("other directory key"))
+		require.False(t, ok)
+		_, ok = c.lookupDirectory("main+/other", directoryKey)
+		require.False(t, ok)
+	})
+
+	t.Run("ParametersChanged", func(t *testing.T) {
+		c, err := loadFileContentsCache(newPopulatedOutputBase(t), referenceFormat, []byte("other parameters"))
+		require.NoError(t, err)
+		_, ok := c.lookupDirectory("main+/sub", directoryKey)
+		require.False(t, ok)
+	})
+
+	t.Run("UnusedEntriesDropped", func(t *testing.T) {
+		// Directories are only retained if they are inserted
+		// again. DoBuild() does this for all directories, both
+		// the ones that were looked up and the ones that were
+		// traversed.
+		outputBase := newPopulatedOutputBase(t)
+		c, err := loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		requireHit(t, c)
+		require.NoError(t, c.save(outputBase))
+
+		c, err = loadFileContentsCache(outputBase, referenceFormat, parametersDigest)
+		require.NoError(t, err)
+		_, ok := c.lookupDirectory("main+/sub", directoryKey)
+		require.False(t, ok)
+	})
+}
+
+func TestLocalCapturableDirectoryComputeDirectoryCacheKey(t *testing.T) {
+	moduleDirectory := t.TempDir()
+	for _, name := range []string{"a", "b"} {
+		require.NoError(t, os.Mkdir(filepath.Join(moduleDirectory, name), 0o777))
+		require.NoError(t, os.WriteFile(filepath.Join(moduleDirectory, name, "file"), []byte("Hello"), 0o666))
+	}
+
+	// computeKeys returns the keys of all directories underneath
+	// the root directory of the module.
+	computeKeys := func(t *testing.T) map[string][]byte {
+		directory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(moduleDirectory))
+		require.NoError(t, err)
+		defer directory.Close()
+
+		c, err := loadFileContentsCache(t.TempDir(), object.MustNewReferenceFormat(object_pb.ReferenceFormat_SHA256_V1), []byte("parameters"))
+		require.NoError(t, err)
+		c.scanStartTime = time.Now().Add(time.Hour)
+		module := &localCapturableModule{
+			name:               label.MustNewModule("main"),
+			ignorer:            &model_filesystem.DirectoryIgnorer{},
+			directoryCacheKeys: map[string][]byte{},
+		}
+		_, cacheable, err := (&localCapturableDirectory{
+			DirectoryCloser: directory,
+			options: &localCapturableDirectoryOptions{
+				fileContentsCache: c,
+			},
+			module: module,
+		}).computeDirectoryCacheKey(fileContentsCacheKey{})
+		require.NoError(t, err)
+		require.True(t, cacheable)
+		return module.directoryCacheKeys
+	}
+
+	keys1 := computeKeys(t)
+	require.Len(t, keys1, 2)
+	require.Contains(t, keys1, "a")
+	require.Contains(t, keys1, "b")
+	require.NotEqual(t, keys1["a"], keys1["b"])
+
+	t.Run("Unchanged", func(t *testing.T) {
+		require.Equal(t, keys1, computeKeys(t))
+	})
+
+	t.Run("FileChanged", func(t *testing.T) {
+		// Modifying a file does not change the timestamps of
+		// the directory containing it. It should nevertheless
+		// cause the key of the directory to change.
+		require.NoError(t, os.WriteFile(filepath.Join(moduleDirectory, "a", "file"), []byte("Hello world"), 0o666))
+		keys2 := computeKeys(t)
+		require.NotEqual(t, keys1["a"], keys2["a"])
+		require.Equal(t, keys1["b"], keys2["b"])
+	})
+}
@@ -0,0 +1,82 @@
+package build
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"math"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/buildbarn/bonanza/pkg/label"
+	"github.com/buildbarn/bonanza/pkg/model/core/inlinedtree"
+	model_encoding "github.com/buildbarn/bonanza/pkg/model/encoding"
+	model_filesystem "github.com/buildbarn/bonanza/pkg/model/filesystem"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+
+	"go.starlark.net/starlark"
+)
+
+// readOptionalFile reads the contents of a file stored in a directory.
+// If the file does not exist, no data is returned.
+func readOptionalFile(d filesystem.Directory, name path.Component) ([]byte, bool, error) {
+	f, err := d.OpenRead(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.NewSectionReader(f, 0, math.MaxInt64))
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// newModuleDirectoryIgnorer creates a DirectoryIgnorer for a module
+// that is about to be uploaded, so that directories that are ignored
+// by the builder are not uploaded in the first place. Just like the
+// builder, it respects calls to ignore_directories() in REPO.bazel,
+// and the .bazelignore file of the root module.
+func newModuleDirectoryIgnorer(moduleRootDirectory filesystem.Directory, moduleName label.Module, isRootModule bool, referenceFormat object.ReferenceFormat) (*model_filesystem.DirectoryIgnorer, error) {
+	var ignorer model_filesystem.DirectoryIgnorer
+
+	repoFileName := label.MustNewTargetName("REPO.bazel")
+	repoFileData, ok, err := readOptionalFile(moduleRootDirectory, path.MustNewComponent(repoFileName.String()))
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to read REPO.bazel")
+	}
+	if ok {
+		_, ignoredDirectories, err := model_starlark.ParseRepoDotBazel(
+			string(repoFileData),
+			moduleName.ToModuleInstance(nil).GetBareCanonicalRepo().GetRootPackage().AppendTargetName(repoFileName),
+			&inlinedtree.Options{
+				ReferenceFormat:  referenceFormat,
+				Encoder:          model_encoding.NewChainedBinaryEncoder(nil),
+				MaximumSizeBytes: 32 * 1024,
+			},
+			// Output of print() is discarded, as the builder
+			// reports it when evaluating REPO.bazel again.
+			func(thread *starlark.Thread, msg string) {},
+		)
+		if err != nil {
+			return nil, util.StatusWrap(err, "Failed to parse REPO.bazel")
+		}
+		ignorer.AddIgnoreDirectoriesPatterns(ignoredDirectories)
+	}
+
+	if isRootModule {
+		bazelIgnoreData, ok, err := readOptionalFile(moduleRootDirectory, path.MustNewComponent(".bazelignore"))
+		if err != nil {
+			return nil, util.StatusWrap(err, "Failed to read .bazelignore")
+		}
+		if ok {
+			ignorer.AddBazelIgnore(string(bazelIgnoreData))
+		}
+	}
+	return &ignorer, nil
+}
@@ -0,0 +1,90 @@
+package build
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	pendingbuild_pb "github.com/buildbarn/bonanza/pkg/proto/bazelclient/pendingbuild"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// pendingBuildsDirectoryName is the name of the directory in the output
+// base in which PendingBuild messages are stored, using the name of
+// the operation as the filename.
+const pendingBuildsDirectoryName = "pending_builds"
+
+// getPendingBuildPath returns the path of the file in which the state
+// of a pending build is stored. Operation names are assigned by the
+// scheduler, so they are validated to prevent them from referring to
+// files outside the pending builds directory.
+func getPendingBuildPath(outputBase, operationName string) (string, error) {
+	if _, ok := path.NewComponent(operationName); !ok {
+		return "", status.Errorf(codes.InvalidArgument, "Invalid operation name %#v", operationName)
+	}
+	return filepath.Join(outputBase, pendingBuildsDirectoryName, operationName), nil
+}
+
+// savePendingBuild stores the state of a build that has been submitted
+// to the scheduler in the output base, so that "bonanza_bazel attach"
+// may reattach to it.
+func savePendingBuild(outputBase string, pendingBuild *pendingbuild_pb.PendingBuild) error {
+	pendingBuildPath, err := getPendingBuildPath(outputBase, pendingBuild.OperationName)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(pendingBuild)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(pendingBuildPath), 0o777); err != nil {
+		return err
+	}
+
+	// Write the state atomically, so that interrupted invocations
+	// don't leave a corrupted file behind.
+	temporaryPath := pendingBuildPath + ".tmp"
+	if err := os.WriteFile(temporaryPath, data, 0o666); err != nil {
+		return err
+	}
+	return os.Rename(temporaryPath, pendingBuildPath)
+}
+
+// loadPendingBuild loads the state of a build that was previously
+// stored by savePendingBuild().
+func loadPendingBuild(outputBase, operationName string) (*pendingbuild_pb.PendingBuild, error) {
+	pendingBuildPath, err := getPendingBuildPath(outputBase, operationName)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(pendingBuildPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, status.Errorf(codes.NotFound, "No pending build with operation name %#v exists in output base %#v", operationName, outputBase)
+		}
+		return nil, err
+	}
+	var pendingBuild pendingbuild_pb.PendingBuild
+	if err := proto.Unmarshal(data, &pendingBuild); err != nil {
+		return nil, err
+	}
+	return &pendingBuild, nil
+}
+
+// removePendingBuild removes the state of a build from the output base
+// after it has completed or has been killed.
+func removePendingBuild(outputBase, operationName string) error {
+	pendingBuildPath, err := getPendingBuildPath(outputBase, operationName)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(pendingBuildPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
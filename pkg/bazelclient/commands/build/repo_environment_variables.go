@@ -0,0 +1,64 @@
+package build
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+)
+
+// addEnvironmentVariablesFromFlags processes the values of flags like
+// --action_env and --repo_env, and stores the resulting environment
+// variables in a map. Values of these flags either have the form
+// NAME=VALUE, or NAME, in which case the value is copied from the
+// client's environment.
+func addEnvironmentVariablesFromFlags(flagName string, flagValues []string, lookupEnv func(string) (string, bool), environmentVariables map[string]string) error {
+	for _, flagValue := range flagValues {
+		name, value, hasValue := strings.Cut(flagValue, "=")
+		if name == "" {
+			return fmt.Errorf("invalid value for --%s: %#v does not specify an environment variable name", flagName, flagValue)
+		}
+		if !hasValue {
+			var ok bool
+			value, ok = lookupEnv(name)
+			if !ok {
+				// Variable is not set in the client's
+				// environment. Undo any previous
+				// assignments.
+				delete(environmentVariables, name)
+				continue
+			}
+		}
+		environmentVariables[name] = value
+	}
+	return nil
+}
+
+// getRepoEnvironmentVariables computes the environment variables that
+// should be visible to repository rules and module extensions. Values
+// provided to --repo_env take precedence over ones provided to
+// --action_env. The resulting list is sorted by name, allowing the
+// builder to perform lookups using binary searching.
+func getRepoEnvironmentVariables(actionEnv, repoEnv []string, lookupEnv func(string) (string, bool)) ([]*model_build_pb.EnvironmentVariable, error) {
+	environmentVariables := map[string]string{}
+	if err := addEnvironmentVariablesFromFlags("action_env", actionEnv, lookupEnv, environmentVariables); err != nil {
+		return nil, err
+	}
+	if err := addEnvironmentVariablesFromFlags("repo_env", repoEnv, lookupEnv, environmentVariables); err != nil {
+		return nil, err
+	}
+
+	sortedEnvironmentVariables := make([]*model_build_pb.EnvironmentVariable, 0, len(environmentVariables))
+	for _, name := range slices.Sorted(maps.Keys(environmentVariables)) {
+		sortedEnvironmentVariables = append(
+			sortedEnvironmentVariables,
+			&model_build_pb.EnvironmentVariable{
+				Name:  name,
+				Value: environmentVariables[name],
+			},
+		)
+	}
+	return sortedEnvironmentVariables, nil
+}
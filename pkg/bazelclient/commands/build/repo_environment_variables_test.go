@@ -0,0 +1,105 @@
+package build
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRepoEnvironmentVariables(t *testing.T) {
+	lookupEnv := func(name string) (string, bool) {
+		switch name {
+		case "CC":
+			return "gcc", true
+		case "HOME":
+			return "/home/alice", true
+		case "PATH":
+			return "/usr/bin:/bin", true
+		}
+		return "", false
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		environmentVariables, err := getRepoEnvironmentVariables(nil, nil, lookupEnv)
+		require.NoError(t, err)
+		require.Empty(t, environmentVariables)
+	})
+
+	t.Run("ActionEnvInheritance", func(t *testing.T) {
+		// Flags that don't specify a value inherit it from
+		// the client's environment. If the variable is not
+		// set, it should be omitted.
+		environmentVariables, err := getRepoEnvironmentVariables(
+			[]string{"PATH", "DISPLAY", "LANG=C"},
+			nil,
+			lookupEnv,
+		)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_build_pb.BuildSpecification{
+			RepoEnvironmentVariables: []*model_build_pb.EnvironmentVariable{
+				{Name: "LANG", Value: "C"},
+				{Name: "PATH", Value: "/usr/bin:/bin"},
+			},
+		}, &model_build_pb.BuildSpecification{
+			RepoEnvironmentVariables: environmentVariables,
+		})
+	})
+
+	t.Run("RepoEnvOverride", func(t *testing.T) {
+		// Values provided to --repo_env take precedence over
+		// ones provided to --action_env, regardless of whether
+		// they are inherited.
+		environmentVariables, err := getRepoEnvironmentVariables(
+			[]string{"CC=clang", "HOME=/nonexistent", "PATH=/opt/bin"},
+			[]string{"CC", "HOME=/tmp"},
+			lookupEnv,
+		)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_build_pb.BuildSpecification{
+			RepoEnvironmentVariables: []*model_build_pb.EnvironmentVariable{
+				{Name: "CC", Value: "gcc"},
+				{Name: "HOME", Value: "/tmp"},
+				{Name: "PATH", Value: "/opt/bin"},
+			},
+		}, &model_build_pb.BuildSpecification{
+			RepoEnvironmentVariables: environmentVariables,
+		})
+	})
+
+	t.Run("RepoEnvUnsetsVariable", func(t *testing.T) {
+		// Inheriting a variable that is not set in the
+		// client's environment should undo assignments made
+		// through --action_env.
+		environmentVariables, err := getRepoEnvironmentVariables(
+			[]string{"DISPLAY=:0"},
+			[]string{"DISPLAY"},
+			lookupEnv,
+		)
+		require.NoError(t, err)
+		require.Empty(t, environmentVariables)
+	})
+
+	t.Run("LastValueWins", func(t *testing.T) {
+		environmentVariables, err := getRepoEnvironmentVariables(
+			nil,
+			[]string{"CC=gcc", "CC=clang", "EMPTY="},
+			lookupEnv,
+		)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_build_pb.BuildSpecification{
+			RepoEnvironmentVariables: []*model_build_pb.EnvironmentVariable{
+				{Name: "CC", Value: "clang"},
+				{Name: "EMPTY", Value: ""},
+			},
+		}, &model_build_pb.BuildSpecification{
+			RepoEnvironmentVariables: environmentVariables,
+		})
+	})
+
+	t.Run("MissingName", func(t *testing.T) {
+		_, err := getRepoEnvironmentVariables(nil, []string{"=value"}, lookupEnv)
+		require.EqualError(t, err, "invalid value for --repo_env: \"=value\" does not specify an environment variable name")
+	})
+}
@@ -0,0 +1,70 @@
+package build
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/buildbarn/bonanza/pkg/bazelclient/logging"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+)
+
+// uploadProgress keeps track of the number of bytes of module sources
+// that have been uploaded to storage, so that the upload throughput can
+// be displayed.
+type uploadProgress struct {
+	logger            logging.Logger
+	startTime         time.Time
+	uploadedSizeBytes atomic.Uint64
+}
+
+func newUploadProgress(logger logging.Logger) *uploadProgress {
+	p := &uploadProgress{
+		logger:    logger,
+		startTime: time.Now(),
+	}
+	p.report()
+	return p
+}
+
+func (p *uploadProgress) report() {
+	p.logger.SetProgress(&logging.Progress{
+		Phase:             logging.PhaseUploading,
+		UploadedSizeBytes: p.uploadedSizeBytes.Load(),
+		UploadStartTime:   p.startTime,
+	})
+}
+
+// newObjectContentsWalker wraps an ObjectContentsWalker, so that the
+// size of all objects that are requested by storage is counted.
+func (p *uploadProgress) newObjectContentsWalker(base dag.ObjectContentsWalker) dag.ObjectContentsWalker {
+	return &uploadProgressObjectContentsWalker{
+		base:     base,
+		progress: p,
+	}
+}
+
+type uploadProgressObjectContentsWalker struct {
+	base     dag.ObjectContentsWalker
+	progress *uploadProgress
+}
+
+func (w *uploadProgressObjectContentsWalker) GetContents(ctx context.Context) (*object.Contents, []dag.ObjectContentsWalker, error) {
+	contents, walkers, err := w.base.GetContents(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	w.progress.uploadedSizeBytes.Add(uint64(len(contents.GetFullData())))
+	w.progress.report()
+
+	wrappedWalkers := make([]dag.ObjectContentsWalker, 0, len(walkers))
+	for _, walker := range walkers {
+		wrappedWalkers = append(wrappedWalkers, w.progress.newObjectContentsWalker(walker))
+	}
+	return contents, wrappedWalkers, nil
+}
+
+func (w *uploadProgressObjectContentsWalker) Discard() {
+	w.base.Discard()
+}
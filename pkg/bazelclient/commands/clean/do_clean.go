@@ -0,0 +1,65 @@
+package clean
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/commands"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/logging"
+)
+
+// preservedOutputBaseEntries contains the names of files and
+// directories in the output base that are not removed when running
+// "bonanza_bazel clean" without --expunge. Pending builds are
+// preserved, so that they can still be reattached to.
+var preservedOutputBaseEntries = map[string]struct{}{
+	"pending_builds": {},
+}
+
+func DoClean(args *arguments.CleanCommand, startupFlags *arguments.StartupFlags, workspacePath path.Parser) {
+	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
+	commands.ValidateInsideWorkspace(logger, "clean", workspacePath)
+
+	workspaceDirectory, err := commands.GetWorkspaceDirectory(workspacePath)
+	if err != nil {
+		logger.Fatal("Failed to obtain workspace path: ", err)
+	}
+	outputBase, err := commands.GetOutputBase(startupFlags, workspacePath)
+	if err != nil {
+		logger.Fatal("Failed to determine output base: ", err)
+	}
+
+	if err := commands.RemoveConvenienceSymlinks(outputBase, workspaceDirectory); err != nil {
+		logger.Fatal("Failed to remove convenience symlinks: ", err)
+	}
+
+	if args.CleanFlags.Expunge {
+		logger.Info("Starting clean of the entire output base")
+		if err := os.RemoveAll(outputBase); err != nil {
+			logger.Fatalf("Failed to remove output base %#v: %s", outputBase, err)
+		}
+		return
+	}
+
+	logger.Info("Starting clean")
+	entries, err := os.ReadDir(outputBase)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return
+		}
+		logger.Fatalf("Failed to read output base %#v: %s", outputBase, err)
+	}
+	for _, entry := range entries {
+		if _, ok := preservedOutputBaseEntries[entry.Name()]; ok {
+			continue
+		}
+		entryPath := filepath.Join(outputBase, entry.Name())
+		if err := os.RemoveAll(entryPath); err != nil {
+			logger.Fatalf("Failed to remove %#v: %s", entryPath, err)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package clean_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/commands"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/commands/clean"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoClean(t *testing.T) {
+	// newWorkspace returns a workspace directory and an output base
+	// that look like the ones left behind by a build, including a
+	// pending build that may still be reattached to.
+	newWorkspace := func(t *testing.T) (string, string) {
+		workspaceDirectory := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(workspaceDirectory, "MODULE.bazel"), nil, 0o666))
+		outputBase := filepath.Join(t.TempDir(), "output_base")
+		require.NoError(t, commands.CreateConvenienceSymlinks(outputBase, workspaceDirectory))
+		require.NoError(t, os.WriteFile(filepath.Join(outputBase, "file_contents_cache"), []byte("Cache"), 0o666))
+		require.NoError(t, os.Mkdir(filepath.Join(outputBase, "pending_builds"), 0o777))
+		require.NoError(t, os.WriteFile(filepath.Join(outputBase, "pending_builds", "7ec2c5a4-8ebd-4a3c-a0b5-2f3ef5d4d3b0"), []byte("Pending build"), 0o666))
+		return workspaceDirectory, outputBase
+	}
+	requireNoConvenienceSymlinks := func(t *testing.T, workspaceDirectory string) {
+		entries, err := os.ReadDir(workspaceDirectory)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "MODULE.bazel", entries[0].Name())
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		// All entries in the output base should be removed,
+		// except for pending builds.
+		workspaceDirectory, outputBase := newWorkspace(t)
+		clean.DoClean(
+			&arguments.CleanCommand{},
+			&arguments.StartupFlags{OutputBase: outputBase},
+			path.LocalFormat.NewParser(workspaceDirectory),
+		)
+
+		requireNoConvenienceSymlinks(t, workspaceDirectory)
+		entries, err := os.ReadDir(outputBase)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "pending_builds", entries[0].Name())
+		contents, err := os.ReadFile(filepath.Join(outputBase, "pending_builds", "7ec2c5a4-8ebd-4a3c-a0b5-2f3ef5d4d3b0"))
+		require.NoError(t, err)
+		require.Equal(t, "Pending build", string(contents))
+	})
+
+	t.Run("Expunge", func(t *testing.T) {
+		// With --expunge, the output base should be removed
+		// entirely.
+		workspaceDirectory, outputBase := newWorkspace(t)
+		clean.DoClean(
+			&arguments.CleanCommand{
+				CleanFlags: arguments.CleanFlags{Expunge: true},
+			},
+			&arguments.StartupFlags{OutputBase: outputBase},
+			path.LocalFormat.NewParser(workspaceDirectory),
+		)
+
+		requireNoConvenienceSymlinks(t, workspaceDirectory)
+		_, err := os.Stat(outputBase)
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("NonexistentOutputBase", func(t *testing.T) {
+		// Cleaning a workspace that was never built should
+		// succeed.
+		workspaceDirectory := t.TempDir()
+		outputBase := filepath.Join(t.TempDir(), "output_base")
+		clean.DoClean(
+			&arguments.CleanCommand{},
+			&arguments.StartupFlags{OutputBase: outputBase},
+			path.LocalFormat.NewParser(workspaceDirectory),
+		)
+
+		_, err := os.Stat(outputBase)
+		require.True(t, os.IsNotExist(err))
+	})
+}
@@ -11,20 +11,36 @@ import (
 	"github.com/buildbarn/bonanza/pkg/bazelclient/logging"
 )
 
-func DoInfo(args *arguments.InfoCommand, workspacePath path.Parser) {
+func DoInfo(args *arguments.InfoCommand, startupFlags *arguments.StartupFlags, workspacePath path.Parser) {
 	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
 	commands.ValidateInsideWorkspace(logger, "info", workspacePath)
 
-	workspacePathBuilder, scopeWalker := path.EmptyBuilder.Join(path.NewAbsoluteScopeWalker(path.VoidComponentWalker))
-	if err := path.Resolve(workspacePath, scopeWalker); err != nil {
+	workspacePathStr, err := commands.GetWorkspaceDirectory(workspacePath)
+	if err != nil {
 		logger.Fatal("Failed to obtain workspace path: ", err)
 	}
-	workspacePathStr, err := path.LocalFormat.GetString(workspacePathBuilder)
+	outputUserRoot, err := commands.GetOutputUserRoot(startupFlags)
 	if err != nil {
-		logger.Fatal("Failed to obtain workspace path: ", err)
+		logger.Fatal("Failed to determine output user root: ", err)
+	}
+	outputBase, err := commands.GetOutputBase(startupFlags, workspacePath)
+	if err != nil {
+		logger.Fatal("Failed to determine output base: ", err)
 	}
 
+	convenienceSymlinkTargets := commands.GetConvenienceSymlinkTargets(outputBase, workspacePathStr)
 	keys := map[string]string{
+		"bazel-bin": convenienceSymlinkTargets["bazel-bin"],
+		// Bazel no longer has a separate genfiles directory.
+		"bazel-genfiles":   convenienceSymlinkTargets["bazel-bin"],
+		"bazel-testlogs":   convenienceSymlinkTargets["bazel-testlogs"],
+		"execution_root":   commands.GetExecutionRoot(outputBase),
+		"output_base":      outputBase,
+		"output_path":      commands.GetOutputPath(outputBase),
+		"output_user_root": outputUserRoot,
+		// Similar to "bazel version", mimic the output of a
+		// version of Bazel that was built from source.
+		"release":   "development version",
 		"workspace": workspacePathStr,
 	}
 
@@ -0,0 +1,438 @@
+package mod
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/commands"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/commands/build"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/logging"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func DoMod(args *arguments.ModCommand, startupFlags *arguments.StartupFlags, workspacePath path.Parser) {
+	logger := logging.NewLoggerFromFlags(&args.CommonFlags)
+	commands.ValidateInsideWorkspace(logger, "mod", workspacePath)
+
+	if len(args.Arguments) == 0 {
+		logger.Fatal("No subcommand specified. Supported subcommands are: deps, explain, graph, show_extension, show_repo, tidy")
+	}
+	subcommand, subcommandArguments := args.Arguments[0], args.Arguments[1:]
+	switch subcommand {
+	case "deps", "explain":
+		if len(subcommandArguments) == 0 {
+			logger.Fatalf("The %#v subcommand requires one or more modules", subcommand)
+		}
+		result := queryModules(logger, args, startupFlags, &model_build_pb.ModuleQuery{}, workspacePath)
+		graph := newModuleGraph(result.Modules)
+		targets, err := graph.resolveModuleArguments(subcommandArguments)
+		if err != nil {
+			logger.Fatal(err)
+		}
+		if subcommand == "deps" {
+			for _, target := range targets {
+				graph.print(os.Stdout, args.ModFlags.Output, target, 1, nil)
+			}
+		} else {
+			graph.print(os.Stdout, args.ModFlags.Output, graph.root(), -1, graph.getModulesReaching(targets))
+		}
+	case "graph":
+		if len(subcommandArguments) != 0 {
+			logger.Fatal("The \"graph\" subcommand does not take any arguments")
+		}
+		result := queryModules(logger, args, startupFlags, &model_build_pb.ModuleQuery{}, workspacePath)
+		graph := newModuleGraph(result.Modules)
+		graph.print(os.Stdout, args.ModFlags.Output, graph.root(), -1, nil)
+	case "show_extension":
+		if len(subcommandArguments) == 0 {
+			logger.Fatal("The \"show_extension\" subcommand requires one or more module extensions of the form ${bzl_file_label}%${extension_name}")
+		}
+		result := queryModules(logger, args, startupFlags, &model_build_pb.ModuleQuery{
+			Extensions: subcommandArguments,
+		}, workspacePath)
+		graph := newModuleGraph(result.Modules)
+		if args.ModFlags.Output == arguments.ModOutput_Json {
+			printProtoListAsJSON(result.Extensions)
+		} else {
+			for _, extension := range result.Extensions {
+				printExtension(graph, extension)
+			}
+		}
+	case "show_repo":
+		if len(subcommandArguments) == 0 {
+			logger.Fatal("The \"show_repo\" subcommand requires one or more repos of the form @apparent_name or @@canonical_name")
+		}
+		result := queryModules(logger, args, startupFlags, &model_build_pb.ModuleQuery{
+			Repos: subcommandArguments,
+		}, workspacePath)
+		graph := newModuleGraph(result.Modules)
+		if args.ModFlags.Output == arguments.ModOutput_Json {
+			printProtoListAsJSON(result.Repos)
+		} else {
+			for _, repo := range result.Repos {
+				if err := printRepo(graph, repo); err != nil {
+					logger.Fatal(err)
+				}
+			}
+		}
+	case "tidy":
+		if len(subcommandArguments) != 0 {
+			logger.Fatal("The \"tidy\" subcommand does not take any arguments")
+		}
+		doTidy(logger, args, startupFlags, workspacePath)
+	default:
+		logger.Fatalf("Unknown subcommand %#v. Supported subcommands are: deps, explain, graph, show_extension, show_repo, tidy", subcommand)
+	}
+}
+
+// queryModules requests that the builder computes the module graph of
+// the workspace, and returns information on the provided repos and
+// module extensions.
+func queryModules(logger logging.Logger, args *arguments.ModCommand, startupFlags *arguments.StartupFlags, moduleQuery *model_build_pb.ModuleQuery, workspacePath path.Parser) *model_build_pb.ModuleQueryResult {
+	result := build.PerformBuild(logger, startupFlags, &args.CommonFlags, &args.BuildFlags, nil, moduleQuery, workspacePath)
+	if err := status.ErrorProto(result.Status); err != nil {
+		logger.Fatal("Failed to query module graph: ", err)
+	}
+	if result.ModuleQueryResult == nil {
+		logger.Fatal("Builder did not return a module query result")
+	}
+	return result.ModuleQueryResult
+}
+
+// moduleGraph is an in-memory representation of the module graph
+// returned by the builder, which can be printed as a tree.
+type moduleGraph struct {
+	modules           []*model_build_pb.ModuleQueryResult_Module
+	modulesByInstance map[string]*model_build_pb.ModuleQueryResult_Module
+}
+
+func newModuleGraph(modules []*model_build_pb.ModuleQueryResult_Module) *moduleGraph {
+	g := &moduleGraph{
+		modules:           modules,
+		modulesByInstance: make(map[string]*model_build_pb.ModuleQueryResult_Module, len(modules)),
+	}
+	for _, module := range modules {
+		g.modulesByInstance[module.ModuleInstance] = module
+	}
+	return g
+}
+
+func (g *moduleGraph) root() *model_build_pb.ModuleQueryResult_Module {
+	return g.modules[0]
+}
+
+// getModuleName returns the name of a module, stripping the version
+// that is part of the module instance.
+func getModuleName(module *model_build_pb.ModuleQueryResult_Module) string {
+	name, _, _ := strings.Cut(module.ModuleInstance, "+")
+	return name
+}
+
+// getDisplayName returns the name of a module as it is displayed to
+// the user, having the format ${name}@${version}. Modules without a
+// version use "_" as their version, similar to Bazel.
+func (g *moduleGraph) getDisplayName(moduleInstance string) string {
+	module, ok := g.modulesByInstance[moduleInstance]
+	if !ok {
+		return moduleInstance
+	}
+	version := module.Version
+	if version == "" {
+		version = "_"
+	}
+	displayName := getModuleName(module) + "@" + version
+	if module == g.root() {
+		return "<root> (" + displayName + ")"
+	}
+	return displayName
+}
+
+// resolveModuleArguments converts command line arguments of the form
+// ${name}, ${name}@${version} or <root> to the modules in the graph
+// that they match.
+func (g *moduleGraph) resolveModuleArguments(moduleArguments []string) ([]*model_build_pb.ModuleQueryResult_Module, error) {
+	var targets []*model_build_pb.ModuleQueryResult_Module
+	for _, moduleArgument := range moduleArguments {
+		if moduleArgument == "<root>" {
+			targets = append(targets, g.root())
+			continue
+		}
+		name, version, hasVersion := strings.Cut(moduleArgument, "@")
+		matched := false
+		for _, module := range g.modules {
+			if getModuleName(module) == name && (!hasVersion || module.Version == version || (version == "_" && module.Version == "")) {
+				targets = append(targets, module)
+				matched = true
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("module %#v is not part of the module graph", moduleArgument)
+		}
+	}
+	return targets, nil
+}
+
+// getModulesReaching returns the set of modules from which any of the
+// provided target modules can be reached, including the targets
+// themselves.
+func (g *moduleGraph) getModulesReaching(targets []*model_build_pb.ModuleQueryResult_Module) map[string]struct{} {
+	dependents := map[string][]string{}
+	for _, module := range g.modules {
+		for _, dependency := range module.Dependencies {
+			dependents[dependency] = append(dependents[dependency], module.ModuleInstance)
+		}
+	}
+	reaching := map[string]struct{}{}
+	queue := make([]string, 0, len(targets))
+	for _, target := range targets {
+		queue = append(queue, target.ModuleInstance)
+	}
+	for len(queue) > 0 {
+		moduleInstance := queue[0]
+		queue = queue[1:]
+		if _, ok := reaching[moduleInstance]; !ok {
+			reaching[moduleInstance] = struct{}{}
+			queue = append(queue, dependents[moduleInstance]...)
+		}
+	}
+	return reaching
+}
+
+// moduleGraphJSONNode is the JSON representation of a module in the
+// module graph, which is emitted when --output=json is provided.
+type moduleGraphJSONNode struct {
+	Key          string                 `json:"key"`
+	Name         string                 `json:"name"`
+	Version      string                 `json:"version"`
+	RegistryURL  string                 `json:"registryUrl,omitempty"`
+	Dependencies []*moduleGraphJSONNode `json:"dependencies,omitempty"`
+	Unexpanded   bool                   `json:"unexpanded,omitempty"`
+}
+
+// print the module graph as a tree, starting at a given module. Modules
+// that are displayed multiple times are only expanded once. If a filter
+// is provided, only modules contained in the filter are displayed.
+func (g *moduleGraph) print(w io.Writer, output arguments.ModOutput, start *model_build_pb.ModuleQueryResult_Module, maximumDepth int, filter map[string]struct{}) {
+	expanded := map[string]struct{}{}
+	var getChildren func(module *model_build_pb.ModuleQueryResult_Module, depth int) ([]*model_build_pb.ModuleQueryResult_Module, bool)
+	getChildren = func(module *model_build_pb.ModuleQueryResult_Module, depth int) ([]*model_build_pb.ModuleQueryResult_Module, bool) {
+		if _, ok := expanded[module.ModuleInstance]; ok {
+			return nil, len(module.Dependencies) > 0
+		}
+		expanded[module.ModuleInstance] = struct{}{}
+		if depth == maximumDepth {
+			return nil, false
+		}
+		var children []*model_build_pb.ModuleQueryResult_Module
+		for _, dependency := range module.Dependencies {
+			if child, ok := g.modulesByInstance[dependency]; ok {
+				if _, ok := filter[dependency]; filter == nil || ok {
+					children = append(children, child)
+				}
+			}
+		}
+		return children, false
+	}
+
+	if output == arguments.ModOutput_Json {
+		var toJSON func(module *model_build_pb.ModuleQueryResult_Module, depth int) *moduleGraphJSONNode
+		toJSON = func(module *model_build_pb.ModuleQueryResult_Module, depth int) *moduleGraphJSONNode {
+			children, unexpanded := getChildren(module, depth)
+			node := &moduleGraphJSONNode{
+				Key:         module.ModuleInstance,
+				Name:        getModuleName(module),
+				Version:     module.Version,
+				RegistryURL: module.RegistryUrl,
+				Unexpanded:  unexpanded,
+			}
+			if module == g.root() {
+				node.Key = "<root>"
+			}
+			for _, child := range children {
+				node.Dependencies = append(node.Dependencies, toJSON(child, depth+1))
+			}
+			return node
+		}
+		data, err := json.MarshalIndent(toJSON(start, 0), "", "  ")
+		if err != nil {
+			panic(err)
+		}
+		fmt.Fprintf(w, "%s\n", data)
+		return
+	}
+
+	var printText func(module *model_build_pb.ModuleQueryResult_Module, prefix, childPrefix string, depth int)
+	printText = func(module *model_build_pb.ModuleQueryResult_Module, prefix, childPrefix string, depth int) {
+		children, unexpanded := getChildren(module, depth)
+		line := prefix + g.getDisplayName(module.ModuleInstance)
+		if unexpanded {
+			line += " (*)"
+		}
+		fmt.Fprintln(w, line)
+		for i, child := range children {
+			if i == len(children)-1 {
+				printText(child, childPrefix+"└───", childPrefix+"    ", depth+1)
+			} else {
+				printText(child, childPrefix+"├───", childPrefix+"│   ", depth+1)
+			}
+		}
+	}
+	printText(start, "", "", 0)
+}
+
+// printProtoListAsJSON prints a list of Protobuf messages as a JSON
+// array.
+func printProtoListAsJSON[T proto.Message](messages []T) {
+	marshaledMessages := make([]json.RawMessage, 0, len(messages))
+	for _, message := range messages {
+		data, err := protojson.Marshal(message)
+		if err != nil {
+			panic(err)
+		}
+		marshaledMessages = append(marshaledMessages, data)
+	}
+	data, err := json.MarshalIndent(marshaledMessages, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%s\n", data)
+}
+
+// printRepo prints the definition of a repo, using a syntax that is
+// similar to how the repo would be declared in Starlark.
+func printRepo(graph *moduleGraph, repo *model_build_pb.ModuleQueryResult_Repo) error {
+	fmt.Printf("## %s:\n", repo.Name)
+	switch {
+	case repo.CanonicalName == "":
+		fmt.Println("# Repo does not exist")
+	case repo.ModuleInstance != "":
+		fmt.Printf("# Repo of module %s\n", graph.getDisplayName(repo.ModuleInstance))
+		fmt.Printf("# Canonical name: @@%s\n", repo.CanonicalName)
+	default:
+		var attributes map[string]any
+		if err := json.Unmarshal([]byte(repo.AttributesJson), &attributes); err != nil {
+			return fmt.Errorf("invalid attributes for repo %#v: %w", repo.Name, err)
+		}
+		var sb strings.Builder
+		sb.WriteString("# Defined by ")
+		sb.WriteString(repo.RepositoryRuleIdentifier)
+		sb.WriteString("\n")
+		_, ruleName, _ := strings.Cut(repo.RepositoryRuleIdentifier, "%")
+		sb.WriteString(ruleName)
+		sb.WriteString("(\n  name = ")
+		writeStarlarkValue(&sb, repo.CanonicalName, "  ")
+		sb.WriteString(",\n")
+		for _, name := range slices.Sorted(func(yield func(string) bool) {
+			for name := range attributes {
+				if !yield(name) {
+					return
+				}
+			}
+		}) {
+			sb.WriteString("  ")
+			sb.WriteString(name)
+			sb.WriteString(" = ")
+			writeStarlarkValue(&sb, attributes[name], "  ")
+			sb.WriteString(",\n")
+		}
+		sb.WriteString(")\n")
+		fmt.Print(sb.String())
+	}
+	fmt.Println()
+	return nil
+}
+
+// writeStarlarkValue converts a value that was obtained by parsing JSON
+// back to Starlark syntax.
+func writeStarlarkValue(sb *strings.Builder, value any, indent string) {
+	switch v := value.(type) {
+	case nil:
+		sb.WriteString("None")
+	case bool:
+		if v {
+			sb.WriteString("True")
+		} else {
+			sb.WriteString("False")
+		}
+	case float64:
+		fmt.Fprint(sb, v)
+	case string:
+		data, _ := json.Marshal(v)
+		sb.Write(data)
+	case []any:
+		if len(v) == 0 {
+			sb.WriteString("[]")
+			return
+		}
+		sb.WriteString("[\n")
+		for _, element := range v {
+			sb.WriteString(indent + "  ")
+			writeStarlarkValue(sb, element, indent+"  ")
+			sb.WriteString(",\n")
+		}
+		sb.WriteString(indent + "]")
+	case map[string]any:
+		if len(v) == 0 {
+			sb.WriteString("{}")
+			return
+		}
+		sb.WriteString("{\n")
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			sb.WriteString(indent + "  ")
+			writeStarlarkValue(sb, key, indent+"  ")
+			sb.WriteString(": ")
+			writeStarlarkValue(sb, v[key], indent+"  ")
+			sb.WriteString(",\n")
+		}
+		sb.WriteString(indent + "}")
+	default:
+		panic(fmt.Sprintf("unexpected JSON value of type %T", value))
+	}
+}
+
+// printExtension prints the repos declared by a module extension and
+// the modules that use it.
+func printExtension(graph *moduleGraph, extension *model_build_pb.ModuleQueryResult_Extension) {
+	fmt.Printf("## %s:\n\n", extension.Name)
+
+	importedBy := map[string][]string{}
+	for _, user := range extension.Users {
+		displayName := graph.getDisplayName(user.ModuleInstance)
+		for _, repo := range user.ImportedRepos {
+			importedBy[repo] = append(importedBy[repo], displayName)
+		}
+		for _, repo := range user.DevImportedRepos {
+			importedBy[repo] = append(importedBy[repo], displayName+" (dev)")
+		}
+	}
+	fmt.Println("Fetched repositories:")
+	for _, repo := range extension.Repos {
+		if users := importedBy[repo]; len(users) > 0 {
+			fmt.Printf("  - %s (imported by %s)\n", repo, strings.Join(users, ", "))
+		} else {
+			fmt.Printf("  - %s\n", repo)
+		}
+	}
+	fmt.Println()
+
+	fmt.Println("Used by:")
+	for _, user := range extension.Users {
+		fmt.Printf("  - %s\n", graph.getDisplayName(user.ModuleInstance))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,158 @@
+package mod
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleGraph(t *testing.T) {
+	// Both rules_a and rules_b depend on rules_c, meaning the
+	// second occurrence of rules_c should not be expanded.
+	graph := newModuleGraph([]*model_build_pb.ModuleQueryResult_Module{
+		{
+			ModuleInstance: "mymodule+",
+			Dependencies:   []string{"rules_a+", "rules_b+"},
+		},
+		{
+			ModuleInstance: "rules_a+",
+			Version:        "1.0",
+			RegistryUrl:    "https://bcr.bazel.build",
+			Dependencies:   []string{"rules_c+"},
+		},
+		{
+			ModuleInstance: "rules_b+",
+			Version:        "2.0",
+			Dependencies:   []string{"rules_c+"},
+		},
+		{
+			ModuleInstance: "rules_c+",
+			Version:        "3.0",
+			Dependencies:   []string{"rules_d+"},
+		},
+		{
+			ModuleInstance: "rules_d+",
+			Version:        "4.0",
+		},
+	})
+
+	resolve := func(t *testing.T, moduleArguments ...string) []*model_build_pb.ModuleQueryResult_Module {
+		targets, err := graph.resolveModuleArguments(moduleArguments)
+		require.NoError(t, err)
+		return targets
+	}
+
+	t.Run("ResolveModuleArguments", func(t *testing.T) {
+		targets := resolve(t, "<root>", "rules_a", "rules_b@2.0", "mymodule@_")
+		require.Len(t, targets, 4)
+		require.Equal(t, "mymodule+", targets[0].ModuleInstance)
+		require.Equal(t, "rules_a+", targets[1].ModuleInstance)
+		require.Equal(t, "rules_b+", targets[2].ModuleInstance)
+		require.Equal(t, "mymodule+", targets[3].ModuleInstance)
+
+		_, err := graph.resolveModuleArguments([]string{"rules_b@1.0"})
+		require.EqualError(t, err, "module \"rules_b@1.0\" is not part of the module graph")
+	})
+
+	t.Run("Graph", func(t *testing.T) {
+		var out bytes.Buffer
+		graph.print(&out, arguments.ModOutput_Text, graph.root(), -1, nil)
+		require.Equal(
+			t,
+			"<root> (mymodule@_)\n"+
+				"├───rules_a@1.0\n"+
+				"│   └───rules_c@3.0\n"+
+				"│       └───rules_d@4.0\n"+
+				"└───rules_b@2.0\n"+
+				"    └───rules_c@3.0 (*)\n",
+			out.String(),
+		)
+	})
+
+	t.Run("GraphJSON", func(t *testing.T) {
+		var out bytes.Buffer
+		graph.print(&out, arguments.ModOutput_Json, graph.root(), -1, nil)
+		require.JSONEq(t, `{
+			"key": "<root>",
+			"name": "mymodule",
+			"version": "",
+			"dependencies": [
+				{
+					"key": "rules_a+",
+					"name": "rules_a",
+					"version": "1.0",
+					"registryUrl": "https://bcr.bazel.build",
+					"dependencies": [
+						{
+							"key": "rules_c+",
+							"name": "rules_c",
+							"version": "3.0",
+							"dependencies": [
+								{
+									"key": "rules_d+",
+									"name": "rules_d",
+									"version": "4.0"
+								}
+							]
+						}
+					]
+				},
+				{
+					"key": "rules_b+",
+					"name": "rules_b",
+					"version": "2.0",
+					"dependencies": [
+						{
+							"key": "rules_c+",
+							"name": "rules_c",
+							"version": "3.0",
+							"unexpanded": true
+						}
+					]
+				}
+			]
+		}`, out.String())
+	})
+
+	t.Run("Deps", func(t *testing.T) {
+		// Only direct dependencies should be displayed.
+		var out bytes.Buffer
+		graph.print(&out, arguments.ModOutput_Text, resolve(t, "rules_a")[0], 1, nil)
+		require.Equal(
+			t,
+			"rules_a@1.0\n"+
+				"└───rules_c@3.0\n",
+			out.String(),
+		)
+	})
+
+	t.Run("Explain", func(t *testing.T) {
+		// Only paths leading to rules_c should be displayed.
+		// Dependencies of rules_c itself should be omitted.
+		var out bytes.Buffer
+		graph.print(&out, arguments.ModOutput_Text, graph.root(), -1, graph.getModulesReaching(resolve(t, "rules_c")))
+		require.Equal(
+			t,
+			"<root> (mymodule@_)\n"+
+				"├───rules_a@1.0\n"+
+				"│   └───rules_c@3.0\n"+
+				"└───rules_b@2.0\n"+
+				"    └───rules_c@3.0 (*)\n",
+			out.String(),
+		)
+	})
+
+	t.Run("ExplainSinglePath", func(t *testing.T) {
+		var out bytes.Buffer
+		graph.print(&out, arguments.ModOutput_Text, graph.root(), -1, graph.getModulesReaching(resolve(t, "rules_b")))
+		require.Equal(
+			t,
+			"<root> (mymodule@_)\n"+
+				"└───rules_b@2.0\n",
+			out.String(),
+		)
+	})
+}
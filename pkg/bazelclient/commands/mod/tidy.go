@@ -0,0 +1,531 @@
+package mod
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/commands/build"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/logging"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	pg_starlark "github.com/buildbarn/bonanza/pkg/starlark"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// maximumUseRepoLineLength is the maximum length of a use_repo() call
+// that is emitted on a single line. Longer calls are split across
+// multiple lines, placing every argument on its own line.
+const maximumUseRepoLineLength = 79
+
+var (
+	moduleDotBazelFilename = path.MustNewComponent("MODULE.bazel")
+	// moduleDotBazelLabel is the label under which MODULE.bazel is
+	// evaluated. Its canonical repo is merely a placeholder, as
+	// the canonical repo of the root module is not known by the
+	// client.
+	moduleDotBazelLabel = label.MustNewCanonicalLabel("@@main+//:MODULE.bazel")
+)
+
+// moduleExtensionProxyDeclaration corresponds to a top-level statement
+// of the form "proxy = use_extension(...)" in MODULE.bazel, and all of
+// the use_repo() calls that are made against the proxy.
+type moduleExtensionProxyDeclaration struct {
+	variable      string
+	extension     string
+	devDependency bool
+	declaration   *syntax.AssignStmt
+	useRepoCalls  []*syntax.CallExpr
+}
+
+// useRepoArgument is an argument of a use_repo() call. Repos may
+// either be imported under their own name, or be given a different
+// apparent name using keyword arguments.
+type useRepoArgument struct {
+	apparentName string
+	repoName     string
+}
+
+func (a useRepoArgument) String() string {
+	if a.apparentName == "" {
+		return strconv.Quote(a.repoName)
+	}
+	return a.apparentName + " = " + strconv.Quote(a.repoName)
+}
+
+// sourceEdit is a replacement of a range of bytes in MODULE.bazel.
+type sourceEdit struct {
+	start, end  int
+	replacement string
+}
+
+func getStringLiteral(expr syntax.Expr) (string, bool) {
+	if literal, ok := expr.(*syntax.Literal); ok && literal.Token == syntax.STRING {
+		return literal.Value.(string), true
+	}
+	return "", false
+}
+
+// callPosition is the line and column number of the opening
+// parenthesis of a call in MODULE.bazel. It is used to look up the
+// syntax tree of calls made while MODULE.bazel is evaluated.
+type callPosition struct {
+	line, col int32
+}
+
+func newCallPosition(position syntax.Position) callPosition {
+	return callPosition{
+		line: position.Line,
+		col:  position.Col,
+	}
+}
+
+// moduleExtensionProxyCapturingModuleDotBazelHandler is called into by
+// ParseModuleDotBazel() to capture all calls to use_extension() and
+// use_repo() made by the root module, and to associate them with
+// their syntax trees.
+type moduleExtensionProxyCapturingModuleDotBazelHandler struct {
+	assignments  map[callPosition]*syntax.AssignStmt
+	calls        map[callPosition]*syntax.CallExpr
+	callPosition syntax.Position
+	declarations []*moduleExtensionProxyDeclaration
+}
+
+func (moduleExtensionProxyCapturingModuleDotBazelHandler) BazelDep(name label.Module, version *label.ModuleVersion, maxCompatibilityLevel int, repoName label.ApparentRepo, devDependency bool) error {
+	return nil
+}
+
+func (moduleExtensionProxyCapturingModuleDotBazelHandler) LocalPathOverride(moduleName label.Module, path path.Parser) error {
+	return nil
+}
+
+func (moduleExtensionProxyCapturingModuleDotBazelHandler) Module(name label.Module, version *label.ModuleVersion, compatibilityLevel int, repoName label.ApparentRepo, bazelCompatibility []string) error {
+	return nil
+}
+
+func (moduleExtensionProxyCapturingModuleDotBazelHandler) MultipleVersionOverride(moduleName label.Module, versions []label.ModuleVersion, registry *url.URL) error {
+	return nil
+}
+
+func (moduleExtensionProxyCapturingModuleDotBazelHandler) RegisterExecutionPlatforms(platformTargetPatterns []label.ApparentTargetPattern, devDependency bool) error {
+	return nil
+}
+
+func (moduleExtensionProxyCapturingModuleDotBazelHandler) RegisterToolchains(toolchainTargetPatterns []label.ApparentTargetPattern, devDependency bool) error {
+	return nil
+}
+
+func (moduleExtensionProxyCapturingModuleDotBazelHandler) RepositoryRuleOverride(moduleName label.Module, repositoryRuleIdentifier label.CanonicalStarlarkIdentifier, attrs map[string]starlark.Value) error {
+	return nil
+}
+
+func (h *moduleExtensionProxyCapturingModuleDotBazelHandler) SetCallPosition(position syntax.Position) {
+	h.callPosition = position
+}
+
+func (moduleExtensionProxyCapturingModuleDotBazelHandler) SingleVersionOverride(moduleName label.Module, version *label.ModuleVersion, registry *url.URL, patchOptions *pg_starlark.PatchOptions) error {
+	return nil
+}
+
+func (h *moduleExtensionProxyCapturingModuleDotBazelHandler) UseExtension(extensionBzlFile label.ApparentLabel, extensionName label.StarlarkIdentifier, devDependency, isolate bool) (pg_starlark.ModuleExtensionProxy, error) {
+	// Imports of isolated module extensions are not affected by
+	// the root module's direct dependencies. Proxies that are not
+	// assigned to a variable at the top level cannot be referenced
+	// by newly added use_repo() calls.
+	assignment, ok := h.assignments[newCallPosition(h.callPosition)]
+	if isolate || !ok {
+		return pg_starlark.NullModuleExtensionProxy, nil
+	}
+
+	// Labels of .bzl files in the root module are resolved against
+	// the placeholder repo that is used while parsing. Convert
+	// these back to labels that are relative to the root module,
+	// so that the builder can resolve them.
+	extension := extensionBzlFile.String()
+	if canonicalLabel, ok := extensionBzlFile.AsCanonical(); ok {
+		if canonicalRepo := moduleDotBazelLabel.GetCanonicalRepo(); canonicalLabel.GetCanonicalRepo() == canonicalRepo {
+			extension = strings.TrimPrefix(extension, "@@"+canonicalRepo.String())
+		}
+	}
+
+	declaration := &moduleExtensionProxyDeclaration{
+		variable:      assignment.LHS.(*syntax.Ident).Name,
+		extension:     extension + "%" + extensionName.String(),
+		devDependency: devDependency,
+		declaration:   assignment,
+	}
+	h.declarations = append(h.declarations, declaration)
+	return &moduleExtensionProxyCapturingModuleExtensionProxy{
+		handler:     h,
+		declaration: declaration,
+	}, nil
+}
+
+func (moduleExtensionProxyCapturingModuleDotBazelHandler) UseRepoRule(repoRuleBzlFile label.ApparentLabel, repoRuleName string) (pg_starlark.RepoRuleProxy, error) {
+	return func(name label.ApparentRepo, devDependency bool, attrs map[string]starlark.Value) error {
+		return nil
+	}, nil
+}
+
+type moduleExtensionProxyCapturingModuleExtensionProxy struct {
+	handler     *moduleExtensionProxyCapturingModuleDotBazelHandler
+	declaration *moduleExtensionProxyDeclaration
+}
+
+func (moduleExtensionProxyCapturingModuleExtensionProxy) Tag(className string, attrs map[string]starlark.Value) error {
+	return nil
+}
+
+func (p *moduleExtensionProxyCapturingModuleExtensionProxy) UseRepo(repos map[label.ApparentRepo]label.ApparentRepo) error {
+	call, ok := p.handler.calls[newCallPosition(p.handler.callPosition)]
+	if !ok {
+		return fmt.Errorf("%s: use_repo() must be called at the top level", p.handler.callPosition)
+	}
+	p.declaration.useRepoCalls = append(p.declaration.useRepoCalls, call)
+	return nil
+}
+
+// parseModuleExtensionProxyDeclarations evaluates MODULE.bazel to
+// obtain all calls to use_extension() and use_repo() made by the root
+// module, together with their syntax trees. Declarations of isolated
+// module extensions are ignored, as their imports are not affected by
+// the root module's direct dependencies.
+func parseModuleExtensionProxyDeclarations(contents []byte) ([]*moduleExtensionProxyDeclaration, error) {
+	file, err := (&syntax.FileOptions{}).Parse(moduleDotBazelFilename.String(), contents, 0)
+	if err != nil {
+		return nil, err
+	}
+	handler := moduleExtensionProxyCapturingModuleDotBazelHandler{
+		assignments: map[callPosition]*syntax.AssignStmt{},
+		calls:       map[callPosition]*syntax.CallExpr{},
+	}
+	for _, stmt := range file.Stmts {
+		switch s := stmt.(type) {
+		case *syntax.AssignStmt:
+			if call, ok := s.RHS.(*syntax.CallExpr); ok {
+				if _, ok := s.LHS.(*syntax.Ident); ok && s.Op == syntax.EQ {
+					handler.assignments[newCallPosition(call.Lparen)] = s
+				}
+			}
+		case *syntax.ExprStmt:
+			if call, ok := s.X.(*syntax.CallExpr); ok {
+				handler.calls[newCallPosition(call.Lparen)] = call
+			}
+		}
+	}
+
+	if err := pg_starlark.ParseModuleDotBazel(
+		string(contents),
+		moduleDotBazelLabel,
+		path.LocalFormat,
+		func(thread *starlark.Thread, msg string) {},
+		&handler,
+	); err != nil {
+		return nil, err
+	}
+	return handler.declarations, nil
+}
+
+// sourceOffsets converts positions reported by the Starlark parser,
+// which consist of line numbers and column numbers in runes, to byte
+// offsets.
+type sourceOffsets struct {
+	source     []byte
+	lineStarts []int
+}
+
+func newSourceOffsets(source []byte) *sourceOffsets {
+	lineStarts := []int{0}
+	for i, c := range source {
+		if c == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+	return &sourceOffsets{
+		source:     source,
+		lineStarts: lineStarts,
+	}
+}
+
+func (so *sourceOffsets) get(position syntax.Position) int {
+	offset := so.lineStarts[position.Line-1]
+	for i := int32(1); i < position.Col; i++ {
+		_, size := utf8.DecodeRune(so.source[offset:])
+		offset += size
+	}
+	return offset
+}
+
+// getStatementRange returns the range of bytes of a call that is
+// placed in a statement of its own. If nothing else is placed on the
+// same lines, the range is extended to cover the full lines, so that
+// the statement can be removed without leaving an empty line behind.
+func (so *sourceOffsets) getStatementRange(call *syntax.CallExpr) (int, int) {
+	start, end := call.Span()
+	startOffset, endOffset := so.get(start), so.get(end)
+	lineStart := startOffset
+	for lineStart > 0 && (so.source[lineStart-1] == ' ' || so.source[lineStart-1] == '\t') {
+		lineStart--
+	}
+	if lineStart > 0 && so.source[lineStart-1] != '\n' {
+		return startOffset, endOffset
+	}
+	lineEnd := endOffset
+	for lineEnd < len(so.source) && (so.source[lineEnd] == ' ' || so.source[lineEnd] == '\t') {
+		lineEnd++
+	}
+	if lineEnd < len(so.source) {
+		if so.source[lineEnd] != '\n' {
+			return startOffset, endOffset
+		}
+		lineEnd++
+	}
+	return lineStart, lineEnd
+}
+
+// formatUseRepoCall emits a use_repo() call, using the same layout
+// that is applied by buildifier.
+func formatUseRepoCall(variable string, arguments []useRepoArgument) string {
+	// Keyword arguments must be placed after positional arguments.
+	formattedArguments := make([]string, 0, len(arguments)+1)
+	formattedArguments = append(formattedArguments, variable)
+	for _, argument := range arguments {
+		if argument.apparentName == "" {
+			formattedArguments = append(formattedArguments, argument.String())
+		}
+	}
+	for _, argument := range arguments {
+		if argument.apparentName != "" {
+			formattedArguments = append(formattedArguments, argument.String())
+		}
+	}
+	if singleLine := "use_repo(" + strings.Join(formattedArguments, ", ") + ")"; len(singleLine) <= maximumUseRepoLineLength {
+		return singleLine
+	}
+	return "use_repo(\n    " + strings.Join(formattedArguments, ",\n    ") + ",\n)"
+}
+
+// computeUseRepoEdits computes the changes that need to be made to the
+// use_repo() calls of a set of proxies of the same module extension,
+// so that exactly the provided repos are imported. Existing imports
+// are retained, including any custom apparent names. Missing repos are
+// added to the first use_repo() call.
+func computeUseRepoEdits(so *sourceOffsets, declarations []*moduleExtensionProxyDeclaration, repoNames []string) ([]sourceEdit, error) {
+	if len(declarations) == 0 {
+		if len(repoNames) > 0 {
+			return nil, fmt.Errorf("repos %s cannot be imported, as no corresponding use_extension() call exists", strings.Join(repoNames, ", "))
+		}
+		return nil, nil
+	}
+
+	wantedRepos := map[string]bool{}
+	for _, repoName := range repoNames {
+		wantedRepos[repoName] = false
+	}
+
+	// Determine which arguments of the existing calls to retain.
+	var edits []sourceEdit
+	type rewrittenCall struct {
+		call      *syntax.CallExpr
+		variable  string
+		arguments []useRepoArgument
+		changed   bool
+	}
+	var calls []*rewrittenCall
+	for _, declaration := range declarations {
+		for _, call := range declaration.useRepoCalls {
+			c := &rewrittenCall{
+				call:     call,
+				variable: declaration.variable,
+			}
+			for _, argument := range call.Args[1:] {
+				var a useRepoArgument
+				if binary, ok := argument.(*syntax.BinaryExpr); ok && binary.Op == syntax.EQ {
+					value, ok := getStringLiteral(binary.Y)
+					if !ok {
+						return nil, fmt.Errorf("%s: arguments of use_repo() must be string literals", syntax.Start(argument))
+					}
+					a = useRepoArgument{
+						apparentName: binary.X.(*syntax.Ident).Name,
+						repoName:     value,
+					}
+				} else {
+					value, ok := getStringLiteral(argument)
+					if !ok {
+						return nil, fmt.Errorf("%s: arguments of use_repo() must be string literals", syntax.Start(argument))
+					}
+					a = useRepoArgument{repoName: value}
+				}
+				if imported, ok := wantedRepos[a.repoName]; ok && (!imported || a.apparentName != "") {
+					wantedRepos[a.repoName] = true
+					c.arguments = append(c.arguments, a)
+				} else {
+					c.changed = true
+				}
+			}
+			calls = append(calls, c)
+		}
+	}
+
+	// Add repos that are not imported yet.
+	var missingRepos []useRepoArgument
+	for _, repoName := range repoNames {
+		if !wantedRepos[repoName] {
+			missingRepos = append(missingRepos, useRepoArgument{repoName: repoName})
+		}
+	}
+	if len(missingRepos) > 0 {
+		if len(calls) == 0 {
+			declaration := declarations[0]
+			_, end := declaration.declaration.Span()
+			offset := so.get(end)
+			edits = append(edits, sourceEdit{
+				start:       offset,
+				end:         offset,
+				replacement: "\n" + formatUseRepoCall(declaration.variable, missingRepos),
+			})
+		} else {
+			calls[0].arguments = append(calls[0].arguments, missingRepos...)
+			calls[0].changed = true
+		}
+	}
+
+	for _, c := range calls {
+		if !c.changed {
+			continue
+		}
+		if len(c.arguments) == 0 {
+			start, end := so.getStatementRange(c.call)
+			edits = append(edits, sourceEdit{start: start, end: end})
+		} else {
+			start, end := c.call.Span()
+			edits = append(edits, sourceEdit{
+				start:       so.get(start),
+				end:         so.get(end),
+				replacement: formatUseRepoCall(c.variable, c.arguments),
+			})
+		}
+	}
+	return edits, nil
+}
+
+// computeModuleExtensionEdits computes the changes that need to be
+// made to the use_repo() calls of all proxies of a single module
+// extension. Proxies declared with dev_dependency = True are updated
+// to import the direct dev dependencies, while all other proxies are
+// updated to import the regular direct dependencies.
+func computeModuleExtensionEdits(so *sourceOffsets, declarations []*moduleExtensionProxyDeclaration, directDeps *model_build_pb.ModuleQueryResult_Extension_RootModuleDirectDeps) ([]sourceEdit, error) {
+	var nonDevDeclarations, devDeclarations []*moduleExtensionProxyDeclaration
+	for _, declaration := range declarations {
+		if declaration.devDependency {
+			devDeclarations = append(devDeclarations, declaration)
+		} else {
+			nonDevDeclarations = append(nonDevDeclarations, declaration)
+		}
+	}
+	nonDevEdits, err := computeUseRepoEdits(so, nonDevDeclarations, directDeps.DirectDeps)
+	if err != nil {
+		return nil, err
+	}
+	devEdits, err := computeUseRepoEdits(so, devDeclarations, directDeps.DirectDevDeps)
+	if err != nil {
+		return nil, fmt.Errorf("dev dependencies: %w", err)
+	}
+	return append(nonDevEdits, devEdits...), nil
+}
+
+// applySourceEdits returns a copy of MODULE.bazel with all of the
+// provided changes applied.
+func applySourceEdits(source []byte, edits []sourceEdit) []byte {
+	// Apply all changes, starting at the end of the file so that
+	// offsets of earlier changes remain valid.
+	edits = slices.Clone(edits)
+	slices.SortFunc(edits, func(a, b sourceEdit) int {
+		return b.start - a.start
+	})
+	newContents := slices.Clone(source)
+	for _, edit := range edits {
+		newContents = slices.Replace(newContents, edit.start, edit.end, []byte(edit.replacement)...)
+	}
+	return newContents
+}
+
+// doTidy updates the use_repo() calls in the root module's MODULE.bazel
+// file, so that they match the direct dependencies that are reported
+// by the module extensions through module_ctx.extension_metadata().
+func doTidy(logger logging.Logger, args *arguments.ModCommand, startupFlags *arguments.StartupFlags, workspacePath path.Parser) {
+	workspaceDirectory, err := filesystem.NewLocalDirectory(workspacePath)
+	if err != nil {
+		logger.Fatal("Failed to open workspace directory: ", err)
+	}
+	moduleDotBazelFile, err := workspaceDirectory.OpenRead(moduleDotBazelFilename)
+	workspaceDirectory.Close()
+	if err != nil {
+		logger.Fatal("Failed to open MODULE.bazel: ", err)
+	}
+	moduleDotBazelContents, err := io.ReadAll(io.NewSectionReader(moduleDotBazelFile, 0, math.MaxInt64))
+	moduleDotBazelFile.Close()
+	if err != nil {
+		logger.Fatal("Failed to read MODULE.bazel: ", err)
+	}
+	declarations, err := parseModuleExtensionProxyDeclarations(moduleDotBazelContents)
+	if err != nil {
+		logger.Fatal("Failed to parse MODULE.bazel: ", err)
+	}
+
+	// Request the direct dependencies of all module extensions used
+	// by the root module.
+	declarationsByExtension := map[string][]*moduleExtensionProxyDeclaration{}
+	var extensionNames []string
+	for _, declaration := range declarations {
+		if _, ok := declarationsByExtension[declaration.extension]; !ok {
+			extensionNames = append(extensionNames, declaration.extension)
+		}
+		declarationsByExtension[declaration.extension] = append(declarationsByExtension[declaration.extension], declaration)
+	}
+	if len(extensionNames) == 0 {
+		logger.Info("MODULE.bazel does not use any module extensions")
+		return
+	}
+	result := queryModules(logger, args, startupFlags, &model_build_pb.ModuleQuery{
+		Extensions: extensionNames,
+	}, workspacePath)
+	if len(result.Extensions) != len(extensionNames) {
+		logger.Fatalf("Requested %d module extensions, while the builder returned %d", len(extensionNames), len(result.Extensions))
+	}
+
+	so := newSourceOffsets(moduleDotBazelContents)
+	var edits []sourceEdit
+	for i, extension := range result.Extensions {
+		directDeps := extension.RootModuleDirectDeps
+		if directDeps == nil {
+			// Module extension does not report its
+			// direct dependencies.
+			continue
+		}
+		extensionEdits, err := computeModuleExtensionEdits(so, declarationsByExtension[extensionNames[i]], directDeps)
+		if err != nil {
+			logger.Fatalf("Cannot tidy imports of module extension %s: %s", extension.Name, err)
+		}
+		edits = append(edits, extensionEdits...)
+	}
+	if len(edits) == 0 {
+		logger.Info("MODULE.bazel is already tidy")
+		return
+	}
+
+	if err := build.WriteWorkspaceFile(workspacePath, moduleDotBazelFilename, applySourceEdits(moduleDotBazelContents, edits)); err != nil {
+		logger.Fatal("Failed to write MODULE.bazel: ", err)
+	}
+	logger.Infof("Updated %d use_repo() calls in MODULE.bazel", len(edits))
+}
@@ -0,0 +1,176 @@
+package mod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	"github.com/stretchr/testify/require"
+
+	"go.starlark.net/syntax"
+)
+
+func TestSourceOffsets(t *testing.T) {
+	source := []byte("a = \"héllo\"\nb = \"wörld\"; use_repo(b)\nuse_repo(c)  \nuse_repo(d)")
+	file, err := (&syntax.FileOptions{}).Parse("MODULE.bazel", source, 0)
+	require.NoError(t, err)
+	require.Len(t, file.Stmts, 5)
+	so := newSourceOffsets(source)
+
+	t.Run("Get", func(t *testing.T) {
+		// Column numbers are expressed in runes. They should be
+		// converted to byte offsets.
+		start, end := file.Stmts[2].Span()
+		require.Equal(t, "use_repo(b)", string(source[so.get(start):so.get(end)]))
+	})
+
+	t.Run("StatementRangeSharedLine", func(t *testing.T) {
+		// If other statements are placed on the same line, only
+		// the call itself should be removed.
+		start, end := so.getStatementRange(file.Stmts[2].(*syntax.ExprStmt).X.(*syntax.CallExpr))
+		require.Equal(t, "use_repo(b)", string(source[start:end]))
+	})
+
+	t.Run("StatementRangeOwnLine", func(t *testing.T) {
+		// If the call is placed on a line of its own, the full
+		// line should be removed, including trailing
+		// whitespace.
+		start, end := so.getStatementRange(file.Stmts[3].(*syntax.ExprStmt).X.(*syntax.CallExpr))
+		require.Equal(t, "use_repo(c)  \n", string(source[start:end]))
+	})
+
+	t.Run("StatementRangeEndOfFile", func(t *testing.T) {
+		start, end := so.getStatementRange(file.Stmts[4].(*syntax.ExprStmt).X.(*syntax.CallExpr))
+		require.Equal(t, "use_repo(d)", string(source[start:end]))
+	})
+}
+
+func TestTidy(t *testing.T) {
+	// Each test case has an input and expected output MODULE.bazel
+	// file stored in the testdata directory. Module extensions for
+	// which no direct dependencies are provided are assumed not to
+	// report them, meaning their imports should be left alone.
+	for _, tc := range []struct {
+		name       string
+		directDeps map[string]*model_build_pb.ModuleQueryResult_Extension_RootModuleDirectDeps
+	}{
+		{
+			name: "add_and_remove",
+			directDeps: map[string]*model_build_pb.ModuleQueryResult_Extension_RootModuleDirectDeps{
+				"@rules_foo//:extensions.bzl%foo": {DirectDeps: []string{"foo_kept", "foo_new"}},
+				"@rules_foo//:extensions.bzl%bar": {DirectDeps: []string{"bar_new"}},
+				"@rules_foo//:extensions.bzl%baz": {},
+			},
+		},
+		{
+			// Custom apparent names of repos should be
+			// retained, while keyword arguments of repos
+			// that are no longer direct dependencies should
+			// be removed.
+			name: "keyword",
+			directDeps: map[string]*model_build_pb.ModuleQueryResult_Extension_RootModuleDirectDeps{
+				"@rules_foo//:extensions.bzl%foo": {DirectDeps: []string{"foo_a", "foo_b", "foo_c"}},
+			},
+		},
+		{
+			// Dev dependencies should be imported through
+			// proxies declared with dev_dependency = True.
+			// Proxies of isolated module extensions should
+			// be left alone.
+			name: "dev_dependency",
+			directDeps: map[string]*model_build_pb.ModuleQueryResult_Extension_RootModuleDirectDeps{
+				"@rules_foo//:extensions.bzl%foo": {
+					DirectDeps:    []string{"foo_regular"},
+					DirectDevDeps: []string{"foo_dev"},
+				},
+				"@rules_foo//:extensions.bzl%bar": {},
+			},
+		},
+		{
+			// Calls exceeding the maximum line length should
+			// be split across multiple lines.
+			name: "long_line",
+			directDeps: map[string]*model_build_pb.ModuleQueryResult_Extension_RootModuleDirectDeps{
+				"@rules_foo//:extensions.bzl%foo": {DirectDeps: []string{
+					"foo_first_repository",
+					"foo_second_repository",
+					"foo_third_repository",
+				}},
+			},
+		},
+		{
+			name: "no_op",
+			directDeps: map[string]*model_build_pb.ModuleQueryResult_Extension_RootModuleDirectDeps{
+				"@rules_foo//:extensions.bzl%foo": {
+					DirectDeps:    []string{"foo_a", "foo_b"},
+					DirectDevDeps: []string{"foo_dev"},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			input, err := os.ReadFile(filepath.Join("testdata", tc.name+"-input"))
+			require.NoError(t, err)
+			declarations, err := parseModuleExtensionProxyDeclarations(input)
+			require.NoError(t, err)
+
+			declarationsByExtension := map[string][]*moduleExtensionProxyDeclaration{}
+			for _, declaration := range declarations {
+				declarationsByExtension[declaration.extension] = append(declarationsByExtension[declaration.extension], declaration)
+			}
+			so := newSourceOffsets(input)
+			var edits []sourceEdit
+			for extension, directDeps := range tc.directDeps {
+				extensionEdits, err := computeModuleExtensionEdits(so, declarationsByExtension[extension], directDeps)
+				require.NoError(t, err)
+				edits = append(edits, extensionEdits...)
+			}
+
+			expectedOutput, err := os.ReadFile(filepath.Join("testdata", tc.name+"-output"))
+			require.NoError(t, err)
+			require.Equal(t, string(expectedOutput), string(applySourceEdits(input, edits)))
+			if string(input) == string(expectedOutput) {
+				require.Empty(t, edits)
+			}
+		})
+	}
+}
+
+func TestParseModuleExtensionProxyDeclarations(t *testing.T) {
+	t.Run("RootModuleExtension", func(t *testing.T) {
+		// Module extensions declared by the root module should
+		// be identified by labels relative to the root module,
+		// as the client does not know its canonical repo.
+		declarations, err := parseModuleExtensionProxyDeclarations([]byte("foo = use_extension(\"//:extensions.bzl\", \"foo\")\nuse_repo(foo, \"foo_a\")\n"))
+		require.NoError(t, err)
+		require.Len(t, declarations, 1)
+		require.Equal(t, "foo", declarations[0].variable)
+		require.Equal(t, "//:extensions.bzl%foo", declarations[0].extension)
+		require.Len(t, declarations[0].useRepoCalls, 1)
+	})
+
+	t.Run("NestedUseRepo", func(t *testing.T) {
+		// use_repo() calls can only be rewritten if they are
+		// placed at the top level.
+		_, err := parseModuleExtensionProxyDeclarations([]byte("foo = use_extension(\"@rules_foo//:extensions.bzl\", \"foo\")\ndef f():\n    use_repo(foo, \"foo_a\")\nf()\n"))
+		require.ErrorContains(t, err, "MODULE.bazel:3:13: use_repo() must be called at the top level")
+	})
+}
+
+func TestComputeUseRepoEditsErrors(t *testing.T) {
+	t.Run("NoDeclaration", func(t *testing.T) {
+		// Repos can only be imported if a proxy of the module
+		// extension is declared.
+		_, err := computeUseRepoEdits(newSourceOffsets(nil), nil, []string{"bar", "foo"})
+		require.EqualError(t, err, "repos bar, foo cannot be imported, as no corresponding use_extension() call exists")
+	})
+
+	t.Run("NonLiteralArgument", func(t *testing.T) {
+		source := []byte("foo = use_extension(\"@rules_foo//:extensions.bzl\", \"foo\")\nname = \"bar\"\nuse_repo(foo, name)\n")
+		declarations, err := parseModuleExtensionProxyDeclarations(source)
+		require.NoError(t, err)
+		_, err = computeUseRepoEdits(newSourceOffsets(source), declarations, nil)
+		require.EqualError(t, err, "MODULE.bazel:3:15: arguments of use_repo() must be string literals")
+	})
+}
@@ -1,7 +1,16 @@
 package commands
 
 import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
 	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
 	"github.com/buildbarn/bonanza/pkg/bazelclient/logging"
 )
 
@@ -10,3 +19,156 @@ func ValidateInsideWorkspace(logger logging.Logger, commandName string, workspac
 		logger.Fatalf("The %#v command is only supported from within a workspace (below a directory having a MODULE.bazel file)", commandName)
 	}
 }
+
+// GetWorkspaceDirectory returns the absolute path of the workspace
+// directory in the local pathname format.
+func GetWorkspaceDirectory(workspacePath path.Parser) (string, error) {
+	workspacePathBuilder, scopeWalker := path.EmptyBuilder.Join(path.NewAbsoluteScopeWalker(path.VoidComponentWalker))
+	if err := path.Resolve(workspacePath, scopeWalker); err != nil {
+		return "", err
+	}
+	return path.LocalFormat.GetString(workspacePathBuilder)
+}
+
+// GetOutputUserRoot returns the path of the directory beneath which
+// the output bases of all workspaces are placed. Unless overridden
+// through --output_user_root, it is placed in the user's cache
+// directory.
+func GetOutputUserRoot(startupFlags *arguments.StartupFlags) (string, error) {
+	if startupFlags.OutputUserRoot != "" {
+		return filepath.Abs(startupFlags.OutputUserRoot)
+	}
+	userCacheDirectory, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userCacheDirectory, "bonanza_bazel"), nil
+}
+
+// GetOutputBase returns the path of the directory in which
+// bonanza_bazel stores state that is specific to a workspace, such as
+// caches. Unless overridden through --output_base, the directory is
+// placed in the output user root, and is named after the MD5 hash of
+// the workspace path. This is similar to Bazel.
+func GetOutputBase(startupFlags *arguments.StartupFlags, workspacePath path.Parser) (string, error) {
+	if startupFlags.OutputBase != "" {
+		return filepath.Abs(startupFlags.OutputBase)
+	}
+	workspaceDirectory, err := GetWorkspaceDirectory(workspacePath)
+	if err != nil {
+		return "", err
+	}
+	outputUserRoot, err := GetOutputUserRoot(startupFlags)
+	if err != nil {
+		return "", err
+	}
+	workspacePathHash := md5.Sum([]byte(workspaceDirectory))
+	return filepath.Join(outputUserRoot, hex.EncodeToString(workspacePathHash[:])), nil
+}
+
+// GetExecutionRoot returns the path of the execution root, which is
+// placed inside the output base. As builds are performed remotely, it
+// is only used as a location for the convenience symlinks to point to.
+func GetExecutionRoot(outputBase string) string {
+	return filepath.Join(outputBase, "execroot", "_main")
+}
+
+// GetOutputPath returns the path of the bazel-out directory inside the
+// execution root.
+func GetOutputPath(outputBase string) string {
+	return filepath.Join(GetExecutionRoot(outputBase), "bazel-out")
+}
+
+// outputDirectoryName is the name of the directory inside bazel-out to
+// which the convenience symlinks point. The configuration of top-level
+// targets is only known to the builder, meaning that a fixed name is
+// used instead.
+const outputDirectoryName = "fastbuild"
+
+// ConvenienceSymlinkPrefix is the prefix of the names of the symbolic
+// links that are created in the workspace directory, pointing into the
+// output base.
+const ConvenienceSymlinkPrefix = "bazel-"
+
+// GetConvenienceSymlinkTargets returns the names of the convenience
+// symlinks that are created in the workspace directory, and the paths
+// inside the output base to which they point.
+func GetConvenienceSymlinkTargets(outputBase, workspaceDirectory string) map[string]string {
+	outputPath := GetOutputPath(outputBase)
+	return map[string]string{
+		ConvenienceSymlinkPrefix + "bin":                             filepath.Join(outputPath, outputDirectoryName, "bin"),
+		ConvenienceSymlinkPrefix + "out":                             outputPath,
+		ConvenienceSymlinkPrefix + "testlogs":                        filepath.Join(outputPath, outputDirectoryName, "testlogs"),
+		ConvenienceSymlinkPrefix + filepath.Base(workspaceDirectory): GetExecutionRoot(outputBase),
+	}
+}
+
+// CreateConvenienceSymlinks creates the bazel-* symbolic links in the
+// workspace directory, pointing into the output base. Existing
+// symbolic links are replaced, so that they point to the current
+// output base. Files and directories having the same names are left
+// alone, as these were not created by bonanza_bazel.
+func CreateConvenienceSymlinks(outputBase, workspaceDirectory string) error {
+	for name, target := range GetConvenienceSymlinkTargets(outputBase, workspaceDirectory) {
+		if err := os.MkdirAll(target, 0o777); err != nil {
+			return util.StatusWrapf(err, "Failed to create directory %#v", target)
+		}
+		symlinkPath := filepath.Join(workspaceDirectory, name)
+		if fileInfo, err := os.Lstat(symlinkPath); err == nil {
+			if fileInfo.Mode()&fs.ModeSymlink == 0 {
+				continue
+			}
+			existingTarget, err := os.Readlink(symlinkPath)
+			if err != nil {
+				return util.StatusWrapf(err, "Failed to read symbolic link %#v", symlinkPath)
+			}
+			if existingTarget == target {
+				continue
+			}
+			if err := os.Remove(symlinkPath); err != nil {
+				return util.StatusWrapf(err, "Failed to remove symbolic link %#v", symlinkPath)
+			}
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return util.StatusWrapf(err, "Failed to obtain properties of %#v", symlinkPath)
+		}
+		if err := os.Symlink(target, symlinkPath); err != nil {
+			return util.StatusWrapf(err, "Failed to create symbolic link %#v", symlinkPath)
+		}
+	}
+	return nil
+}
+
+// RemoveConvenienceSymlinks removes the bazel-* symbolic links from the
+// workspace directory. Only symbolic links pointing into the output
+// base are removed. Files, directories and symbolic links pointing
+// elsewhere that have the same names are left alone, as these were not
+// created by bonanza_bazel for this output base.
+func RemoveConvenienceSymlinks(outputBase, workspaceDirectory string) error {
+	for name := range GetConvenienceSymlinkTargets(outputBase, workspaceDirectory) {
+		symlinkPath := filepath.Join(workspaceDirectory, name)
+		fileInfo, err := os.Lstat(symlinkPath)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return util.StatusWrapf(err, "Failed to obtain properties of %#v", symlinkPath)
+		}
+		if fileInfo.Mode()&fs.ModeSymlink == 0 {
+			continue
+		}
+		target, err := os.Readlink(symlinkPath)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to read symbolic link %#v", symlinkPath)
+		}
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(workspaceDirectory, target)
+		}
+		if relativeTarget, err := filepath.Rel(outputBase, target); err != nil || !filepath.IsLocal(relativeTarget) {
+			continue
+		}
+		if err := os.Remove(symlinkPath); err != nil {
+			return util.StatusWrapf(err, "Failed to remove symbolic link %#v", symlinkPath)
+		}
+	}
+	return nil
+}
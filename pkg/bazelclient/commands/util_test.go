@@ -0,0 +1,168 @@
+package commands_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
+	"github.com/buildbarn/bonanza/pkg/bazelclient/commands"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOutputUserRoot(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		userCacheDirectory, err := os.UserCacheDir()
+		require.NoError(t, err)
+		outputUserRoot, err := commands.GetOutputUserRoot(&arguments.StartupFlags{})
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(userCacheDirectory, "bonanza_bazel"), outputUserRoot)
+	})
+
+	t.Run("Absolute", func(t *testing.T) {
+		outputUserRoot, err := commands.GetOutputUserRoot(&arguments.StartupFlags{
+			OutputUserRoot: "/tmp/output_user_root",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "/tmp/output_user_root", outputUserRoot)
+	})
+
+	t.Run("Relative", func(t *testing.T) {
+		// Relative paths should be resolved against the
+		// working directory.
+		workingDirectory, err := os.Getwd()
+		require.NoError(t, err)
+		outputUserRoot, err := commands.GetOutputUserRoot(&arguments.StartupFlags{
+			OutputUserRoot: "output_user_root",
+		})
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(workingDirectory, "output_user_root"), outputUserRoot)
+	})
+}
+
+func TestGetOutputBase(t *testing.T) {
+	workspacePath := path.LocalFormat.NewParser("/home/user/project")
+
+	t.Run("Default", func(t *testing.T) {
+		// The output base should be named after the MD5 hash of
+		// the workspace path, similar to Bazel.
+		outputBase, err := commands.GetOutputBase(&arguments.StartupFlags{
+			OutputUserRoot: "/tmp/output_user_root",
+		}, workspacePath)
+		require.NoError(t, err)
+		require.Equal(t, "/tmp/output_user_root/90722f2638004be06d790eaac9ac1f8a", outputBase)
+	})
+
+	t.Run("Explicit", func(t *testing.T) {
+		// --output_base should take precedence over
+		// --output_user_root.
+		outputBase, err := commands.GetOutputBase(&arguments.StartupFlags{
+			OutputBase:     "/tmp/output_base",
+			OutputUserRoot: "/tmp/output_user_root",
+		}, workspacePath)
+		require.NoError(t, err)
+		require.Equal(t, "/tmp/output_base", outputBase)
+	})
+}
+
+func TestConvenienceSymlinks(t *testing.T) {
+	// requireSymlink asserts that a symbolic link exists in the
+	// workspace, pointing to a directory inside the output base.
+	requireSymlink := func(t *testing.T, workspaceDirectory, name, expectedTarget string) {
+		target, err := os.Readlink(filepath.Join(workspaceDirectory, name))
+		require.NoError(t, err)
+		require.Equal(t, expectedTarget, target)
+		fileInfo, err := os.Stat(target)
+		require.NoError(t, err)
+		require.True(t, fileInfo.IsDir())
+	}
+	requireNotExist := func(t *testing.T, workspaceDirectory, name string) {
+		_, err := os.Lstat(filepath.Join(workspaceDirectory, name))
+		require.True(t, os.IsNotExist(err))
+	}
+
+	t.Run("CreateAndRemove", func(t *testing.T) {
+		workspaceDirectory := filepath.Join(t.TempDir(), "project")
+		require.NoError(t, os.Mkdir(workspaceDirectory, 0o777))
+		outputBase := t.TempDir()
+		executionRoot := filepath.Join(outputBase, "execroot", "_main")
+
+		// Creating the symbolic links repeatedly should be a
+		// no-op.
+		for i := 0; i < 2; i++ {
+			require.NoError(t, commands.CreateConvenienceSymlinks(outputBase, workspaceDirectory))
+			requireSymlink(t, workspaceDirectory, "bazel-bin", filepath.Join(executionRoot, "bazel-out", "fastbuild", "bin"))
+			requireSymlink(t, workspaceDirectory, "bazel-out", filepath.Join(executionRoot, "bazel-out"))
+			requireSymlink(t, workspaceDirectory, "bazel-testlogs", filepath.Join(executionRoot, "bazel-out", "fastbuild", "testlogs"))
+			requireSymlink(t, workspaceDirectory, "bazel-project", executionRoot)
+		}
+
+		require.NoError(t, commands.RemoveConvenienceSymlinks(outputBase, workspaceDirectory))
+		for _, name := range []string{"bazel-bin", "bazel-out", "bazel-testlogs", "bazel-project"} {
+			requireNotExist(t, workspaceDirectory, name)
+		}
+
+		// Removing symbolic links that don't exist should be
+		// a no-op.
+		require.NoError(t, commands.RemoveConvenienceSymlinks(outputBase, workspaceDirectory))
+	})
+
+	t.Run("ReplaceStale", func(t *testing.T) {
+		// Symbolic links pointing to an old output base should
+		// be replaced.
+		workspaceDirectory := filepath.Join(t.TempDir(), "project")
+		require.NoError(t, os.Mkdir(workspaceDirectory, 0o777))
+		require.NoError(t, os.Symlink("/nonexistent", filepath.Join(workspaceDirectory, "bazel-out")))
+		outputBase := t.TempDir()
+
+		require.NoError(t, commands.CreateConvenienceSymlinks(outputBase, workspaceDirectory))
+		requireSymlink(t, workspaceDirectory, "bazel-out", filepath.Join(outputBase, "execroot", "_main", "bazel-out"))
+	})
+
+	t.Run("SymlinksOutsideOutputBase", func(t *testing.T) {
+		// Symbolic links that don't point into the output base
+		// were either created by the user, or belong to another
+		// output base. They should be left alone.
+		workspaceDirectory := filepath.Join(t.TempDir(), "project")
+		require.NoError(t, os.Mkdir(workspaceDirectory, 0o777))
+		outputBase := t.TempDir()
+		require.NoError(t, os.Symlink("/nonexistent", filepath.Join(workspaceDirectory, "bazel-bin")))
+		require.NoError(t, os.Symlink(outputBase+"2", filepath.Join(workspaceDirectory, "bazel-out")))
+		require.NoError(t, os.Symlink("..", filepath.Join(workspaceDirectory, "bazel-testlogs")))
+
+		require.NoError(t, commands.RemoveConvenienceSymlinks(outputBase, workspaceDirectory))
+		for name, expectedTarget := range map[string]string{
+			"bazel-bin":      "/nonexistent",
+			"bazel-out":      outputBase + "2",
+			"bazel-testlogs": "..",
+		} {
+			target, err := os.Readlink(filepath.Join(workspaceDirectory, name))
+			require.NoError(t, err)
+			require.Equal(t, expectedTarget, target)
+		}
+	})
+
+	t.Run("FilesAndDirectories", func(t *testing.T) {
+		// Files and directories that happen to have the same
+		// name as one of the symbolic links were not created
+		// by bonanza_bazel. They should be left alone.
+		workspaceDirectory := filepath.Join(t.TempDir(), "project")
+		require.NoError(t, os.Mkdir(workspaceDirectory, 0o777))
+		require.NoError(t, os.WriteFile(filepath.Join(workspaceDirectory, "bazel-bin"), []byte("Hello"), 0o666))
+		require.NoError(t, os.Mkdir(filepath.Join(workspaceDirectory, "bazel-out"), 0o777))
+		outputBase := t.TempDir()
+
+		require.NoError(t, commands.CreateConvenienceSymlinks(outputBase, workspaceDirectory))
+		requireSymlink(t, workspaceDirectory, "bazel-testlogs", filepath.Join(outputBase, "execroot", "_main", "bazel-out", "fastbuild", "testlogs"))
+		require.NoError(t, commands.RemoveConvenienceSymlinks(outputBase, workspaceDirectory))
+		requireNotExist(t, workspaceDirectory, "bazel-testlogs")
+
+		contents, err := os.ReadFile(filepath.Join(workspaceDirectory, "bazel-bin"))
+		require.NoError(t, err)
+		require.Equal(t, "Hello", string(contents))
+		fileInfo, err := os.Lstat(filepath.Join(workspaceDirectory, "bazel-out"))
+		require.NoError(t, err)
+		require.True(t, fileInfo.IsDir())
+	})
+}
@@ -5,76 +5,224 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync"
+	"time"
 )
 
+// maximumDisplayedOperations is the maximum number of running
+// operations that are displayed in the status area.
+const maximumDisplayedOperations = 8
+
 type consoleLogger struct {
-	w               io.Writer
-	escapeSequences *EscapeSequences
+	w                 io.Writer
+	escapeSequences   *EscapeSequences
+	progressRateLimit time.Duration
+	getTerminalWidth  func() int
+
+	lock                sync.Mutex
+	progress            *Progress
+	lastProgressTime    time.Time
+	lastProgressPhase   Phase
+	lastProgressSummary string
+	statusAreaLines     int
 }
 
-func NewConsoleLogger(w io.Writer, escapeSequences *EscapeSequences) Logger {
+// NewConsoleLogger creates a Logger that writes messages to a terminal
+// or a file.
+//
+// If getTerminalWidth is set, the progress of the build is displayed
+// in a status area below the messages that is redrawn using cursor
+// controls. Otherwise, progress is written as plain lines of text.
+// Progress is written no more frequently than the provided rate limit,
+// unless the phase of the build changes.
+func NewConsoleLogger(w io.Writer, escapeSequences *EscapeSequences, progressRateLimit time.Duration, getTerminalWidth func() int) Logger {
 	return &consoleLogger{
-		w:               w,
-		escapeSequences: escapeSequences,
+		w:                 w,
+		escapeSequences:   escapeSequences,
+		progressRateLimit: progressRateLimit,
+		getTerminalWidth:  getTerminalWidth,
+		lastProgressPhase: -1,
 	}
 }
 
-func (l *consoleLogger) Error(v ...any) {
-	var b bytes.Buffer
+// clearStatusAreaLocked appends escape sequences to a buffer that
+// remove the status area from the terminal.
+func (l *consoleLogger) clearStatusAreaLocked(b *bytes.Buffer) {
+	if l.statusAreaLines > 0 {
+		for range l.statusAreaLines {
+			b.Write(l.escapeSequences.CursorUp)
+		}
+		b.Write(l.escapeSequences.EraseBelow)
+		l.statusAreaLines = 0
+	}
+}
+
+// writeStatusAreaLineLocked appends a single line of the status area
+// to a buffer, truncating it to the width of the terminal to prevent
+// it from wrapping.
+func (l *consoleLogger) writeStatusAreaLineLocked(b *bytes.Buffer, width int, line string) {
+	if runes := []rune(line); len(runes) > width {
+		line = string(runes[:width])
+	}
+	b.WriteString(line)
+	b.WriteByte('\n')
+	l.statusAreaLines++
+}
+
+// drawStatusAreaLocked appends the status area containing the current
+// progress of the build to a buffer.
+func (l *consoleLogger) drawStatusAreaLocked(b *bytes.Buffer) {
+	progress := l.progress
+	if progress == nil {
+		return
+	}
+	now := time.Now()
+	width := max(l.getTerminalWidth(), 1)
 
-	b.Write(l.escapeSequences.Bold)
-	b.Write(l.escapeSequences.Red)
-	b.WriteString("ERROR: ")
+	phase := progress.Phase.String() + ": "
+	if runes := []rune(phase); len(runes) > width {
+		phase = string(runes[:width])
+	}
+	b.Write(l.escapeSequences.Green)
+	b.WriteString(phase)
 	b.Write(l.escapeSequences.Reset)
-	fmt.Fprint(&b, v...)
-	b.Write([]byte{'\n'})
+	l.writeStatusAreaLineLocked(b, width-len([]rune(phase)), progress.getSummary(now))
+
+	operations := progress.RunningOperations
+	if len(operations) > maximumDisplayedOperations {
+		operations = operations[:maximumDisplayedOperations-1]
+	}
+	for _, operation := range operations {
+		// Truncate the description instead of the elapsed
+		// time, as the latter is more useful to display.
+		suffix := "; " + formatElapsedTime(now.Sub(operation.StartTime))
+		description := []rune(operation.Description)
+		if maximumLength := max(width-4-len(suffix), 0); len(description) > maximumLength {
+			description = description[:maximumLength]
+		}
+		l.writeStatusAreaLineLocked(b, width, "    "+string(description)+suffix)
+	}
+	if remaining := len(progress.RunningOperations) - len(operations); remaining > 0 {
+		l.writeStatusAreaLineLocked(b, width, fmt.Sprintf("    ... and %d more", remaining))
+	}
+}
+
+// write a message to the output. If a status area is displayed, it is
+// redrawn below the message.
+func (l *consoleLogger) write(message []byte) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.getTerminalWidth == nil || l.progress == nil {
+		l.w.Write(message)
+		return
+	}
 
+	var b bytes.Buffer
+	l.clearStatusAreaLocked(&b)
+	b.Write(message)
+	l.drawStatusAreaLocked(&b)
 	l.w.Write(b.Bytes())
 }
 
-func (l *consoleLogger) Errorf(format string, v ...any) {
+func (l *consoleLogger) log(prefixEscapeSequences [][]byte, prefix, message string) {
 	var b bytes.Buffer
 
-	b.Write(l.escapeSequences.Bold)
-	b.Write(l.escapeSequences.Red)
-	b.WriteString("ERROR: ")
+	for _, escapeSequence := range prefixEscapeSequences {
+		b.Write(escapeSequence)
+	}
+	b.WriteString(prefix)
 	b.Write(l.escapeSequences.Reset)
-	fmt.Fprintf(&b, format, v...)
+	b.WriteString(message)
 	b.Write([]byte{'\n'})
 
-	l.w.Write(b.Bytes())
+	l.write(b.Bytes())
+}
+
+func (l *consoleLogger) Debug(v ...any) {
+	l.log([][]byte{l.escapeSequences.Yellow}, "DEBUG: ", fmt.Sprint(v...))
+}
+
+func (l *consoleLogger) Error(v ...any) {
+	l.log([][]byte{l.escapeSequences.Bold, l.escapeSequences.Red}, "ERROR: ", fmt.Sprint(v...))
+}
+
+func (l *consoleLogger) Errorf(format string, v ...any) {
+	l.log([][]byte{l.escapeSequences.Bold, l.escapeSequences.Red}, "ERROR: ", fmt.Sprintf(format, v...))
 }
 
 func (l *consoleLogger) Fatal(v ...any) {
-	l.Error(v...)
-	os.Exit(1)
+	l.FatalWithExitCode(1, v...)
 }
 
 func (l *consoleLogger) Fatalf(format string, v ...any) {
+	l.SetProgress(nil)
 	l.Errorf(format, v...)
 	os.Exit(1)
 }
 
+func (l *consoleLogger) FatalWithExitCode(exitCode int, v ...any) {
+	l.SetProgress(nil)
+	l.Error(v...)
+	os.Exit(exitCode)
+}
+
 func (l *consoleLogger) Info(v ...any) {
-	var b bytes.Buffer
+	l.log([][]byte{l.escapeSequences.Green}, "INFO: ", fmt.Sprint(v...))
+}
 
-	b.Write(l.escapeSequences.Green)
-	b.WriteString("INFO: ")
-	b.Write(l.escapeSequences.Reset)
-	fmt.Fprint(&b, v...)
-	b.Write([]byte{'\n'})
+func (l *consoleLogger) Infof(format string, v ...any) {
+	l.log([][]byte{l.escapeSequences.Green}, "INFO: ", fmt.Sprintf(format, v...))
+}
 
-	l.w.Write(b.Bytes())
+func (l *consoleLogger) Warning(v ...any) {
+	l.log([][]byte{l.escapeSequences.Magenta}, "WARNING: ", fmt.Sprint(v...))
 }
 
-func (l *consoleLogger) Infof(format string, v ...any) {
-	var b bytes.Buffer
+func (l *consoleLogger) SetProgress(progress *Progress) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	if progress == nil {
+		// Remove the status area, so that any subsequent output
+		// is not interleaved with it.
+		l.progress = nil
+		l.lastProgressPhase = -1
+		if l.getTerminalWidth != nil {
+			var b bytes.Buffer
+			l.clearStatusAreaLocked(&b)
+			l.w.Write(b.Bytes())
+		}
+		return
+	}
 
-	b.Write(l.escapeSequences.Green)
-	b.WriteString("INFO: ")
-	b.Write(l.escapeSequences.Reset)
-	fmt.Fprintf(&b, format, v...)
-	b.Write([]byte{'\n'})
+	l.progress = progress
+	phaseChanged := progress.Phase != l.lastProgressPhase
+	if !phaseChanged && now.Sub(l.lastProgressTime) < l.progressRateLimit {
+		return
+	}
 
+	var b bytes.Buffer
+	if l.getTerminalWidth == nil {
+		// Plain output. Only print progress if it has changed,
+		// so that log files are not filled with repeated lines.
+		summary := progress.getSummary(now)
+		if !phaseChanged && summary == l.lastProgressSummary {
+			return
+		}
+		l.lastProgressSummary = summary
+		b.Write(l.escapeSequences.Green)
+		b.WriteString(progress.Phase.String())
+		b.WriteString(": ")
+		b.Write(l.escapeSequences.Reset)
+		b.WriteString(summary)
+		b.WriteByte('\n')
+	} else {
+		l.clearStatusAreaLocked(&b)
+		l.drawStatusAreaLocked(&b)
+	}
+	l.lastProgressTime = now
+	l.lastProgressPhase = progress.Phase
 	l.w.Write(b.Bytes())
 }
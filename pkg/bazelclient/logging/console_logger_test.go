@@ -0,0 +1,192 @@
+package logging_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bonanza/pkg/bazelclient/logging"
+	"github.com/stretchr/testify/require"
+)
+
+// testEscapeSequences uses human readable placeholders for escape
+// sequences, so that the output of the logger is easy to inspect.
+var testEscapeSequences = logging.EscapeSequences{
+	Reset:      []byte("<reset>"),
+	Bold:       []byte("<bold>"),
+	Red:        []byte("<red>"),
+	Green:      []byte("<green>"),
+	Yellow:     []byte("<yellow>"),
+	Magenta:    []byte("<magenta>"),
+	CursorUp:   []byte("<up>"),
+	EraseBelow: []byte("<erase>"),
+}
+
+// readOutput returns all output written since the previous call.
+func readOutput(b *bytes.Buffer) string {
+	s := b.String()
+	b.Reset()
+	return s
+}
+
+func TestConsoleLoggerStatusArea(t *testing.T) {
+	getTerminalWidth := func() int { return 80 }
+
+	t.Run("Messages", func(t *testing.T) {
+		// Without any progress, messages should be written as
+		// is.
+		var out bytes.Buffer
+		logger := logging.NewConsoleLogger(&out, &testEscapeSequences, 0, getTerminalWidth)
+		logger.Info("Hello")
+		logger.Warning("Something is off")
+		logger.Debug("print() output")
+		require.Equal(
+			t,
+			"<green>INFO: <reset>Hello\n"+
+				"<magenta>WARNING: <reset>Something is off\n"+
+				"<yellow>DEBUG: <reset>print() output\n",
+			readOutput(&out),
+		)
+	})
+
+	t.Run("RedrawAndClear", func(t *testing.T) {
+		var out bytes.Buffer
+		logger := logging.NewConsoleLogger(&out, &testEscapeSequences, 0, getTerminalWidth)
+
+		logger.SetProgress(&logging.Progress{
+			Phase:             logging.PhaseAnalyzing,
+			ConfiguredTargets: 3,
+			CompletedActions:  2,
+			RunningOperations: []logging.RunningOperation{{
+				Description: "Fetching repository @@foo+",
+				StartTime:   time.Now().Add(-90 * time.Second),
+			}},
+		})
+		statusArea := "<green>Analyzing: <reset>3 targets configured, 2 actions completed\n" +
+			"    Fetching repository @@foo+; 1m 30s\n"
+		require.Equal(t, statusArea, readOutput(&out))
+
+		// Messages should be written above the status area,
+		// meaning the status area needs to be redrawn.
+		logger.Info("Hello")
+		require.Equal(t, "<up><up><erase><green>INFO: <reset>Hello\n"+statusArea, readOutput(&out))
+
+		// Updating the progress should replace the existing
+		// status area.
+		logger.SetProgress(&logging.Progress{
+			Phase:             logging.PhaseExecuting,
+			ConfiguredTargets: 3,
+			CompletedActions:  4,
+			RunningActions:    1,
+		})
+		require.Equal(t, "<up><up><erase><green>Executing: <reset>3 targets configured, 4 actions completed, 1 actions running\n", readOutput(&out))
+
+		// Clearing the progress should remove the status area.
+		// Subsequent messages should no longer cause it to be
+		// redrawn.
+		logger.SetProgress(nil)
+		require.Equal(t, "<up><erase>", readOutput(&out))
+		logger.Info("Goodbye")
+		require.Equal(t, "<green>INFO: <reset>Goodbye\n", readOutput(&out))
+
+		// Clearing the progress repeatedly should be a no-op.
+		logger.SetProgress(nil)
+		require.Empty(t, readOutput(&out))
+	})
+
+	t.Run("Truncation", func(t *testing.T) {
+		// Lines should be truncated to the width of the
+		// terminal. For operations, the description should be
+		// truncated, so that the elapsed time remains visible.
+		var out bytes.Buffer
+		logger := logging.NewConsoleLogger(&out, &testEscapeSequences, 0, func() int { return 20 })
+		logger.SetProgress(&logging.Progress{
+			Phase: logging.PhaseUploading,
+			RunningOperations: []logging.RunningOperation{{
+				Description: "Downloading https://example.com/archive.tar.gz",
+				StartTime:   time.Now().Add(-5 * time.Second),
+			}},
+			UploadStartTime: time.Now(),
+		})
+		require.Equal(
+			t,
+			"<green>Uploading: <reset>module so\n"+
+				"    Downloading ; 5s\n",
+			readOutput(&out),
+		)
+	})
+
+	t.Run("MaximumDisplayedOperations", func(t *testing.T) {
+		// If many operations are running, only a limited number
+		// of them should be displayed.
+		var out bytes.Buffer
+		logger := logging.NewConsoleLogger(&out, &testEscapeSequences, 0, getTerminalWidth)
+		startTime := time.Now().Add(-time.Second)
+		operations := make([]logging.RunningOperation, 10)
+		for i := range operations {
+			operations[i] = logging.RunningOperation{
+				Description: "Executing action",
+				StartTime:   startTime,
+			}
+		}
+		logger.SetProgress(&logging.Progress{
+			Phase:             logging.PhaseExecuting,
+			RunningOperations: operations,
+		})
+		require.Equal(
+			t,
+			"<green>Executing: <reset>0 targets configured, 0 actions completed\n"+
+				"    Executing action; 1s\n"+
+				"    Executing action; 1s\n"+
+				"    Executing action; 1s\n"+
+				"    Executing action; 1s\n"+
+				"    Executing action; 1s\n"+
+				"    Executing action; 1s\n"+
+				"    Executing action; 1s\n"+
+				"    ... and 3 more\n",
+			readOutput(&out),
+		)
+	})
+
+	t.Run("RateLimit", func(t *testing.T) {
+		// Progress should only be redrawn if the rate limit
+		// permits it, or if the phase changes.
+		var out bytes.Buffer
+		logger := logging.NewConsoleLogger(&out, &testEscapeSequences, time.Hour, getTerminalWidth)
+		logger.SetProgress(&logging.Progress{Phase: logging.PhaseScanning})
+		require.Equal(t, "<green>Scanning: <reset>module sources\n", readOutput(&out))
+		logger.SetProgress(&logging.Progress{Phase: logging.PhaseScanning})
+		require.Empty(t, readOutput(&out))
+		logger.SetProgress(&logging.Progress{Phase: logging.PhaseAnalyzing})
+		require.Equal(t, "<up><erase><green>Analyzing: <reset>0 targets configured, 0 actions completed\n", readOutput(&out))
+
+		// Messages should cause the latest progress to be
+		// displayed, even if it was not drawn due to rate
+		// limiting.
+		logger.SetProgress(&logging.Progress{Phase: logging.PhaseAnalyzing, ConfiguredTargets: 5})
+		require.Empty(t, readOutput(&out))
+		logger.Info("Hello")
+		require.Equal(t, "<up><erase><green>INFO: <reset>Hello\n<green>Analyzing: <reset>5 targets configured, 0 actions completed\n", readOutput(&out))
+	})
+}
+
+func TestConsoleLoggerPlainProgress(t *testing.T) {
+	// If the output is not a terminal, progress should be written
+	// as plain lines, and only if it changed.
+	var out bytes.Buffer
+	logger := logging.NewConsoleLogger(&out, &logging.NoEscapeSequences, 0, nil)
+
+	logger.SetProgress(&logging.Progress{Phase: logging.PhaseAnalyzing, ConfiguredTargets: 1})
+	require.Equal(t, "Analyzing: 1 targets configured, 0 actions completed\n", readOutput(&out))
+	logger.SetProgress(&logging.Progress{Phase: logging.PhaseAnalyzing, ConfiguredTargets: 1})
+	require.Empty(t, readOutput(&out))
+	logger.SetProgress(&logging.Progress{Phase: logging.PhaseAnalyzing, ConfiguredTargets: 2})
+	require.Equal(t, "Analyzing: 2 targets configured, 0 actions completed\n", readOutput(&out))
+
+	// Messages should not cause progress to be repeated, nor
+	// should clearing progress emit any output.
+	logger.Info("Hello")
+	require.Equal(t, "INFO: Hello\n", readOutput(&out))
+	logger.SetProgress(nil)
+	require.Empty(t, readOutput(&out))
+}
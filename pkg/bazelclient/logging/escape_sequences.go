@@ -5,8 +5,15 @@ type EscapeSequences struct {
 
 	Bold []byte
 
-	Red   []byte
-	Green []byte
+	Red     []byte
+	Green   []byte
+	Yellow  []byte
+	Magenta []byte
+
+	// Cursor controls that are used to redraw the status area that
+	// displays the progress of the build.
+	CursorUp   []byte
+	EraseBelow []byte
 }
 
 var (
@@ -16,7 +23,12 @@ var (
 
 		Bold: []byte("\x1b[1m"),
 
-		Red:   []byte("\x1b[31m"),
-		Green: []byte("\x1b[32m"),
+		Red:     []byte("\x1b[31m"),
+		Green:   []byte("\x1b[32m"),
+		Yellow:  []byte("\x1b[33m"),
+		Magenta: []byte("\x1b[35m"),
+
+		CursorUp:   []byte("\x1b[A"),
+		EraseBelow: []byte("\x1b[J"),
 	}
 )
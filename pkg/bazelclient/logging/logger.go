@@ -2,6 +2,7 @@ package logging
 
 import (
 	"os"
+	"time"
 
 	"github.com/buildbarn/bonanza/pkg/bazelclient/arguments"
 
@@ -9,26 +10,58 @@ import (
 )
 
 type Logger interface {
+	Debug(v ...any)
 	Fatal(v ...any)
 	Fatalf(format string, v ...any)
+
+	// FatalWithExitCode is identical to Fatal, except that the
+	// process terminates with the provided exit code instead of 1.
+	FatalWithExitCode(exitCode int, v ...any)
 	Info(v ...any)
 	Infof(format string, v ...any)
+	Warning(v ...any)
+
+	// SetProgress updates the progress of the build that is
+	// displayed. Passing nil causes progress to no longer be
+	// displayed.
+	SetProgress(progress *Progress)
 }
 
 func NewLoggerFromFlags(commonFlags *arguments.CommonFlags) Logger {
 	w := os.Stderr
-	var escapeSequences *EscapeSequences
+	isTerminal := term.IsTerminal(int(w.Fd()))
+	var escapeSequences EscapeSequences
 	switch commonFlags.Color {
 	case arguments.Color_Yes:
-		escapeSequences = &VT100EscapeSequences
+		escapeSequences = VT100EscapeSequences
 	case arguments.Color_No:
-		escapeSequences = &NoEscapeSequences
+		escapeSequences = NoEscapeSequences
 	case arguments.Color_Auto:
-		if term.IsTerminal(int(w.Fd())) {
-			escapeSequences = &VT100EscapeSequences
+		if isTerminal {
+			escapeSequences = VT100EscapeSequences
 		} else {
-			escapeSequences = &NoEscapeSequences
+			escapeSequences = NoEscapeSequences
+		}
+	}
+
+	// Cursor controls are used to display the status area, even if
+	// colors are disabled.
+	var getTerminalWidth func() int
+	if commonFlags.Curses == arguments.Curses_Yes || (commonFlags.Curses == arguments.Curses_Auto && isTerminal) {
+		escapeSequences.CursorUp = VT100EscapeSequences.CursorUp
+		escapeSequences.EraseBelow = VT100EscapeSequences.EraseBelow
+		getTerminalWidth = func() int {
+			width, _, err := term.GetSize(int(w.Fd()))
+			if err != nil || width <= 0 {
+				return 80
+			}
+			return width
 		}
 	}
-	return NewConsoleLogger(w, escapeSequences)
+	return NewConsoleLogger(
+		w,
+		&escapeSequences,
+		time.Duration(commonFlags.ShowProgressRateLimit*float64(time.Second)),
+		getTerminalWidth,
+	)
 }
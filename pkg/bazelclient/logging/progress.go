@@ -0,0 +1,109 @@
+package logging
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Phase of a build, as displayed in the status area.
+type Phase int
+
+const (
+	// PhaseScanning indicates that the sources of modules are being
+	// scanned, so that Merkle trees can be computed.
+	PhaseScanning Phase = iota
+	// PhaseUploading indicates that the sources of modules are being
+	// uploaded to storage.
+	PhaseUploading
+	// PhaseAnalyzing indicates that the builder is loading packages
+	// and configuring targets.
+	PhaseAnalyzing
+	// PhaseExecuting indicates that the builder is executing actions.
+	PhaseExecuting
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhaseScanning:
+		return "Scanning"
+	case PhaseUploading:
+		return "Uploading"
+	case PhaseAnalyzing:
+		return "Analyzing"
+	case PhaseExecuting:
+		return "Executing"
+	default:
+		return "Unknown"
+	}
+}
+
+// RunningOperation is a long running operation performed as part of
+// the build, such as the execution of an action or the fetching of a
+// repo.
+type RunningOperation struct {
+	Description string
+	StartTime   time.Time
+}
+
+// Progress of a build, which is displayed by the Logger.
+type Progress struct {
+	Phase Phase
+
+	ConfiguredTargets uint64
+	CompletedActions  uint64
+	RunningActions    uint64
+	RunningOperations []RunningOperation
+
+	UploadedSizeBytes uint64
+	UploadStartTime   time.Time
+}
+
+// getSummary returns a single line of text that summarizes the
+// progress of the build, excluding the name of the phase.
+func (p *Progress) getSummary(now time.Time) string {
+	switch p.Phase {
+	case PhaseScanning:
+		return "module sources"
+	case PhaseUploading:
+		summary := "module sources, " + formatSizeBytes(p.UploadedSizeBytes)
+		if elapsed := now.Sub(p.UploadStartTime); elapsed >= time.Second {
+			summary += fmt.Sprintf(" (%s/s)", formatSizeBytes(uint64(float64(p.UploadedSizeBytes)/elapsed.Seconds())))
+		}
+		return summary
+	default:
+		var b strings.Builder
+		fmt.Fprintf(&b, "%d targets configured, %d actions completed", p.ConfiguredTargets, p.CompletedActions)
+		if p.RunningActions > 0 {
+			fmt.Fprintf(&b, ", %d actions running", p.RunningActions)
+		}
+		return b.String()
+	}
+}
+
+// formatSizeBytes formats a size in bytes in a human readable way,
+// using binary prefixes.
+func formatSizeBytes(sizeBytes uint64) string {
+	const unit = 1024
+	if sizeBytes < unit {
+		return fmt.Sprintf("%d B", sizeBytes)
+	}
+	size := float64(sizeBytes) / unit
+	prefixes := "KMGTPE"
+	i := 0
+	for size >= unit && i < len(prefixes)-1 {
+		size /= unit
+		i++
+	}
+	return fmt.Sprintf("%.1f %ciB", size, prefixes[i])
+}
+
+// formatElapsedTime formats the duration of a running operation in the
+// same way as Bazel, using whole seconds.
+func formatElapsedTime(d time.Duration) string {
+	seconds := int64(d / time.Second)
+	if seconds < 60 {
+		return fmt.Sprintf("%ds", seconds)
+	}
+	return fmt.Sprintf("%dm %ds", seconds/60, seconds%60)
+}
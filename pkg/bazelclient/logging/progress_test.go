@@ -0,0 +1,77 @@
+package logging
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressGetSummary(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	t.Run("Scanning", func(t *testing.T) {
+		require.Equal(t, "module sources", (&Progress{Phase: PhaseScanning}).getSummary(now))
+	})
+
+	t.Run("UploadingJustStarted", func(t *testing.T) {
+		// The upload rate should only be displayed once it can
+		// be computed reliably.
+		require.Equal(t, "module sources, 1.5 MiB", (&Progress{
+			Phase:             PhaseUploading,
+			UploadedSizeBytes: 1536 * 1024,
+			UploadStartTime:   now.Add(-500 * time.Millisecond),
+		}).getSummary(now))
+	})
+
+	t.Run("Uploading", func(t *testing.T) {
+		require.Equal(t, "module sources, 4.0 MiB (2.0 MiB/s)", (&Progress{
+			Phase:             PhaseUploading,
+			UploadedSizeBytes: 4 * 1024 * 1024,
+			UploadStartTime:   now.Add(-2 * time.Second),
+		}).getSummary(now))
+	})
+
+	t.Run("Analyzing", func(t *testing.T) {
+		require.Equal(t, "10 targets configured, 0 actions completed", (&Progress{
+			Phase:             PhaseAnalyzing,
+			ConfiguredTargets: 10,
+		}).getSummary(now))
+	})
+
+	t.Run("Executing", func(t *testing.T) {
+		require.Equal(t, "10 targets configured, 5 actions completed, 3 actions running", (&Progress{
+			Phase:             PhaseExecuting,
+			ConfiguredTargets: 10,
+			CompletedActions:  5,
+			RunningActions:    3,
+		}).getSummary(now))
+	})
+}
+
+func TestFormatSizeBytes(t *testing.T) {
+	for sizeBytes, expected := range map[uint64]string{
+		0:                      "0 B",
+		1023:                   "1023 B",
+		1024:                   "1.0 KiB",
+		1536:                   "1.5 KiB",
+		1024 * 1024:            "1.0 MiB",
+		5 * 1024 * 1024 * 1024: "5.0 GiB",
+		1 << 60:                "1.0 EiB",
+		1<<64 - 1:              "16.0 EiB",
+	} {
+		require.Equal(t, expected, formatSizeBytes(sizeBytes), "Size %d", sizeBytes)
+	}
+}
+
+func TestFormatElapsedTime(t *testing.T) {
+	for d, expected := range map[time.Duration]string{
+		0:                              "0s",
+		999 * time.Millisecond:         "0s",
+		59 * time.Second:               "59s",
+		time.Minute:                    "1m 0s",
+		61*time.Minute + 5*time.Second: "61m 5s",
+	} {
+		require.Equal(t, expected, formatElapsedTime(d), "Duration %s", d)
+	}
+}
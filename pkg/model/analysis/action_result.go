@@ -73,6 +73,8 @@ func (c *baseComputer) ComputeActionResultValue(ctx context.Context, key model_c
 			StableFingerprint: commandReferenceSHA256[:],
 			ExecutionTimeout:  key.Message.ExecutionTimeout,
 		},
+		c.executionPriority,
+		/* onOperationCreated = */ nil,
 		&completionEvent,
 		&errExecution,
 	) {
@@ -0,0 +1,173 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_parser "github.com/buildbarn/bonanza/pkg/model/parser"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+
+	"go.starlark.net/starlark"
+)
+
+var (
+	commandLineOptionAllowAnalysisFailuresLabel = label.MustNewCanonicalLabel("@@bazel_tools+//command_line_option:allow_analysis_failures")
+
+	analysisFailureProviderIdentifier     = label.MustNewCanonicalStarlarkIdentifier("@@builtins_core+//:exports.bzl%AnalysisFailure")
+	analysisFailureInfoProviderIdentifier = label.MustNewCanonicalStarlarkIdentifier("@@builtins_core+//:exports.bzl%AnalysisFailureInfo")
+
+	analysisFailureProviderInstanceProperties     = model_starlark.NewProviderInstanceProperties(&analysisFailureProviderIdentifier, false)
+	analysisFailureInfoProviderInstanceProperties = model_starlark.NewProviderInstanceProperties(&analysisFailureInfoProviderIdentifier, false)
+)
+
+// analysisFailureInDependencyError is returned when accessing a
+// dependency of a target that yielded AnalysisFailureInfo, while
+// analysis failures are permitted. Instead of continuing the analysis
+// of the target, the causes of the failure are propagated.
+type analysisFailureInDependencyError struct {
+	label  string
+	causes *model_starlark.Depset
+}
+
+func (e analysisFailureInDependencyError) Error() string {
+	return fmt.Sprintf("analysis of dependency %#v failed", e.label)
+}
+
+// getAllowAnalysisFailures returns whether failures during the
+// analysis of targets in a given configuration should be captured in
+// the form of AnalysisFailureInfo, as opposed to causing the build to
+// fail. This is the case for targets under test of analysis tests that
+// expect failures.
+func (c *baseComputer) getAllowAnalysisFailures(ctx context.Context, configurationReference model_core.Message[*model_core_pb.Reference]) (bool, error) {
+	configuration, err := c.getConfigurationByReference(ctx, configurationReference)
+	if err != nil {
+		return false, err
+	}
+	commandLineOptionAllowAnalysisFailuresLabelStr := commandLineOptionAllowAnalysisFailuresLabel.String()
+	override, err := c.getBuildSettingOverride(ctx, configuration, commandLineOptionAllowAnalysisFailuresLabelStr)
+	if err != nil {
+		return false, err
+	}
+	if !override.IsSet() {
+		return false, nil
+	}
+	value, ok := override.Message.Value.GetKind().(*model_starlark_pb.Value_Bool)
+	if !ok {
+		return false, fmt.Errorf("build setting override for %#v is not a Boolean value", commandLineOptionAllowAnalysisFailuresLabelStr)
+	}
+	return value.Bool, nil
+}
+
+// getAnalysisFailureCauses returns the causes of the analysis failure
+// of a configured target, if the target yielded AnalysisFailureInfo.
+func (c *baseComputer) getAnalysisFailureCauses(ctx context.Context, providerInstances model_core.Message[[]*model_starlark_pb.Struct]) (*model_starlark.Depset, bool, error) {
+	analysisFailureInfoProviderIdentifierStr := analysisFailureInfoProviderIdentifier.String()
+	index, ok := sort.Find(
+		len(providerInstances.Message),
+		func(i int) int {
+			return strings.Compare(analysisFailureInfoProviderIdentifierStr, providerInstances.Message[i].ProviderInstanceProperties.GetProviderIdentifier())
+		},
+	)
+	if !ok {
+		return nil, false, nil
+	}
+
+	causes, err := model_starlark.GetStructFieldValue(
+		ctx,
+		model_parser.NewStorageBackedParsedObjectReader(
+			c.objectDownloader,
+			c.getValueObjectEncoder(),
+			model_parser.NewMessageListObjectParser[object.LocalReference, model_starlark_pb.List_Element](),
+		),
+		model_core.Message[*model_starlark_pb.Struct_Fields]{
+			Message:            providerInstances.Message[index].Fields,
+			OutgoingReferences: providerInstances.OutgoingReferences,
+		},
+		"causes",
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to obtain field \"causes\" of AnalysisFailureInfo: %w", err)
+	}
+	decodedCauses, err := model_starlark.DecodeValue(
+		causes,
+		/* currentIdentifier = */ nil,
+		c.getValueDecodingOptions(ctx, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+			return model_starlark.NewLabel(resolvedLabel), nil
+		}),
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode field \"causes\" of AnalysisFailureInfo: %w", err)
+	}
+	depset, ok := decodedCauses.(*model_starlark.Depset)
+	if !ok {
+		return nil, false, errors.New("field \"causes\" of AnalysisFailureInfo is not a depset")
+	}
+	return depset, true, nil
+}
+
+// getAnalysisFailureConfiguredTargetValue returns the value of a
+// configured target whose analysis failed, while analysis failures are
+// permitted. The resulting target only yields AnalysisFailureInfo and
+// an empty DefaultInfo.
+func (c *baseComputer) getAnalysisFailureConfiguredTargetValue(thread *starlark.Thread, targetLabel label.CanonicalLabel, analysisErr error) (PatchedConfiguredTargetValue, error) {
+	var causes *model_starlark.Depset
+	var dependencyErr analysisFailureInDependencyError
+	if errors.As(analysisErr, &dependencyErr) {
+		causes = dependencyErr.causes
+	} else {
+		message := analysisErr.Error()
+		var evalErr *starlark.EvalError
+		if errors.As(analysisErr, &evalErr) {
+			message = evalErr.Backtrace()
+		}
+		var err error
+		causes, err = model_starlark.NewDepset(
+			thread,
+			[]starlark.Value{
+				model_starlark.NewStructFromDict(
+					analysisFailureProviderInstanceProperties,
+					map[string]any{
+						"label":   model_starlark.NewLabel(targetLabel.AsResolved()),
+						"message": starlark.String(message),
+					},
+				),
+			},
+			/* transitive = */ nil,
+			model_starlark_pb.Depset_DEFAULT,
+		)
+		if err != nil {
+			return PatchedConfiguredTargetValue{}, err
+		}
+	}
+
+	encodedProviderInstances, err := c.encodeProviderInstances(
+		targetLabel,
+		map[label.CanonicalStarlarkIdentifier]*model_starlark.Struct{
+			analysisFailureInfoProviderIdentifier: model_starlark.NewStructFromDict(
+				analysisFailureInfoProviderInstanceProperties,
+				map[string]any{
+					"causes": causes,
+				},
+			),
+			defaultInfoProviderIdentifier: emptyDefaultInfo,
+		},
+	)
+	if err != nil {
+		return PatchedConfiguredTargetValue{}, err
+	}
+	return model_core.NewPatchedMessage(
+		&model_analysis_pb.ConfiguredTarget_Value{
+			ProviderInstances: encodedProviderInstances.Message,
+		},
+		encodedProviderInstances.Patcher,
+	), nil
+}
@@ -0,0 +1,187 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/stretchr/testify/require"
+
+	"go.starlark.net/starlark"
+)
+
+func newTestBoolBuildSettingOverride(buildSettingLabel string, value bool) *model_analysis_pb.Configuration_BuildSettingOverride {
+	return &model_analysis_pb.Configuration_BuildSettingOverride{
+		Level: &model_analysis_pb.Configuration_BuildSettingOverride_Leaf_{
+			Leaf: &model_analysis_pb.Configuration_BuildSettingOverride_Leaf{
+				Label: buildSettingLabel,
+				Value: &model_starlark_pb.Value{
+					Kind: &model_starlark_pb.Value_Bool{Bool: value},
+				},
+			},
+		},
+	}
+}
+
+// requireAnalysisFailureCauses checks that the provider instances of a
+// configured target contain AnalysisFailureInfo, whose causes consist
+// of failures with the provided labels and messages.
+func requireAnalysisFailureCauses(t *testing.T, c *baseComputer, providerInstances model_core.Message[[]*model_starlark_pb.Struct], expectedCauses [][2]string) {
+	ctx := context.Background()
+	thread := &starlark.Thread{}
+	thread.SetLocal(model_starlark.ValueDecodingOptionsKey, c.getValueDecodingOptions(ctx, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+		return model_starlark.NewLabel(resolvedLabel), nil
+	}))
+	causes, ok, err := c.getAnalysisFailureCauses(ctx, providerInstances)
+	require.NoError(t, err)
+	require.True(t, ok)
+	causesList, err := causes.ToList(thread)
+	require.NoError(t, err)
+
+	var actualCauses [][2]string
+	for cause := range causesList.Elements() {
+		failure, ok := cause.(*model_starlark.Struct)
+		require.True(t, ok)
+		failureLabel, err := failure.Attr(thread, "label")
+		require.NoError(t, err)
+		failureMessage, err := failure.Attr(thread, "message")
+		require.NoError(t, err)
+		actualCauses = append(actualCauses, [2]string{
+			failureLabel.String(),
+			string(failureMessage.(starlark.String)),
+		})
+	}
+	require.Equal(t, expectedCauses, actualCauses)
+}
+
+func TestGetAllowAnalysisFailures(t *testing.T) {
+	ctx := context.Background()
+	objectDownloader := testObjectDownloader{}
+	c := newTestBaseComputer(objectDownloader)
+
+	t.Run("EmptyConfiguration", func(t *testing.T) {
+		// By default, analysis failures cause the build to fail.
+		allowAnalysisFailures, err := c.getAllowAnalysisFailures(ctx, model_core.NewSimpleMessage[*model_core_pb.Reference](nil))
+		require.NoError(t, err)
+		require.False(t, allowAnalysisFailures)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		// Targets under test of analysis tests that expect
+		// failures are analyzed in a configuration that has
+		// --allow_analysis_failures set.
+		configurationReference, _ := storeTestConfiguration(t, objectDownloader, &model_analysis_pb.Configuration{
+			BuildSettingOverrides: []*model_analysis_pb.Configuration_BuildSettingOverride{
+				newTestBoolBuildSettingOverride("@@bazel_tools+//command_line_option:allow_analysis_failures", true),
+			},
+		}).SortAndSetReferences()
+		allowAnalysisFailures, err := c.getAllowAnalysisFailures(ctx, configurationReference)
+		require.NoError(t, err)
+		require.True(t, allowAnalysisFailures)
+	})
+
+	t.Run("NotABoolean", func(t *testing.T) {
+		configurationReference, _ := storeTestConfiguration(t, objectDownloader, &model_analysis_pb.Configuration{
+			BuildSettingOverrides: []*model_analysis_pb.Configuration_BuildSettingOverride{
+				newLabelBuildSettingOverride("@@bazel_tools+//command_line_option:allow_analysis_failures", "@@example+//:foo"),
+			},
+		}).SortAndSetReferences()
+		_, err := c.getAllowAnalysisFailures(ctx, configurationReference)
+		require.EqualError(t, err, "build setting override for \"@@bazel_tools+//command_line_option:allow_analysis_failures\" is not a Boolean value")
+	})
+}
+
+func TestAnalysisFailureConfiguredTargetValue(t *testing.T) {
+	c := newTestBaseComputer(testObjectDownloader{})
+	targetLabel := label.MustNewCanonicalLabel("@@example+//:under_test")
+
+	t.Run("Success", func(t *testing.T) {
+		// Targets that were analyzed successfully do not yield
+		// AnalysisFailureInfo.
+		_, ok, err := c.getAnalysisFailureCauses(context.Background(), model_core.NewSimpleMessage([]*model_starlark_pb.Struct{
+			newTestProviderInstance("@@builtins_core+//:exports.bzl%DefaultInfo", nil),
+		}))
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("CapturedFailure", func(t *testing.T) {
+		// If analysis of the target under test fails, the error
+		// should be captured in AnalysisFailureInfo, so that the
+		// analysis test can inspect it.
+		configuredTarget, err := c.getAnalysisFailureConfiguredTargetValue(
+			&starlark.Thread{},
+			targetLabel,
+			errors.New("srcs must not be empty"),
+		)
+		require.NoError(t, err)
+		m, _ := configuredTarget.SortAndSetReferences()
+
+		providerIdentifiers := make([]string, 0, len(m.Message.ProviderInstances))
+		for _, providerInstance := range m.Message.ProviderInstances {
+			providerIdentifiers = append(providerIdentifiers, providerInstance.ProviderInstanceProperties.ProviderIdentifier)
+		}
+		require.Equal(t, []string{
+			"@@builtins_core+//:exports.bzl%AnalysisFailureInfo",
+			"@@builtins_core+//:exports.bzl%DefaultInfo",
+		}, providerIdentifiers)
+
+		requireAnalysisFailureCauses(
+			t,
+			c,
+			model_core.Message[[]*model_starlark_pb.Struct]{
+				Message:            m.Message.ProviderInstances,
+				OutgoingReferences: m.OutgoingReferences,
+			},
+			[][2]string{{"@@example+//:under_test", "srcs must not be empty"}},
+		)
+	})
+
+	t.Run("FailureInDependency", func(t *testing.T) {
+		// If analysis failed because one of the dependencies
+		// failed, the causes of the dependency's failure should
+		// be propagated, as opposed to reporting a new failure.
+		thread := &starlark.Thread{}
+		dependencyCauses, err := model_starlark.NewDepset(
+			thread,
+			[]starlark.Value{
+				model_starlark.NewStructFromDict(
+					analysisFailureProviderInstanceProperties,
+					map[string]any{
+						"label":   model_starlark.NewLabel(label.MustNewCanonicalLabel("@@example+//:dep").AsResolved()),
+						"message": starlark.String("deps must not contain cycles"),
+					},
+				),
+			},
+			/* transitive = */ nil,
+			model_starlark_pb.Depset_DEFAULT,
+		)
+		require.NoError(t, err)
+
+		configuredTarget, err := c.getAnalysisFailureConfiguredTargetValue(
+			thread,
+			targetLabel,
+			analysisFailureInDependencyError{
+				label:  "@@example+//:dep",
+				causes: dependencyCauses,
+			},
+		)
+		require.NoError(t, err)
+		m, _ := configuredTarget.SortAndSetReferences()
+		requireAnalysisFailureCauses(
+			t,
+			c,
+			model_core.Message[[]*model_starlark_pb.Struct]{
+				Message:            m.Message.ProviderInstances,
+				OutgoingReferences: m.OutgoingReferences,
+			},
+			[][2]string{{"@@example+//:dep", "deps must not contain cycles"}},
+		)
+	})
+}
@@ -0,0 +1,136 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_parser "github.com/buildbarn/bonanza/pkg/model/parser"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+
+	"go.starlark.net/starlark"
+)
+
+// ComputeAnalysisTestTransitionValue computes the configuration that
+// is obtained by applying a transition created through
+// analysis_test_transition(). Such transitions are not backed by an
+// implementation function. Instead, they assign fixed values to a set
+// of build settings.
+func (c *baseComputer) ComputeAnalysisTestTransitionValue(ctx context.Context, key model_core.Message[*model_analysis_pb.AnalysisTestTransition_Key], e AnalysisTestTransitionEnvironment) (PatchedAnalysisTestTransitionValue, error) {
+	inputConfigurationReference := model_core.Message[*model_core_pb.Reference]{
+		Message:            key.Message.InputConfigurationReference,
+		OutgoingReferences: key.OutgoingReferences,
+	}
+	settings := key.Message.Transition.GetSettings()
+	if len(settings) == 0 {
+		// Transition does not set any build settings.
+		outputConfigurationReference := model_core.NewPatchedMessageFromExisting(
+			inputConfigurationReference,
+			func(index int) dag.ObjectContentsWalker {
+				return dag.ExistingObjectContentsWalker
+			},
+		)
+		return model_core.NewPatchedMessage(
+			&model_analysis_pb.AnalysisTestTransition_Value{
+				OutputConfigurationReference: outputConfigurationReference.Message,
+			},
+			outputConfigurationReference.Patcher,
+		), nil
+	}
+
+	allBuiltinsModulesNames := e.GetBuiltinsModuleNamesValue(&model_analysis_pb.BuiltinsModuleNames_Key{})
+	if !allBuiltinsModulesNames.IsSet() {
+		return PatchedAnalysisTestTransitionValue{}, evaluation.ErrMissingDependency
+	}
+
+	// Determine which build settings are written, and decode the
+	// values that need to be assigned to them.
+	missingDependencies := false
+	expectedOutputs := make([]expectedTransitionOutput, 0, len(settings))
+	outputs := make(map[string]starlark.Value, len(settings))
+	for _, setting := range settings {
+		buildSettingLabel, err := label.NewCanonicalLabel(setting.Label)
+		if err != nil {
+			return PatchedAnalysisTestTransitionValue{}, fmt.Errorf("invalid build setting label %#v: %w", setting.Label, err)
+		}
+		// String values of label settings are resolved
+		// relative to the package containing the build setting,
+		// as the transition itself is not bound to a file.
+		expectedOutput, err := getExpectedTransitionOutput(e, buildSettingLabel.GetCanonicalPackage(), buildSettingLabel, setting.Label)
+		if err != nil {
+			if errors.Is(err, evaluation.ErrMissingDependency) {
+				missingDependencies = true
+				continue
+			}
+			return PatchedAnalysisTestTransitionValue{}, err
+		}
+		expectedOutputs = append(expectedOutputs, expectedOutput)
+
+		value, err := model_starlark.DecodeValue(
+			model_core.Message[*model_starlark_pb.Value]{
+				Message:            setting.Value,
+				OutgoingReferences: key.OutgoingReferences,
+			},
+			/* currentIdentifier = */ nil,
+			c.getValueDecodingOptions(ctx, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+				return model_starlark.NewLabel(resolvedLabel), nil
+			}),
+		)
+		if err != nil {
+			return PatchedAnalysisTestTransitionValue{}, fmt.Errorf("failed to decode value of build setting %#v: %w", setting.Label, err)
+		}
+		outputs[setting.Label] = value
+	}
+	if missingDependencies {
+		return PatchedAnalysisTestTransitionValue{}, evaluation.ErrMissingDependency
+	}
+
+	slices.SortFunc(expectedOutputs, func(a, b expectedTransitionOutput) int {
+		return strings.Compare(a.label, b.label)
+	})
+	for i := 1; i < len(expectedOutputs); i++ {
+		if a, b := expectedOutputs[i-1], expectedOutputs[i]; a.label == b.label {
+			return PatchedAnalysisTestTransitionValue{}, fmt.Errorf("settings %#v and %#v both refer to build setting %#v", a.key, b.key, a.label)
+		}
+	}
+
+	inputConfiguration, err := c.getConfigurationByReference(ctx, inputConfigurationReference)
+	if err != nil {
+		return PatchedAnalysisTestTransitionValue{}, err
+	}
+	thread := c.newStarlarkThread(ctx, e, allBuiltinsModulesNames.Message.BuiltinsModuleNames)
+	outputConfigurationReference, err := c.applyTransition(
+		ctx,
+		inputConfiguration,
+		model_parser.NewStorageBackedParsedObjectReader(
+			c.objectDownloader,
+			c.getValueObjectEncoder(),
+			model_parser.NewMessageListObjectParser[object.LocalReference, model_analysis_pb.Configuration_BuildSettingOverride](),
+		),
+		expectedOutputs,
+		thread,
+		outputs,
+		// Values of build settings cannot contain functions,
+		// meaning the filename provided here is irrelevant.
+		c.getValueEncodingOptions(label.MustNewCanonicalLabel(settings[0].Label)),
+	)
+	if err != nil {
+		return PatchedAnalysisTestTransitionValue{}, err
+	}
+	return model_core.NewPatchedMessage(
+		&model_analysis_pb.AnalysisTestTransition_Value{
+			OutputConfigurationReference: outputConfigurationReference.Message,
+		},
+		outputConfigurationReference.Patcher,
+	), nil
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
+	"slices"
+	"strings"
 
 	re_filesystem "github.com/buildbarn/bb-remote-execution/pkg/filesystem"
 	"github.com/buildbarn/bb-storage/pkg/filesystem"
@@ -21,6 +24,7 @@ import (
 	model_command_pb "github.com/buildbarn/bonanza/pkg/proto/model/command"
 	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
 	model_filesystem_pb "github.com/buildbarn/bonanza/pkg/proto/model/filesystem"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
 	remoteexecution "github.com/buildbarn/bonanza/pkg/remoteexecution"
 	"github.com/buildbarn/bonanza/pkg/storage/dag"
 	"github.com/buildbarn/bonanza/pkg/storage/object"
@@ -38,6 +42,8 @@ type baseComputer struct {
 	filePool                    re_filesystem.FilePool
 	cacheDirectory              filesystem.Directory
 	executionClient             *remoteexecution.Client[*model_command_pb.Action, emptypb.Empty, *emptypb.Empty, *model_command_pb.Result]
+	executionPriority           int32
+	diagnosticsReporter         DiagnosticsReporter
 }
 
 func NewBaseComputer(
@@ -48,6 +54,8 @@ func NewBaseComputer(
 	filePool re_filesystem.FilePool,
 	cacheDirectory filesystem.Directory,
 	executionClient *remoteexecution.Client[*model_command_pb.Action, emptypb.Empty, *emptypb.Empty, *model_command_pb.Result],
+	executionPriority int32,
+	diagnosticsReporter DiagnosticsReporter,
 ) Computer {
 	return &baseComputer{
 		objectDownloader:            objectDownloader,
@@ -57,6 +65,8 @@ func NewBaseComputer(
 		filePool:                    filePool,
 		cacheDirectory:              cacheDirectory,
 		executionClient:             executionClient,
+		executionPriority:           executionPriority,
+		diagnosticsReporter:         diagnosticsReporter,
 	}
 }
 
@@ -207,8 +217,7 @@ func trimBuiltinModuleNames(builtinsModuleNames []string, module label.Module) [
 
 func (c *baseComputer) newStarlarkThread(ctx context.Context, e starlarkThreadEnvironment, builtinsModuleNames []string) *starlark.Thread {
 	thread := &starlark.Thread{
-		// TODO: Provide print method.
-		Print: nil,
+		Print: c.printFromStarlark,
 		Load: func(thread *starlark.Thread, loadLabelStr string) (starlark.StringDict, error) {
 			return c.loadBzlGlobalsInStarlarkThread(e, thread, loadLabelStr, builtinsModuleNames)
 		},
@@ -275,10 +284,20 @@ func (c *baseComputer) ComputeBuildResultValue(ctx context.Context, key *model_a
 	rootRepo := rootModule.ToModuleInstance(nil).GetBareCanonicalRepo()
 	rootPackage := rootRepo.GetRootPackage()
 
-	// TODO: Obtain platform constraints from --platforms.
+	configuration, err := c.createTopLevelConfiguration(e, rootRepo, buildSpecification.Message.BuildSpecification)
+	if err != nil {
+		return PatchedBuildResultValue{}, err
+	}
+
+	// Expand all target patterns. Target patterns prefixed with
+	// "-" remove targets matched by preceding target patterns. For
+	// each target, track whether it was requested explicitly, as
+	// opposed to being matched by a wildcard.
 	missingDependencies := false
+	targetLabels := map[label.CanonicalLabel]bool{}
 	for _, targetPattern := range buildSpecification.Message.BuildSpecification.TargetPatterns {
-		apparentTargetPattern, err := rootPackage.AppendTargetPattern(targetPattern)
+		positiveTargetPattern, isNegative := strings.CutPrefix(targetPattern, "-")
+		apparentTargetPattern, err := rootPackage.AppendTargetPattern(positiveTargetPattern)
 		if err != nil {
 			return PatchedBuildResultValue{}, fmt.Errorf("invalid target pattern %#v: %w", targetPattern, err)
 		}
@@ -286,35 +305,14 @@ func (c *baseComputer) ComputeBuildResultValue(ctx context.Context, key *model_a
 		if err != nil {
 			return PatchedBuildResultValue{}, err
 		}
+		_, isExplicit := canonicalTargetPattern.AsCanonicalLabel()
 
 		var iterErr error
 		for canonicalTargetLabel := range c.expandCanonicalTargetPattern(ctx, e, canonicalTargetPattern, &iterErr) {
-			visibleTargetPatcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
-			visibleTargetValue := e.GetVisibleTargetValue(
-				model_core.PatchedMessage[*model_analysis_pb.VisibleTarget_Key, dag.ObjectContentsWalker]{
-					Message: &model_analysis_pb.VisibleTarget_Key{
-						FromPackage: canonicalTargetLabel.GetCanonicalPackage().String(),
-						ToLabel:     canonicalTargetLabel.String(),
-					},
-					Patcher: visibleTargetPatcher,
-				},
-			)
-			if !visibleTargetValue.IsSet() {
-				missingDependencies = true
-				continue
-			}
-
-			targetCompletionPatcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
-			targetCompletionValue := e.GetTargetCompletionValue(
-				model_core.PatchedMessage[*model_analysis_pb.TargetCompletion_Key, dag.ObjectContentsWalker]{
-					Message: &model_analysis_pb.TargetCompletion_Key{
-						Label: visibleTargetValue.Message.Label,
-					},
-					Patcher: targetCompletionPatcher,
-				},
-			)
-			if !targetCompletionValue.IsSet() {
-				missingDependencies = true
+			if isNegative {
+				delete(targetLabels, canonicalTargetLabel)
+			} else {
+				targetLabels[canonicalTargetLabel] = targetLabels[canonicalTargetLabel] || isExplicit
 			}
 		}
 		if iterErr != nil {
@@ -327,7 +325,105 @@ func (c *baseComputer) ComputeBuildResultValue(ctx context.Context, key *model_a
 	if missingDependencies {
 		return PatchedBuildResultValue{}, evaluation.ErrMissingDependency
 	}
-	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.BuildResult_Value{}), nil
+
+	var targets []*model_build_pb.Target
+	for _, canonicalTargetLabel := range slices.SortedFunc(
+		maps.Keys(targetLabels),
+		func(a, b label.CanonicalLabel) int { return strings.Compare(a.String(), b.String()) },
+	) {
+		visibleTargetConfigurationReference := configuration.getPatchedReference()
+		visibleTargetValue := e.GetVisibleTargetValue(
+			model_core.NewPatchedMessage(
+				&model_analysis_pb.VisibleTarget_Key{
+					FromPackage:            canonicalTargetLabel.GetCanonicalPackage().String(),
+					ToLabel:                canonicalTargetLabel.String(),
+					ConfigurationReference: visibleTargetConfigurationReference.Message,
+				},
+				visibleTargetConfigurationReference.Patcher,
+			),
+		)
+		if !visibleTargetValue.IsSet() {
+			missingDependencies = true
+			continue
+		}
+
+		// Targets whose target_compatible_with attribute is
+		// not satisfied by the target platform are skipped if
+		// they were matched by a wildcard. Requesting them
+		// explicitly is an error.
+		targetCompatibilityConfigurationReference := configuration.getPatchedReference()
+		targetCompatibilityValue := e.GetTargetCompatibilityValue(
+			model_core.NewPatchedMessage(
+				&model_analysis_pb.TargetCompatibility_Key{
+					Label:                  visibleTargetValue.Message.Label,
+					ConfigurationReference: targetCompatibilityConfigurationReference.Message,
+				},
+				targetCompatibilityConfigurationReference.Patcher,
+			),
+		)
+		if !targetCompatibilityValue.IsSet() {
+			missingDependencies = true
+			continue
+		}
+		if !targetCompatibilityValue.Message.Compatible {
+			if targetLabels[canonicalTargetLabel] {
+				return PatchedBuildResultValue{}, fmt.Errorf("target %#v is incompatible with the target platform", canonicalTargetLabel.String())
+			}
+			continue
+		}
+
+		targetCompletionConfigurationReference := configuration.getPatchedReference()
+		targetCompletionValue := e.GetTargetCompletionValue(
+			model_core.NewPatchedMessage(
+				&model_analysis_pb.TargetCompletion_Key{
+					Label:                  visibleTargetValue.Message.Label,
+					ConfigurationReference: targetCompletionConfigurationReference.Message,
+				},
+				targetCompletionConfigurationReference.Patcher,
+			),
+		)
+		if !targetCompletionValue.IsSet() {
+			missingDependencies = true
+			continue
+		}
+
+		// Report the rule name and tags of the target, so that
+		// the client can include them in build events.
+		targetValue := e.GetTargetValue(&model_analysis_pb.Target_Key{
+			Label: visibleTargetValue.Message.Label,
+		})
+		if !targetValue.IsSet() {
+			missingDependencies = true
+			continue
+		}
+		target := &model_build_pb.Target{
+			Label:           visibleTargetValue.Message.Label,
+			ConfigurationId: configuration.getID(),
+		}
+		if ruleTarget, ok := targetValue.Message.Definition.GetKind().(*model_starlark_pb.Target_Definition_RuleTarget); ok {
+			ruleIdentifier, err := label.NewCanonicalStarlarkIdentifier(ruleTarget.RuleTarget.RuleIdentifier)
+			if err != nil {
+				return PatchedBuildResultValue{}, fmt.Errorf("invalid rule identifier %#v for target %#v: %w", ruleTarget.RuleTarget.RuleIdentifier, visibleTargetValue.Message.Label, err)
+			}
+			target.RuleName = ruleIdentifier.GetStarlarkIdentifier().String()
+			target.Tags = ruleTarget.RuleTarget.Tags
+		}
+		targets = append(targets, target)
+	}
+
+	// Provide an up-to-date copy of MODULE.bazel.lock, so that the
+	// client can write it into the workspace.
+	updatedModuleLockfileValue := e.GetUpdatedModuleLockfileValue(&model_analysis_pb.UpdatedModuleLockfile_Key{})
+	if !updatedModuleLockfileValue.IsSet() {
+		missingDependencies = true
+	}
+	if missingDependencies {
+		return PatchedBuildResultValue{}, evaluation.ErrMissingDependency
+	}
+	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.BuildResult_Value{
+		ModuleLockfile: updatedModuleLockfileValue.Message.Contents,
+		Targets:        targets,
+	}), nil
 }
 
 func (c *baseComputer) ComputeBuildSpecificationValue(ctx context.Context, key *model_analysis_pb.BuildSpecification_Key, e BuildSpecificationEnvironment) (PatchedBuildSpecificationValue, error) {
@@ -440,11 +536,13 @@ func (c *baseComputer) ComputeRepoDefaultAttrsValue(ctx context.Context, key *mo
 		return PatchedRepoDefaultAttrsValue{}, err
 	}
 
-	// Extract the default inheritable attrs from REPO.bazel.
-	defaultAttrs, err := model_starlark.ParseRepoDotBazel(
+	// Extract the default inheritable attrs and the list of
+	// ignored directories from REPO.bazel.
+	defaultAttrs, ignoredDirectories, err := model_starlark.ParseRepoDotBazel(
 		string(repoFileData),
 		canonicalRepo.GetRootPackage().AppendTargetName(repoFileName),
 		c.getInlinedTreeOptions(),
+		c.printFromStarlark,
 	)
 	if err != nil {
 		return PatchedRepoDefaultAttrsValue{}, fmt.Errorf("failed to parse %#v: %w", repoFileLabel.String(), err)
@@ -452,7 +550,8 @@ func (c *baseComputer) ComputeRepoDefaultAttrsValue(ctx context.Context, key *mo
 
 	return model_core.NewPatchedMessage(
 		&model_analysis_pb.RepoDefaultAttrs_Value{
-			InheritableAttrs: defaultAttrs.Message,
+			InheritableAttrs:   defaultAttrs.Message,
+			IgnoredDirectories: ignoredDirectories,
 		},
 		defaultAttrs.Patcher,
 	), nil
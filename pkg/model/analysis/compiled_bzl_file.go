@@ -79,6 +79,12 @@ func (c *baseComputer) ComputeCompiledBzlFileValue(ctx context.Context, key *mod
 	if err := c.preloadBzlGlobals(e, canonicalPackage, program, key.BuiltinsModuleNames); err != nil {
 		return PatchedCompiledBzlFileValue{}, err
 	}
+	if err := c.checkBzlLoadVisibility(ctx, e, canonicalLabel, program, key.BuiltinsModuleNames); err != nil {
+		return PatchedCompiledBzlFileValue{}, err
+	}
+
+	loadVisibilityRegistrar := model_starlark.NewLoadVisibilityRegistrar(c.getInlinedTreeOptions())
+	thread.SetLocal(model_starlark.LoadVisibilityRegistrarKey, loadVisibilityRegistrar)
 
 	globals, err := program.Init(thread, bzlFileBuiltins)
 	if err != nil {
@@ -97,14 +103,83 @@ func (c *baseComputer) ComputeCompiledBzlFileValue(ctx context.Context, key *mod
 	if err != nil {
 		return PatchedCompiledBzlFileValue{}, err
 	}
+	loadVisibility := loadVisibilityRegistrar.GetVisibility()
+	if loadVisibility.IsSet() {
+		compiledProgram.Patcher.Merge(loadVisibility.Patcher)
+	}
 	return PatchedCompiledBzlFileValue{
 		Message: &model_analysis_pb.CompiledBzlFile_Value{
 			CompiledProgram: compiledProgram.Message,
+			LoadVisibility:  loadVisibility.Message,
 		},
 		Patcher: compiledProgram.Patcher,
 	}, nil
 }
 
+type checkBzlLoadVisibilityEnvironment interface {
+	resolveApparentEnvironment
+	GetCompiledBzlFileValue(*model_analysis_pb.CompiledBzlFile_Key) model_core.Message[*model_analysis_pb.CompiledBzlFile_Value]
+}
+
+// checkBzlLoadVisibility checks that all .bzl files that are loaded by
+// a program permit being loaded from the package containing the
+// program, as declared by calling visibility().
+func (c *baseComputer) checkBzlLoadVisibility(ctx context.Context, e checkBzlLoadVisibilityEnvironment, fromLabel label.CanonicalLabel, program *starlark.Program, builtinsModuleNames []string) error {
+	fromPackage := fromLabel.GetCanonicalPackage()
+	missingDependencies := false
+	numLoads := program.NumLoads()
+	for i := 0; i < numLoads; i++ {
+		loadLabelStr, _ := program.Load(i)
+		apparentLoadLabel, err := fromPackage.AppendLabel(loadLabelStr)
+		if err != nil {
+			return fmt.Errorf("invalid label %#v in load() statement: %w", loadLabelStr, err)
+		}
+		canonicalLoadLabel, err := resolveApparent(e, fromPackage.GetCanonicalRepo(), apparentLoadLabel)
+		if err != nil {
+			if errors.Is(err, evaluation.ErrMissingDependency) {
+				missingDependencies = true
+				continue
+			}
+			return fmt.Errorf("failed to resolve label %#v in load() statement: %w", apparentLoadLabel.String(), err)
+		}
+
+		// Always permit loading files within the same package.
+		if canonicalLoadLabel.GetCanonicalPackage() == fromPackage {
+			continue
+		}
+		loadedBzlFile := e.GetCompiledBzlFileValue(&model_analysis_pb.CompiledBzlFile_Key{
+			Label:               canonicalLoadLabel.String(),
+			BuiltinsModuleNames: builtinsModuleNames,
+		})
+		if !loadedBzlFile.IsSet() {
+			missingDependencies = true
+			continue
+		}
+		loadVisibility := loadedBzlFile.Message.LoadVisibility
+		if loadVisibility == nil {
+			continue
+		}
+		visible, err := c.packageGroupTreeContainsPackage(
+			ctx,
+			fromPackage,
+			model_core.Message[*model_starlark_pb.PackageGroup_Subpackages]{
+				Message:            loadVisibility.Tree,
+				OutgoingReferences: loadedBzlFile.OutgoingReferences,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to check load visibility of %#v: %w", canonicalLoadLabel.String(), err)
+		}
+		if !visible {
+			return fmt.Errorf("file %#v cannot be loaded by %#v, as package %#v is not included in the file's load visibility", canonicalLoadLabel.String(), fromLabel.String(), fromPackage.String())
+		}
+	}
+	if missingDependencies {
+		return evaluation.ErrMissingDependency
+	}
+	return nil
+}
+
 func (c *baseComputer) ComputeCompiledBzlFileDecodedGlobalsValue(ctx context.Context, key *model_analysis_pb.CompiledBzlFileDecodedGlobals_Key, e CompiledBzlFileDecodedGlobalsEnvironment) (starlark.StringDict, error) {
 	currentFilename, err := label.NewCanonicalLabel(key.Label)
 	if err != nil {
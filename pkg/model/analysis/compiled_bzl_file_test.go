@@ -0,0 +1,107 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	"github.com/stretchr/testify/require"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+	"go.uber.org/mock/gomock"
+)
+
+// newTestBzlProgram compiles a .bzl file, so that its load()
+// statements can be inspected.
+func newTestBzlProgram(t *testing.T, filename, source string) *starlark.Program {
+	_, program, err := starlark.SourceProgramOptions(
+		&syntax.FileOptions{},
+		filename,
+		source,
+		func(string) bool { return true },
+	)
+	require.NoError(t, err)
+	return program
+}
+
+func TestCheckBzlLoadVisibility(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	c := newTestBaseComputer(testObjectDownloader{})
+
+	e := NewMockCompiledBzlFileEnvironment(ctrl)
+	e.EXPECT().GetCanonicalRepoNameValue(gomock.Any()).
+		DoAndReturn(getTestValueFromMap(
+			map[string]string{
+				"rules_cc": "rules_cc+",
+			},
+			// The repo from which the apparent repo is
+			// referenced is ignored.
+			(*model_analysis_pb.CanonicalRepoName_Key).GetToApparentRepo,
+			func(canonicalRepo string) *model_analysis_pb.CanonicalRepoName_Value {
+				return &model_analysis_pb.CanonicalRepoName_Value{ToCanonicalRepo: canonicalRepo}
+			},
+		)).AnyTimes()
+	compiledBzlFiles := map[string]*model_analysis_pb.CompiledBzlFile_Value{
+		// File that did not call visibility().
+		"@@example+//lib:public.bzl": {},
+		// File that is only visible to //lib/...
+		"@@example+//lib:private.bzl": {
+			LoadVisibility: newTestPackageGroup(t, model_starlark.PackageSpecification{
+				Package:            label.MustNewCanonicalPackage("@@example+//lib"),
+				IncludeSubpackages: true,
+			}),
+		},
+		// File that is visible to all packages.
+		"@@rules_cc+//cc:defs.bzl": {
+			LoadVisibility: newTestPackageGroup(t, model_starlark.PackageSpecification{
+				AllPackages: true,
+			}),
+		},
+	}
+	e.EXPECT().GetCompiledBzlFileValue(gomock.Any()).
+		DoAndReturn(getTestValueFromMap(
+			compiledBzlFiles,
+			(*model_analysis_pb.CompiledBzlFile_Key).GetLabel,
+			func(compiledBzlFile *model_analysis_pb.CompiledBzlFile_Value) *model_analysis_pb.CompiledBzlFile_Value {
+				return compiledBzlFile
+			},
+		)).AnyTimes()
+
+	t.Run("Visible", func(t *testing.T) {
+		fromLabel := label.MustNewCanonicalLabel("@@example+//lib/sub:defs.bzl")
+		require.NoError(t, c.checkBzlLoadVisibility(ctx, e, fromLabel, newTestBzlProgram(t, fromLabel.String(), `
+load("//lib:private.bzl", "a")
+load("//lib:public.bzl", "b")
+load("@rules_cc//cc:defs.bzl", "c")
+`), nil))
+	})
+
+	t.Run("SamePackage", func(t *testing.T) {
+		// Loading files within the same package is always
+		// permitted, even if they have not been compiled yet.
+		fromLabel := label.MustNewCanonicalLabel("@@example+//app:defs.bzl")
+		require.NoError(t, c.checkBzlLoadVisibility(ctx, e, fromLabel, newTestBzlProgram(t, fromLabel.String(), `
+load(":helpers.bzl", "a")
+`), nil))
+	})
+
+	t.Run("NotVisible", func(t *testing.T) {
+		fromLabel := label.MustNewCanonicalLabel("@@example+//app:BUILD.bazel")
+		require.EqualError(t, c.checkBzlLoadVisibility(ctx, e, fromLabel, newTestBzlProgram(t, fromLabel.String(), `
+load("//lib:public.bzl", "a")
+load("//lib:private.bzl", "b")
+`), nil), "file \"@@example+//lib:private.bzl\" cannot be loaded by \"@@example+//app:BUILD.bazel\", as package \"@@example+//app\" is not included in the file's load visibility")
+	})
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		fromLabel := label.MustNewCanonicalLabel("@@example+//app:defs.bzl")
+		require.Equal(t, evaluation.ErrMissingDependency, c.checkBzlLoadVisibility(ctx, e, fromLabel, newTestBzlProgram(t, fromLabel.String(), `
+load("//lib:unknown.bzl", "a")
+`), nil))
+	})
+}
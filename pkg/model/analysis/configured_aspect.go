@@ -0,0 +1,459 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+
+	"go.starlark.net/starlark"
+)
+
+// propagatingAspect contains the properties of an aspect that are
+// needed to determine whether it propagates along an attribute of a
+// rule target.
+type propagatingAspect struct {
+	identifier  string
+	attrAspects []string
+}
+
+func (pa *propagatingAspect) propagatesAlong(attrName string) bool {
+	for _, attrAspect := range pa.attrAspects {
+		if attrAspect == "*" || attrAspect == attrName {
+			return true
+		}
+	}
+	return false
+}
+
+type getAspectDefinitionEnvironment interface {
+	GetCompiledBzlFileGlobalValue(*model_analysis_pb.CompiledBzlFileGlobal_Key) model_core.Message[*model_analysis_pb.CompiledBzlFileGlobal_Value]
+}
+
+// getAspectDefinition looks up the definition of an aspect, given the
+// identifier under which it was declared.
+func getAspectDefinition(e getAspectDefinitionEnvironment, aspectIdentifier string) (model_core.Message[*model_starlark_pb.Aspect_Definition], error) {
+	aspectValue := e.GetCompiledBzlFileGlobalValue(&model_analysis_pb.CompiledBzlFileGlobal_Key{
+		Identifier: aspectIdentifier,
+	})
+	if !aspectValue.IsSet() {
+		return model_core.Message[*model_starlark_pb.Aspect_Definition]{}, evaluation.ErrMissingDependency
+	}
+	v, ok := aspectValue.Message.Global.GetKind().(*model_starlark_pb.Value_Aspect)
+	if !ok {
+		return model_core.Message[*model_starlark_pb.Aspect_Definition]{}, fmt.Errorf("%#v is not an aspect", aspectIdentifier)
+	}
+	d, ok := v.Aspect.Kind.(*model_starlark_pb.Aspect_Definition_)
+	if !ok {
+		return model_core.Message[*model_starlark_pb.Aspect_Definition]{}, fmt.Errorf("%#v is not an aspect definition", aspectIdentifier)
+	}
+	return model_core.Message[*model_starlark_pb.Aspect_Definition]{
+		Message:            d.Definition,
+		OutgoingReferences: aspectValue.OutgoingReferences,
+	}, nil
+}
+
+// appendAspectWithRequirements appends an aspect to a list of aspects
+// that need to be applied to a target. Aspects that are required by the
+// aspect through aspect(requires = ...) are added before it. Aspects
+// that are already present in the list are not added again.
+func appendAspectWithRequirements(e getAspectDefinitionEnvironment, aspectIdentifiers []string, aspectIdentifier string, visiting map[string]struct{}) ([]string, error) {
+	if slices.Contains(aspectIdentifiers, aspectIdentifier) {
+		return aspectIdentifiers, nil
+	}
+	if _, ok := visiting[aspectIdentifier]; ok {
+		return nil, fmt.Errorf("aspect %#v transitively requires itself", aspectIdentifier)
+	}
+	visiting[aspectIdentifier] = struct{}{}
+	defer delete(visiting, aspectIdentifier)
+
+	aspectDefinition, err := getAspectDefinition(e, aspectIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	for _, requiredAspectIdentifier := range aspectDefinition.Message.Requires {
+		aspectIdentifiers, err = appendAspectWithRequirements(e, aspectIdentifiers, requiredAspectIdentifier, visiting)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return append(aspectIdentifiers, aspectIdentifier), nil
+}
+
+// getAspectIdentifiersForAttr returns the identifiers of the aspects
+// that need to be applied to targets referenced by an attr, in the
+// order in which they need to be applied. These include aspects that
+// are propagated along the attr, followed by the ones that are listed
+// in the attr's definition.
+func (rc *ruleContext) getAspectIdentifiersForAttr(attrName string, attrAspectIdentifiers []string) ([]string, error) {
+	var aspectIdentifiers []string
+	for _, aspect := range rc.propagatingAspects {
+		if aspect.propagatesAlong(attrName) {
+			aspectIdentifiers = append(aspectIdentifiers, aspect.identifier)
+		}
+	}
+
+	missingDependencies := false
+	visiting := map[string]struct{}{}
+	for _, aspectIdentifier := range attrAspectIdentifiers {
+		newAspectIdentifiers, err := appendAspectWithRequirements(rc.environment, aspectIdentifiers, aspectIdentifier, visiting)
+		if err != nil {
+			if errors.Is(err, evaluation.ErrMissingDependency) {
+				missingDependencies = true
+				continue
+			}
+			return nil, fmt.Errorf("attr %#v: %w", attrName, err)
+		}
+		aspectIdentifiers = newAspectIdentifiers
+	}
+	if missingDependencies {
+		return nil, evaluation.ErrMissingDependency
+	}
+	return aspectIdentifiers, nil
+}
+
+type applyAspectsToConfiguredTargetEnvironment interface {
+	GetConfiguredAspectValue(key model_core.PatchedMessage[*model_analysis_pb.ConfiguredAspect_Key, dag.ObjectContentsWalker]) model_core.Message[*model_analysis_pb.ConfiguredAspect_Value]
+}
+
+// applyAspectsToConfiguredTarget applies a sequence of aspects to a
+// configured target, returning the provider instances of the target
+// combined with the ones produced by the aspects.
+func applyAspectsToConfiguredTarget(e applyAspectsToConfiguredTargetEnvironment, targetLabel string, configurationReference model_core.Message[*model_core_pb.Reference], providerInstances model_core.Message[[]*model_starlark_pb.Struct], aspectIdentifiers []string) (model_core.Message[[]*model_starlark_pb.Struct], error) {
+	allProviderInstances := []model_core.Message[[]*model_starlark_pb.Struct]{providerInstances}
+	missingDependencies := false
+	for i := range aspectIdentifiers {
+		patchedConfigurationReference := model_core.NewPatchedMessageFromExisting(
+			configurationReference,
+			func(index int) dag.ObjectContentsWalker {
+				return dag.ExistingObjectContentsWalker
+			},
+		)
+		configuredAspect := e.GetConfiguredAspectValue(
+			model_core.PatchedMessage[*model_analysis_pb.ConfiguredAspect_Key, dag.ObjectContentsWalker]{
+				Message: &model_analysis_pb.ConfiguredAspect_Key{
+					Label:                  targetLabel,
+					ConfigurationReference: patchedConfigurationReference.Message,
+					AspectIdentifiers:      aspectIdentifiers[:i+1],
+				},
+				Patcher: patchedConfigurationReference.Patcher,
+			},
+		)
+		if !configuredAspect.IsSet() {
+			missingDependencies = true
+			continue
+		}
+		allProviderInstances = append(allProviderInstances, model_core.Message[[]*model_starlark_pb.Struct]{
+			Message:            configuredAspect.Message.ProviderInstances,
+			OutgoingReferences: configuredAspect.OutgoingReferences,
+		})
+	}
+	if missingDependencies {
+		return model_core.Message[[]*model_starlark_pb.Struct]{}, evaluation.ErrMissingDependency
+	}
+	return mergeProviderInstances(targetLabel, allProviderInstances)
+}
+
+// mergeProviderInstances combines multiple lists of provider instances
+// into a single list that is sorted by provider identifier. An error is
+// returned if multiple lists contain an instance of the same provider.
+func mergeProviderInstances(targetLabel string, lists []model_core.Message[[]*model_starlark_pb.Struct]) (model_core.Message[[]*model_starlark_pb.Struct], error) {
+	nonEmptyLists := 0
+	var lastNonEmptyList model_core.Message[[]*model_starlark_pb.Struct]
+	for _, list := range lists {
+		if len(list.Message) > 0 {
+			nonEmptyLists++
+			lastNonEmptyList = list
+		}
+	}
+	if nonEmptyLists <= 1 {
+		// No need to merge anything.
+		return lastNonEmptyList, nil
+	}
+
+	var mergedProviderInstances []*model_starlark_pb.Struct
+	patcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
+	for _, list := range lists {
+		for _, providerInstance := range list.Message {
+			patchedProviderInstance := model_core.NewPatchedMessageFromExisting(
+				model_core.Message[*model_starlark_pb.Struct]{
+					Message:            providerInstance,
+					OutgoingReferences: list.OutgoingReferences,
+				},
+				func(index int) dag.ObjectContentsWalker {
+					return dag.ExistingObjectContentsWalker
+				},
+			)
+			mergedProviderInstances = append(mergedProviderInstances, patchedProviderInstance.Message)
+			patcher.Merge(patchedProviderInstance.Patcher)
+		}
+	}
+
+	sort.SliceStable(mergedProviderInstances, func(i, j int) bool {
+		return mergedProviderInstances[i].ProviderInstanceProperties.GetProviderIdentifier() <
+			mergedProviderInstances[j].ProviderInstanceProperties.GetProviderIdentifier()
+	})
+	for i := 1; i < len(mergedProviderInstances); i++ {
+		if providerIdentifier := mergedProviderInstances[i].ProviderInstanceProperties.GetProviderIdentifier(); providerIdentifier == mergedProviderInstances[i-1].ProviderInstanceProperties.GetProviderIdentifier() {
+			patcher.Discard()
+			return model_core.Message[[]*model_starlark_pb.Struct]{}, fmt.Errorf("provider %#v is provided by target %#v and one of the aspects applied to it, or by multiple aspects", providerIdentifier, targetLabel)
+		}
+	}
+
+	merged, _ := model_core.NewPatchedMessage(mergedProviderInstances, patcher).SortAndSetReferences()
+	return merged, nil
+}
+
+// providerSetsAreSatisfied returns true if a list of provider instances
+// contains instances of all providers in at least one of the provided
+// provider sets.
+func providerSetsAreSatisfied(providerSets []*model_starlark_pb.Aspect_ProviderSet, providerInstances []*model_starlark_pb.Struct) bool {
+ProviderSet:
+	for _, providerSet := range providerSets {
+		for _, providerIdentifier := range providerSet.ProviderIdentifiers {
+			if _, ok := sort.Find(
+				len(providerInstances),
+				func(i int) int {
+					return strings.Compare(providerIdentifier, providerInstances[i].ProviderInstanceProperties.GetProviderIdentifier())
+				},
+			); !ok {
+				continue ProviderSet
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (c *baseComputer) ComputeConfiguredAspectValue(ctx context.Context, key model_core.Message[*model_analysis_pb.ConfiguredAspect_Key], e ConfiguredAspectEnvironment) (PatchedConfiguredAspectValue, error) {
+	aspectIdentifiers := key.Message.AspectIdentifiers
+	if len(aspectIdentifiers) == 0 {
+		return PatchedConfiguredAspectValue{}, errors.New("no aspect identifiers provided")
+	}
+	aspectIdentifierStr := aspectIdentifiers[len(aspectIdentifiers)-1]
+	aspectIdentifier, err := label.NewCanonicalStarlarkIdentifier(aspectIdentifierStr)
+	if err != nil {
+		return PatchedConfiguredAspectValue{}, fmt.Errorf("invalid aspect identifier %#v: %w", aspectIdentifierStr, err)
+	}
+	targetLabel, err := label.NewCanonicalLabel(key.Message.Label)
+	if err != nil {
+		return PatchedConfiguredAspectValue{}, fmt.Errorf("invalid target label: %w", err)
+	}
+	targetLabelStr := targetLabel.String()
+
+	targetValue := e.GetTargetValue(&model_analysis_pb.Target_Key{
+		Label: targetLabelStr,
+	})
+	if !targetValue.IsSet() {
+		return PatchedConfiguredAspectValue{}, evaluation.ErrMissingDependency
+	}
+	ruleTarget, ok := targetValue.Message.Definition.GetKind().(*model_starlark_pb.Target_Definition_RuleTarget)
+	if !ok {
+		// Aspects are only applied to rule targets.
+		return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.ConfiguredAspect_Value{}), nil
+	}
+	ruleIdentifier, err := label.NewCanonicalStarlarkIdentifier(ruleTarget.RuleTarget.RuleIdentifier)
+	if err != nil {
+		return PatchedConfiguredAspectValue{}, fmt.Errorf("invalid rule identifier: %w", err)
+	}
+
+	// Obtain the definitions of all aspects that are applied to the
+	// target, as each of them may propagate to the target's
+	// dependencies.
+	missingDependencies := false
+	aspectDefinitions := make([]model_core.Message[*model_starlark_pb.Aspect_Definition], 0, len(aspectIdentifiers))
+	propagatingAspects := make([]propagatingAspect, 0, len(aspectIdentifiers))
+	for _, identifier := range aspectIdentifiers {
+		aspectDefinition, err := getAspectDefinition(e, identifier)
+		if err != nil {
+			if errors.Is(err, evaluation.ErrMissingDependency) {
+				missingDependencies = true
+				continue
+			}
+			return PatchedConfiguredAspectValue{}, err
+		}
+		aspectDefinitions = append(aspectDefinitions, aspectDefinition)
+		propagatingAspects = append(propagatingAspects, propagatingAspect{
+			identifier:  identifier,
+			attrAspects: aspectDefinition.Message.AttrAspects,
+		})
+	}
+
+	configurationReference := model_core.Message[*model_core_pb.Reference]{
+		Message:            key.Message.ConfigurationReference,
+		OutgoingReferences: key.OutgoingReferences,
+	}
+	patchedConfigurationReference := model_core.NewPatchedMessageFromExisting(
+		configurationReference,
+		func(index int) dag.ObjectContentsWalker {
+			return dag.ExistingObjectContentsWalker
+		},
+	)
+	configuredTarget := e.GetConfiguredTargetValue(
+		model_core.PatchedMessage[*model_analysis_pb.ConfiguredTarget_Key, dag.ObjectContentsWalker]{
+			Message: &model_analysis_pb.ConfiguredTarget_Key{
+				Label:                  targetLabelStr,
+				ConfigurationReference: patchedConfigurationReference.Message,
+			},
+			Patcher: patchedConfigurationReference.Patcher,
+		},
+	)
+	allBuiltinsModulesNames := e.GetBuiltinsModuleNamesValue(&model_analysis_pb.BuiltinsModuleNames_Key{})
+	if missingDependencies || !configuredTarget.IsSet() || !allBuiltinsModulesNames.IsSet() {
+		return PatchedConfiguredAspectValue{}, evaluation.ErrMissingDependency
+	}
+
+	// Only apply the aspect if the target yields the providers
+	// that the aspect requires.
+	aspectDefinition := aspectDefinitions[len(aspectDefinitions)-1]
+	if requiredProviders := aspectDefinition.Message.RequiredProviders; len(requiredProviders) > 0 && !providerSetsAreSatisfied(requiredProviders, configuredTarget.Message.ProviderInstances) {
+		return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.ConfiguredAspect_Value{}), nil
+	}
+
+	// Providers of aspects that were applied to the target before
+	// this aspect are only visible if they are explicitly requested
+	// through required_aspect_providers.
+	visibleProviderInstances := []model_core.Message[[]*model_starlark_pb.Struct]{{
+		Message:            configuredTarget.Message.ProviderInstances,
+		OutgoingReferences: configuredTarget.OutgoingReferences,
+	}}
+	if requiredAspectProviders := aspectDefinition.Message.RequiredAspectProviders; len(requiredAspectProviders) > 0 {
+		for i := range aspectIdentifiers[:len(aspectIdentifiers)-1] {
+			patchedConfigurationReference := model_core.NewPatchedMessageFromExisting(
+				configurationReference,
+				func(index int) dag.ObjectContentsWalker {
+					return dag.ExistingObjectContentsWalker
+				},
+			)
+			baseAspect := e.GetConfiguredAspectValue(
+				model_core.PatchedMessage[*model_analysis_pb.ConfiguredAspect_Key, dag.ObjectContentsWalker]{
+					Message: &model_analysis_pb.ConfiguredAspect_Key{
+						Label:                  targetLabelStr,
+						ConfigurationReference: patchedConfigurationReference.Message,
+						AspectIdentifiers:      aspectIdentifiers[:i+1],
+					},
+					Patcher: patchedConfigurationReference.Patcher,
+				},
+			)
+			if !baseAspect.IsSet() {
+				missingDependencies = true
+				continue
+			}
+			if providerSetsAreSatisfied(requiredAspectProviders, baseAspect.Message.ProviderInstances) {
+				visibleProviderInstances = append(visibleProviderInstances, model_core.Message[[]*model_starlark_pb.Struct]{
+					Message:            baseAspect.Message.ProviderInstances,
+					OutgoingReferences: baseAspect.OutgoingReferences,
+				})
+			}
+		}
+		if missingDependencies {
+			return PatchedConfiguredAspectValue{}, evaluation.ErrMissingDependency
+		}
+	}
+	targetProviderInstances, err := mergeProviderInstances(targetLabelStr, visibleProviderInstances)
+	if err != nil {
+		return PatchedConfiguredAspectValue{}, err
+	}
+
+	// Create a context for the rule target, which is exposed to
+	// the aspect as ctx.rule. Dependencies of the rule target have
+	// all aspects applied that propagate along the attr.
+	ruleTargetMessage := model_core.Message[*model_starlark_pb.RuleTarget]{
+		Message:            ruleTarget.RuleTarget,
+		OutgoingReferences: targetValue.OutgoingReferences,
+	}
+	ruleDefinition, err := getRuleTargetDefinition(e, ruleIdentifier, ruleTargetMessage)
+	if err != nil {
+		return PatchedConfiguredAspectValue{}, err
+	}
+	ruleConfigurationReference, err := applyIncomingEdgeTransition(e, ruleIdentifier, ruleDefinition, targetLabel, ruleTargetMessage, configurationReference)
+	if err != nil {
+		return PatchedConfiguredAspectValue{}, err
+	}
+	rc := c.newRuleContext(ctx, e, ruleIdentifier, targetLabel, ruleConfigurationReference, ruleDefinition, ruleTargetMessage)
+	rc.propagatingAspects = propagatingAspects
+
+	// Create a context for the aspect itself. Aspects have
+	// attributes and exec groups like rules do, meaning we can
+	// represent the aspect as if it were a rule.
+	arc := c.newRuleContext(
+		ctx,
+		e,
+		aspectIdentifier,
+		targetLabel,
+		ruleConfigurationReference,
+		model_core.Message[*model_starlark_pb.Rule_Definition]{
+			Message: &model_starlark_pb.Rule_Definition{
+				Attrs:          aspectDefinition.Message.Attrs,
+				ExecGroups:     aspectDefinition.Message.ExecGroups,
+				Implementation: aspectDefinition.Message.Implementation,
+			},
+			OutgoingReferences: aspectDefinition.OutgoingReferences,
+		},
+		ruleTargetMessage,
+	)
+	arc.rule = rc
+
+	thread := c.newStarlarkThread(ctx, e, allBuiltinsModulesNames.Message.BuiltinsModuleNames)
+	returnValue, err := starlark.Call(
+		thread,
+		model_starlark.NewNamedFunction(
+			model_starlark.NewProtoNamedFunctionDefinition(
+				model_core.Message[*model_starlark_pb.Function]{
+					Message:            aspectDefinition.Message.Implementation,
+					OutgoingReferences: aspectDefinition.OutgoingReferences,
+				},
+			),
+		),
+		/* args = */ starlark.Tuple{
+			model_starlark.NewTargetReference(targetLabel.AsResolved(), targetProviderInstances),
+			arc,
+		},
+		/* kwargs = */ nil,
+	)
+	if err != nil {
+		if !errors.Is(err, evaluation.ErrMissingDependency) {
+			var evalErr *starlark.EvalError
+			if errors.As(err, &evalErr) {
+				return PatchedConfiguredAspectValue{}, errors.New(evalErr.Backtrace())
+			}
+		}
+		return PatchedConfiguredAspectValue{}, err
+	}
+
+	providerInstancesByIdentifier, err := getProviderInstancesFromReturnValue(thread, returnValue)
+	if err != nil {
+		return PatchedConfiguredAspectValue{}, err
+	}
+	for _, providerIdentifierStr := range aspectDefinition.Message.Provides {
+		providerIdentifier, err := label.NewCanonicalStarlarkIdentifier(providerIdentifierStr)
+		if err != nil {
+			return PatchedConfiguredAspectValue{}, fmt.Errorf("invalid provider identifier %#v: %w", providerIdentifierStr, err)
+		}
+		if _, ok := providerInstancesByIdentifier[providerIdentifier]; !ok {
+			return PatchedConfiguredAspectValue{}, fmt.Errorf("implementation function of aspect %#v did not return provider %#v", aspectIdentifierStr, providerIdentifierStr)
+		}
+	}
+
+	encodedProviderInstances, err := c.encodeProviderInstances(targetLabel, providerInstancesByIdentifier)
+	if err != nil {
+		return PatchedConfiguredAspectValue{}, err
+	}
+	return model_core.NewPatchedMessage(
+		&model_analysis_pb.ConfiguredAspect_Value{
+			ProviderInstances: encodedProviderInstances.Message,
+		},
+		encodedProviderInstances.Patcher,
+	), nil
+}
@@ -0,0 +1,274 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func newTestAspectValue(requires ...string) *model_starlark_pb.Value {
+	return &model_starlark_pb.Value{
+		Kind: &model_starlark_pb.Value_Aspect{
+			Aspect: &model_starlark_pb.Aspect{
+				Kind: &model_starlark_pb.Aspect_Definition_{
+					Definition: &model_starlark_pb.Aspect_Definition{
+						Requires: requires,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRuleContextGetAspectIdentifiersForAttr(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	e := NewMockConfiguredTargetEnvironment(ctrl)
+	compiledBzlFileGlobals := map[string]*model_starlark_pb.Value{
+		"@@example+//:aspects.bzl%compile": newTestAspectValue("@@example+//:aspects.bzl%collect"),
+		"@@example+//:aspects.bzl%collect": newTestAspectValue(),
+		"@@example+//:aspects.bzl%cycle1":  newTestAspectValue("@@example+//:aspects.bzl%cycle2"),
+		"@@example+//:aspects.bzl%cycle2":  newTestAspectValue("@@example+//:aspects.bzl%cycle1"),
+		"@@example+//:aspects.bzl%lint":    newTestAspectValue(),
+		"@@example+//:rules.bzl%my_rule": {
+			Kind: &model_starlark_pb.Value_Str{Str: "Not an aspect"},
+		},
+	}
+	e.EXPECT().GetCompiledBzlFileGlobalValue(gomock.Any()).
+		DoAndReturn(getTestValueFromMap(
+			compiledBzlFileGlobals,
+			(*model_analysis_pb.CompiledBzlFileGlobal_Key).GetIdentifier,
+			func(global *model_starlark_pb.Value) *model_analysis_pb.CompiledBzlFileGlobal_Value {
+				return &model_analysis_pb.CompiledBzlFileGlobal_Value{Global: global}
+			},
+		)).AnyTimes()
+
+	rc := &ruleContext{
+		environment: e,
+		propagatingAspects: []propagatingAspect{
+			{
+				identifier:  "@@example+//:aspects.bzl%lint",
+				attrAspects: []string{"deps", "srcs"},
+			},
+			{
+				identifier:  "@@example+//:aspects.bzl%collect",
+				attrAspects: []string{"*"},
+			},
+		},
+	}
+
+	t.Run("PropagatingAspects", func(t *testing.T) {
+		// Aspects that are currently being applied should be
+		// propagated along attrs listed in attr_aspects.
+		aspectIdentifiers, err := rc.getAspectIdentifiersForAttr("deps", nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"@@example+//:aspects.bzl%lint",
+			"@@example+//:aspects.bzl%collect",
+		}, aspectIdentifiers)
+
+		aspectIdentifiers, err = rc.getAspectIdentifiersForAttr("toolchain", nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"@@example+//:aspects.bzl%collect",
+		}, aspectIdentifiers)
+	})
+
+	t.Run("AttrAspects", func(t *testing.T) {
+		// Aspects listed in the attr's definition should be
+		// applied after the propagating ones. Aspects that are
+		// required by them should be applied before them, but
+		// only if they are not already applied.
+		aspectIdentifiers, err := rc.getAspectIdentifiersForAttr("data", []string{
+			"@@example+//:aspects.bzl%compile",
+			"@@example+//:aspects.bzl%lint",
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"@@example+//:aspects.bzl%collect",
+			"@@example+//:aspects.bzl%compile",
+			"@@example+//:aspects.bzl%lint",
+		}, aspectIdentifiers)
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		_, err := rc.getAspectIdentifiersForAttr("deps", []string{
+			"@@example+//:aspects.bzl%cycle1",
+		})
+		require.EqualError(t, err, "attr \"deps\": aspect \"@@example+//:aspects.bzl%cycle1\" transitively requires itself")
+	})
+
+	t.Run("NotAnAspect", func(t *testing.T) {
+		_, err := rc.getAspectIdentifiersForAttr("deps", []string{
+			"@@example+//:rules.bzl%my_rule",
+		})
+		require.EqualError(t, err, "attr \"deps\": \"@@example+//:rules.bzl%my_rule\" is not an aspect")
+	})
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		_, err := rc.getAspectIdentifiersForAttr("deps", []string{
+			"@@example+//:aspects.bzl%unknown",
+		})
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+	})
+}
+
+// expectConfiguredAspect sets up an expectation for a ConfiguredAspect
+// value to be requested for target "@@example+//:foo", having a given
+// list of aspects applied.
+func expectConfiguredAspect(t *testing.T, e *MockConfiguredTargetEnvironment, aspectIdentifiers []string, providerInstances []*model_starlark_pb.Struct) *gomock.Call {
+	return e.EXPECT().GetConfiguredAspectValue(gomock.Any()).
+		DoAndReturn(func(key model_core.PatchedMessage[*model_analysis_pb.ConfiguredAspect_Key, dag.ObjectContentsWalker]) model_core.Message[*model_analysis_pb.ConfiguredAspect_Value] {
+			require.Equal(t, "@@example+//:foo", key.Message.Label)
+			require.Equal(t, aspectIdentifiers, key.Message.AspectIdentifiers)
+			return newTestMessage(&model_analysis_pb.ConfiguredAspect_Value{ProviderInstances: providerInstances}, providerInstances != nil)
+		})
+}
+
+func TestApplyAspectsToConfiguredTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	targetProviderInstances := model_core.NewSimpleMessage([]*model_starlark_pb.Struct{
+		newTestProviderInstance("@@builtins_core+//:exports.bzl%DefaultInfo", nil),
+		newTestProviderInstance("@@rules_cc+//cc:defs.bzl%CcInfo", nil),
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// Each aspect should be requested with the list of
+		// aspects that were applied before it, so that aspects
+		// can see the providers of the ones they require.
+		e := NewMockConfiguredTargetEnvironment(ctrl)
+		gomock.InOrder(
+			expectConfiguredAspect(t, e, []string{
+				"@@example+//:aspects.bzl%collect",
+			}, []*model_starlark_pb.Struct{
+				newTestProviderInstance("@@example+//:aspects.bzl%CollectInfo", nil),
+			}),
+			expectConfiguredAspect(t, e, []string{
+				"@@example+//:aspects.bzl%collect",
+				"@@example+//:aspects.bzl%compile",
+			}, []*model_starlark_pb.Struct{
+				newTestProviderInstance("@@example+//:aspects.bzl%AnotherInfo", nil),
+				newTestProviderInstance("@@example+//:aspects.bzl%CompileInfo", nil),
+			}),
+		)
+
+		providerInstances, err := applyAspectsToConfiguredTarget(
+			e,
+			"@@example+//:foo",
+			model_core.NewSimpleMessage[*model_core_pb.Reference](nil),
+			targetProviderInstances,
+			[]string{
+				"@@example+//:aspects.bzl%collect",
+				"@@example+//:aspects.bzl%compile",
+			},
+		)
+		require.NoError(t, err)
+
+		// Provider instances should be merged and sorted by
+		// provider identifier.
+		providerIdentifiers := make([]string, 0, len(providerInstances.Message))
+		for _, providerInstance := range providerInstances.Message {
+			providerIdentifiers = append(providerIdentifiers, providerInstance.ProviderInstanceProperties.ProviderIdentifier)
+		}
+		require.Equal(t, []string{
+			"@@builtins_core+//:exports.bzl%DefaultInfo",
+			"@@example+//:aspects.bzl%AnotherInfo",
+			"@@example+//:aspects.bzl%CollectInfo",
+			"@@example+//:aspects.bzl%CompileInfo",
+			"@@rules_cc+//cc:defs.bzl%CcInfo",
+		}, providerIdentifiers)
+	})
+
+	t.Run("NoAspects", func(t *testing.T) {
+		providerInstances, err := applyAspectsToConfiguredTarget(
+			NewMockConfiguredTargetEnvironment(ctrl),
+			"@@example+//:foo",
+			model_core.NewSimpleMessage[*model_core_pb.Reference](nil),
+			targetProviderInstances,
+			nil,
+		)
+		require.NoError(t, err)
+		require.Len(t, providerInstances.Message, 2)
+		for i, providerInstance := range providerInstances.Message {
+			testutil.RequireEqualProto(t, targetProviderInstances.Message[i], providerInstance)
+		}
+	})
+
+	t.Run("DuplicateProvider", func(t *testing.T) {
+		e := NewMockConfiguredTargetEnvironment(ctrl)
+		expectConfiguredAspect(t, e, []string{
+			"@@example+//:aspects.bzl%cc",
+		}, []*model_starlark_pb.Struct{
+			newTestProviderInstance("@@rules_cc+//cc:defs.bzl%CcInfo", nil),
+		})
+
+		_, err := applyAspectsToConfiguredTarget(
+			e,
+			"@@example+//:foo",
+			model_core.NewSimpleMessage[*model_core_pb.Reference](nil),
+			targetProviderInstances,
+			[]string{"@@example+//:aspects.bzl%cc"},
+		)
+		require.EqualError(t, err, "provider \"@@rules_cc+//cc:defs.bzl%CcInfo\" is provided by target \"@@example+//:foo\" and one of the aspects applied to it, or by multiple aspects")
+	})
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		e := NewMockConfiguredTargetEnvironment(ctrl)
+		expectConfiguredAspect(t, e, []string{
+			"@@example+//:aspects.bzl%collect",
+		}, nil)
+
+		_, err := applyAspectsToConfiguredTarget(
+			e,
+			"@@example+//:foo",
+			model_core.NewSimpleMessage[*model_core_pb.Reference](nil),
+			targetProviderInstances,
+			[]string{"@@example+//:aspects.bzl%collect"},
+		)
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+	})
+}
+
+func TestProviderSetsAreSatisfied(t *testing.T) {
+	providerInstances := []*model_starlark_pb.Struct{
+		newTestProviderInstance("@@builtins_core+//:exports.bzl%DefaultInfo", nil),
+		newTestProviderInstance("@@rules_cc+//cc:defs.bzl%CcInfo", nil),
+	}
+
+	t.Run("NoProviderSets", func(t *testing.T) {
+		require.False(t, providerSetsAreSatisfied(nil, providerInstances))
+	})
+
+	t.Run("EmptyProviderSet", func(t *testing.T) {
+		require.True(t, providerSetsAreSatisfied([]*model_starlark_pb.Aspect_ProviderSet{{}}, providerInstances))
+	})
+
+	t.Run("Satisfied", func(t *testing.T) {
+		require.True(t, providerSetsAreSatisfied([]*model_starlark_pb.Aspect_ProviderSet{
+			{ProviderIdentifiers: []string{"@@rules_java+//java:defs.bzl%JavaInfo"}},
+			{ProviderIdentifiers: []string{
+				"@@builtins_core+//:exports.bzl%DefaultInfo",
+				"@@rules_cc+//cc:defs.bzl%CcInfo",
+			}},
+		}, providerInstances))
+	})
+
+	t.Run("PartiallySatisfied", func(t *testing.T) {
+		require.False(t, providerSetsAreSatisfied([]*model_starlark_pb.Aspect_ProviderSet{
+			{ProviderIdentifiers: []string{
+				"@@rules_cc+//cc:defs.bzl%CcInfo",
+				"@@rules_java+//java:defs.bzl%JavaInfo",
+			}},
+		}, providerInstances))
+	})
+}
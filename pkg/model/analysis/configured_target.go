@@ -376,12 +376,20 @@ func (c *baseComputer) ComputeConfiguredTargetValue(ctx context.Context, key mod
 			},
 		), nil
 	case *model_starlark_pb.Target_Definition_PredeclaredOutputFileTarget:
-		// Handcraft a DefaultInfo provider for this source file.
-		return getSingleFileConfiguredTargetValue(&model_starlark_pb.File{
-			Owner: &model_starlark_pb.File_Owner{
-				Cfg:        []byte{0xbe, 0x8a, 0x60, 0x1c, 0xe3, 0x03, 0x44, 0xf0},
-				TargetName: targetKind.PredeclaredOutputFileTarget.OwnerTargetName,
+		// Handcraft a DefaultInfo provider for this output file.
+		outputDirectory, err := c.getOutputDirectory(
+			ctx,
+			e,
+			model_core.Message[*model_core_pb.Reference]{
+				Message:            key.Message.ConfigurationReference,
+				OutgoingReferences: key.OutgoingReferences,
 			},
+		)
+		if err != nil {
+			return PatchedConfiguredTargetValue{}, err
+		}
+		return getSingleFileConfiguredTargetValue(&model_starlark_pb.File{
+			Owner:               outputDirectory.newFileOwner(targetKind.PredeclaredOutputFileTarget.OwnerTargetName),
 			Package:             targetLabel.GetCanonicalPackage().String(),
 			PackageRelativePath: targetLabel.GetTargetName().String(),
 			Type:                model_starlark_pb.File_FILE,
@@ -393,88 +401,46 @@ func (c *baseComputer) ComputeConfiguredTargetValue(ctx context.Context, key mod
 			return PatchedConfiguredTargetValue{}, evaluation.ErrMissingDependency
 		}
 
-		allBuiltinsModulesNames := e.GetBuiltinsModuleNamesValue(&model_analysis_pb.BuiltinsModuleNames_Key{})
-		ruleValue := e.GetCompiledBzlFileGlobalValue(&model_analysis_pb.CompiledBzlFileGlobal_Key{
-			Identifier: ruleIdentifier.String(),
-		})
-		if !allBuiltinsModulesNames.IsSet() || !ruleValue.IsSet() {
-			return PatchedConfiguredTargetValue{}, evaluation.ErrMissingDependency
-		}
-		v, ok := ruleValue.Message.Global.GetKind().(*model_starlark_pb.Value_Rule)
-		if !ok {
-			return PatchedConfiguredTargetValue{}, fmt.Errorf("%#v is not a rule", ruleIdentifier.String())
+		ruleTargetMessage := model_core.Message[*model_starlark_pb.RuleTarget]{
+			Message:            ruleTarget,
+			OutgoingReferences: targetValue.OutgoingReferences,
 		}
-		d, ok := v.Rule.Kind.(*model_starlark_pb.Rule_Definition_)
-		if !ok {
-			return PatchedConfiguredTargetValue{}, fmt.Errorf("%#v is not a rule definition", ruleIdentifier.String())
+		allBuiltinsModulesNames := e.GetBuiltinsModuleNamesValue(&model_analysis_pb.BuiltinsModuleNames_Key{})
+		ruleDefinition, err := getRuleTargetDefinition(e, ruleIdentifier, ruleTargetMessage)
+		if err != nil {
+			return PatchedConfiguredTargetValue{}, err
 		}
-		ruleDefinition := model_core.Message[*model_starlark_pb.Rule_Definition]{
-			Message:            d.Definition,
-			OutgoingReferences: ruleValue.OutgoingReferences,
+		if !allBuiltinsModulesNames.IsSet() {
+			return PatchedConfiguredTargetValue{}, evaluation.ErrMissingDependency
 		}
 
 		// Determine the configuration to use. If an incoming
 		// edge transition is specified, apply it.
-		configurationReference := model_core.Message[*model_core_pb.Reference]{
-			Message:            key.Message.ConfigurationReference,
-			OutgoingReferences: key.OutgoingReferences,
-		}
-		if cfgTransitionIdentifier := ruleDefinition.Message.CfgTransitionIdentifier; cfgTransitionIdentifier != "" {
-			patchedConfigurationReference := model_core.NewPatchedMessageFromExisting(
-				configurationReference,
-				func(index int) dag.ObjectContentsWalker {
-					return dag.ExistingObjectContentsWalker
-				},
-			)
-			incomingEdgeTransitionValue := e.GetUserDefinedTransitionValue(
-				model_core.PatchedMessage[*model_analysis_pb.UserDefinedTransition_Key, dag.ObjectContentsWalker]{
-					Message: &model_analysis_pb.UserDefinedTransition_Key{
-						TransitionIdentifier:        cfgTransitionIdentifier,
-						InputConfigurationReference: patchedConfigurationReference.Message,
-					},
-					Patcher: patchedConfigurationReference.Patcher,
-				},
-			)
-			if !incomingEdgeTransitionValue.IsSet() {
-				return PatchedConfiguredTargetValue{}, evaluation.ErrMissingDependency
-			}
-			switch result := incomingEdgeTransitionValue.Message.Result.(type) {
-			case *model_analysis_pb.UserDefinedTransition_Value_TransitionDependsOnAttrs:
-				return PatchedConfiguredTargetValue{}, fmt.Errorf("TODO: support incoming edge transitions that depends on attrs")
-			case *model_analysis_pb.UserDefinedTransition_Value_Success_:
-				if l := len(result.Success.Entries); l != 1 {
-					return PatchedConfiguredTargetValue{}, fmt.Errorf("incoming edge transition %#v used by rule %#v is a 1:%d transition, while a 1:1 transition was expected", cfgTransitionIdentifier, ruleIdentifier.String(), l)
-				}
-				configurationReference = model_core.Message[*model_core_pb.Reference]{
-					Message:            result.Success.Entries[0].OutputConfigurationReference,
-					OutgoingReferences: incomingEdgeTransitionValue.OutgoingReferences,
-				}
-			default:
-				return PatchedConfiguredTargetValue{}, fmt.Errorf("incoming edge transition %#v used by rule %#v is not a 1:1 transition", cfgTransitionIdentifier, ruleIdentifier.String())
-			}
+		configurationReference, err := applyIncomingEdgeTransition(
+			e,
+			ruleIdentifier,
+			ruleDefinition,
+			targetLabel,
+			ruleTargetMessage,
+			model_core.Message[*model_core_pb.Reference]{
+				Message:            key.Message.ConfigurationReference,
+				OutgoingReferences: key.OutgoingReferences,
+			},
+		)
+		if err != nil {
+			return PatchedConfiguredTargetValue{}, err
 		}
 
 		thread := c.newStarlarkThread(ctx, e, allBuiltinsModulesNames.Message.BuiltinsModuleNames)
-		rc := &ruleContext{
-			computer:               c,
-			context:                ctx,
-			environment:            e,
-			ruleIdentifier:         ruleIdentifier,
-			targetLabel:            targetLabel,
-			configurationReference: configurationReference,
-			ruleDefinition:         ruleDefinition,
-			ruleTarget: model_core.Message[*model_starlark_pb.RuleTarget]{
-				Message:            ruleTarget,
-				OutgoingReferences: targetValue.OutgoingReferences,
-			},
-			attrs:         make([]starlark.Value, len(ruleDefinition.Message.Attrs)),
-			executables:   make([]starlark.Value, len(ruleDefinition.Message.Attrs)),
-			singleFiles:   make([]starlark.Value, len(ruleDefinition.Message.Attrs)),
-			multipleFiles: make([]starlark.Value, len(ruleDefinition.Message.Attrs)),
-			outputs:       make([]starlark.Value, len(ruleDefinition.Message.Attrs)),
-			execGroups:    make([]*ruleContextExecGroupState, len(ruleDefinition.Message.ExecGroups)),
-			fragments:     map[string]*model_starlark.Struct{},
-		}
+		rc := c.newRuleContext(
+			ctx,
+			e,
+			ruleIdentifier,
+			targetLabel,
+			configurationReference,
+			ruleDefinition,
+			ruleTargetMessage,
+		)
 
 		thread.SetLocal(model_starlark.SubruleInvokerKey, func(subruleIdentifier label.CanonicalStarlarkIdentifier, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
 			// TODO: Subrules are allowed to be nested. Keep a stack!
@@ -583,6 +549,18 @@ func (c *baseComputer) ComputeConfiguredTargetValue(ctx context.Context, key mod
 		)
 		if err != nil {
 			if !errors.Is(err, evaluation.ErrMissingDependency) {
+				// If the target is analyzed as part of an
+				// analysis test that expects failures,
+				// capture the failure in the form of
+				// AnalysisFailureInfo.
+				allowAnalysisFailures, allowErr := c.getAllowAnalysisFailures(ctx, rc.configurationReference)
+				if allowErr != nil {
+					return PatchedConfiguredTargetValue{}, allowErr
+				}
+				if allowAnalysisFailures {
+					return c.getAnalysisFailureConfiguredTargetValue(thread, targetLabel, err)
+				}
+
 				var evalErr *starlark.EvalError
 				if errors.As(err, &evalErr) {
 					return PatchedConfiguredTargetValue{}, errors.New(evalErr.Backtrace())
@@ -591,31 +569,9 @@ func (c *baseComputer) ComputeConfiguredTargetValue(ctx context.Context, key mod
 			return PatchedConfiguredTargetValue{}, err
 		}
 
-		// Bazel permits returning either a single provider, or
-		// a list of providers.
-		var providerInstances []*model_starlark.Struct
-		structUnpackerInto := unpack.Type[*model_starlark.Struct]("struct")
-		if err := unpack.IfNotNone(
-			unpack.Or([]unpack.UnpackerInto[[]*model_starlark.Struct]{
-				unpack.Singleton(structUnpackerInto),
-				unpack.List(structUnpackerInto),
-			}),
-		).UnpackInto(thread, returnValue, &providerInstances); err != nil {
-			return PatchedConfiguredTargetValue{}, fmt.Errorf("failed to unpack implementation function return value: %w", err)
-		}
-
-		// Convert list of providers to a map where the provider
-		// identifier is the key.
-		providerInstancesByIdentifier := make(map[label.CanonicalStarlarkIdentifier]*model_starlark.Struct, len(providerInstances))
-		for _, providerInstance := range providerInstances {
-			providerIdentifier, err := providerInstance.GetProviderIdentifier()
-			if err != nil {
-				return PatchedConfiguredTargetValue{}, err
-			}
-			if _, ok := providerInstancesByIdentifier[providerIdentifier]; ok {
-				return PatchedConfiguredTargetValue{}, fmt.Errorf("implementation function returned multiple structs for provider %#v", providerIdentifier.String())
-			}
-			providerInstancesByIdentifier[providerIdentifier] = providerInstance
+		providerInstancesByIdentifier, err := getProviderInstancesFromReturnValue(thread, returnValue)
+		if err != nil {
+			return PatchedConfiguredTargetValue{}, err
 		}
 
 		if defaultInfo, ok := providerInstancesByIdentifier[defaultInfoProviderIdentifier]; ok {
@@ -656,28 +612,15 @@ func (c *baseComputer) ComputeConfiguredTargetValue(ctx context.Context, key mod
 			providerInstancesByIdentifier[defaultInfoProviderIdentifier] = emptyDefaultInfo
 		}
 
-		encodedProviderInstances := make([]*model_starlark_pb.Struct, 0, len(providerInstancesByIdentifier))
-		patcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
-		for _, providerIdentifier := range slices.SortedFunc(
-			maps.Keys(providerInstancesByIdentifier),
-			func(a, b label.CanonicalStarlarkIdentifier) int {
-				return strings.Compare(a.String(), b.String())
-			},
-		) {
-			v, _, err := providerInstancesByIdentifier[providerIdentifier].
-				Encode(map[starlark.Value]struct{}{}, c.getValueEncodingOptions(targetLabel))
-			if err != nil {
-				return PatchedConfiguredTargetValue{}, err
-			}
-			encodedProviderInstances = append(encodedProviderInstances, v.Message)
-			patcher.Merge(v.Patcher)
+		encodedProviderInstances, err := c.encodeProviderInstances(targetLabel, providerInstancesByIdentifier)
+		if err != nil {
+			return PatchedConfiguredTargetValue{}, err
 		}
-
 		return model_core.NewPatchedMessage(
 			&model_analysis_pb.ConfiguredTarget_Value{
-				ProviderInstances: encodedProviderInstances,
+				ProviderInstances: encodedProviderInstances.Message,
 			},
-			patcher,
+			encodedProviderInstances.Patcher,
 		), nil
 	case *model_starlark_pb.Target_Definition_SourceFileTarget:
 		// Handcraft a DefaultInfo provider for this source file.
@@ -691,6 +634,200 @@ func (c *baseComputer) ComputeConfiguredTargetValue(ctx context.Context, key mod
 	}
 }
 
+type getRuleDefinitionEnvironment interface {
+	GetCompiledBzlFileGlobalValue(*model_analysis_pb.CompiledBzlFileGlobal_Key) model_core.Message[*model_analysis_pb.CompiledBzlFileGlobal_Value]
+}
+
+// getRuleDefinition looks up the definition of a rule, given the
+// identifier under which it was declared.
+func getRuleDefinition(e getRuleDefinitionEnvironment, ruleIdentifier label.CanonicalStarlarkIdentifier) (model_core.Message[*model_starlark_pb.Rule_Definition], error) {
+	ruleValue := e.GetCompiledBzlFileGlobalValue(&model_analysis_pb.CompiledBzlFileGlobal_Key{
+		Identifier: ruleIdentifier.String(),
+	})
+	if !ruleValue.IsSet() {
+		return model_core.Message[*model_starlark_pb.Rule_Definition]{}, evaluation.ErrMissingDependency
+	}
+	v, ok := ruleValue.Message.Global.GetKind().(*model_starlark_pb.Value_Rule)
+	if !ok {
+		return model_core.Message[*model_starlark_pb.Rule_Definition]{}, fmt.Errorf("%#v is not a rule", ruleIdentifier.String())
+	}
+	d, ok := v.Rule.Kind.(*model_starlark_pb.Rule_Definition_)
+	if !ok {
+		return model_core.Message[*model_starlark_pb.Rule_Definition]{}, fmt.Errorf("%#v is not a rule definition", ruleIdentifier.String())
+	}
+	return model_core.Message[*model_starlark_pb.Rule_Definition]{
+		Message:            d.Definition,
+		OutgoingReferences: ruleValue.OutgoingReferences,
+	}, nil
+}
+
+// getRuleTargetDefinition looks up the definition of the rule used by
+// a rule target. Rules that are not bound to a global identifier (e.g.,
+// the ones declared by testing.analysis_test()) have their definition
+// embedded in the target.
+func getRuleTargetDefinition(e getRuleDefinitionEnvironment, ruleIdentifier label.CanonicalStarlarkIdentifier, ruleTarget model_core.Message[*model_starlark_pb.RuleTarget]) (model_core.Message[*model_starlark_pb.Rule_Definition], error) {
+	if inlineRuleDefinition := ruleTarget.Message.InlineRuleDefinition; inlineRuleDefinition != nil {
+		return model_core.Message[*model_starlark_pb.Rule_Definition]{
+			Message:            inlineRuleDefinition,
+			OutgoingReferences: ruleTarget.OutgoingReferences,
+		}, nil
+	}
+	return getRuleDefinition(e, ruleIdentifier)
+}
+
+type applyIncomingEdgeTransitionEnvironment interface {
+	getUserDefinedTransitionEnvironment
+	getValueFromSelectGroupEnvironment
+}
+
+// getRuleTargetAttrValuePartsByName returns the value of an attr of a
+// rule target, which is needed to compute transitions that depend on
+// attrs. If the rule does not have an attr with the provided name, an
+// empty value is returned.
+func getRuleTargetAttrValuePartsByName(
+	e getValueFromSelectGroupEnvironment,
+	ruleIdentifier label.CanonicalStarlarkIdentifier,
+	ruleDefinition model_core.Message[*model_starlark_pb.Rule_Definition],
+	targetLabel label.CanonicalLabel,
+	ruleTarget model_core.Message[*model_starlark_pb.RuleTarget],
+	name string,
+) (model_core.Message[[]*model_starlark_pb.Value], error) {
+	attrs := ruleDefinition.Message.Attrs
+	attrIndex, ok := sort.Find(
+		len(attrs),
+		func(i int) int { return strings.Compare(name, attrs[i].Name) },
+	)
+	if !ok {
+		return model_core.NewSimpleMessage[[]*model_starlark_pb.Value](nil), nil
+	}
+	valueParts, _, err := getAttrValueParts(e, ruleIdentifier, ruleDefinition, targetLabel, ruleTarget, attrs[attrIndex])
+	return valueParts, err
+}
+
+// applyIncomingEdgeTransition applies the incoming edge transition of
+// a rule to the configuration of a target, if the rule declares one.
+func applyIncomingEdgeTransition(
+	e applyIncomingEdgeTransitionEnvironment,
+	ruleIdentifier label.CanonicalStarlarkIdentifier,
+	ruleDefinition model_core.Message[*model_starlark_pb.Rule_Definition],
+	targetLabel label.CanonicalLabel,
+	ruleTarget model_core.Message[*model_starlark_pb.RuleTarget],
+	configurationReference model_core.Message[*model_core_pb.Reference],
+) (model_core.Message[*model_core_pb.Reference], error) {
+	cfgTransitionIdentifier := ruleDefinition.Message.CfgTransitionIdentifier
+	if cfgTransitionIdentifier == "" {
+		return configurationReference, nil
+	}
+
+	transition, err := getUserDefinedTransition(
+		e,
+		cfgTransitionIdentifier,
+		configurationReference,
+		func(name string) (model_core.Message[[]*model_starlark_pb.Value], error) {
+			return getRuleTargetAttrValuePartsByName(e, ruleIdentifier, ruleDefinition, targetLabel, ruleTarget, name)
+		},
+	)
+	if err != nil {
+		return model_core.Message[*model_core_pb.Reference]{}, err
+	}
+	if l := len(transition.Message.Entries); l != 1 {
+		return model_core.Message[*model_core_pb.Reference]{}, fmt.Errorf("incoming edge transition %#v used by rule %#v is a 1:%d transition, while a 1:1 transition was expected", cfgTransitionIdentifier, ruleIdentifier.String(), l)
+	}
+	return model_core.Message[*model_core_pb.Reference]{
+		Message:            transition.Message.Entries[0].OutputConfigurationReference,
+		OutgoingReferences: transition.OutgoingReferences,
+	}, nil
+}
+
+// newRuleContext creates the object that is provided to the
+// implementation function of a rule as ctx. It is also used to
+// construct the context of aspects, in which case ruleIdentifier and
+// ruleDefinition correspond to the aspect.
+func (c *baseComputer) newRuleContext(
+	ctx context.Context,
+	e ConfiguredTargetEnvironment,
+	ruleIdentifier label.CanonicalStarlarkIdentifier,
+	targetLabel label.CanonicalLabel,
+	configurationReference model_core.Message[*model_core_pb.Reference],
+	ruleDefinition model_core.Message[*model_starlark_pb.Rule_Definition],
+	ruleTarget model_core.Message[*model_starlark_pb.RuleTarget],
+) *ruleContext {
+	return &ruleContext{
+		computer:               c,
+		context:                ctx,
+		environment:            e,
+		ruleIdentifier:         ruleIdentifier,
+		targetLabel:            targetLabel,
+		configurationReference: configurationReference,
+		ruleDefinition:         ruleDefinition,
+		ruleTarget:             ruleTarget,
+		attrs:                  make([]starlark.Value, len(ruleDefinition.Message.Attrs)),
+		executables:            make([]starlark.Value, len(ruleDefinition.Message.Attrs)),
+		singleFiles:            make([]starlark.Value, len(ruleDefinition.Message.Attrs)),
+		multipleFiles:          make([]starlark.Value, len(ruleDefinition.Message.Attrs)),
+		outputs:                make([]starlark.Value, len(ruleDefinition.Message.Attrs)),
+		execGroups:             make([]*ruleContextExecGroupState, len(ruleDefinition.Message.ExecGroups)),
+		fragments:              map[string]*model_starlark.Struct{},
+	}
+}
+
+// getProviderInstancesFromReturnValue converts the value returned by
+// the implementation function of a rule or aspect to a map of provider
+// instances, keyed by provider identifier.
+func getProviderInstancesFromReturnValue(thread *starlark.Thread, returnValue starlark.Value) (map[label.CanonicalStarlarkIdentifier]*model_starlark.Struct, error) {
+	// Bazel permits returning either a single provider, or a list
+	// of providers.
+	var providerInstances []*model_starlark.Struct
+	structUnpackerInto := unpack.Type[*model_starlark.Struct]("struct")
+	if err := unpack.IfNotNone(
+		unpack.Or([]unpack.UnpackerInto[[]*model_starlark.Struct]{
+			unpack.Singleton(structUnpackerInto),
+			unpack.List(structUnpackerInto),
+		}),
+	).UnpackInto(thread, returnValue, &providerInstances); err != nil {
+		return nil, fmt.Errorf("failed to unpack implementation function return value: %w", err)
+	}
+
+	// Convert list of providers to a map where the provider
+	// identifier is the key.
+	providerInstancesByIdentifier := make(map[label.CanonicalStarlarkIdentifier]*model_starlark.Struct, len(providerInstances))
+	for _, providerInstance := range providerInstances {
+		providerIdentifier, err := providerInstance.GetProviderIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := providerInstancesByIdentifier[providerIdentifier]; ok {
+			return nil, fmt.Errorf("implementation function returned multiple structs for provider %#v", providerIdentifier.String())
+		}
+		providerInstancesByIdentifier[providerIdentifier] = providerInstance
+	}
+	return providerInstancesByIdentifier, nil
+}
+
+// encodeProviderInstances converts provider instances returned by the
+// implementation function of a rule or aspect to a list of Protobuf
+// messages, sorted by provider identifier.
+func (c *baseComputer) encodeProviderInstances(targetLabel label.CanonicalLabel, providerInstancesByIdentifier map[label.CanonicalStarlarkIdentifier]*model_starlark.Struct) (model_core.PatchedMessage[[]*model_starlark_pb.Struct, dag.ObjectContentsWalker], error) {
+	encodedProviderInstances := make([]*model_starlark_pb.Struct, 0, len(providerInstancesByIdentifier))
+	patcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
+	for _, providerIdentifier := range slices.SortedFunc(
+		maps.Keys(providerInstancesByIdentifier),
+		func(a, b label.CanonicalStarlarkIdentifier) int {
+			return strings.Compare(a.String(), b.String())
+		},
+	) {
+		v, _, err := providerInstancesByIdentifier[providerIdentifier].
+			Encode(map[starlark.Value]struct{}{}, c.getValueEncodingOptions(targetLabel))
+		if err != nil {
+			patcher.Discard()
+			return model_core.PatchedMessage[[]*model_starlark_pb.Struct, dag.ObjectContentsWalker]{}, err
+		}
+		encodedProviderInstances = append(encodedProviderInstances, v.Message)
+		patcher.Merge(v.Patcher)
+	}
+	return model_core.NewPatchedMessage(encodedProviderInstances, patcher), nil
+}
+
 type ruleContext struct {
 	computer               *baseComputer
 	context                context.Context
@@ -709,10 +846,66 @@ type ruleContext struct {
 	execGroups             []*ruleContextExecGroupState
 	tags                   *starlark.List
 	fragments              map[string]*model_starlark.Struct
+	outputDirectory        *outputDirectory
+	actions                []*ruleContextAction
+
+	// Only set when evaluating aspects. For the context of an
+	// aspect, rule refers to the context of the rule target to which
+	// the aspect is applied. For the context of that rule target,
+	// propagatingAspects contains the aspects that need to be
+	// applied to its dependencies.
+	rule               *ruleContext
+	propagatingAspects []propagatingAspect
 }
 
 var _ starlark.HasAttrs = (*ruleContext)(nil)
 
+// getOutputDirectory returns the properties of the output directory of
+// the configuration in which the target is analyzed. These are needed
+// to compute paths of files declared by the rule.
+func (rc *ruleContext) getOutputDirectory() (*outputDirectory, error) {
+	if rc.outputDirectory == nil {
+		outputDirectory, err := rc.computer.getOutputDirectory(rc.context, rc.environment, rc.configurationReference)
+		if err != nil {
+			return nil, err
+		}
+		rc.outputDirectory = outputDirectory
+	}
+	return rc.outputDirectory, nil
+}
+
+// getWorkspaceStatusFile returns one of the files containing the output
+// of the workspace status command, which are exposed to rules as
+// ctx.info_file and ctx.version_file. Like in Bazel, these files do not
+// depend on the configuration of the target. They are therefore placed
+// in the output directory of the empty configuration.
+func (rc *ruleContext) getWorkspaceStatusFile(filename string) (starlark.Value, error) {
+	outputDirectory, err := rc.computer.getOutputDirectory(
+		rc.context,
+		rc.environment,
+		model_core.NewSimpleMessage[*model_core_pb.Reference](nil),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return model_starlark.NewFile(&model_starlark_pb.File{
+		Owner:               outputDirectory.newFileOwner("stamp"),
+		Package:             "@@builtins_core+",
+		PackageRelativePath: filename,
+		Type:                model_starlark_pb.File_FILE,
+	}), nil
+}
+
+// newFileOwner returns the owner of output files that are declared by
+// the rule.
+func (rc *ruleContext) newFileOwner() (*model_starlark_pb.File_Owner, error) {
+	outputDirectory, err := rc.getOutputDirectory()
+	if err != nil {
+		return nil, err
+	}
+	return outputDirectory.newFileOwner(rc.targetLabel.GetTargetName().String()), nil
+}
+
 func (rc *ruleContext) String() string {
 	return fmt.Sprintf("<ctx for %s>", rc.targetLabel.String())
 }
@@ -835,10 +1028,14 @@ func (rc *ruleContext) Attr(thread *starlark.Thread, name string) (starlark.Valu
 		}), nil
 	case "coverage_instrumented":
 		return starlark.NewBuiltin("ctx.coverage_instrumented", rc.doCoverageInstrumented), nil
-	case "bin_dir":
+	case "bin_dir", "genfiles_dir":
+		// We don't provide a separate genfiles directory.
+		outputDirectory, err := rc.getOutputDirectory()
+		if err != nil {
+			return nil, err
+		}
 		return model_starlark.NewStructFromDict(nil, map[string]any{
-			// TODO: Fill in the right configuration in the path.
-			"path": starlark.String("bazel-bin/TODO-CONFIGURATION/bin"),
+			"path": starlark.String(outputDirectory.getBinDirectoryPath()),
 		}), nil
 	case "disabled_features":
 		return starlark.NewList(nil), nil
@@ -866,22 +1063,33 @@ func (rc *ruleContext) Attr(thread *starlark.Thread, name string) (starlark.Valu
 			ruleContext: rc,
 		}, nil
 	case "info_file":
-		// Fill all of this in properly.
-		return model_starlark.NewFile(&model_starlark_pb.File{
-			Owner: &model_starlark_pb.File_Owner{
-				Cfg:        []byte{0xbe, 0x8a, 0x60, 0x1c, 0xe3, 0x03, 0x44, 0xf0},
-				TargetName: "stamp",
-			},
-			Package:             "@@builtins_core+",
-			PackageRelativePath: "stable-status.txt",
-			Type:                model_starlark_pb.File_FILE,
-		}), nil
+		return rc.getWorkspaceStatusFile("stable-status.txt")
 	case "label":
 		return model_starlark.NewLabel(rc.targetLabel.AsResolved()), nil
 	case "outputs":
 		return &ruleContextOutputs{
 			ruleContext: rc,
 		}, nil
+	case "rule":
+		if rc.rule == nil {
+			// ctx.rule is only provided to aspects.
+			return nil, nil
+		}
+		return model_starlark.NewStructFromDict(nil, map[string]any{
+			"attr": &ruleContextAttr{
+				ruleContext: rc.rule,
+			},
+			"executable": &ruleContextExecutable{
+				ruleContext: rc.rule,
+			},
+			"file": &ruleContextFile{
+				ruleContext: rc.rule,
+			},
+			"files": &ruleContextFiles{
+				ruleContext: rc.rule,
+			},
+			"kind": starlark.String(rc.rule.ruleIdentifier.GetStarlarkIdentifier().String()),
+		}), nil
 	case "runfiles":
 		return starlark.NewBuiltin("ctx.runfiles", rc.doRunfiles), nil
 	case "toolchains":
@@ -908,16 +1116,7 @@ func (rc *ruleContext) Attr(thread *starlark.Thread, name string) (starlark.Valu
 		d.Freeze()
 		return d, nil
 	case "version_file":
-		// Fill all of this in properly.
-		return model_starlark.NewFile(&model_starlark_pb.File{
-			Owner: &model_starlark_pb.File_Owner{
-				Cfg:        []byte{0xbe, 0x8a, 0x60, 0x1c, 0xe3, 0x03, 0x44, 0xf0},
-				TargetName: "stamp",
-			},
-			Package:             "@@builtins_core+",
-			PackageRelativePath: "volatile-status.txt",
-			Type:                model_starlark_pb.File_FILE,
-		}), nil
+		return rc.getWorkspaceStatusFile("volatile-status.txt")
 	case "workspace_name":
 		return starlark.String("_main"), nil
 	default:
@@ -925,28 +1124,97 @@ func (rc *ruleContext) Attr(thread *starlark.Thread, name string) (starlark.Valu
 	}
 }
 
+// wrapDependencyError annotates an error that occurred while processing
+// a label referenced by one of the rule's attrs, so that the dependency
+// edge is named. Missing dependencies are returned as is.
+func (rc *ruleContext) wrapDependencyError(attrName, toLabel string, err error) error {
+	if errors.Is(err, evaluation.ErrMissingDependency) {
+		return err
+	}
+	return fmt.Errorf("dependency of target %#v on %#v through attr %#v: %w", rc.targetLabel.String(), toLabel, attrName, err)
+}
+
+// nameDependencyEdge decorates a function that resolves labels that are
+// referenced by one of the rule's attrs, so that errors returned by it
+// name the dependency edge.
+func (rc *ruleContext) nameDependencyEdge(attrName string, resolveLabel func(label.ResolvedLabel) (starlark.Value, error)) func(label.ResolvedLabel) (starlark.Value, error) {
+	return func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+		v, err := resolveLabel(resolvedLabel)
+		if err != nil {
+			return nil, rc.wrapDependencyError(attrName, resolvedLabel.String(), err)
+		}
+		return v, nil
+	}
+}
+
 func (rc *ruleContext) configureAttr(thread *starlark.Thread, namedAttr *model_starlark_pb.NamedAttr, valueParts model_core.Message[[]*model_starlark_pb.Value], visibilityFromPackage label.CanonicalPackage) (starlark.Value, error) {
 	// See if any transitions need to be applied.
-	var cfg *model_starlark_pb.Transition_Reference
+	var labelOptions *model_starlark_pb.Attr_LabelOptions
 	isScalar := false
 	switch attrType := namedAttr.Attr.GetType().(type) {
 	case *model_starlark_pb.Attr_Label:
-		cfg = attrType.Label.ValueOptions.GetCfg()
+		labelOptions = attrType.Label.ValueOptions
 		isScalar = true
 	case *model_starlark_pb.Attr_LabelKeyedStringDict:
-		cfg = attrType.LabelKeyedStringDict.DictKeyOptions.GetCfg()
+		labelOptions = attrType.LabelKeyedStringDict.DictKeyOptions
 	case *model_starlark_pb.Attr_LabelList:
-		cfg = attrType.LabelList.ListValueOptions.GetCfg()
+		labelOptions = attrType.LabelList.ListValueOptions
 	}
+	cfg := labelOptions.GetCfg()
 	var configurationReferences []model_core.Message[*model_core_pb.Reference]
 	mayHaveMultipleConfigurations := false
 	if cfg != nil {
 		switch tr := cfg.Kind.(type) {
+		case *model_starlark_pb.Transition_Reference_AnalysisTest_:
+			configurationReference := rc.getPatchedConfigurationReference()
+			analysisTestTransitionValue := rc.environment.GetAnalysisTestTransitionValue(
+				model_core.PatchedMessage[*model_analysis_pb.AnalysisTestTransition_Key, dag.ObjectContentsWalker]{
+					Message: &model_analysis_pb.AnalysisTestTransition_Key{
+						Transition:                  tr.AnalysisTest,
+						InputConfigurationReference: configurationReference.Message,
+					},
+					Patcher: configurationReference.Patcher,
+				},
+			)
+			if !analysisTestTransitionValue.IsSet() {
+				return nil, evaluation.ErrMissingDependency
+			}
+			configurationReferences = []model_core.Message[*model_core_pb.Reference]{{
+				Message:            analysisTestTransitionValue.Message.OutputConfigurationReference,
+				OutgoingReferences: analysisTestTransitionValue.OutgoingReferences,
+			}}
 		case *model_starlark_pb.Transition_Reference_ExecGroup:
-			// TODO: Actually transition to the exec platform!
-			configurationReferences = []model_core.Message[*model_core_pb.Reference]{
-				rc.configurationReference,
+			// Transition to the execution platform that was
+			// selected for the exec group.
+			execGroups := rc.ruleDefinition.Message.ExecGroups
+			execGroupIndex, ok := sort.Find(
+				len(execGroups),
+				func(i int) int { return strings.Compare(tr.ExecGroup, execGroups[i].Name) },
+			)
+			if !ok {
+				return nil, fmt.Errorf("attr %#v uses exec group %#v, which does not exist", namedAttr.Name, tr.ExecGroup)
+			}
+			execGroup, err := rc.getExecGroupState(execGroupIndex)
+			if err != nil {
+				return nil, err
+			}
+			configurationReference := rc.getPatchedConfigurationReference()
+			execTransitionValue := rc.environment.GetExecTransitionValue(
+				model_core.PatchedMessage[*model_analysis_pb.ExecTransition_Key, dag.ObjectContentsWalker]{
+					Message: &model_analysis_pb.ExecTransition_Key{
+						PlatformLabel:               execGroup.platformLabel,
+						InputConfigurationReference: configurationReference.Message,
+					},
+					Patcher: configurationReference.Patcher,
+				},
+			)
+			if !execTransitionValue.IsSet() {
+				return nil, evaluation.ErrMissingDependency
 			}
+			configurationReferences = []model_core.Message[*model_core_pb.Reference]{{
+				Message:            execTransitionValue.Message.OutputConfigurationReference,
+				OutgoingReferences: execTransitionValue.OutgoingReferences,
+			}}
 		case *model_starlark_pb.Transition_Reference_None:
 			// Use the empty configuration.
 			configurationReferences = []model_core.Message[*model_core_pb.Reference]{
@@ -961,39 +1229,37 @@ func (rc *ruleContext) configureAttr(thread *starlark.Thread, namedAttr *model_s
 			// Leave targets unconfigured.
 		case *model_starlark_pb.Transition_Reference_UserDefined:
 			// TODO: Should we cache this in the ruleContext?
-			configurationReference := rc.getPatchedConfigurationReference()
-			transitionValue := rc.environment.GetUserDefinedTransitionValue(
-				model_core.PatchedMessage[*model_analysis_pb.UserDefinedTransition_Key, dag.ObjectContentsWalker]{
-					Message: &model_analysis_pb.UserDefinedTransition_Key{
-						TransitionIdentifier:        tr.UserDefined,
-						InputConfigurationReference: configurationReference.Message,
-					},
-					Patcher: configurationReference.Patcher,
+			transition, err := getUserDefinedTransition(
+				rc.environment,
+				tr.UserDefined,
+				rc.configurationReference,
+				func(name string) (model_core.Message[[]*model_starlark_pb.Value], error) {
+					return getRuleTargetAttrValuePartsByName(rc.environment, rc.ruleIdentifier, rc.ruleDefinition, rc.targetLabel, rc.ruleTarget, name)
 				},
 			)
-			if !transitionValue.IsSet() {
-				return nil, evaluation.ErrMissingDependency
+			if err != nil {
+				return nil, err
 			}
-			switch result := transitionValue.Message.Result.(type) {
-			case *model_analysis_pb.UserDefinedTransition_Value_TransitionDependsOnAttrs:
-				return nil, fmt.Errorf("TODO: support transitions that depends on attrs")
-			case *model_analysis_pb.UserDefinedTransition_Value_Success_:
-				configurationReferences = make([]model_core.Message[*model_core_pb.Reference], 0, len(result.Success.Entries))
-				for _, entry := range result.Success.Entries {
-					configurationReferences = append(configurationReferences, model_core.Message[*model_core_pb.Reference]{
-						Message:            entry.OutputConfigurationReference,
-						OutgoingReferences: transitionValue.OutgoingReferences,
-					})
-				}
-				mayHaveMultipleConfigurations = true
-			default:
-				return nil, fmt.Errorf("transition %#v uses an unknown result type", tr.UserDefined)
+			configurationReferences = make([]model_core.Message[*model_core_pb.Reference], 0, len(transition.Message.Entries))
+			for _, entry := range transition.Message.Entries {
+				configurationReferences = append(configurationReferences, model_core.Message[*model_core_pb.Reference]{
+					Message:            entry.OutputConfigurationReference,
+					OutgoingReferences: transition.OutgoingReferences,
+				})
 			}
+			mayHaveMultipleConfigurations = true
 		default:
 			return nil, fmt.Errorf("attr %#v uses an unknown transition type", namedAttr.Name)
 		}
 	}
 
+	// Determine which aspects need to be applied to the targets
+	// referenced by the attr.
+	aspectIdentifiers, err := rc.getAspectIdentifiersForAttr(namedAttr.Name, labelOptions.GetAspects())
+	if err != nil {
+		return nil, err
+	}
+
 	decodedParts := make([]starlark.Value, 0, len(valueParts.Message))
 	if len(configurationReferences) == 0 {
 		for _, valuePart := range valueParts.Message {
@@ -1022,7 +1288,7 @@ func (rc *ruleContext) configureAttr(thread *starlark.Thread, namedAttr *model_s
 	} else {
 		missingDependencies := false
 		for _, configurationReference := range configurationReferences {
-			valueDecodingOptions := rc.computer.getValueDecodingOptions(rc.context, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+			valueDecodingOptions := rc.computer.getValueDecodingOptions(rc.context, rc.nameDependencyEdge(namedAttr.Name, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
 				// Resolve the label.
 				canonicalLabel, err := resolvedLabel.AsCanonical()
 				if err != nil {
@@ -1074,18 +1340,50 @@ func (rc *ruleContext) configureAttr(thread *starlark.Thread, namedAttr *model_s
 						missingDependencies = true
 						return starlark.None, nil
 					}
+					configuredTargetProviderInstances := model_core.Message[[]*model_starlark_pb.Struct]{
+						Message:            configuredTarget.Message.ProviderInstances,
+						OutgoingReferences: configuredTarget.OutgoingReferences,
+					}
 
-					return model_starlark.NewTargetReference(
-						canonicalLabel.AsResolved(),
-						model_core.Message[[]*model_starlark_pb.Struct]{
-							Message:            configuredTarget.Message.ProviderInstances,
-							OutgoingReferences: configuredTarget.OutgoingReferences,
-						},
-					), nil
+					// If analysis of the dependency failed
+					// and failures are permitted, propagate
+					// the failure to the current target.
+					causes, analysisFailed, err := rc.computer.getAnalysisFailureCauses(rc.context, configuredTargetProviderInstances)
+					if err != nil {
+						return nil, err
+					}
+					if analysisFailed {
+						allowAnalysisFailures, err := rc.computer.getAllowAnalysisFailures(rc.context, rc.configurationReference)
+						if err != nil {
+							return nil, err
+						}
+						if allowAnalysisFailures {
+							return nil, analysisFailureInDependencyError{
+								label:  resolvedLabelStr,
+								causes: causes,
+							}
+						}
+					}
+
+					providerInstances, err := applyAspectsToConfiguredTarget(
+						rc.environment,
+						resolvedLabelStr,
+						configurationReference,
+						configuredTargetProviderInstances,
+						aspectIdentifiers,
+					)
+					if err != nil {
+						if errors.Is(err, evaluation.ErrMissingDependency) {
+							missingDependencies = true
+							return starlark.None, nil
+						}
+						return nil, err
+					}
+					return model_starlark.NewTargetReference(canonicalLabel.AsResolved(), providerInstances), nil
 				} else {
 					return starlark.None, nil
 				}
-			})
+			}))
 			for _, valuePart := range valueParts.Message {
 				decodedPart, err := model_starlark.DecodeValue(
 					model_core.Message[*model_starlark_pb.Value]{
@@ -1133,6 +1431,7 @@ func (rc *ruleContext) configureAttr(thread *starlark.Thread, namedAttr *model_s
 var ruleContextAttrNames = []string{
 	"actions",
 	"attr",
+	"bin_dir",
 	"build_setting_value",
 	"exec_groups",
 	"executable",
@@ -1140,6 +1439,7 @@ var ruleContextAttrNames = []string{
 	"file",
 	"files",
 	"fragments",
+	"genfiles_dir",
 	"info_file",
 	"label",
 	"runfiles",
@@ -1204,7 +1504,19 @@ func (ruleContext) doRunfiles(thread *starlark.Thread, b *starlark.Builtin, args
 	), nil
 }
 
-func (rc *ruleContext) getAttrValueParts(namedAttr *model_starlark_pb.NamedAttr) (valueParts model_core.Message[[]*model_starlark_pb.Value], visibilityFromPackage label.CanonicalPackage, err error) {
+// getAttrValueParts returns the value of an attr of a rule target. If
+// the rule target does not provide a value, the default value from the
+// rule definition is returned. In addition to the value, it returns the
+// package from which visibility of any labels contained in the value
+// needs to be checked.
+func getAttrValueParts(
+	e getValueFromSelectGroupEnvironment,
+	ruleIdentifier label.CanonicalStarlarkIdentifier,
+	ruleDefinition model_core.Message[*model_starlark_pb.Rule_Definition],
+	targetLabel label.CanonicalLabel,
+	ruleTarget model_core.Message[*model_starlark_pb.RuleTarget],
+	namedAttr *model_starlark_pb.NamedAttr,
+) (valueParts model_core.Message[[]*model_starlark_pb.Value], visibilityFromPackage label.CanonicalPackage, err error) {
 	attr := namedAttr.Attr
 	var badCanonicalPackage label.CanonicalPackage
 	if attr == nil {
@@ -1213,7 +1525,7 @@ func (rc *ruleContext) getAttrValueParts(namedAttr *model_starlark_pb.NamedAttr)
 
 	if !strings.HasPrefix(namedAttr.Name, "_") {
 		// Attr is public. Extract the value from the rule target.
-		ruleTargetAttrValues := rc.ruleTarget.Message.AttrValues
+		ruleTargetAttrValues := ruleTarget.Message.AttrValues
 		ruleTargetAttrValueIndex, ok := sort.Find(
 			len(ruleTargetAttrValues),
 			func(i int) int { return strings.Compare(namedAttr.Name, ruleTargetAttrValues[i].Name) },
@@ -1228,7 +1540,7 @@ func (rc *ruleContext) getAttrValueParts(namedAttr *model_starlark_pb.NamedAttr)
 		}
 		valueParts := make([]*model_starlark_pb.Value, 0, len(selectGroups))
 		for _, selectGroup := range selectGroups {
-			valuePart, err := getValueFromSelectGroup(rc.environment, selectGroup, false)
+			valuePart, err := getValueFromSelectGroup(e, selectGroup, false)
 			if err != nil {
 				return model_core.Message[[]*model_starlark_pb.Value]{}, badCanonicalPackage, err
 			}
@@ -1246,8 +1558,8 @@ func (rc *ruleContext) getAttrValueParts(namedAttr *model_starlark_pb.NamedAttr)
 		if gotProperValue {
 			return model_core.Message[[]*model_starlark_pb.Value]{
 				Message:            valueParts,
-				OutgoingReferences: rc.ruleTarget.OutgoingReferences,
-			}, rc.targetLabel.GetCanonicalPackage(), nil
+				OutgoingReferences: ruleTarget.OutgoingReferences,
+			}, targetLabel.GetCanonicalPackage(), nil
 		}
 	}
 
@@ -1257,8 +1569,12 @@ func (rc *ruleContext) getAttrValueParts(namedAttr *model_starlark_pb.NamedAttr)
 	}
 	return model_core.Message[[]*model_starlark_pb.Value]{
 		Message:            []*model_starlark_pb.Value{attr.Default},
-		OutgoingReferences: rc.ruleDefinition.OutgoingReferences,
-	}, rc.ruleIdentifier.GetCanonicalLabel().GetCanonicalPackage(), nil
+		OutgoingReferences: ruleDefinition.OutgoingReferences,
+	}, ruleIdentifier.GetCanonicalLabel().GetCanonicalPackage(), nil
+}
+
+func (rc *ruleContext) getAttrValueParts(namedAttr *model_starlark_pb.NamedAttr) (valueParts model_core.Message[[]*model_starlark_pb.Value], visibilityFromPackage label.CanonicalPackage, err error) {
+	return getAttrValueParts(rc.environment, rc.ruleIdentifier, rc.ruleDefinition, rc.targetLabel, rc.ruleTarget, namedAttr)
 }
 
 func (rc *ruleContext) getPatchedConfigurationReference() model_core.PatchedMessage[*model_core_pb.Reference, dag.ObjectContentsWalker] {
@@ -1360,12 +1676,12 @@ func (rca *ruleContextActions) doDeclareDirectory(thread *starlark.Thread, b *st
 	}
 
 	rc := rca.ruleContext
+	owner, err := rc.newFileOwner()
+	if err != nil {
+		return nil, err
+	}
 	return model_starlark.NewFile(&model_starlark_pb.File{
-		Owner: &model_starlark_pb.File_Owner{
-			// TODO: Fill in a proper hash.
-			Cfg:        []byte{0xbe, 0x8a, 0x60, 0x1c, 0xe3, 0x03, 0x44, 0xf0},
-			TargetName: rc.targetLabel.GetTargetName().String(),
-		},
+		Owner:               owner,
 		Package:             rc.targetLabel.GetCanonicalPackage().String(),
 		PackageRelativePath: filename.String(),
 		Type:                model_starlark_pb.File_DIRECTORY,
@@ -1387,33 +1703,181 @@ func (rca *ruleContextActions) doDeclareFile(thread *starlark.Thread, b *starlar
 	}
 
 	rc := rca.ruleContext
+	owner, err := rc.newFileOwner()
+	if err != nil {
+		return nil, err
+	}
 	return model_starlark.NewFile(&model_starlark_pb.File{
-		Owner: &model_starlark_pb.File_Owner{
-			// TODO: Fill in a proper hash.
-			Cfg:        []byte{0xbe, 0x8a, 0x60, 0x1c, 0xe3, 0x03, 0x44, 0xf0},
-			TargetName: rc.targetLabel.GetTargetName().String(),
-		},
+		Owner:               owner,
 		Package:             rc.targetLabel.GetCanonicalPackage().String(),
 		PackageRelativePath: filename.String(),
 		Type:                model_starlark_pb.File_FILE,
 	}), nil
 }
 
-func (rca *ruleContextActions) doRun(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
-	return starlark.None, nil
+// supportsPathMappingExecutionRequirement is the execution requirement
+// that rules can set on actions to indicate that their command lines
+// do not depend on the names of output directories. For these actions
+// path mapping is applied, causing identical actions in different
+// configurations to be deduplicated.
+const supportsPathMappingExecutionRequirement = "supports-path-mapping"
+
+// ruleContextAction contains the properties of an action that was
+// registered by a rule implementation function through
+// ctx.actions.run() or ctx.actions.run_shell().
+type ruleContextAction struct {
+	mnemonic  string
+	arguments []string
+	inputs    []string
+	outputs   []string
 }
 
-func (rca *ruleContextActions) doRunShell(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+// getFilesFromSequence converts the value of the inputs, outputs or
+// tools argument of ctx.actions.run() and ctx.actions.run_shell() to a
+// list of files. These arguments either accept a list or a depset.
+func getFilesFromSequence(thread *starlark.Thread, argumentName string, v starlark.Value) ([]model_starlark.File, error) {
+	switch typedV := v.(type) {
+	case nil, starlark.NoneType:
+		return nil, nil
+	case *model_starlark.Depset:
+		l, err := typedV.ToList(thread)
+		if err != nil {
+			return nil, err
+		}
+		v = l
+	}
+	iterable, ok := v.(starlark.Iterable)
+	if !ok {
+		return nil, fmt.Errorf("%s: got %s, want sequence or depset", argumentName, v.Type())
+	}
+	var files []model_starlark.File
+	iter := iterable.Iterate()
+	defer iter.Done()
+	var element starlark.Value
+	for iter.Next(&element) {
+		switch typedElement := element.(type) {
+		case model_starlark.File:
+			files = append(files, typedElement)
+		case starlark.HasAttrs:
+			// FilesToRunProvider, as provided to tools.
+			executable, err := typedElement.Attr(thread, "executable")
+			if err != nil {
+				return nil, err
+			}
+			if executableFile, ok := executable.(model_starlark.File); ok {
+				files = append(files, executableFile)
+			}
+		default:
+			return nil, fmt.Errorf("%s: got element of type %s, want File", argumentName, element.Type())
+		}
+	}
+	return files, nil
+}
+
+// getActionFilePaths returns the sorted paths of a set of files that
+// are used as inputs or outputs of an action.
+func getActionFilePaths(files []model_starlark.File, mapPaths bool) ([]string, error) {
+	paths := make([]string, 0, len(files))
+	for _, f := range files {
+		p, err := getActionFilePath(f, mapPaths)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+	return slices.Compact(paths), nil
+}
+
+func getActionFilePath(f model_starlark.File, mapPaths bool) (string, error) {
+	if mapPaths {
+		return f.GetMappedPath()
+	}
+	return f.GetPath()
+}
+
+// getActionArguments expands the values provided to the arguments
+// argument of ctx.actions.run() and ctx.actions.run_shell(), which may
+// either be strings or Args objects.
+func getActionArguments(thread *starlark.Thread, arguments []any, mapPaths bool) ([]string, error) {
+	var expandedArguments []string
+	for _, argument := range arguments {
+		switch typedArgument := argument.(type) {
+		case string:
+			expandedArguments = append(expandedArguments, typedArgument)
+		case *args:
+			var err error
+			expandedArguments, err = typedArgument.appendExpanded(thread, expandedArguments, mapPaths)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			panic("unexpected argument type")
+		}
+	}
+	return expandedArguments, nil
+}
+
+// newRuleContextAction computes the command line, inputs and outputs of
+// an action. If the action supports path mapping, the names of output
+// directories are replaced with a placeholder.
+func newRuleContextAction(thread *starlark.Thread, mnemonic string, argv0 []any, arguments []any, inputs, outputs []model_starlark.File, executionRequirements map[string]string) (*ruleContextAction, error) {
+	_, mapPaths := executionRequirements[supportsPathMappingExecutionRequirement]
+	expandedArguments := make([]string, 0, len(argv0))
+	for _, argument := range argv0 {
+		switch typedArgument := argument.(type) {
+		case string:
+			expandedArguments = append(expandedArguments, typedArgument)
+		case model_starlark.File:
+			p, err := getActionFilePath(typedArgument, mapPaths)
+			if err != nil {
+				return nil, err
+			}
+			expandedArguments = append(expandedArguments, p)
+		default:
+			panic("unexpected argument type")
+		}
+	}
+	moreArguments, err := getActionArguments(thread, arguments, mapPaths)
+	if err != nil {
+		return nil, err
+	}
+	inputPaths, err := getActionFilePaths(inputs, mapPaths)
+	if err != nil {
+		return nil, fmt.Errorf("inputs: %w", err)
+	}
+	outputPaths, err := getActionFilePaths(outputs, mapPaths)
+	if err != nil {
+		return nil, fmt.Errorf("outputs: %w", err)
+	}
+	return &ruleContextAction{
+		mnemonic:  mnemonic,
+		arguments: append(expandedArguments, moreArguments...),
+		inputs:    inputPaths,
+		outputs:   outputPaths,
+	}, nil
+}
+
+var actionArgumentsUnpackerInto = unpack.List(
+	unpack.Or([]unpack.UnpackerInto[any]{
+		unpack.Decay(unpack.String),
+		unpack.Decay(unpack.Type[*args]("Args")),
+	}),
+)
+
+func (rca *ruleContextActions) doRun(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
 	var outputs []model_starlark.File
 	var inputs starlark.Value
+	var unusedInputsList *model_starlark.File
+	var executable starlark.Value
 	var tools starlark.Value
-	var arguments starlark.Value
+	var arguments []any
 	mnemonic := ""
-	command := ""
 	progressMessage := ""
 	useDefaultShellEnv := false
 	var env map[string]string
 	var executionRequirements map[string]string
+	var inputManifests starlark.Value
 	execGroup := ""
 	var shadowedAction starlark.Value
 	var resourceSet starlark.Value
@@ -1422,14 +1886,16 @@ func (rca *ruleContextActions) doRunShell(thread *starlark.Thread, b *starlark.B
 		b.Name(), args, kwargs,
 		"outputs", unpack.Bind(thread, &outputs, unpack.List(unpack.Type[model_starlark.File]("File"))),
 		"inputs?", &inputs,
+		"unused_inputs_list?", unpack.Bind(thread, &unusedInputsList, unpack.IfNotNone(unpack.Pointer(unpack.Type[model_starlark.File]("File")))),
+		"executable", &executable,
 		"tools?", &tools,
-		"arguments?", &arguments,
+		"arguments?", unpack.Bind(thread, &arguments, actionArgumentsUnpackerInto),
 		"mnemonic?", unpack.Bind(thread, &mnemonic, unpack.IfNotNone(unpack.String)),
-		"command?", unpack.Bind(thread, &command, unpack.String),
 		"progress_message?", unpack.Bind(thread, &progressMessage, unpack.IfNotNone(unpack.String)),
 		"use_default_shell_env?", unpack.Bind(thread, &useDefaultShellEnv, unpack.Bool),
 		"env?", unpack.Bind(thread, &env, unpack.Dict(unpack.String, unpack.String)),
 		"execution_requirements?", unpack.Bind(thread, &executionRequirements, unpack.Dict(unpack.String, unpack.String)),
+		"input_manifests?", &inputManifests,
 		"exec_group?", unpack.Bind(thread, &execGroup, unpack.IfNotNone(unpack.String)),
 		"shadowed_action?", &shadowedAction,
 		"resource_set?", &resourceSet,
@@ -1438,8 +1904,104 @@ func (rca *ruleContextActions) doRunShell(thread *starlark.Thread, b *starlark.B
 		return nil, err
 	}
 
-	// TODO: Actually register the action.
-	return starlark.None, nil
+	inputFiles, err := getFilesFromSequence(thread, "inputs", inputs)
+	if err != nil {
+		return nil, err
+	}
+	toolFiles, err := getFilesFromSequence(thread, "tools", tools)
+	if err != nil {
+		return nil, err
+	}
+
+	// The executable may either be a path, a File, or a
+	// FilesToRunProvider.
+	var argv0 any
+	switch typedExecutable := executable.(type) {
+	case starlark.String:
+		argv0 = string(typedExecutable)
+	case model_starlark.File:
+		argv0 = typedExecutable
+		toolFiles = append(toolFiles, typedExecutable)
+	default:
+		executableFiles, err := getFilesFromSequence(thread, "executable", starlark.NewList([]starlark.Value{executable}))
+		if err != nil {
+			return nil, err
+		}
+		if len(executableFiles) != 1 {
+			return nil, fmt.Errorf("%s: executable does not provide an executable file", b.Name())
+		}
+		argv0 = executableFiles[0]
+		toolFiles = append(toolFiles, executableFiles[0])
+	}
+
+	action, err := newRuleContextAction(thread, mnemonic, []any{argv0}, arguments, append(inputFiles, toolFiles...), outputs, executionRequirements)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: Actually register the action, so that it can be
+	// executed.
+	rc := rca.ruleContext
+	rc.actions = append(rc.actions, action)
+	return starlark.None, nil
+}
+
+func (rca *ruleContextActions) doRunShell(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var outputs []model_starlark.File
+	var inputs starlark.Value
+	var tools starlark.Value
+	var arguments []any
+	mnemonic := ""
+	command := ""
+	progressMessage := ""
+	useDefaultShellEnv := false
+	var env map[string]string
+	var executionRequirements map[string]string
+	execGroup := ""
+	var shadowedAction starlark.Value
+	var resourceSet starlark.Value
+	var toolchain starlark.Value
+	if err := starlark.UnpackArgs(
+		b.Name(), args, kwargs,
+		"outputs", unpack.Bind(thread, &outputs, unpack.List(unpack.Type[model_starlark.File]("File"))),
+		"inputs?", &inputs,
+		"tools?", &tools,
+		"arguments?", unpack.Bind(thread, &arguments, actionArgumentsUnpackerInto),
+		"mnemonic?", unpack.Bind(thread, &mnemonic, unpack.IfNotNone(unpack.String)),
+		"command?", unpack.Bind(thread, &command, unpack.String),
+		"progress_message?", unpack.Bind(thread, &progressMessage, unpack.IfNotNone(unpack.String)),
+		"use_default_shell_env?", unpack.Bind(thread, &useDefaultShellEnv, unpack.Bool),
+		"env?", unpack.Bind(thread, &env, unpack.Dict(unpack.String, unpack.String)),
+		"execution_requirements?", unpack.Bind(thread, &executionRequirements, unpack.Dict(unpack.String, unpack.String)),
+		"exec_group?", unpack.Bind(thread, &execGroup, unpack.IfNotNone(unpack.String)),
+		"shadowed_action?", &shadowedAction,
+		"resource_set?", &resourceSet,
+		"toolchain?", &toolchain,
+	); err != nil {
+		return nil, err
+	}
+
+	inputFiles, err := getFilesFromSequence(thread, "inputs", inputs)
+	if err != nil {
+		return nil, err
+	}
+	toolFiles, err := getFilesFromSequence(thread, "tools", tools)
+	if err != nil {
+		return nil, err
+	}
+
+	// Similar to Bazel, arguments are passed to the shell as
+	// positional parameters, starting at $1.
+	action, err := newRuleContextAction(thread, mnemonic, []any{"/bin/bash", "-c", command, ""}, arguments, append(inputFiles, toolFiles...), outputs, executionRequirements)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: Actually register the action, so that it can be
+	// executed.
+	rc := rca.ruleContext
+	rc.actions = append(rc.actions, action)
+	return starlark.None, nil
 }
 
 func (rca *ruleContextActions) doSymlink(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
@@ -1607,7 +2169,12 @@ func (rca *ruleContextExecGroups) Get(thread *starlark.Thread, key starlark.Valu
 		return nil, false, fmt.Errorf("rule does not have an exec group with name %#v", execGroupName)
 	}
 
-	return nil, true, fmt.Errorf("TODO: use exec group with index %d", execGroupIndex)
+	return model_starlark.NewStructFromDict(nil, map[string]any{
+		"toolchains": &toolchainContext{
+			ruleContext:    rc,
+			execGroupIndex: execGroupIndex,
+		},
+	}), true, nil
 }
 
 type ruleContextExecutable struct {
@@ -1666,65 +2233,9 @@ func (rce *ruleContextExecutable) Attr(thread *starlark.Thread, name string) (st
 		}
 		switch labelValue := valueParts.Message[0].GetKind().(type) {
 		case *model_starlark_pb.Value_Label:
-			// Extract the executable from the label's DefaultInfo.
-			configurationReference := rc.getPatchedConfigurationReference()
-			visibleTarget := rc.environment.GetVisibleTargetValue(
-				model_core.NewPatchedMessage(
-					&model_analysis_pb.VisibleTarget_Key{
-						FromPackage:            visibilityFromPackage.String(),
-						ToLabel:                labelValue.Label,
-						ConfigurationReference: configurationReference.Message,
-					},
-					configurationReference.Patcher,
-				),
-			)
-			if !visibleTarget.IsSet() {
-				return nil, evaluation.ErrMissingDependency
-			}
-			defaultInfo, err := getProviderFromConfiguredTarget(
-				rc.environment,
-				visibleTarget.Message.Label,
-				rc.getPatchedConfigurationReference(),
-				defaultInfoProviderIdentifier,
-			)
-			if err != nil {
-				return nil, fmt.Errorf("attr %#v with label %#v: %w", name, visibleTarget.Message.Label, err)
-			}
-			listReader := model_parser.NewStorageBackedParsedObjectReader(
-				rc.computer.objectDownloader,
-				rc.computer.getValueObjectEncoder(),
-				model_parser.NewMessageListObjectParser[object.LocalReference, model_starlark_pb.List_Element](),
-			)
-			filesToRun, err := model_starlark.GetStructFieldValue(rc.context, listReader, defaultInfo, "files_to_run")
-			if err != nil {
-				return nil, fmt.Errorf("failed to obtain field \"files_to_run\" of DefaultInfo provider of target with label %#v: %w", visibleTarget.Message.Label, err)
-			}
-			filesToRunStruct, ok := filesToRun.Message.Kind.(*model_starlark_pb.Value_Struct)
-			if !ok {
-				return nil, fmt.Errorf("field \"files_to_run\" of DefaultInfo provider of target with label %#v is not a struct", visibleTarget.Message.Label)
-			}
-			encodedExecutable, err := model_starlark.GetStructFieldValue(
-				rc.context,
-				listReader,
-				model_core.Message[*model_starlark_pb.Struct_Fields]{
-					Message:            filesToRunStruct.Struct.Fields,
-					OutgoingReferences: filesToRun.OutgoingReferences,
-				},
-				"executable",
-			)
+			executable, err = rc.getExecutableFromLabel(labelValue.Label, visibilityFromPackage)
 			if err != nil {
-				return nil, fmt.Errorf("failed to obtain field \"files_to_run.executable\" of DefaultInfo provider of target with label %#v: %w", visibleTarget.Message.Label, err)
-			}
-
-			executable, err = model_starlark.DecodeValue(
-				encodedExecutable,
-				/* currentIdentifier = */ nil,
-				rc.computer.getValueDecodingOptions(rc.context, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
-					return model_starlark.NewLabel(resolvedLabel), nil
-				}),
-			)
-			if err != nil {
-				return nil, fmt.Errorf("failed to decode executable of target with label %#v: %w", visibleTarget.Message.Label, err)
+				return nil, rc.wrapDependencyError(name, labelValue.Label, err)
 			}
 		case *model_starlark_pb.Value_None:
 			executable = starlark.None
@@ -1740,8 +2251,80 @@ func (rce *ruleContextExecutable) Attr(thread *starlark.Thread, name string) (st
 	return executable, nil
 }
 
-func (ruleContextExecutable) AttrNames() []string {
-	panic("TODO")
+// getExecutableFromLabel returns the executable of a target referenced
+// by an attr, as provided by its DefaultInfo.
+func (rc *ruleContext) getExecutableFromLabel(toLabel string, visibilityFromPackage label.CanonicalPackage) (starlark.Value, error) {
+	// Extract the executable from the label's DefaultInfo.
+	configurationReference := rc.getPatchedConfigurationReference()
+	visibleTarget := rc.environment.GetVisibleTargetValue(
+		model_core.NewPatchedMessage(
+			&model_analysis_pb.VisibleTarget_Key{
+				FromPackage:            visibilityFromPackage.String(),
+				ToLabel:                toLabel,
+				ConfigurationReference: configurationReference.Message,
+			},
+			configurationReference.Patcher,
+		),
+	)
+	if !visibleTarget.IsSet() {
+		return nil, evaluation.ErrMissingDependency
+	}
+	defaultInfo, err := getProviderFromConfiguredTarget(
+		rc.environment,
+		visibleTarget.Message.Label,
+		rc.getPatchedConfigurationReference(),
+		defaultInfoProviderIdentifier,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("target with label %#v: %w", visibleTarget.Message.Label, err)
+	}
+	listReader := model_parser.NewStorageBackedParsedObjectReader(
+		rc.computer.objectDownloader,
+		rc.computer.getValueObjectEncoder(),
+		model_parser.NewMessageListObjectParser[object.LocalReference, model_starlark_pb.List_Element](),
+	)
+	filesToRun, err := model_starlark.GetStructFieldValue(rc.context, listReader, defaultInfo, "files_to_run")
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain field \"files_to_run\" of DefaultInfo provider of target with label %#v: %w", visibleTarget.Message.Label, err)
+	}
+	filesToRunStruct, ok := filesToRun.Message.Kind.(*model_starlark_pb.Value_Struct)
+	if !ok {
+		return nil, fmt.Errorf("field \"files_to_run\" of DefaultInfo provider of target with label %#v is not a struct", visibleTarget.Message.Label)
+	}
+	encodedExecutable, err := model_starlark.GetStructFieldValue(
+		rc.context,
+		listReader,
+		model_core.Message[*model_starlark_pb.Struct_Fields]{
+			Message:            filesToRunStruct.Struct.Fields,
+			OutgoingReferences: filesToRun.OutgoingReferences,
+		},
+		"executable",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain field \"files_to_run.executable\" of DefaultInfo provider of target with label %#v: %w", visibleTarget.Message.Label, err)
+	}
+
+	executable, err := model_starlark.DecodeValue(
+		encodedExecutable,
+		/* currentIdentifier = */ nil,
+		rc.computer.getValueDecodingOptions(rc.context, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+			return model_starlark.NewLabel(resolvedLabel), nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode executable of target with label %#v: %w", visibleTarget.Message.Label, err)
+	}
+	return executable, nil
+}
+
+func (rce *ruleContextExecutable) AttrNames() []string {
+	var attrNames []string
+	for _, namedAttr := range rce.ruleContext.ruleDefinition.Message.Attrs {
+		if labelType, ok := namedAttr.Attr.GetType().(*model_starlark_pb.Attr_Label); ok && labelType.Label.Executable {
+			attrNames = append(attrNames, namedAttr.Name)
+		}
+	}
+	return attrNames
 }
 
 type ruleContextFile struct {
@@ -1800,64 +2383,9 @@ func (rcf *ruleContextFile) Attr(thread *starlark.Thread, name string) (starlark
 		}
 		switch labelValue := valueParts.Message[0].GetKind().(type) {
 		case *model_starlark_pb.Value_Label:
-			// Extract the file from the label's DefaultInfo.
-			configurationReference := rc.getPatchedConfigurationReference()
-			visibleTarget := rc.environment.GetVisibleTargetValue(
-				model_core.NewPatchedMessage(
-					&model_analysis_pb.VisibleTarget_Key{
-						FromPackage:            visibilityFromPackage.String(),
-						ToLabel:                labelValue.Label,
-						ConfigurationReference: configurationReference.Message,
-					},
-					configurationReference.Patcher,
-				),
-			)
-			if !visibleTarget.IsSet() {
-				return nil, evaluation.ErrMissingDependency
-			}
-			defaultInfo, err := getProviderFromConfiguredTarget(
-				rc.environment,
-				visibleTarget.Message.Label,
-				rc.getPatchedConfigurationReference(),
-				defaultInfoProviderIdentifier,
-			)
+			file, err = rc.getSingleFileFromLabel(labelValue.Label, visibilityFromPackage)
 			if err != nil {
-				return nil, fmt.Errorf("attr %#v with label %#v: %w", name, visibleTarget.Message.Label, err)
-			}
-			listReader := model_parser.NewStorageBackedParsedObjectReader(
-				rc.computer.objectDownloader,
-				rc.computer.getValueObjectEncoder(),
-				model_parser.NewMessageListObjectParser[object.LocalReference, model_starlark_pb.List_Element](),
-			)
-			files, err := model_starlark.GetStructFieldValue(rc.context, listReader, defaultInfo, "files")
-			if err != nil {
-				return nil, fmt.Errorf("failed to obtain field \"files\" of DefaultInfo provider of target with label %#v: %w", visibleTarget.Message.Label, err)
-			}
-			valueDepset, ok := files.Message.Kind.(*model_starlark_pb.Value_Depset)
-			if !ok {
-				return nil, fmt.Errorf("field \"files\" of DefaultInfo provider of target with label %#v is not a depset", visibleTarget.Message.Label)
-			}
-			filesDepset := valueDepset.Depset
-			if len(filesDepset.Elements) != 1 {
-				return nil, fmt.Errorf("target with label %#v does not yield exactly one file", visibleTarget.Message.Label)
-			}
-			element, ok := filesDepset.Elements[0].Level.(*model_starlark_pb.List_Element_Leaf)
-			if !ok {
-				return nil, fmt.Errorf("target with label %#v does not yield exactly one file", visibleTarget.Message.Label)
-			}
-
-			file, err = model_starlark.DecodeValue(
-				model_core.Message[*model_starlark_pb.Value]{
-					Message:            element.Leaf,
-					OutgoingReferences: files.OutgoingReferences,
-				},
-				/* currentIdentifier = */ nil,
-				rc.computer.getValueDecodingOptions(rc.context, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
-					return model_starlark.NewLabel(resolvedLabel), nil
-				}),
-			)
-			if err != nil {
-				return nil, fmt.Errorf("failed to decode file of target with label %#v: %w", visibleTarget.Message.Label, err)
+				return nil, rc.wrapDependencyError(name, labelValue.Label, err)
 			}
 		case *model_starlark_pb.Value_None:
 			file = starlark.None
@@ -1873,6 +2401,72 @@ func (rcf *ruleContextFile) Attr(thread *starlark.Thread, name string) (starlark
 	return file, nil
 }
 
+// getSingleFileFromLabel returns the file of a target referenced by an
+// attr, as provided by its DefaultInfo. The target must yield exactly
+// one file.
+func (rc *ruleContext) getSingleFileFromLabel(toLabel string, visibilityFromPackage label.CanonicalPackage) (starlark.Value, error) {
+	// Extract the file from the label's DefaultInfo.
+	configurationReference := rc.getPatchedConfigurationReference()
+	visibleTarget := rc.environment.GetVisibleTargetValue(
+		model_core.NewPatchedMessage(
+			&model_analysis_pb.VisibleTarget_Key{
+				FromPackage:            visibilityFromPackage.String(),
+				ToLabel:                toLabel,
+				ConfigurationReference: configurationReference.Message,
+			},
+			configurationReference.Patcher,
+		),
+	)
+	if !visibleTarget.IsSet() {
+		return nil, evaluation.ErrMissingDependency
+	}
+	defaultInfo, err := getProviderFromConfiguredTarget(
+		rc.environment,
+		visibleTarget.Message.Label,
+		rc.getPatchedConfigurationReference(),
+		defaultInfoProviderIdentifier,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("target with label %#v: %w", visibleTarget.Message.Label, err)
+	}
+	listReader := model_parser.NewStorageBackedParsedObjectReader(
+		rc.computer.objectDownloader,
+		rc.computer.getValueObjectEncoder(),
+		model_parser.NewMessageListObjectParser[object.LocalReference, model_starlark_pb.List_Element](),
+	)
+	files, err := model_starlark.GetStructFieldValue(rc.context, listReader, defaultInfo, "files")
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain field \"files\" of DefaultInfo provider of target with label %#v: %w", visibleTarget.Message.Label, err)
+	}
+	valueDepset, ok := files.Message.Kind.(*model_starlark_pb.Value_Depset)
+	if !ok {
+		return nil, fmt.Errorf("field \"files\" of DefaultInfo provider of target with label %#v is not a depset", visibleTarget.Message.Label)
+	}
+	filesDepset := valueDepset.Depset
+	if len(filesDepset.Elements) != 1 {
+		return nil, fmt.Errorf("target with label %#v does not yield exactly one file", visibleTarget.Message.Label)
+	}
+	element, ok := filesDepset.Elements[0].Level.(*model_starlark_pb.List_Element_Leaf)
+	if !ok {
+		return nil, fmt.Errorf("target with label %#v does not yield exactly one file", visibleTarget.Message.Label)
+	}
+
+	file, err := model_starlark.DecodeValue(
+		model_core.Message[*model_starlark_pb.Value]{
+			Message:            element.Leaf,
+			OutgoingReferences: files.OutgoingReferences,
+		},
+		/* currentIdentifier = */ nil,
+		rc.computer.getValueDecodingOptions(rc.context, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+			return model_starlark.NewLabel(resolvedLabel), nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode file of target with label %#v: %w", visibleTarget.Message.Label, err)
+	}
+	return file, nil
+}
+
 func (rcf *ruleContextFile) AttrNames() []string {
 	var attrNames []string
 	for _, namedAttr := range rcf.ruleContext.ruleDefinition.Message.Attrs {
@@ -1995,18 +2589,18 @@ func (rcf *ruleContextFiles) Attr(thread *starlark.Thread, name string) (starlar
 					defaultInfoProviderIdentifier,
 				)
 				if err != nil {
-					return nil, fmt.Errorf("attr %#v with label %#v: %w", name, visibleTarget.Message.Label, err)
+					return nil, rc.wrapDependencyError(name, labelElement.Label, fmt.Errorf("target with label %#v: %w", visibleTarget.Message.Label, err))
 				}
 
 				// Obtain the "files" depset contained within
 				// the DefaultInfo provider.
 				files, err := model_starlark.GetStructFieldValue(rc.context, listReader, defaultInfo, "files")
 				if err != nil {
-					return nil, fmt.Errorf("failed to obtain field \"files\" of DefaultInfo provider of target with label %#v: %w", visibleTarget.Message.Label, err)
+					return nil, rc.wrapDependencyError(name, labelElement.Label, fmt.Errorf("failed to obtain field \"files\" of DefaultInfo provider of target with label %#v: %w", visibleTarget.Message.Label, err))
 				}
 				valueDepset, ok := files.Message.Kind.(*model_starlark_pb.Value_Depset)
 				if !ok {
-					return nil, fmt.Errorf("field \"files\" of DefaultInfo provider of target with label %#v is not a depset", visibleTarget.Message.Label)
+					return nil, rc.wrapDependencyError(name, labelElement.Label, fmt.Errorf("field \"files\" of DefaultInfo provider of target with label %#v is not a depset", visibleTarget.Message.Label))
 				}
 				for _, element := range valueDepset.Depset.Elements {
 					filesDepsetElements = append(
@@ -2047,7 +2641,6 @@ func (rcf *ruleContextFiles) AttrNames() []string {
 		switch namedAttr.Attr.GetType().(type) {
 		case *model_starlark_pb.Attr_Label, *model_starlark_pb.Attr_LabelList:
 			attrNames = append(attrNames, namedAttr.Name)
-		default:
 		}
 	}
 	return attrNames
@@ -2117,9 +2710,8 @@ func (rcf *ruleContextFragments) Attr(thread *starlark.Thread, name string) (sta
 	return fragmentInfo, nil
 }
 
-func (ruleContextFragments) AttrNames() []string {
-	// TODO: implement.
-	return nil
+func (rcf *ruleContextFragments) AttrNames() []string {
+	return rcf.ruleContext.ruleDefinition.Message.Fragments
 }
 
 type ruleContextOutputs struct {
@@ -2171,41 +2763,62 @@ func (rco *ruleContextOutputs) Attr(thread *starlark.Thread, name string) (starl
 			if len(valueParts.Message) != 1 {
 				return nil, errors.New("labels cannot consist of multiple value parts")
 			}
-			switch valueParts.Message[0].GetKind().(type) {
+			switch labelValue := valueParts.Message[0].GetKind().(type) {
 			case *model_starlark_pb.Value_Label:
-				labelValue, ok := valueParts.Message[0].GetKind().(*model_starlark_pb.Value_Label)
-				if !ok {
-					return nil, fmt.Errorf("value of attr %#v is not of type label", name)
-				}
-				outputLabel, err := label.NewResolvedLabel(labelValue.Label)
-				if err != nil {
-					return nil, fmt.Errorf("invalid resolved label %#v: %w", labelValue.Label, err)
-				}
-				outputCanonicalLabel, err := outputLabel.AsCanonical()
+				output, err := rc.newPredeclaredOutputFile(name, labelValue.Label)
 				if err != nil {
 					return nil, err
 				}
-				outputPackage := outputCanonicalLabel.GetCanonicalPackage()
-				if outputPackage != rc.targetLabel.GetCanonicalPackage() {
-					return nil, fmt.Errorf("output attr %#v is set to label %#v, which refers to a different package", name, labelValue.Label)
-				}
-				outputs = model_starlark.NewFile(&model_starlark_pb.File{
-					Owner: &model_starlark_pb.File_Owner{
-						// TODO: Fill in a proper hash.
-						Cfg:        []byte{0xbe, 0x8a, 0x60, 0x1c, 0xe3, 0x03, 0x44, 0xf0},
-						TargetName: rc.targetLabel.GetTargetName().String(),
-					},
-					Package:             outputPackage.String(),
-					PackageRelativePath: outputLabel.GetTargetName().String(),
-					Type:                model_starlark_pb.File_FILE,
-				})
+				outputs = output
 			case *model_starlark_pb.Value_None:
 				outputs = starlark.None
 			default:
 				return nil, fmt.Errorf("attr %#v has a non-label value", name)
 			}
 		case *model_starlark_pb.Attr_OutputList:
-			return nil, errors.New("TODO: Implement!")
+			// List of output files. The value may consist of
+			// multiple parts if select() is used.
+			valueParts, _, err := rc.getAttrValueParts(ruleDefinitionAttrs[ruleDefinitionAttrIndex])
+			if err != nil {
+				return nil, err
+			}
+			listReader := model_parser.NewStorageBackedParsedObjectReader(
+				rc.computer.objectDownloader,
+				rc.computer.getValueObjectEncoder(),
+				model_parser.NewMessageListObjectParser[object.LocalReference, model_starlark_pb.List_Element](),
+			)
+			var outputsList []starlark.Value
+			for _, valuePart := range valueParts.Message {
+				listValue, ok := valuePart.Kind.(*model_starlark_pb.Value_List)
+				if !ok {
+					return nil, fmt.Errorf("attr %#v has a non-list value", name)
+				}
+				var errIter error
+				for encodedElement := range model_starlark.AllListLeafElementsSkippingDuplicateParents(
+					rc.context,
+					listReader,
+					model_core.Message[[]*model_starlark_pb.List_Element]{
+						Message:            listValue.List.Elements,
+						OutgoingReferences: valueParts.OutgoingReferences,
+					},
+					map[object.LocalReference]struct{}{},
+					&errIter,
+				) {
+					labelValue, ok := encodedElement.Message.Kind.(*model_starlark_pb.Value_Label)
+					if !ok {
+						return nil, fmt.Errorf("attr %#v contains non-label values", name)
+					}
+					output, err := rc.newPredeclaredOutputFile(name, labelValue.Label)
+					if err != nil {
+						return nil, err
+					}
+					outputsList = append(outputsList, output)
+				}
+				if errIter != nil {
+					return nil, fmt.Errorf("failed to iterate value of attr %#v: %w", name, errIter)
+				}
+			}
+			outputs = starlark.NewList(outputsList)
 		default:
 			return nil, fmt.Errorf("attr %#v is not of type output or output_list", name)
 		}
@@ -2217,8 +2830,44 @@ func (rco *ruleContextOutputs) Attr(thread *starlark.Thread, name string) (starl
 	return outputs, nil
 }
 
-func (ruleContextOutputs) AttrNames() []string {
-	return nil
+func (rco *ruleContextOutputs) AttrNames() []string {
+	var attrNames []string
+	for _, namedAttr := range rco.ruleContext.ruleDefinition.Message.Attrs {
+		switch namedAttr.Attr.GetType().(type) {
+		case *model_starlark_pb.Attr_Output, *model_starlark_pb.Attr_OutputList:
+			attrNames = append(attrNames, namedAttr.Name)
+		}
+	}
+	return attrNames
+}
+
+// newPredeclaredOutputFile returns a File object for an output that is
+// declared by assigning a label to an attr of type output or
+// output_list. As the output is generated by the current target, the
+// label must refer to a file in the target's package.
+func (rc *ruleContext) newPredeclaredOutputFile(attrName, labelStr string) (model_starlark.File, error) {
+	outputLabel, err := label.NewResolvedLabel(labelStr)
+	if err != nil {
+		return model_starlark.File{}, fmt.Errorf("invalid resolved label %#v: %w", labelStr, err)
+	}
+	outputCanonicalLabel, err := outputLabel.AsCanonical()
+	if err != nil {
+		return model_starlark.File{}, err
+	}
+	outputPackage := outputCanonicalLabel.GetCanonicalPackage()
+	if outputPackage != rc.targetLabel.GetCanonicalPackage() {
+		return model_starlark.File{}, fmt.Errorf("output attr %#v is set to label %#v, which refers to a different package", attrName, labelStr)
+	}
+	owner, err := rc.newFileOwner()
+	if err != nil {
+		return model_starlark.File{}, err
+	}
+	return model_starlark.NewFile(&model_starlark_pb.File{
+		Owner:               owner,
+		Package:             outputPackage.String(),
+		PackageRelativePath: outputCanonicalLabel.GetTargetName().String(),
+		Type:                model_starlark_pb.File_FILE,
+	}), nil
 }
 
 type toolchainContext struct {
@@ -2270,41 +2919,9 @@ func (tc *toolchainContext) Get(thread *starlark.Thread, v starlark.Value) (star
 		return nil, false, fmt.Errorf("exec group %#v does not depend on toolchain type %#v", namedExecGroup.Name, toolchainType)
 	}
 
-	execGroup := rc.execGroups[tc.execGroupIndex]
-	if execGroup == nil {
-		execCompatibleWith, err := rc.computer.constraintValuesToConstraints(
-			rc.context,
-			rc.environment,
-			rc.targetLabel.GetCanonicalPackage(),
-			execGroupDefinition.ExecCompatibleWith,
-		)
-		if err != nil {
-			return nil, true, evaluation.ErrMissingDependency
-		}
-		configurationReference := rc.getPatchedConfigurationReference()
-		resolvedToolchains := rc.environment.GetResolvedToolchainsValue(
-			model_core.PatchedMessage[*model_analysis_pb.ResolvedToolchains_Key, dag.ObjectContentsWalker]{
-				Message: &model_analysis_pb.ResolvedToolchains_Key{
-					ExecCompatibleWith:     execCompatibleWith,
-					ConfigurationReference: configurationReference.Message,
-					Toolchains:             execGroupDefinition.Toolchains,
-				},
-				Patcher: configurationReference.Patcher,
-			},
-		)
-		if !resolvedToolchains.IsSet() {
-			return nil, true, evaluation.ErrMissingDependency
-		}
-		toolchainIdentifiers := resolvedToolchains.Message.ToolchainIdentifiers
-		if actual, expected := len(toolchainIdentifiers), len(toolchains); actual != expected {
-			return nil, true, fmt.Errorf("obtained %d resolved toolchains, while %d were expected", actual, expected)
-		}
-
-		execGroup = &ruleContextExecGroupState{
-			toolchainIdentifiers: toolchainIdentifiers,
-			toolchainInfos:       make([]starlark.Value, len(toolchains)),
-		}
-		rc.execGroups[tc.execGroupIndex] = execGroup
+	execGroup, err := rc.getExecGroupState(tc.execGroupIndex)
+	if err != nil {
+		return nil, true, err
 	}
 
 	toolchainInfo := execGroup.toolchainInfos[toolchainIndex]
@@ -2349,10 +2966,69 @@ func (tc *toolchainContext) Get(thread *starlark.Thread, v starlark.Value) (star
 }
 
 type ruleContextExecGroupState struct {
+	platformLabel        string
 	toolchainIdentifiers []string
 	toolchainInfos       []starlark.Value
 }
 
+// getExecGroupState returns the execution platform and toolchains that
+// were selected for one of the exec groups of the rule. As resolving
+// toolchains is expensive, this is only performed when the exec group
+// is used.
+func (rc *ruleContext) getExecGroupState(execGroupIndex int) (*ruleContextExecGroupState, error) {
+	if execGroup := rc.execGroups[execGroupIndex]; execGroup != nil {
+		return execGroup, nil
+	}
+
+	namedExecGroup := rc.ruleDefinition.Message.ExecGroups[execGroupIndex]
+	execGroupDefinition := namedExecGroup.ExecGroup
+	if execGroupDefinition == nil {
+		return nil, errors.New("rule definition lacks exec group definition")
+	}
+	execCompatibleWith, err := rc.computer.constraintValuesToConstraints(
+		rc.context,
+		rc.environment,
+		rc.targetLabel.GetCanonicalPackage(),
+		execGroupDefinition.ExecCompatibleWith,
+	)
+	if err != nil {
+		if errors.Is(err, evaluation.ErrMissingDependency) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to obtain constraints of exec group %#v: %w", namedExecGroup.Name, err)
+	}
+	configurationReference := rc.getPatchedConfigurationReference()
+	resolvedToolchains := rc.environment.GetResolvedToolchainsValue(
+		model_core.PatchedMessage[*model_analysis_pb.ResolvedToolchains_Key, dag.ObjectContentsWalker]{
+			Message: &model_analysis_pb.ResolvedToolchains_Key{
+				ExecCompatibleWith:     execCompatibleWith,
+				ConfigurationReference: configurationReference.Message,
+				Toolchains:             execGroupDefinition.Toolchains,
+			},
+			Patcher: configurationReference.Patcher,
+		},
+	)
+	if !resolvedToolchains.IsSet() {
+		return nil, evaluation.ErrMissingDependency
+	}
+	toolchainIdentifiers := resolvedToolchains.Message.ToolchainIdentifiers
+	if actual, expected := len(toolchainIdentifiers), len(execGroupDefinition.Toolchains); actual != expected {
+		return nil, fmt.Errorf("obtained %d resolved toolchains, while %d were expected", actual, expected)
+	}
+	executionPlatform := resolvedToolchains.Message.ExecutionPlatform
+	if executionPlatform == nil {
+		return nil, errors.New("resolved toolchains lack an execution platform")
+	}
+
+	execGroup := &ruleContextExecGroupState{
+		platformLabel:        executionPlatform.Label,
+		toolchainIdentifiers: toolchainIdentifiers,
+		toolchainInfos:       make([]starlark.Value, len(toolchainIdentifiers)),
+	}
+	rc.execGroups[execGroupIndex] = execGroup
+	return execGroup, nil
+}
+
 type getProviderFromConfiguredTargetEnvironment interface {
 	GetConfiguredTargetValue(key model_core.PatchedMessage[*model_analysis_pb.ConfiguredTarget_Key, dag.ObjectContentsWalker]) model_core.Message[*model_analysis_pb.ConfiguredTarget_Value]
 }
@@ -2389,7 +3065,25 @@ func getProviderFromConfiguredTarget(e getProviderFromConfiguredTargetEnvironmen
 	return model_core.Message[*model_starlark_pb.Struct_Fields]{}, fmt.Errorf("target did not yield provider %#v", providerIdentifierStr)
 }
 
-type args struct{}
+// argsElement corresponds to a single call to Args.add(),
+// Args.add_all() or Args.add_joined().
+type argsElement struct {
+	argName       *string
+	values        []starlark.Value
+	mapEach       starlark.Callable
+	formatEach    string
+	beforeEach    *string
+	joinWith      *string
+	formatJoined  string
+	omitIfEmpty   bool
+	uniquify      bool
+	terminateWith *string
+}
+
+type args struct {
+	elements []argsElement
+	frozen   bool
+}
 
 var _ starlark.HasAttrs = (*args)(nil)
 
@@ -2401,7 +3095,9 @@ func (args) Type() string {
 	return "Args"
 }
 
-func (args) Freeze() {}
+func (a *args) Freeze() {
+	a.frozen = true
+}
 
 func (args) Truth() starlark.Bool {
 	return starlark.True
@@ -2440,16 +3136,296 @@ func (args) AttrNames() []string {
 	return argsAttrNames
 }
 
-func (a *args) doAdd(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+func (a *args) appendElement(b *starlark.Builtin, element argsElement) (starlark.Value, error) {
+	if a.frozen {
+		return nil, fmt.Errorf("%s: cannot modify frozen Args", b.Name())
+	}
+	a.elements = append(a.elements, element)
 	return a, nil
 }
 
+// formatArgument applies a format string containing a single "%s"
+// placeholder to a value.
+func formatArgument(format, value string) (string, error) {
+	if format == "" {
+		return value, nil
+	}
+	var sb strings.Builder
+	placeholders := 0
+	for i := 0; i < len(format); i++ {
+		if format[i] == '%' && i+1 < len(format) {
+			switch format[i+1] {
+			case '%':
+				sb.WriteByte('%')
+				i++
+				continue
+			case 's':
+				sb.WriteString(value)
+				placeholders++
+				i++
+				continue
+			}
+		}
+		sb.WriteByte(format[i])
+	}
+	if placeholders != 1 {
+		return "", fmt.Errorf("format string %#v must contain exactly one %%s placeholder", format)
+	}
+	return sb.String(), nil
+}
+
+// stringifyArgument converts a value provided to Args to a string. Files
+// are converted to their paths, which are subject to path mapping.
+func stringifyArgument(v starlark.Value, mapPaths bool) (string, error) {
+	switch typedV := v.(type) {
+	case starlark.String:
+		return string(typedV), nil
+	case model_starlark.File:
+		return getActionFilePath(typedV, mapPaths)
+	default:
+		return v.String(), nil
+	}
+}
+
+// expandValues converts the values of an element to strings, calling
+// map_each if provided.
+func (ae *argsElement) expandValues(thread *starlark.Thread, mapPaths bool) ([]string, error) {
+	values := make([]string, 0, len(ae.values))
+	for _, value := range ae.values {
+		if ae.mapEach == nil {
+			valueStr, err := stringifyArgument(value, mapPaths)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, valueStr)
+			continue
+		}
+
+		mappedValue, err := starlark.Call(thread, ae.mapEach, starlark.Tuple{value}, nil)
+		if err != nil {
+			return nil, err
+		}
+		switch typedMappedValue := mappedValue.(type) {
+		case starlark.NoneType:
+		case starlark.String:
+			values = append(values, string(typedMappedValue))
+		case *starlark.List:
+			for mappedElement := range typedMappedValue.Elements() {
+				mappedElementStr, ok := starlark.AsString(mappedElement)
+				if !ok {
+					return nil, fmt.Errorf("map_each returned a list containing a value of type %s, while strings were expected", mappedElement.Type())
+				}
+				values = append(values, mappedElementStr)
+			}
+		default:
+			return nil, fmt.Errorf("map_each returned a value of type %s, while a string, list of strings or None was expected", mappedValue.Type())
+		}
+	}
+	if ae.uniquify {
+		seen := make(map[string]struct{}, len(values))
+		values = slices.DeleteFunc(values, func(value string) bool {
+			if _, ok := seen[value]; ok {
+				return true
+			}
+			seen[value] = struct{}{}
+			return false
+		})
+	}
+	return values, nil
+}
+
+// appendExpanded appends the command line arguments described by the
+// Args object to a list of arguments.
+func (a *args) appendExpanded(thread *starlark.Thread, arguments []string, mapPaths bool) ([]string, error) {
+	for _, element := range a.elements {
+		values, err := element.expandValues(thread, mapPaths)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) == 0 && element.omitIfEmpty {
+			continue
+		}
+		if element.argName != nil {
+			arguments = append(arguments, *element.argName)
+		}
+
+		formattedValues := make([]string, 0, len(values))
+		for _, value := range values {
+			formattedValue, err := formatArgument(element.formatEach, value)
+			if err != nil {
+				return nil, err
+			}
+			formattedValues = append(formattedValues, formattedValue)
+		}
+		if element.joinWith != nil {
+			joinedValue, err := formatArgument(element.formatJoined, strings.Join(formattedValues, *element.joinWith))
+			if err != nil {
+				return nil, err
+			}
+			arguments = append(arguments, joinedValue)
+		} else {
+			for _, formattedValue := range formattedValues {
+				if element.beforeEach != nil {
+					arguments = append(arguments, *element.beforeEach)
+				}
+				arguments = append(arguments, formattedValue)
+			}
+		}
+		if element.terminateWith != nil {
+			arguments = append(arguments, *element.terminateWith)
+		}
+	}
+	return arguments, nil
+}
+
+// getArgsValues converts the values provided to Args.add_all() and
+// Args.add_joined() to a list. Both lists and depsets are accepted.
+func getArgsValues(thread *starlark.Thread, v starlark.Value) ([]starlark.Value, error) {
+	if d, ok := v.(*model_starlark.Depset); ok {
+		l, err := d.ToList(thread)
+		if err != nil {
+			return nil, err
+		}
+		v = l
+	}
+	iterable, ok := v.(starlark.Iterable)
+	if !ok {
+		return nil, fmt.Errorf("values: got %s, want sequence or depset", v.Type())
+	}
+	var values []starlark.Value
+	iter := iterable.Iterate()
+	defer iter.Done()
+	var value starlark.Value
+	for iter.Next(&value) {
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// getArgsNameAndValues processes the arguments of Args.add(),
+// Args.add_all() and Args.add_joined(), where the first positional
+// argument is optional.
+func getArgsNameAndValues(argNameOrValues, values starlark.Value) (*string, starlark.Value, error) {
+	if values == nil {
+		return nil, argNameOrValues, nil
+	}
+	argName, ok := starlark.AsString(argNameOrValues)
+	if !ok {
+		return nil, nil, fmt.Errorf("arg_name: got %s, want string", argNameOrValues.Type())
+	}
+	return &argName, values, nil
+}
+
+func (a *args) doAdd(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var argNameOrValue, value starlark.Value
+	format := ""
+	if err := starlark.UnpackArgs(
+		b.Name(), args, kwargs,
+		"arg_name_or_value", &argNameOrValue,
+		"value?", &value,
+		"format?", unpack.Bind(thread, &format, unpack.IfNotNone(unpack.String)),
+	); err != nil {
+		return nil, err
+	}
+	argName, value, err := getArgsNameAndValues(argNameOrValue, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", b.Name(), err)
+	}
+	return a.appendElement(b, argsElement{
+		argName:    argName,
+		values:     []starlark.Value{value},
+		formatEach: format,
+	})
+}
+
 func (a *args) doAddAll(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
-	return a, nil
+	var argNameOrValues, values starlark.Value
+	var mapEach starlark.Callable
+	formatEach := ""
+	var beforeEach *string
+	omitIfEmpty := true
+	uniquify := false
+	expandDirectories := true
+	var terminateWith *string
+	allowClosure := false
+	if err := starlark.UnpackArgs(
+		b.Name(), args, kwargs,
+		"arg_name_or_values", &argNameOrValues,
+		"values?", &values,
+		"map_each?", unpack.Bind(thread, &mapEach, unpack.IfNotNone(unpack.Type[starlark.Callable]("function"))),
+		"format_each?", unpack.Bind(thread, &formatEach, unpack.IfNotNone(unpack.String)),
+		"before_each?", unpack.Bind(thread, &beforeEach, unpack.IfNotNone(unpack.Pointer(unpack.String))),
+		"omit_if_empty?", unpack.Bind(thread, &omitIfEmpty, unpack.Bool),
+		"uniquify?", unpack.Bind(thread, &uniquify, unpack.Bool),
+		"expand_directories?", unpack.Bind(thread, &expandDirectories, unpack.Bool),
+		"terminate_with?", unpack.Bind(thread, &terminateWith, unpack.IfNotNone(unpack.Pointer(unpack.String))),
+		"allow_closure?", unpack.Bind(thread, &allowClosure, unpack.Bool),
+	); err != nil {
+		return nil, err
+	}
+	argName, values, err := getArgsNameAndValues(argNameOrValues, values)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", b.Name(), err)
+	}
+	valuesList, err := getArgsValues(thread, values)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", b.Name(), err)
+	}
+	return a.appendElement(b, argsElement{
+		argName:       argName,
+		values:        valuesList,
+		mapEach:       mapEach,
+		formatEach:    formatEach,
+		beforeEach:    beforeEach,
+		omitIfEmpty:   omitIfEmpty,
+		uniquify:      uniquify,
+		terminateWith: terminateWith,
+	})
 }
 
 func (a *args) doAddJoined(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
-	return a, nil
+	var argNameOrValues, values starlark.Value
+	var joinWith string
+	var mapEach starlark.Callable
+	formatEach := ""
+	formatJoined := ""
+	omitIfEmpty := true
+	uniquify := false
+	expandDirectories := true
+	allowClosure := false
+	if err := starlark.UnpackArgs(
+		b.Name(), args, kwargs,
+		"arg_name_or_values", &argNameOrValues,
+		"values?", &values,
+		"join_with", unpack.Bind(thread, &joinWith, unpack.String),
+		"map_each?", unpack.Bind(thread, &mapEach, unpack.IfNotNone(unpack.Type[starlark.Callable]("function"))),
+		"format_each?", unpack.Bind(thread, &formatEach, unpack.IfNotNone(unpack.String)),
+		"format_joined?", unpack.Bind(thread, &formatJoined, unpack.IfNotNone(unpack.String)),
+		"omit_if_empty?", unpack.Bind(thread, &omitIfEmpty, unpack.Bool),
+		"uniquify?", unpack.Bind(thread, &uniquify, unpack.Bool),
+		"expand_directories?", unpack.Bind(thread, &expandDirectories, unpack.Bool),
+		"allow_closure?", unpack.Bind(thread, &allowClosure, unpack.Bool),
+	); err != nil {
+		return nil, err
+	}
+	argName, values, err := getArgsNameAndValues(argNameOrValues, values)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", b.Name(), err)
+	}
+	valuesList, err := getArgsValues(thread, values)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", b.Name(), err)
+	}
+	return a.appendElement(b, argsElement{
+		argName:      argName,
+		values:       valuesList,
+		mapEach:      mapEach,
+		formatEach:   formatEach,
+		joinWith:     &joinWith,
+		formatJoined: formatJoined,
+		omitIfEmpty:  omitIfEmpty,
+		uniquify:     uniquify,
+	})
 }
 
 func (a *args) doSetParamFileFormat(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
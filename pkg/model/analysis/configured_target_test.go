@@ -0,0 +1,455 @@
+package analysis
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.starlark.net/starlark"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestSelectGroup(value *model_starlark_pb.Value) *model_starlark_pb.Select_Group {
+	return &model_starlark_pb.Select_Group{
+		NoMatch: &model_starlark_pb.Select_Group_NoMatchValue{
+			NoMatchValue: value,
+		},
+	}
+}
+
+func newTestNoneValue() *model_starlark_pb.Value {
+	return &model_starlark_pb.Value{
+		Kind: &model_starlark_pb.Value_None{
+			None: &emptypb.Empty{},
+		},
+	}
+}
+
+func newTestLabelListValue(labels ...string) *model_starlark_pb.Value {
+	elements := make([]*model_starlark_pb.List_Element, 0, len(labels))
+	for _, label := range labels {
+		elements = append(elements, &model_starlark_pb.List_Element{
+			Level: &model_starlark_pb.List_Element_Leaf{
+				Leaf: newTestLabelValue(label),
+			},
+		})
+	}
+	return &model_starlark_pb.Value{
+		Kind: &model_starlark_pb.Value_List{
+			List: &model_starlark_pb.List{Elements: elements},
+		},
+	}
+}
+
+// newTestOutputsRuleContext creates a ruleContext for a target whose
+// rule has attrs of type output and output_list, which can be used to
+// test ctx.outputs.
+func newTestOutputsRuleContext(ctrl *gomock.Controller, attrValues []*model_starlark_pb.RuleTarget_AttrValue) *ruleContext {
+	ruleDefinition := &model_starlark_pb.Rule_Definition{
+		Attrs: []*model_starlark_pb.NamedAttr{
+			{
+				Name: "out",
+				Attr: &model_starlark_pb.Attr{
+					Type: &model_starlark_pb.Attr_Output{
+						Output: &model_starlark_pb.Attr_OutputType{},
+					},
+					Default: newTestNoneValue(),
+				},
+			},
+			{
+				Name: "outs",
+				Attr: &model_starlark_pb.Attr{
+					Type: &model_starlark_pb.Attr_OutputList{
+						OutputList: &model_starlark_pb.Attr_OutputListType{},
+					},
+					Default: newTestLabelListValue(),
+				},
+			},
+			{
+				Name: "srcs",
+				Attr: &model_starlark_pb.Attr{
+					Type: &model_starlark_pb.Attr_LabelList{
+						LabelList: &model_starlark_pb.Attr_LabelListType{},
+					},
+					Default: newTestLabelListValue(),
+				},
+			},
+		},
+	}
+	e := NewMockConfiguredTargetEnvironment(ctrl)
+	e.EXPECT().GetTargetValue(gomock.Any()).DoAndReturn(getTestPlatformTargetValue).AnyTimes()
+	return &ruleContext{
+		computer:               newTestBaseComputer(testObjectDownloader{}),
+		context:                context.Background(),
+		environment:            e,
+		ruleIdentifier:         label.MustNewCanonicalStarlarkIdentifier("@@example+//:rules.bzl%my_rule"),
+		targetLabel:            label.MustNewCanonicalLabel("@@example+//pkg:foo"),
+		configurationReference: model_core.NewSimpleMessage[*model_core_pb.Reference](nil),
+		ruleDefinition:         model_core.NewSimpleMessage(ruleDefinition),
+		ruleTarget: model_core.NewSimpleMessage(&model_starlark_pb.RuleTarget{
+			RuleIdentifier: "@@example+//:rules.bzl%my_rule",
+			AttrValues:     attrValues,
+		}),
+		outputs: make([]starlark.Value, len(ruleDefinition.Attrs)),
+	}
+}
+
+func requireOutputFilePath(t *testing.T, expectedPath string, output starlark.Value) {
+	file, ok := output.(model_starlark.File)
+	require.True(t, ok, "value %s is not a File", output)
+	path, err := file.Attr(nil, "path")
+	require.NoError(t, err)
+	require.Equal(t, starlark.String(expectedPath), path)
+}
+
+func TestRuleContextOutputs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	checksum, err := getConfigurationChecksum(model_core.NewSimpleMessage[*model_core_pb.Reference](nil))
+	require.NoError(t, err)
+	binDirectory := "bazel-out/host-" + hex.EncodeToString(checksum) + "/bin/external/example+/pkg/"
+
+	t.Run("AttrNames", func(t *testing.T) {
+		// Only attrs of type output and output_list should be
+		// exposed through ctx.outputs.
+		rco := &ruleContextOutputs{ruleContext: newTestOutputsRuleContext(ctrl, nil)}
+		require.Equal(t, []string{"out", "outs"}, rco.AttrNames())
+	})
+
+	t.Run("Output", func(t *testing.T) {
+		rco := &ruleContextOutputs{ruleContext: newTestOutputsRuleContext(ctrl, []*model_starlark_pb.RuleTarget_AttrValue{
+			{
+				Name:       "out",
+				ValueParts: []*model_starlark_pb.Select_Group{newTestSelectGroup(newTestLabelValue("@@example+//pkg:foo.txt"))},
+			},
+		})}
+		output, err := rco.Attr(nil, "out")
+		require.NoError(t, err)
+		requireOutputFilePath(t, binDirectory+"foo.txt", output)
+
+		// Subsequent lookups should return the cached value.
+		outputAgain, err := rco.Attr(nil, "out")
+		require.NoError(t, err)
+		require.Equal(t, output, outputAgain)
+	})
+
+	// Attrs that are not set by the target have value None, causing
+	// the default value from the rule definition to be used.
+	unsetAttrValues := []*model_starlark_pb.RuleTarget_AttrValue{
+		{
+			Name:       "out",
+			ValueParts: []*model_starlark_pb.Select_Group{newTestSelectGroup(newTestNoneValue())},
+		},
+		{
+			Name:       "outs",
+			ValueParts: []*model_starlark_pb.Select_Group{newTestSelectGroup(newTestNoneValue())},
+		},
+	}
+
+	t.Run("OutputUnset", func(t *testing.T) {
+		// If no label is assigned to an attr of type output,
+		// its value is None.
+		rco := &ruleContextOutputs{ruleContext: newTestOutputsRuleContext(ctrl, unsetAttrValues)}
+		output, err := rco.Attr(nil, "out")
+		require.NoError(t, err)
+		require.Equal(t, starlark.None, output)
+	})
+
+	t.Run("OutputList", func(t *testing.T) {
+		// If select() is used, the value of an attr of type
+		// output_list may consist of multiple parts. The files
+		// of all parts should be returned in order.
+		rco := &ruleContextOutputs{ruleContext: newTestOutputsRuleContext(ctrl, []*model_starlark_pb.RuleTarget_AttrValue{
+			{
+				Name: "outs",
+				ValueParts: []*model_starlark_pb.Select_Group{
+					newTestSelectGroup(newTestLabelListValue("@@example+//pkg:b.txt", "@@example+//pkg:a.txt")),
+					newTestSelectGroup(newTestLabelListValue("@@example+//pkg:sub/c.txt")),
+				},
+			},
+		})}
+		outputs, err := rco.Attr(nil, "outs")
+		require.NoError(t, err)
+		outputsList, ok := outputs.(*starlark.List)
+		require.True(t, ok)
+		require.Equal(t, 3, outputsList.Len())
+		requireOutputFilePath(t, binDirectory+"b.txt", outputsList.Index(0))
+		requireOutputFilePath(t, binDirectory+"a.txt", outputsList.Index(1))
+		requireOutputFilePath(t, binDirectory+"sub/c.txt", outputsList.Index(2))
+
+		// The list is cached, so it must be frozen to prevent
+		// rule implementations from modifying it.
+		require.Error(t, outputsList.Append(starlark.None))
+	})
+
+	t.Run("OutputListUnset", func(t *testing.T) {
+		rco := &ruleContextOutputs{ruleContext: newTestOutputsRuleContext(ctrl, unsetAttrValues)}
+		outputs, err := rco.Attr(nil, "outs")
+		require.NoError(t, err)
+		require.Equal(t, 0, outputs.(*starlark.List).Len())
+	})
+
+	t.Run("OutputListDifferentPackage", func(t *testing.T) {
+		// Predeclared outputs must be placed in the package of
+		// the target that generates them.
+		rco := &ruleContextOutputs{ruleContext: newTestOutputsRuleContext(ctrl, []*model_starlark_pb.RuleTarget_AttrValue{
+			{
+				Name:       "outs",
+				ValueParts: []*model_starlark_pb.Select_Group{newTestSelectGroup(newTestLabelListValue("@@example+//other:a.txt"))},
+			},
+		})}
+		_, err := rco.Attr(nil, "outs")
+		require.EqualError(t, err, "output attr \"outs\" is set to label \"@@example+//other:a.txt\", which refers to a different package")
+	})
+
+	t.Run("OutputListNonLabel", func(t *testing.T) {
+		rco := &ruleContextOutputs{ruleContext: newTestOutputsRuleContext(ctrl, []*model_starlark_pb.RuleTarget_AttrValue{
+			{
+				Name: "outs",
+				ValueParts: []*model_starlark_pb.Select_Group{newTestSelectGroup(&model_starlark_pb.Value{
+					Kind: &model_starlark_pb.Value_List{
+						List: &model_starlark_pb.List{
+							Elements: []*model_starlark_pb.List_Element{{
+								Level: &model_starlark_pb.List_Element_Leaf{
+									Leaf: &model_starlark_pb.Value{
+										Kind: &model_starlark_pb.Value_Str{Str: "a.txt"},
+									},
+								},
+							}},
+						},
+					},
+				})},
+			},
+		})}
+		_, err := rco.Attr(nil, "outs")
+		require.EqualError(t, err, "attr \"outs\" contains non-label values")
+	})
+
+	t.Run("NotAnOutputAttr", func(t *testing.T) {
+		rco := &ruleContextOutputs{ruleContext: newTestOutputsRuleContext(ctrl, nil)}
+		_, err := rco.Attr(nil, "srcs")
+		require.EqualError(t, err, "attr \"srcs\" is not of type output or output_list")
+	})
+
+	t.Run("UnknownAttr", func(t *testing.T) {
+		rco := &ruleContextOutputs{ruleContext: newTestOutputsRuleContext(ctrl, nil)}
+		_, err := rco.Attr(nil, "nonexistent")
+		require.EqualError(t, err, "rule does not have an attr named \"nonexistent\"")
+	})
+}
+
+func TestRuleContextGetExecGroupState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	newRuleContext := func(e ConfiguredTargetEnvironment) *ruleContext {
+		ruleDefinition := &model_starlark_pb.Rule_Definition{
+			ExecGroups: []*model_starlark_pb.NamedExecGroup{{
+				Name: "compile",
+				ExecGroup: &model_starlark_pb.ExecGroup{
+					ExecCompatibleWith: []string{"@@example+//constraints:linux"},
+				},
+			}},
+		}
+		return &ruleContext{
+			computer:               newTestBaseComputer(testObjectDownloader{}),
+			context:                context.Background(),
+			environment:            e,
+			targetLabel:            label.MustNewCanonicalLabel("@@example+//pkg:foo"),
+			configurationReference: model_core.NewSimpleMessage[*model_core_pb.Reference](nil),
+			ruleDefinition:         model_core.NewSimpleMessage(ruleDefinition),
+			execGroups:             make([]*ruleContextExecGroupState, len(ruleDefinition.ExecGroups)),
+		}
+	}
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		// Missing dependencies of the exec group's constraints
+		// should be propagated as is, so that evaluation can be
+		// retried.
+		e := NewMockConfiguredTargetEnvironment(ctrl)
+		e.EXPECT().GetVisibleTargetValue(gomock.Any()).Return(model_core.Message[*model_analysis_pb.VisibleTarget_Value]{})
+
+		_, err := newRuleContext(e).getExecGroupState(0)
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+	})
+
+	t.Run("NotAConstraintValue", func(t *testing.T) {
+		// Other errors should be reported, instead of causing
+		// evaluation to be retried indefinitely.
+		e := NewMockConfiguredTargetEnvironment(ctrl)
+		e.EXPECT().GetVisibleTargetValue(gomock.Any()).Return(model_core.NewSimpleMessage(&model_analysis_pb.VisibleTarget_Value{
+			Label: "@@example+//constraints:linux",
+		}))
+		e.EXPECT().GetConfiguredTargetValue(gomock.Any()).Return(model_core.NewSimpleMessage(&model_analysis_pb.ConfiguredTarget_Value{}))
+
+		_, err := newRuleContext(e).getExecGroupState(0)
+		require.EqualError(t, err, "failed to obtain constraints of exec group \"compile\": target did not yield provider \""+constraintValueInfoProviderIdentifier.String()+"\"")
+	})
+}
+
+func TestRuleContextNameDependencyEdge(t *testing.T) {
+	rc := &ruleContext{
+		targetLabel: label.MustNewCanonicalLabel("@@example+//app:main"),
+	}
+	toLabel := label.MustNewCanonicalLabel("@@example+//lib:internal").AsResolved()
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		// Missing dependencies should be propagated as is, so
+		// that evaluation can be retried.
+		_, err := rc.nameDependencyEdge("deps", func(label.ResolvedLabel) (starlark.Value, error) {
+			return nil, evaluation.ErrMissingDependency
+		})(toLabel)
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+	})
+
+	t.Run("NotVisible", func(t *testing.T) {
+		// Other errors should name the dependency edge that
+		// caused them.
+		_, err := rc.nameDependencyEdge("deps", func(label.ResolvedLabel) (starlark.Value, error) {
+			return nil, errors.New("target is not visible")
+		})(toLabel)
+		require.EqualError(t, err, "dependency of target \"@@example+//app:main\" on \"@@example+//lib:internal\" through attr \"deps\": target is not visible")
+	})
+}
+
+func TestRuleContextActionsPathMapping(t *testing.T) {
+	thread := &starlark.Thread{}
+	builtin := starlark.NewBuiltin("ctx.actions", nil)
+
+	// runShell creates a rule context for a target in a given
+	// configuration, and lets it register an action that copies a
+	// file built by another target in the same configuration.
+	runShell := func(t *testing.T, configurationChecksum []byte, executionRequirements *starlark.Dict) *ruleContextAction {
+		od := &outputDirectory{
+			mnemonic:              "linux_x86_64",
+			configurationChecksum: configurationChecksum,
+		}
+		rc := &ruleContext{
+			targetLabel:     label.MustNewCanonicalLabel("@@example+//pkg:foo"),
+			outputDirectory: od,
+		}
+		rca := &ruleContextActions{ruleContext: rc}
+		output, err := rca.doDeclareFile(thread, builtin, starlark.Tuple{starlark.String("out.txt")}, nil)
+		require.NoError(t, err)
+		input := model_starlark.NewFile(&model_starlark_pb.File{
+			Owner:               od.newFileOwner("bar"),
+			Package:             "@@example+//pkg",
+			PackageRelativePath: "in.txt",
+			Type:                model_starlark_pb.File_FILE,
+		})
+
+		a := &args{}
+		_, err = a.doAdd(thread, builtin, starlark.Tuple{starlark.String("--out"), output}, nil)
+		require.NoError(t, err)
+		_, err = a.doAddAll(thread, builtin, starlark.Tuple{starlark.NewList([]starlark.Value{input})}, nil)
+		require.NoError(t, err)
+
+		_, err = rca.doRunShell(thread, builtin, nil, []starlark.Tuple{
+			{starlark.String("outputs"), starlark.NewList([]starlark.Value{output})},
+			{starlark.String("inputs"), starlark.NewList([]starlark.Value{input})},
+			{starlark.String("arguments"), starlark.NewList([]starlark.Value{a})},
+			{starlark.String("mnemonic"), starlark.String("Copy")},
+			{starlark.String("command"), starlark.String("cp \"$3\" \"$2\"")},
+			{starlark.String("execution_requirements"), executionRequirements},
+		})
+		require.NoError(t, err)
+		require.Len(t, rc.actions, 1)
+		return rc.actions[0]
+	}
+	checksum1 := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+	checksum2 := []byte{0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
+
+	t.Run("SupportsPathMapping", func(t *testing.T) {
+		// If the action supports path mapping, the names of
+		// output directories should be replaced by a
+		// placeholder. This causes the action to be identical
+		// in both configurations.
+		executionRequirements := starlark.NewDict(1)
+		require.NoError(t, executionRequirements.SetKey(thread, starlark.String("supports-path-mapping"), starlark.String("1")))
+		action1 := runShell(t, checksum1, executionRequirements)
+		action2 := runShell(t, checksum2, executionRequirements)
+		require.Equal(t, &ruleContextAction{
+			mnemonic: "Copy",
+			arguments: []string{
+				"/bin/bash",
+				"-c",
+				"cp \"$3\" \"$2\"",
+				"",
+				"--out",
+				"bazel-out/cfg/bin/external/example+/pkg/out.txt",
+				"bazel-out/cfg/bin/external/example+/pkg/in.txt",
+			},
+			inputs:  []string{"bazel-out/cfg/bin/external/example+/pkg/in.txt"},
+			outputs: []string{"bazel-out/cfg/bin/external/example+/pkg/out.txt"},
+		}, action1)
+		require.Equal(t, action1, action2)
+	})
+
+	t.Run("NoPathMapping", func(t *testing.T) {
+		// Without path mapping, the actions should refer to
+		// the configuration specific output directories.
+		action1 := runShell(t, checksum1, starlark.NewDict(0))
+		action2 := runShell(t, checksum2, starlark.NewDict(0))
+		require.Equal(t, []string{"bazel-out/linux_x86_64-010203040506/bin/external/example+/pkg/in.txt"}, action1.inputs)
+		require.Equal(t, []string{"bazel-out/linux_x86_64-0a0b0c0d0e0f/bin/external/example+/pkg/in.txt"}, action2.inputs)
+		require.NotEqual(t, action1.arguments, action2.arguments)
+	})
+}
+
+func TestArgs(t *testing.T) {
+	thread := &starlark.Thread{}
+	builtin := starlark.NewBuiltin("Args", nil)
+
+	t.Run("AddAllAndJoined", func(t *testing.T) {
+		a := &args{}
+		values := starlark.NewList([]starlark.Value{
+			starlark.String("a"),
+			starlark.String("b"),
+			starlark.String("a"),
+		})
+		_, err := a.doAddAll(thread, builtin, starlark.Tuple{starlark.String("--all"), values}, []starlark.Tuple{
+			{starlark.String("before_each"), starlark.String("-I")},
+			{starlark.String("format_each"), starlark.String("%s/include")},
+			{starlark.String("uniquify"), starlark.True},
+		})
+		require.NoError(t, err)
+		_, err = a.doAddJoined(thread, builtin, starlark.Tuple{values}, []starlark.Tuple{
+			{starlark.String("join_with"), starlark.String(",")},
+			{starlark.String("format_joined"), starlark.String("--joined=%s")},
+		})
+		require.NoError(t, err)
+		_, err = a.doAddAll(thread, builtin, starlark.Tuple{starlark.String("--empty"), starlark.NewList(nil)}, nil)
+		require.NoError(t, err)
+
+		arguments, err := a.appendExpanded(thread, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"--all",
+			"-I",
+			"a/include",
+			"-I",
+			"b/include",
+			"--joined=a,b,a",
+		}, arguments)
+	})
+
+	t.Run("InvalidFormat", func(t *testing.T) {
+		a := &args{}
+		_, err := a.doAdd(thread, builtin, starlark.Tuple{starlark.String("a")}, []starlark.Tuple{
+			{starlark.String("format"), starlark.String("%s=%s")},
+		})
+		require.NoError(t, err)
+		_, err = a.appendExpanded(thread, nil, false)
+		require.EqualError(t, err, "format string \"%s=%s\" must contain exactly one %s placeholder")
+	})
+}
@@ -0,0 +1,102 @@
+package analysis
+
+import (
+	"sync"
+
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+
+	"go.starlark.net/starlark"
+)
+
+// DiagnosticsReporter is called into by the computer whenever Starlark
+// code calls print(), or when a warning needs to be displayed to the
+// user.
+type DiagnosticsReporter interface {
+	ReportDiagnostic(diagnostic *model_build_pb.Diagnostic)
+}
+
+type diagnosticKey struct {
+	kind     model_build_pb.Diagnostic_Kind
+	location string
+	message  string
+}
+
+// DiagnosticsCollector is an implementation of DiagnosticsReporter
+// that retains all diagnostics that are reported, so that they can be
+// returned to the client.
+//
+// Computing a value may be restarted if one of its dependencies is
+// missing, causing the same diagnostics to be reported repeatedly.
+// Duplicate diagnostics are therefore discarded.
+type DiagnosticsCollector struct {
+	onChange func(diagnostics []*model_build_pb.Diagnostic)
+
+	lock        sync.Mutex
+	seen        map[diagnosticKey]struct{}
+	diagnostics []*model_build_pb.Diagnostic
+}
+
+var _ DiagnosticsReporter = (*DiagnosticsCollector)(nil)
+
+// NewDiagnosticsCollector creates a DiagnosticsCollector that contains
+// no diagnostics. The provided callback is invoked every time a
+// diagnostic is added, receiving the full list of diagnostics.
+func NewDiagnosticsCollector(onChange func(diagnostics []*model_build_pb.Diagnostic)) *DiagnosticsCollector {
+	return &DiagnosticsCollector{
+		onChange: onChange,
+		seen:     map[diagnosticKey]struct{}{},
+	}
+}
+
+// ReportDiagnostic adds a diagnostic to the collector, if it was not
+// reported previously.
+func (dc *DiagnosticsCollector) ReportDiagnostic(diagnostic *model_build_pb.Diagnostic) {
+	key := diagnosticKey{
+		kind:     diagnostic.Kind,
+		location: diagnostic.Location,
+		message:  diagnostic.Message,
+	}
+
+	dc.lock.Lock()
+	defer dc.lock.Unlock()
+	if _, ok := dc.seen[key]; ok {
+		return
+	}
+	dc.seen[key] = struct{}{}
+	dc.diagnostics = append(dc.diagnostics, diagnostic)
+	dc.onChange(dc.diagnostics[:len(dc.diagnostics):len(dc.diagnostics)])
+}
+
+// GetDiagnostics returns all diagnostics that were reported, in the
+// order in which they were reported.
+func (dc *DiagnosticsCollector) GetDiagnostics() []*model_build_pb.Diagnostic {
+	dc.lock.Lock()
+	defer dc.lock.Unlock()
+	return dc.diagnostics[:len(dc.diagnostics):len(dc.diagnostics)]
+}
+
+// printFromStarlark can be used as the print() function of Starlark
+// threads. It reports the message as a debug diagnostic, annotated
+// with the location of the caller.
+func (c *baseComputer) printFromStarlark(thread *starlark.Thread, msg string) {
+	var location string
+	if thread.CallStackDepth() > 1 {
+		// Frame 0 corresponds to print() itself.
+		location = thread.CallFrame(1).Pos.String()
+	}
+	c.diagnosticsReporter.ReportDiagnostic(&model_build_pb.Diagnostic{
+		Kind:     model_build_pb.Diagnostic_DEBUG,
+		Location: location,
+		Message:  msg,
+	})
+}
+
+// reportWarning reports a warning that does not cause the build to
+// fail, such as an inconsistency in a MODULE.bazel file that the user
+// should address.
+func (c *baseComputer) reportWarning(message string) {
+	c.diagnosticsReporter.ReportDiagnostic(&model_build_pb.Diagnostic{
+		Kind:    model_build_pb.Diagnostic_WARNING,
+		Message: message,
+	})
+}
@@ -0,0 +1,125 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	"github.com/stretchr/testify/require"
+
+	"go.starlark.net/starlark"
+)
+
+func TestDiagnosticsCollector(t *testing.T) {
+	var updates [][]*model_build_pb.Diagnostic
+	dc := NewDiagnosticsCollector(func(diagnostics []*model_build_pb.Diagnostic) {
+		updates = append(updates, diagnostics)
+	})
+	require.Empty(t, dc.GetDiagnostics())
+
+	diagnostic1 := &model_build_pb.Diagnostic{
+		Kind:     model_build_pb.Diagnostic_DEBUG,
+		Location: "@@example+//:defs.bzl:12:5",
+		Message:  "Hello",
+	}
+	diagnostic2 := &model_build_pb.Diagnostic{
+		Kind:    model_build_pb.Diagnostic_WARNING,
+		Message: "Hello",
+	}
+	diagnostic3 := &model_build_pb.Diagnostic{
+		Kind:     model_build_pb.Diagnostic_DEBUG,
+		Location: "@@example+//:defs.bzl:13:5",
+		Message:  "Hello",
+	}
+
+	t.Run("Order", func(t *testing.T) {
+		// Diagnostics should be returned in the order in which
+		// they were reported. Diagnostics that only differ in
+		// kind or location are not duplicates.
+		dc.ReportDiagnostic(diagnostic1)
+		dc.ReportDiagnostic(diagnostic2)
+		dc.ReportDiagnostic(diagnostic3)
+
+		diagnostics := dc.GetDiagnostics()
+		require.Len(t, diagnostics, 3)
+		testutil.RequireEqualProto(t, diagnostic1, diagnostics[0])
+		testutil.RequireEqualProto(t, diagnostic2, diagnostics[1])
+		testutil.RequireEqualProto(t, diagnostic3, diagnostics[2])
+
+		// The callback should be invoked for every diagnostic
+		// that is added, receiving the full list.
+		require.Len(t, updates, 3)
+		for i, update := range updates {
+			require.Len(t, update, i+1)
+		}
+	})
+
+	t.Run("Deduplication", func(t *testing.T) {
+		// Computing a value may be restarted, causing the same
+		// diagnostic to be reported again. Such diagnostics
+		// should be discarded without invoking the callback.
+		dc.ReportDiagnostic(&model_build_pb.Diagnostic{
+			Kind:     model_build_pb.Diagnostic_DEBUG,
+			Location: "@@example+//:defs.bzl:12:5",
+			Message:  "Hello",
+		})
+		dc.ReportDiagnostic(diagnostic2)
+
+		require.Len(t, dc.GetDiagnostics(), 3)
+		require.Len(t, updates, 3)
+	})
+
+	t.Run("ImmutableSnapshots", func(t *testing.T) {
+		// Lists of diagnostics that were handed out previously
+		// must not be affected by diagnostics that are added
+		// afterwards.
+		snapshot := dc.GetDiagnostics()
+		diagnostic4 := &model_build_pb.Diagnostic{
+			Kind:    model_build_pb.Diagnostic_WARNING,
+			Message: "Goodbye",
+		}
+		dc.ReportDiagnostic(diagnostic4)
+
+		require.Len(t, snapshot, 3)
+		require.Len(t, updates[2], 3)
+		diagnostics := dc.GetDiagnostics()
+		require.Len(t, diagnostics, 4)
+		testutil.RequireEqualProto(t, diagnostic4, diagnostics[3])
+	})
+}
+
+func TestBaseComputerPrintFromStarlark(t *testing.T) {
+	dc := NewDiagnosticsCollector(func([]*model_build_pb.Diagnostic) {})
+	c := &baseComputer{diagnosticsReporter: dc}
+
+	// Calls to print() should be reported as debug diagnostics,
+	// annotated with the location of the caller. Printing the same
+	// message at the same location repeatedly should only yield a
+	// single diagnostic.
+	thread := &starlark.Thread{
+		Name:  "main",
+		Print: c.printFromStarlark,
+	}
+	_, err := starlark.ExecFile(thread, "@@example+//:defs.bzl", `
+def hello(name):
+    print("Hello, %s!" % name)
+
+hello("world")
+hello("world")
+print("Goodbye")
+`, nil)
+	require.NoError(t, err)
+
+	diagnostics := dc.GetDiagnostics()
+	require.Len(t, diagnostics, 2)
+	testutil.RequireEqualProto(t, &model_build_pb.Diagnostic{
+		Kind:     model_build_pb.Diagnostic_DEBUG,
+		Location: "@@example+//:defs.bzl:3:10",
+		Message:  "Hello, world!",
+	}, diagnostics[0])
+	testutil.RequireEqualProto(t, &model_build_pb.Diagnostic{
+		Kind:     model_build_pb.Diagnostic_DEBUG,
+		Location: "@@example+//:defs.bzl:7:6",
+		Message:  "Goodbye",
+	}, diagnostics[1])
+}
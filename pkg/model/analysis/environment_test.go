@@ -0,0 +1,188 @@
+package analysis
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_encoding "github.com/buildbarn/bonanza/pkg/model/encoding"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// newTestMessage returns a message that contains a value, or an unset
+// message if the value is not present. This can be used by mocks of
+// environments to report missing dependencies.
+func newTestMessage[T proto.Message](v T, ok bool) model_core.Message[T] {
+	if !ok {
+		return model_core.Message[T]{}
+	}
+	return model_core.NewSimpleMessage(v)
+}
+
+// getTestValueFromMap returns a function that can be used as an
+// implementation of one of the Get*Value() methods of a mock of an
+// environment. Values are constructed from entries in a map, keyed by
+// a string that is extracted from the key. If the map does not contain
+// an entry, the value is reported as missing.
+func getTestValueFromMap[TKey, TEntry any, TValue proto.Message](entries map[string]TEntry, getEntryKey func(TKey) string, newValue func(TEntry) TValue) func(TKey) model_core.Message[TValue] {
+	return func(key TKey) model_core.Message[TValue] {
+		entry, ok := entries[getEntryKey(key)]
+		return newTestMessage(newValue(entry), ok)
+	}
+}
+
+// getTestTargetValueFromMap can be used as an implementation of
+// GetTargetValue() that serves targets from a map, keyed by label.
+func getTestTargetValueFromMap(targets map[string]*model_analysis_pb.Target_Value) func(*model_analysis_pb.Target_Key) model_core.Message[*model_analysis_pb.Target_Value] {
+	return getTestValueFromMap(
+		targets,
+		(*model_analysis_pb.Target_Key).GetLabel,
+		func(target *model_analysis_pb.Target_Value) *model_analysis_pb.Target_Value {
+			return target
+		},
+	)
+}
+
+// getTestVisibleTargetValueFromMap can be used as an implementation of
+// GetVisibleTargetValue() that serves visible targets from a map. The
+// map is keyed by the label to which the key refers, yielding the label
+// of the actual target, having aliases expanded.
+func getTestVisibleTargetValueFromMap(visibleTargets map[string]string) func(model_core.PatchedMessage[*model_analysis_pb.VisibleTarget_Key, dag.ObjectContentsWalker]) model_core.Message[*model_analysis_pb.VisibleTarget_Value] {
+	return getTestValueFromMap(
+		visibleTargets,
+		func(key model_core.PatchedMessage[*model_analysis_pb.VisibleTarget_Key, dag.ObjectContentsWalker]) string {
+			return key.Message.ToLabel
+		},
+		func(label string) *model_analysis_pb.VisibleTarget_Value {
+			return &model_analysis_pb.VisibleTarget_Value{Label: label}
+		},
+	)
+}
+
+// getTestConfiguredTargetValueFromMap can be used as an implementation
+// of GetConfiguredTargetValue() that serves provider instances of
+// configured targets from a map, keyed by label. Configurations are
+// ignored.
+func getTestConfiguredTargetValueFromMap(configuredTargets map[string][]*model_starlark_pb.Struct) func(model_core.PatchedMessage[*model_analysis_pb.ConfiguredTarget_Key, dag.ObjectContentsWalker]) model_core.Message[*model_analysis_pb.ConfiguredTarget_Value] {
+	return getTestValueFromMap(
+		configuredTargets,
+		func(key model_core.PatchedMessage[*model_analysis_pb.ConfiguredTarget_Key, dag.ObjectContentsWalker]) string {
+			return key.Message.Label
+		},
+		func(providerInstances []*model_starlark_pb.Struct) *model_analysis_pb.ConfiguredTarget_Value {
+			return &model_analysis_pb.ConfiguredTarget_Value{ProviderInstances: providerInstances}
+		},
+	)
+}
+
+// testObjectDownloader is an in-memory object store, which can be used
+// to provide objects created by the computer back to it.
+type testObjectDownloader map[object.LocalReference]*object.Contents
+
+func (d testObjectDownloader) DownloadObject(ctx context.Context, reference object.LocalReference) (*object.Contents, error) {
+	if contents, ok := d[reference]; ok {
+		return contents, nil
+	}
+	return nil, status.Error(codes.NotFound, "Object not found")
+}
+
+func newTestBaseComputer(objectDownloader testObjectDownloader) *baseComputer {
+	return &baseComputer{
+		objectDownloader:            objectDownloader,
+		buildSpecificationReference: object.MustNewSHA256V1GlobalReference("hello", "8f6dd7bb2a1a1a2dfe5fd6ab1f4bc3ba7d4f6b2b8d9b33ce17e9f5c51a2c1e0e", 100, 0, 0, 0),
+	}
+}
+
+// storeTestConfiguration marshals a configuration and stores it in an
+// object downloader. It returns a message containing a reference to it,
+// which can be embedded into keys.
+func storeTestConfiguration(t *testing.T, objectDownloader testObjectDownloader, configuration *model_analysis_pb.Configuration) model_core.PatchedMessage[*model_core_pb.Reference, dag.ObjectContentsWalker] {
+	contents, _, err := model_core.MarshalAndEncodePatchedMessage(
+		model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](configuration),
+		object.ReferenceFormat{},
+		model_encoding.NewChainedBinaryEncoder(nil),
+	)
+	require.NoError(t, err)
+	objectDownloader[contents.GetReference()] = contents
+
+	patcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
+	return model_core.NewPatchedMessage(
+		patcher.AddReference(contents.GetReference(), dag.ExistingObjectContentsWalker),
+		patcher,
+	)
+}
+
+// loadTestConfiguration extracts a configuration that was created by
+// the computer from the patched message that references it.
+func loadTestConfiguration(t *testing.T, reference model_core.PatchedMessage[*model_core_pb.Reference, dag.ObjectContentsWalker]) *model_analysis_pb.Configuration {
+	m, metadata := reference.SortAndSetReferences()
+	index, err := model_core.GetIndexFromReferenceMessage(m.Message, m.OutgoingReferences.GetDegree())
+	require.NoError(t, err)
+	contents, _, err := metadata[index].GetContents(context.Background())
+	require.NoError(t, err)
+	var configuration model_analysis_pb.Configuration
+	require.NoError(t, proto.Unmarshal(contents.GetPayload(), &configuration))
+	return &configuration
+}
+
+func newTestLabelValue(label string) *model_starlark_pb.Value {
+	return &model_starlark_pb.Value{
+		Kind: &model_starlark_pb.Value_Label{Label: label},
+	}
+}
+
+func newTestStructFields(fields map[string]*model_starlark_pb.Value) *model_starlark_pb.Struct_Fields {
+	structFields := &model_starlark_pb.Struct_Fields{}
+	for _, key := range slices.Sorted(maps.Keys(fields)) {
+		structFields.Keys = append(structFields.Keys, key)
+		structFields.Values = append(structFields.Values, &model_starlark_pb.List_Element{
+			Level: &model_starlark_pb.List_Element_Leaf{Leaf: fields[key]},
+		})
+	}
+	return structFields
+}
+
+func newTestProviderInstance(providerIdentifier string, fields map[string]*model_starlark_pb.Value) *model_starlark_pb.Struct {
+	return &model_starlark_pb.Struct{
+		Fields: newTestStructFields(fields),
+		ProviderInstanceProperties: &model_starlark_pb.Provider_InstanceProperties{
+			ProviderIdentifier: providerIdentifier,
+		},
+	}
+}
+
+// newTestConstraintValueInfo creates a ConstraintValueInfo provider
+// instance, similar to the one returned by constraint_value().
+func newTestConstraintValueInfo(constraintSetting, defaultConstraintValue, constraintValue string) *model_starlark_pb.Struct {
+	defaultValue := &model_starlark_pb.Value{
+		Kind: &model_starlark_pb.Value_None{None: &emptypb.Empty{}},
+	}
+	if defaultConstraintValue != "" {
+		defaultValue = newTestLabelValue(defaultConstraintValue)
+	}
+	return newTestProviderInstance(constraintValueInfoProviderIdentifier.String(), map[string]*model_starlark_pb.Value{
+		"constraint": {
+			Kind: &model_starlark_pb.Value_Struct{
+				Struct: &model_starlark_pb.Struct{
+					Fields: newTestStructFields(map[string]*model_starlark_pb.Value{
+						"default_constraint_value": defaultValue,
+						"label":                    newTestLabelValue(constraintSetting),
+					}),
+				},
+			},
+		},
+		"label": newTestLabelValue(constraintValue),
+	})
+}
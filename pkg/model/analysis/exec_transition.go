@@ -0,0 +1,95 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+)
+
+// ComputeExecTransitionValue computes the configuration that is used
+// to build dependencies that are needed by an action, as opposed to
+// being part of the action's output. The resulting configuration
+// targets the execution platform that was selected for the exec group
+// of the action. Apart from the host platform, all build settings are
+// reset to their default values.
+func (c *baseComputer) ComputeExecTransitionValue(ctx context.Context, key model_core.Message[*model_analysis_pb.ExecTransition_Key], e ExecTransitionEnvironment) (PatchedExecTransitionValue, error) {
+	platformLabel, err := label.NewCanonicalLabel(key.Message.PlatformLabel)
+	if err != nil {
+		return PatchedExecTransitionValue{}, fmt.Errorf("invalid platform label: %w", err)
+	}
+
+	commandLineOptionPlatformsLabelStr := commandLineOptionPlatformsLabel.String()
+	targetValue := e.GetTargetValue(&model_analysis_pb.Target_Key{
+		Label: commandLineOptionPlatformsLabelStr,
+	})
+	if !targetValue.IsSet() {
+		return PatchedExecTransitionValue{}, evaluation.ErrMissingDependency
+	}
+	labelSetting, ok := targetValue.Message.Definition.GetKind().(*model_starlark_pb.Target_Definition_LabelSetting)
+	if !ok {
+		return PatchedExecTransitionValue{}, fmt.Errorf("target %#v is not a label setting", commandLineOptionPlatformsLabelStr)
+	}
+
+	inputConfiguration, err := c.getConfigurationByReference(
+		ctx,
+		model_core.Message[*model_core_pb.Reference]{
+			Message:            key.Message.InputConfigurationReference,
+			OutgoingReferences: key.OutgoingReferences,
+		},
+	)
+	if err != nil {
+		return PatchedExecTransitionValue{}, err
+	}
+	commandLineOptionHostPlatformLabelStr := commandLineOptionHostPlatformLabel.String()
+	hostPlatformOverride, err := c.getBuildSettingOverride(ctx, inputConfiguration, commandLineOptionHostPlatformLabelStr)
+	if err != nil {
+		return PatchedExecTransitionValue{}, err
+	}
+
+	// Construct the output configuration. Overrides are listed in
+	// the order in which they need to be stored in the
+	// configuration, namely sorted by label.
+	var buildSettingOverrides []*model_analysis_pb.Configuration_BuildSettingOverride
+	if hostPlatformOverride.IsSet() {
+		hostPlatformLabel, ok := hostPlatformOverride.Message.Value.GetKind().(*model_starlark_pb.Value_Label)
+		if !ok {
+			return PatchedExecTransitionValue{}, fmt.Errorf("build setting override for %#v is not a label", commandLineOptionHostPlatformLabelStr)
+		}
+		buildSettingOverrides = append(buildSettingOverrides, newLabelBuildSettingOverride(commandLineOptionHostPlatformLabelStr, hostPlatformLabel.Label))
+	}
+	platformLabelStr := platformLabel.String()
+	if platformLabelStr != labelSetting.LabelSetting.BuildSettingDefault {
+		buildSettingOverrides = append(buildSettingOverrides, newLabelBuildSettingOverride(commandLineOptionPlatformsLabelStr, platformLabelStr))
+	}
+	if len(buildSettingOverrides) == 0 {
+		return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.ExecTransition_Value{}), nil
+	}
+
+	contents, metadata, err := model_core.MarshalAndEncodePatchedMessage(
+		model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.Configuration{
+			BuildSettingOverrides: buildSettingOverrides,
+		}),
+		c.buildSpecificationReference.GetReferenceFormat(),
+		c.getValueObjectEncoder(),
+	)
+	if err != nil {
+		return PatchedExecTransitionValue{}, fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+	patcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
+	return model_core.NewPatchedMessage(
+		&model_analysis_pb.ExecTransition_Value{
+			OutputConfigurationReference: patcher.AddReference(
+				contents.GetReference(),
+				dag.NewSimpleObjectContentsWalker(contents, metadata),
+			),
+		},
+		patcher,
+	), nil
+}
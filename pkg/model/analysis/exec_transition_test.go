@@ -0,0 +1,102 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestComputeExecTransitionValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	objectDownloader := testObjectDownloader{}
+	c := newTestBaseComputer(objectDownloader)
+
+	e := NewMockExecTransitionEnvironment(ctrl)
+	e.EXPECT().GetTargetValue(gomock.Any()).DoAndReturn(getTestPlatformTargetValue).AnyTimes()
+
+	t.Run("DefaultPlatform", func(t *testing.T) {
+		// Transitioning to the default platform from the empty
+		// configuration should yield the empty configuration.
+		execTransition, err := c.ComputeExecTransitionValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.ExecTransition_Key{
+				PlatformLabel: "@@platforms+//host",
+			}),
+			e,
+		)
+		require.NoError(t, err)
+		require.Nil(t, execTransition.Message.OutputConfigurationReference)
+	})
+
+	t.Run("OtherPlatform", func(t *testing.T) {
+		execTransition, err := c.ComputeExecTransitionValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.ExecTransition_Key{
+				PlatformLabel: "@@example+//platforms:linux_aarch64",
+			}),
+			e,
+		)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.Configuration{
+			BuildSettingOverrides: []*model_analysis_pb.Configuration_BuildSettingOverride{
+				newLabelBuildSettingOverride("@@bazel_tools+//command_line_option:platforms", "@@example+//platforms:linux_aarch64"),
+			},
+		}, loadTestConfiguration(t, model_core.NewPatchedMessage(execTransition.Message.OutputConfigurationReference, execTransition.Patcher)))
+	})
+
+	t.Run("ResetBuildSettings", func(t *testing.T) {
+		// Apart from the host platform, all build settings of
+		// the input configuration should be reset to their
+		// default values.
+		inputConfigurationReference := storeTestConfiguration(t, objectDownloader, &model_analysis_pb.Configuration{
+			BuildSettingOverrides: []*model_analysis_pb.Configuration_BuildSettingOverride{
+				newLabelBuildSettingOverride("@@bazel_tools+//command_line_option:host_platform", "@@example+//platforms:linux_aarch64"),
+				newLabelBuildSettingOverride("@@bazel_tools+//command_line_option:platforms", "@@example+//platforms:linux_aarch64"),
+				{
+					Level: &model_analysis_pb.Configuration_BuildSettingOverride_Leaf_{
+						Leaf: &model_analysis_pb.Configuration_BuildSettingOverride_Leaf{
+							Label: "@@example+//:enable_feature",
+							Value: &model_starlark_pb.Value{
+								Kind: &model_starlark_pb.Value_Bool{Bool: true},
+							},
+						},
+					},
+				},
+			},
+		})
+		key, _ := model_core.NewPatchedMessage(
+			&model_analysis_pb.ExecTransition_Key{
+				PlatformLabel:               "@@platforms+//host",
+				InputConfigurationReference: inputConfigurationReference.Message,
+			},
+			inputConfigurationReference.Patcher,
+		).SortAndSetReferences()
+
+		execTransition, err := c.ComputeExecTransitionValue(ctx, key, e)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.Configuration{
+			BuildSettingOverrides: []*model_analysis_pb.Configuration_BuildSettingOverride{
+				newLabelBuildSettingOverride("@@bazel_tools+//command_line_option:host_platform", "@@example+//platforms:linux_aarch64"),
+			},
+		}, loadTestConfiguration(t, model_core.NewPatchedMessage(execTransition.Message.OutputConfigurationReference, execTransition.Patcher)))
+	})
+
+	t.Run("InvalidPlatformLabel", func(t *testing.T) {
+		_, err := c.ComputeExecTransitionValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.ExecTransition_Key{
+				PlatformLabel: "//platforms:linux_aarch64",
+			}),
+			e,
+		)
+		require.ErrorContains(t, err, "invalid platform label: ")
+	})
+}
@@ -1,14 +1,19 @@
 package analysis
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"io/fs"
 	"net/http"
+	"strings"
 
 	"github.com/buildbarn/bb-storage/pkg/filesystem"
 	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
@@ -19,18 +24,82 @@ import (
 	"github.com/buildbarn/bonanza/pkg/storage/dag"
 )
 
+// subresourceIntegrity contains the parsed value of a Subresource
+// Integrity (SRI) string, as used by the "integrity" parameters of
+// repository_ctx.download() and http_archive().
+type subresourceIntegrity struct {
+	algorithm string
+	newHash   func() hash.Hash
+	digest    []byte
+}
+
+var subresourceIntegrityAlgorithms = map[string]func() hash.Hash{
+	"sha256": sha256.New,
+	"sha384": sha512.New384,
+	"sha512": sha512.New,
+}
+
+func parseSubresourceIntegrity(integrity string) (*subresourceIntegrity, error) {
+	algorithm, encodedDigest, ok := strings.Cut(integrity, "-")
+	if !ok {
+		return nil, errors.New("integrity does not contain a hash algorithm")
+	}
+	newHash, ok := subresourceIntegrityAlgorithms[algorithm]
+	if !ok {
+		return nil, fmt.Errorf("unsupported hash algorithm %#v", algorithm)
+	}
+	digest, err := base64.StdEncoding.DecodeString(encodedDigest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid digest: %w", err)
+	}
+	if expectedSize := newHash().Size(); len(digest) != expectedSize {
+		return nil, fmt.Errorf("digest is %d bytes in size, while %s digests are %d bytes in size", len(digest), algorithm, expectedSize)
+	}
+	return &subresourceIntegrity{
+		algorithm: algorithm,
+		newHash:   newHash,
+		digest:    digest,
+	}, nil
+}
+
+// getCacheFilename returns the name of the file in the local cache
+// directory under which a file having this integrity is stored.
+func (si *subresourceIntegrity) getCacheFilename() path.Component {
+	return path.MustNewComponent(si.algorithm + "-" + hex.EncodeToString(si.digest))
+}
+
 func (c *baseComputer) ComputeHttpFileContentsValue(ctx context.Context, key *model_analysis_pb.HttpFileContents_Key, e HttpFileContentsEnvironment) (PatchedHttpFileContentsValue, error) {
 	fileCreationParameters, gotFileCreationParameters := e.GetFileCreationParametersObjectValue(&model_analysis_pb.FileCreationParametersObject_Key{})
 	if !gotFileCreationParameters {
 		return PatchedHttpFileContentsValue{}, evaluation.ErrMissingDependency
 	}
 
+	var integrity *subresourceIntegrity
+	if key.Integrity != "" {
+		var err error
+		integrity, err = parseSubresourceIntegrity(key.Integrity)
+		if err != nil {
+			return PatchedHttpFileContentsValue{}, fmt.Errorf("invalid integrity %#v: %w", key.Integrity, err)
+		}
+	}
+
 ProcessURLs:
 	for _, url := range key.Urls {
 		// Store copies of the file in a local cache directory.
 		// TODO: Remove this feature once our storage is robust enough.
-		urlHash := sha256.Sum256([]byte(url))
-		filename := path.MustNewComponent(hex.EncodeToString(urlHash[:]))
+		var filename path.Component
+		if integrity == nil {
+			urlHash := sha256.Sum256([]byte(url))
+			filename = path.MustNewComponent(hex.EncodeToString(urlHash[:]))
+		} else {
+			// If the integrity of the file is known, store
+			// it under its checksum, similar to Bazel's
+			// repository cache. This allows downloads to be
+			// skipped entirely if the file was obtained
+			// before, regardless of its URL. This is used
+			// for registry files listed in MODULE.bazel.lock.
+			filename = integrity.getCacheFilename()
+		}
 		downloadedFile, err := c.cacheDirectory.OpenReadWrite(filename, filesystem.DontCreate)
 		if err != nil {
 			if !errors.Is(err, fs.ErrNotExist) {
@@ -57,11 +126,29 @@ ProcessURLs:
 			switch resp.StatusCode {
 			case http.StatusOK:
 				// Download the file to the local system.
-				if _, err := io.Copy(model_filesystem.NewSectionWriter(downloadedFile), resp.Body); err != nil {
+				var w io.Writer = model_filesystem.NewSectionWriter(downloadedFile)
+				var hasher hash.Hash
+				if integrity != nil {
+					hasher = integrity.newHash()
+					w = io.MultiWriter(w, hasher)
+				}
+				if _, err := io.Copy(w, resp.Body); err != nil {
 					downloadedFile.Close()
 					c.cacheDirectory.Remove(filename)
 					return PatchedHttpFileContentsValue{}, err
 				}
+
+				// Only retain files in the cache that
+				// match the expected integrity. Files
+				// that are already present in the cache
+				// were thus validated previously.
+				if hasher != nil {
+					if digest := hasher.Sum(nil); !bytes.Equal(digest, integrity.digest) {
+						downloadedFile.Close()
+						c.cacheDirectory.Remove(filename)
+						return PatchedHttpFileContentsValue{}, fmt.Errorf("file at URL %#v has integrity %#v, while %#v was expected", url, integrity.algorithm+"-"+base64.StdEncoding.EncodeToString(digest), key.Integrity)
+					}
+				}
 			case http.StatusNotFound:
 				downloadedFile.Close()
 				c.cacheDirectory.Remove(filename)
@@ -76,10 +163,6 @@ ProcessURLs:
 			}
 		}
 
-		if key.Integrity != "" {
-			// TODO: Validate integrity of the downloaded file!
-		}
-
 		// Compute a Merkle tree of the file and return it. The
 		// downloaded file is removed after uploading completes.
 		// any chunks of data in memory, as we would consume a large
@@ -0,0 +1,175 @@
+package analysis
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	model_filesystem "github.com/buildbarn/bonanza/pkg/model/filesystem"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_filesystem_pb "github.com/buildbarn/bonanza/pkg/proto/model/filesystem"
+	object_pb "github.com/buildbarn/bonanza/pkg/proto/storage/object"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestParseSubresourceIntegrity(t *testing.T) {
+	sha256Digest := sha256.Sum256([]byte("Hello"))
+	sha384Digest := sha512.Sum384([]byte("Hello"))
+
+	t.Run("SHA256", func(t *testing.T) {
+		integrity, err := parseSubresourceIntegrity("sha256-" + base64.StdEncoding.EncodeToString(sha256Digest[:]))
+		require.NoError(t, err)
+		require.Equal(t, "sha256", integrity.algorithm)
+		require.Equal(t, sha256Digest[:], integrity.digest)
+		require.Equal(t, "sha256-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", integrity.getCacheFilename().String())
+	})
+
+	t.Run("SHA384", func(t *testing.T) {
+		integrity, err := parseSubresourceIntegrity("sha384-" + base64.StdEncoding.EncodeToString(sha384Digest[:]))
+		require.NoError(t, err)
+		require.Equal(t, "sha384", integrity.algorithm)
+		require.Equal(t, sha384Digest[:], integrity.digest)
+	})
+
+	t.Run("NoAlgorithm", func(t *testing.T) {
+		_, err := parseSubresourceIntegrity(base64.StdEncoding.EncodeToString(sha256Digest[:]))
+		require.EqualError(t, err, "integrity does not contain a hash algorithm")
+	})
+
+	t.Run("UnsupportedAlgorithm", func(t *testing.T) {
+		_, err := parseSubresourceIntegrity("md5-XUFAKrxLKna5cZ2REBfFkg==")
+		require.EqualError(t, err, "unsupported hash algorithm \"md5\"")
+	})
+
+	t.Run("InvalidBase64", func(t *testing.T) {
+		_, err := parseSubresourceIntegrity("sha256-!!!")
+		require.ErrorContains(t, err, "invalid digest: ")
+	})
+
+	t.Run("DigestSizeMismatch", func(t *testing.T) {
+		_, err := parseSubresourceIntegrity("sha256-" + base64.StdEncoding.EncodeToString(sha384Digest[:]))
+		require.EqualError(t, err, "digest is 48 bytes in size, while sha256 digests are 32 bytes in size")
+	})
+}
+
+func TestComputeHttpFileContentsValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	var requestCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount.Add(1)
+		switch r.URL.Path {
+		case "/hello.txt", "/mirror/hello.txt":
+			w.Write([]byte("Hello"))
+		case "/error.txt":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cacheDirectory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(t.TempDir()))
+	require.NoError(t, err)
+	defer cacheDirectory.Close()
+
+	c := newTestBaseComputer(testObjectDownloader{})
+	c.httpClient = server.Client()
+	c.cacheDirectory = cacheDirectory
+
+	fileCreationParameters, err := model_filesystem.NewFileCreationParametersFromProto(
+		&model_filesystem_pb.FileCreationParameters{
+			Access:                           &model_filesystem_pb.FileAccessParameters{},
+			ChunkMinimumSizeBytes:            1 << 16,
+			ChunkMaximumSizeBytes:            1 << 18,
+			FileContentsListMinimumSizeBytes: 1 << 12,
+			FileContentsListMaximumSizeBytes: 1 << 14,
+		},
+		object.MustNewReferenceFormat(object_pb.ReferenceFormat_SHA256_V1),
+	)
+	require.NoError(t, err)
+	e := NewMockHttpFileContentsEnvironment(ctrl)
+	e.EXPECT().GetFileCreationParametersObjectValue(gomock.Any()).Return(fileCreationParameters, true).AnyTimes()
+
+	helloDigest := sha256.Sum256([]byte("Hello"))
+	helloIntegrity := "sha256-" + base64.StdEncoding.EncodeToString(helloDigest[:])
+
+	t.Run("NotFound", func(t *testing.T) {
+		fileContents, err := c.ComputeHttpFileContentsValue(ctx, &model_analysis_pb.HttpFileContents_Key{
+			Urls: []string{server.URL + "/missing.txt"},
+		}, e)
+		require.NoError(t, err)
+		require.Nil(t, fileContents.Message.Exists)
+	})
+
+	t.Run("UnexpectedStatus", func(t *testing.T) {
+		_, err := c.ComputeHttpFileContentsValue(ctx, &model_analysis_pb.HttpFileContents_Key{
+			Urls: []string{server.URL + "/error.txt"},
+		}, e)
+		require.EqualError(t, err, "received unexpected HTTP response \"500 Internal Server Error\"")
+
+		// If failures are allowed, the file is treated as if
+		// it does not exist.
+		fileContents, err := c.ComputeHttpFileContentsValue(ctx, &model_analysis_pb.HttpFileContents_Key{
+			Urls:      []string{server.URL + "/error.txt"},
+			AllowFail: true,
+		}, e)
+		require.NoError(t, err)
+		require.Nil(t, fileContents.Message.Exists)
+	})
+
+	t.Run("IntegrityMismatch", func(t *testing.T) {
+		// Files whose contents don't match the integrity must
+		// be rejected, and must not be stored in the cache.
+		otherDigest := sha256.Sum256([]byte("Goodbye"))
+		otherIntegrity := "sha256-" + base64.StdEncoding.EncodeToString(otherDigest[:])
+		for i := 0; i < 2; i++ {
+			_, err := c.ComputeHttpFileContentsValue(ctx, &model_analysis_pb.HttpFileContents_Key{
+				Urls:      []string{server.URL + "/hello.txt"},
+				Integrity: otherIntegrity,
+			}, e)
+			require.EqualError(t, err, "file at URL \""+server.URL+"/hello.txt\" has integrity \""+helloIntegrity+"\", while \""+otherIntegrity+"\" was expected")
+		}
+	})
+
+	t.Run("IntegrityMatch", func(t *testing.T) {
+		requestCount.Store(0)
+		fileContents, err := c.ComputeHttpFileContentsValue(ctx, &model_analysis_pb.HttpFileContents_Key{
+			Urls:      []string{server.URL + "/hello.txt"},
+			Integrity: helloIntegrity,
+		}, e)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), fileContents.Message.Exists.Contents.TotalSizeBytes)
+		require.Equal(t, int32(1), requestCount.Load())
+
+		// Files having a known integrity are cached by
+		// checksum. Requesting the same file from another URL
+		// should not cause it to be downloaded again.
+		fileContents, err = c.ComputeHttpFileContentsValue(ctx, &model_analysis_pb.HttpFileContents_Key{
+			Urls:      []string{server.URL + "/mirror/hello.txt"},
+			Integrity: helloIntegrity,
+		}, e)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), fileContents.Message.Exists.Contents.TotalSizeBytes)
+		require.Equal(t, int32(1), requestCount.Load())
+	})
+
+	t.Run("InvalidIntegrity", func(t *testing.T) {
+		_, err := c.ComputeHttpFileContentsValue(ctx, &model_analysis_pb.HttpFileContents_Key{
+			Urls:      []string{server.URL + "/hello.txt"},
+			Integrity: "md5-XUFAKrxLKna5cZ2REBfFkg==",
+		}, e)
+		require.EqualError(t, err, "invalid integrity \"md5-XUFAKrxLKna5cZ2REBfFkg==\": unsupported hash algorithm \"md5\"")
+	})
+}
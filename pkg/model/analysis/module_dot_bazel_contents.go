@@ -99,6 +99,7 @@ func (c *baseComputer) parseModuleDotBazel(ctx context.Context, moduleContentsMs
 		string(moduleFileContents),
 		moduleTarget,
 		nil,
+		c.printFromStarlark,
 		handler,
 	)
 }
@@ -0,0 +1,148 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+)
+
+// checkRootModuleImports compares the repos that the root module
+// imports through use_repo() against the repos declared by the module
+// extension and the direct dependencies it reported. Any mismatches
+// are returned as a human readable description, similar to the
+// warning that is displayed by Bazel. If no mismatches are found, an
+// empty string is returned.
+func checkRootModuleImports(
+	rootUser *model_analysis_pb.ModuleExtensionImports_User,
+	repoNames []string,
+	rootModuleDirectDeps *model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps,
+	ignoreDevDependencies bool,
+) string {
+	declaredRepos := make(map[string]struct{}, len(repoNames))
+	for _, repo := range repoNames {
+		declaredRepos[repo] = struct{}{}
+	}
+
+	importedRepos := map[string]bool{}
+	for _, repo := range rootUser.ImportedRepos {
+		importedRepos[repo] = false
+	}
+	for _, repo := range rootUser.DevImportedRepos {
+		if _, ok := importedRepos[repo]; !ok {
+			importedRepos[repo] = true
+		}
+	}
+
+	var importedButNotCreated, notImportedButDirect, importedButIndirect, importedAsRegularButDev, importedAsDevButRegular []string
+	for _, repo := range slices.Sorted(maps.Keys(importedRepos)) {
+		if _, ok := declaredRepos[repo]; !ok {
+			importedButNotCreated = append(importedButNotCreated, repo)
+		}
+	}
+	if rootModuleDirectDeps != nil {
+		directDeps := map[string]bool{}
+		for _, repo := range rootModuleDirectDeps.DirectDeps {
+			directDeps[repo] = false
+		}
+		if !ignoreDevDependencies {
+			for _, repo := range rootModuleDirectDeps.DirectDevDeps {
+				directDeps[repo] = true
+			}
+		}
+		for _, repo := range slices.Sorted(maps.Keys(directDeps)) {
+			isDevImport, ok := importedRepos[repo]
+			if !ok {
+				notImportedButDirect = append(notImportedButDirect, repo)
+			} else if isDevDep := directDeps[repo]; isDevDep && !isDevImport {
+				importedAsRegularButDev = append(importedAsRegularButDev, repo)
+			} else if !isDevDep && isDevImport {
+				importedAsDevButRegular = append(importedAsDevButRegular, repo)
+			}
+		}
+		for _, repo := range slices.Sorted(maps.Keys(importedRepos)) {
+			if _, ok := declaredRepos[repo]; ok {
+				if _, ok := directDeps[repo]; !ok && !slices.Contains(rootModuleDirectDeps.DirectDevDeps, repo) {
+					importedButIndirect = append(importedButIndirect, repo)
+				}
+			}
+		}
+	}
+
+	var message strings.Builder
+	for _, category := range []struct {
+		description string
+		repos       []string
+	}{
+		{"Imported, but not created by the extension (will cause the build to fail)", importedButNotCreated},
+		{"Not imported, but reported as direct dependencies by the extension (may cause the build to fail)", notImportedButDirect},
+		{"Imported, but reported as indirect dependencies by the extension", importedButIndirect},
+		{"Imported as a regular dependency, but reported as a dev dependency by the extension (may cause the build to fail when used by other modules)", importedAsRegularButDev},
+		{"Imported as a dev dependency, but reported as a regular dependency by the extension (may cause the build to fail when used by other modules)", importedAsDevButRegular},
+	} {
+		if len(category.repos) > 0 {
+			fmt.Fprintf(&message, "\n\n%s:\n    %s", category.description, strings.Join(category.repos, ", "))
+		}
+	}
+	return message.String()
+}
+
+func (c *baseComputer) ComputeModuleExtensionImportsCheckValue(ctx context.Context, key *model_analysis_pb.ModuleExtensionImportsCheck_Key, e ModuleExtensionImportsCheckEnvironment) (PatchedModuleExtensionImportsCheckValue, error) {
+	usedModuleExtensions := e.GetUsedModuleExtensionsValue(&model_analysis_pb.UsedModuleExtensions_Key{})
+	moduleExtensionRepos := e.GetModuleExtensionReposValue(&model_analysis_pb.ModuleExtensionRepos_Key{
+		ModuleExtension: key.ModuleExtension,
+	})
+	moduleExtensionRepoNames := e.GetModuleExtensionRepoNamesValue(&model_analysis_pb.ModuleExtensionRepoNames_Key{
+		ModuleExtension: key.ModuleExtension,
+	})
+	rootModule := e.GetRootModuleValue(&model_analysis_pb.RootModule_Key{})
+	if !usedModuleExtensions.IsSet() || !moduleExtensionRepos.IsSet() || !moduleExtensionRepoNames.IsSet() || !rootModule.IsSet() {
+		return PatchedModuleExtensionImportsCheckValue{}, evaluation.ErrMissingDependency
+	}
+
+	// Look up the repos imported by the users of the module
+	// extension. Only imports by the root module are checked.
+	allImports := usedModuleExtensions.Message.ModuleExtensionImports
+	i := sort.Search(
+		len(allImports),
+		func(i int) bool {
+			identifier, err := label.NewCanonicalStarlarkIdentifier(allImports[i].Identifier)
+			return err == nil && identifier.ToModuleExtension().String() >= key.ModuleExtension
+		},
+	)
+	if i >= len(allImports) {
+		return PatchedModuleExtensionImportsCheckValue{}, fmt.Errorf("module extension %#v is not used by any module", key.ModuleExtension)
+	}
+	imports := allImports[i]
+	identifier, err := label.NewCanonicalStarlarkIdentifier(imports.Identifier)
+	if err != nil {
+		return PatchedModuleExtensionImportsCheckValue{}, fmt.Errorf("invalid module extension Starlark identifier %#v: %w", imports.Identifier, err)
+	}
+	if identifier.ToModuleExtension().String() != key.ModuleExtension {
+		return PatchedModuleExtensionImportsCheckValue{}, fmt.Errorf("module extension %#v is not used by any module", key.ModuleExtension)
+	}
+
+	if len(imports.Users) > 0 && imports.Users[0].IsRoot {
+		if message := checkRootModuleImports(
+			imports.Users[0],
+			moduleExtensionRepoNames.Message.RepoNames,
+			moduleExtensionRepos.Message.RootModuleDirectDeps,
+			rootModule.Message.IgnoreRootModuleDevDependencies,
+		); message != "" {
+			c.reportWarning(fmt.Sprintf(
+				"The module extension %s reported incorrect imports of repos via use_repo():%s\n\nFix the use_repo() calls in the MODULE.bazel file of the root module accordingly, or run \"bonanza_bazel mod tidy\" to do so automatically.",
+				identifier.String(),
+				message,
+			))
+		}
+	}
+	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.ModuleExtensionImportsCheck_Value{}), nil
+}
@@ -0,0 +1,230 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestCheckRootModuleImports(t *testing.T) {
+	repoNames := []string{"bar", "baz", "foo", "qux"}
+
+	for _, tc := range []struct {
+		name                  string
+		rootUser              *model_analysis_pb.ModuleExtensionImports_User
+		rootModuleDirectDeps  *model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps
+		ignoreDevDependencies bool
+		expectedMessage       string
+	}{
+		{
+			// If the module extension does not report its
+			// direct dependencies, only imports of repos
+			// that don't exist should be reported.
+			name: "NoDirectDeps",
+			rootUser: &model_analysis_pb.ModuleExtensionImports_User{
+				ImportedRepos:    []string{"foo"},
+				DevImportedRepos: []string{"bar"},
+			},
+		},
+		{
+			name: "Undeclared",
+			rootUser: &model_analysis_pb.ModuleExtensionImports_User{
+				ImportedRepos:    []string{"foo", "nonexistent1"},
+				DevImportedRepos: []string{"nonexistent2"},
+			},
+			expectedMessage: "\n\nImported, but not created by the extension (will cause the build to fail):\n    nonexistent1, nonexistent2",
+		},
+		{
+			name: "Matching",
+			rootUser: &model_analysis_pb.ModuleExtensionImports_User{
+				ImportedRepos:    []string{"bar", "foo"},
+				DevImportedRepos: []string{"baz"},
+			},
+			rootModuleDirectDeps: &model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps{
+				DirectDeps:    []string{"bar", "foo"},
+				DirectDevDeps: []string{"baz"},
+			},
+		},
+		{
+			name: "AllMismatches",
+			rootUser: &model_analysis_pb.ModuleExtensionImports_User{
+				ImportedRepos:    []string{"baz", "qux"},
+				DevImportedRepos: []string{"bar", "nonexistent"},
+			},
+			rootModuleDirectDeps: &model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps{
+				DirectDeps:    []string{"bar", "foo"},
+				DirectDevDeps: []string{"baz"},
+			},
+			expectedMessage: "\n\nImported, but not created by the extension (will cause the build to fail):\n    nonexistent" +
+				"\n\nNot imported, but reported as direct dependencies by the extension (may cause the build to fail):\n    foo" +
+				"\n\nImported, but reported as indirect dependencies by the extension:\n    qux" +
+				"\n\nImported as a regular dependency, but reported as a dev dependency by the extension (may cause the build to fail when used by other modules):\n    baz" +
+				"\n\nImported as a dev dependency, but reported as a regular dependency by the extension (may cause the build to fail when used by other modules):\n    bar",
+		},
+		{
+			// Repos that are imported both as a regular
+			// and a dev dependency are considered regular
+			// imports.
+			name: "ImportedAsBoth",
+			rootUser: &model_analysis_pb.ModuleExtensionImports_User{
+				ImportedRepos:    []string{"foo"},
+				DevImportedRepos: []string{"foo"},
+			},
+			rootModuleDirectDeps: &model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps{
+				DirectDeps: []string{"foo"},
+			},
+		},
+		{
+			// With --ignore_dev_dependency, dev imports
+			// are discarded. Direct dev dependencies should
+			// thus not be reported as missing, nor should
+			// they be considered indirect.
+			name: "IgnoreDevDependencies",
+			rootUser: &model_analysis_pb.ModuleExtensionImports_User{
+				ImportedRepos: []string{"foo"},
+			},
+			rootModuleDirectDeps: &model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps{
+				DirectDeps:    []string{"foo"},
+				DirectDevDeps: []string{"bar"},
+			},
+			ignoreDevDependencies: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectedMessage, checkRootModuleImports(tc.rootUser, repoNames, tc.rootModuleDirectDeps, tc.ignoreDevDependencies))
+		})
+	}
+}
+
+func TestComputeModuleExtensionImportsCheckValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	identifier := label.MustNewCanonicalStarlarkIdentifier("@@rules_foo+//:extensions.bzl%foo")
+	moduleExtension := identifier.ToModuleExtension().String()
+
+	// expectDependencies sets up expectations for all of the values
+	// on which the check depends. The module extension declares
+	// repos "bar" and "foo", and reports "bar" as a direct
+	// dependency.
+	expectDependencies := func(e *MockModuleExtensionImportsCheckEnvironment, moduleExtension string, rootUser *model_analysis_pb.ModuleExtensionImports_User) {
+		e.EXPECT().GetUsedModuleExtensionsValue(gomock.Any()).
+			Return(model_core.NewSimpleMessage(&model_analysis_pb.UsedModuleExtensions_Value{
+				ModuleExtensionImports: []*model_analysis_pb.ModuleExtensionImports{{
+					Identifier: identifier.String(),
+					Users: []*model_analysis_pb.ModuleExtensionImports_User{
+						rootUser,
+						{
+							ModuleInstance: "rules_foo+",
+							ImportedRepos:  []string{"nonexistent"},
+						},
+					},
+				}},
+			}))
+		e.EXPECT().GetModuleExtensionReposValue(testutil.EqProto(t, &model_analysis_pb.ModuleExtensionRepos_Key{
+			ModuleExtension: moduleExtension,
+		})).Return(model_core.NewSimpleMessage(&model_analysis_pb.ModuleExtensionRepos_Value{
+			RootModuleDirectDeps: &model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps{
+				DirectDeps: []string{"bar"},
+			},
+		}))
+		e.EXPECT().GetModuleExtensionRepoNamesValue(testutil.EqProto(t, &model_analysis_pb.ModuleExtensionRepoNames_Key{
+			ModuleExtension: moduleExtension,
+		})).Return(model_core.NewSimpleMessage(&model_analysis_pb.ModuleExtensionRepoNames_Value{
+			RepoNames: []string{"bar", "foo"},
+		}))
+		e.EXPECT().GetRootModuleValue(gomock.Any()).
+			Return(model_core.NewSimpleMessage(&model_analysis_pb.RootModule_Value{
+				RootModuleName: "mymodule",
+			}))
+	}
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		c := newTestBaseComputer(testObjectDownloader{})
+		e := NewMockModuleExtensionImportsCheckEnvironment(ctrl)
+		e.EXPECT().GetUsedModuleExtensionsValue(gomock.Any()).
+			Return(model_core.Message[*model_analysis_pb.UsedModuleExtensions_Value]{})
+		e.EXPECT().GetModuleExtensionReposValue(gomock.Any()).
+			Return(model_core.Message[*model_analysis_pb.ModuleExtensionRepos_Value]{})
+		e.EXPECT().GetModuleExtensionRepoNamesValue(gomock.Any()).
+			Return(model_core.Message[*model_analysis_pb.ModuleExtensionRepoNames_Value]{})
+		e.EXPECT().GetRootModuleValue(gomock.Any()).
+			Return(model_core.Message[*model_analysis_pb.RootModule_Value]{})
+
+		_, err := c.ComputeModuleExtensionImportsCheckValue(ctx, &model_analysis_pb.ModuleExtensionImportsCheck_Key{
+			ModuleExtension: moduleExtension,
+		}, e)
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+	})
+
+	t.Run("RootModule", func(t *testing.T) {
+		// Incorrect imports by the root module should be
+		// reported as a warning.
+		diagnosticsCollector := NewDiagnosticsCollector(func([]*model_build_pb.Diagnostic) {})
+		c := newTestBaseComputer(testObjectDownloader{})
+		c.diagnosticsReporter = diagnosticsCollector
+		e := NewMockModuleExtensionImportsCheckEnvironment(ctrl)
+		expectDependencies(e, moduleExtension, &model_analysis_pb.ModuleExtensionImports_User{
+			ModuleInstance: "mymodule+",
+			IsRoot:         true,
+			ImportedRepos:  []string{"foo"},
+		})
+
+		_, err := c.ComputeModuleExtensionImportsCheckValue(ctx, &model_analysis_pb.ModuleExtensionImportsCheck_Key{
+			ModuleExtension: moduleExtension,
+		}, e)
+		require.NoError(t, err)
+		diagnostics := diagnosticsCollector.GetDiagnostics()
+		require.Len(t, diagnostics, 1)
+		testutil.RequireEqualProto(t, &model_build_pb.Diagnostic{
+			Kind: model_build_pb.Diagnostic_WARNING,
+			Message: "The module extension @@rules_foo+//:extensions.bzl%foo reported incorrect imports of repos via use_repo():" +
+				"\n\nNot imported, but reported as direct dependencies by the extension (may cause the build to fail):\n    bar" +
+				"\n\nImported, but reported as indirect dependencies by the extension:\n    foo" +
+				"\n\nFix the use_repo() calls in the MODULE.bazel file of the root module accordingly, or run \"bonanza_bazel mod tidy\" to do so automatically.",
+		}, diagnostics[0])
+	})
+
+	t.Run("NotUsedByRootModule", func(t *testing.T) {
+		// Imports of modules other than the root module
+		// should not be checked.
+		diagnosticsCollector := NewDiagnosticsCollector(func([]*model_build_pb.Diagnostic) {})
+		c := newTestBaseComputer(testObjectDownloader{})
+		c.diagnosticsReporter = diagnosticsCollector
+		e := NewMockModuleExtensionImportsCheckEnvironment(ctrl)
+		expectDependencies(e, moduleExtension, &model_analysis_pb.ModuleExtensionImports_User{
+			ModuleInstance: "rules_bar+",
+			ImportedRepos:  []string{"foo"},
+		})
+
+		_, err := c.ComputeModuleExtensionImportsCheckValue(ctx, &model_analysis_pb.ModuleExtensionImportsCheck_Key{
+			ModuleExtension: moduleExtension,
+		}, e)
+		require.NoError(t, err)
+		require.Empty(t, diagnosticsCollector.GetDiagnostics())
+	})
+
+	t.Run("UnusedModuleExtension", func(t *testing.T) {
+		c := newTestBaseComputer(testObjectDownloader{})
+		otherModuleExtension := label.MustNewCanonicalStarlarkIdentifier("@@rules_foo+//:extensions.bzl%zzz").ToModuleExtension().String()
+		e := NewMockModuleExtensionImportsCheckEnvironment(ctrl)
+		expectDependencies(e, otherModuleExtension, &model_analysis_pb.ModuleExtensionImports_User{
+			ModuleInstance: "mymodule+",
+			IsRoot:         true,
+		})
+
+		_, err := c.ComputeModuleExtensionImportsCheckValue(ctx, &model_analysis_pb.ModuleExtensionImportsCheck_Key{
+			ModuleExtension: otherModuleExtension,
+		}, e)
+		require.EqualError(t, err, "module extension \"rules_foo++zzz\" is not used by any module")
+	})
+}
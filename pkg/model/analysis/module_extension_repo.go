@@ -30,7 +30,12 @@ func (c *baseComputer) ComputeModuleExtensionRepoValue(ctx context.Context, key
 	moduleExtensionReposValue := e.GetModuleExtensionReposValue(&model_analysis_pb.ModuleExtensionRepos_Key{
 		ModuleExtension: moduleExtension.String(),
 	})
-	if !moduleExtensionReposValue.IsSet() {
+	// Ensure that the root module is warned about incorrect
+	// use_repo() calls whenever one of the repos is used.
+	importsCheckValue := e.GetModuleExtensionImportsCheckValue(&model_analysis_pb.ModuleExtensionImportsCheck_Key{
+		ModuleExtension: moduleExtension.String(),
+	})
+	if !moduleExtensionReposValue.IsSet() || !importsCheckValue.IsSet() {
 		return PatchedModuleExtensionRepoValue{}, evaluation.ErrMissingDependency
 	}
 
@@ -24,6 +24,7 @@ import (
 	"github.com/buildbarn/bonanza/pkg/storage/object"
 
 	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
 )
 
 type bazelModuleTag struct {
@@ -76,6 +77,134 @@ func (t bazelModuleTag) AttrNames() []string {
 	return attrNames
 }
 
+// moduleExtensionDirectDeps contains the value of the
+// root_module_direct_deps or root_module_direct_dev_deps argument of
+// module_ctx.extension_metadata().
+type moduleExtensionDirectDeps struct {
+	all   bool
+	repos []string
+}
+
+var moduleExtensionDirectDepsUnpackerInto = unpack.IfNotNone(
+	unpack.Pointer[moduleExtensionDirectDeps](moduleExtensionDirectDepsValueUnpackerInto{}),
+)
+
+type moduleExtensionDirectDepsValueUnpackerInto struct{}
+
+func (moduleExtensionDirectDepsValueUnpackerInto) UnpackInto(thread *starlark.Thread, v starlark.Value, dst *moduleExtensionDirectDeps) error {
+	if s, ok := v.(starlark.String); ok {
+		if s != "all" {
+			return fmt.Errorf("got %s, want \"all\" or list of strings", s)
+		}
+		*dst = moduleExtensionDirectDeps{all: true}
+		return nil
+	}
+	var repos []string
+	if err := unpack.List(unpack.String).UnpackInto(thread, v, &repos); err != nil {
+		return err
+	}
+	*dst = moduleExtensionDirectDeps{repos: repos}
+	return nil
+}
+
+func (ui moduleExtensionDirectDepsValueUnpackerInto) Canonicalize(thread *starlark.Thread, v starlark.Value) (starlark.Value, error) {
+	var directDeps moduleExtensionDirectDeps
+	if err := ui.UnpackInto(thread, v, &directDeps); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (moduleExtensionDirectDepsValueUnpackerInto) GetConcatenationOperator() syntax.Token {
+	return 0
+}
+
+// moduleExtensionMetadata is the value returned by
+// module_ctx.extension_metadata(). Implementation functions of module
+// extensions may return it to report which repos the root module
+// should import, and whether the module extension is reproducible.
+type moduleExtensionMetadata struct {
+	rootModuleDirectDeps    *moduleExtensionDirectDeps
+	rootModuleDirectDevDeps *moduleExtensionDirectDeps
+	reproducible            bool
+}
+
+var _ starlark.Value = moduleExtensionMetadata{}
+
+func (moduleExtensionMetadata) String() string {
+	return "<extension_metadata>"
+}
+
+func (moduleExtensionMetadata) Type() string {
+	return "extension_metadata"
+}
+
+func (moduleExtensionMetadata) Freeze() {
+}
+
+func (moduleExtensionMetadata) Truth() starlark.Bool {
+	return starlark.True
+}
+
+func (moduleExtensionMetadata) Hash(thread *starlark.Thread) (uint32, error) {
+	return 0, errors.New("extension_metadata cannot be hashed")
+}
+
+// getRootModuleDirectDeps converts the direct dependencies reported
+// through module_ctx.extension_metadata() to a list of repos that the
+// root module should import, both as regular and dev dependencies.
+func (m *moduleExtensionMetadata) getRootModuleDirectDeps(repos map[string]model_core.PatchedMessage[*model_starlark_pb.Repo, dag.ObjectContentsWalker]) (*model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps, error) {
+	directDeps, directDevDeps := m.rootModuleDirectDeps, m.rootModuleDirectDevDeps
+	if directDeps == nil && directDevDeps == nil {
+		return nil, nil
+	}
+	if directDeps == nil || directDevDeps == nil {
+		return nil, errors.New("root_module_direct_deps and root_module_direct_dev_deps must both be specified or both be None")
+	}
+
+	allRepos := slices.Sorted(maps.Keys(repos))
+	var directDepsList, directDevDepsList []string
+	switch {
+	case directDeps.all && directDevDeps.all:
+		return nil, errors.New("root_module_direct_deps and root_module_direct_dev_deps cannot both be \"all\"")
+	case directDeps.all:
+		if len(directDevDeps.repos) > 0 {
+			return nil, errors.New("root_module_direct_dev_deps must be empty if root_module_direct_deps is \"all\"")
+		}
+		directDepsList = allRepos
+	case directDevDeps.all:
+		if len(directDeps.repos) > 0 {
+			return nil, errors.New("root_module_direct_deps must be empty if root_module_direct_dev_deps is \"all\"")
+		}
+		directDevDepsList = allRepos
+	default:
+		seen := map[string]string{}
+		for _, field := range []struct {
+			name  string
+			repos []string
+		}{
+			{"root_module_direct_deps", directDeps.repos},
+			{"root_module_direct_dev_deps", directDevDeps.repos},
+		} {
+			for _, repo := range field.repos {
+				if _, ok := repos[repo]; !ok {
+					return nil, fmt.Errorf("%s contains repo %#v, which is not declared by the module extension", field.name, repo)
+				}
+				if otherName, ok := seen[repo]; ok {
+					return nil, fmt.Errorf("repo %#v is listed in both %s and %s", repo, otherName, field.name)
+				}
+				seen[repo] = field.name
+			}
+		}
+		directDepsList = slices.Sorted(slices.Values(directDeps.repos))
+		directDevDepsList = slices.Sorted(slices.Values(directDevDeps.repos))
+	}
+	return &model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps{
+		DirectDeps:    directDepsList,
+		DirectDevDeps: directDevDepsList,
+	}, nil
+}
+
 func (c *baseComputer) ComputeModuleExtensionReposValue(ctx context.Context, key *model_analysis_pb.ModuleExtensionRepos_Key, e ModuleExtensionReposEnvironment) (PatchedModuleExtensionReposValue, error) {
 	allBuiltinsModulesNames := e.GetBuiltinsModuleNamesValue(&model_analysis_pb.BuiltinsModuleNames_Key{})
 	repoPlatform := e.GetRegisteredRepoPlatformValue(&model_analysis_pb.RegisteredRepoPlatform_Key{})
@@ -301,8 +430,16 @@ func (c *baseComputer) ComputeModuleExtensionReposValue(ctx context.Context, key
 		"extension_metadata": starlark.NewBuiltin(
 			"module_ctx.extension_metadata",
 			func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
-				// TODO: Properly implement this function.
-				return starlark.None, nil
+				var metadata moduleExtensionMetadata
+				if err := starlark.UnpackArgs(
+					b.Name(), args, kwargs,
+					"root_module_direct_deps?", unpack.Bind(thread, &metadata.rootModuleDirectDeps, moduleExtensionDirectDepsUnpackerInto),
+					"root_module_direct_dev_deps?", unpack.Bind(thread, &metadata.rootModuleDirectDevDeps, moduleExtensionDirectDepsUnpackerInto),
+					"reproducible?", unpack.Bind(thread, &metadata.reproducible, unpack.Bool),
+				); err != nil {
+					return nil, err
+				}
+				return metadata, nil
 			},
 		),
 		"is_dev_dependency": starlark.NewBuiltin(
@@ -322,8 +459,7 @@ func (c *baseComputer) ComputeModuleExtensionReposValue(ctx context.Context, key
 	})
 	moduleCtx.Freeze()
 
-	// TODO: Capture extension_metadata.
-	_, err = starlark.Call(
+	result, err := starlark.Call(
 		thread,
 		model_starlark.NewNamedFunction(model_starlark.NewProtoNamedFunctionDefinition(
 			model_core.Message[*model_starlark_pb.Function]{
@@ -342,6 +478,22 @@ func (c *baseComputer) ComputeModuleExtensionReposValue(ctx context.Context, key
 		return PatchedModuleExtensionReposValue{}, err
 	}
 
+	// The implementation function may return metadata that
+	// describes which repos should be imported by the root module.
+	var metadata moduleExtensionMetadata
+	switch typedResult := result.(type) {
+	case starlark.NoneType:
+	case moduleExtensionMetadata:
+		metadata = typedResult
+	default:
+		return PatchedModuleExtensionReposValue{}, fmt.Errorf("implementation function of module extension %#v returned a value of type %s, while None or extension_metadata was expected", moduleExtensionIdentifier.String(), result.Type())
+	}
+	repos := repoRegistrar.GetRepos()
+	rootModuleDirectDeps, err := metadata.getRootModuleDirectDeps(repos)
+	if err != nil {
+		return PatchedModuleExtensionReposValue{}, fmt.Errorf("invalid extension metadata returned by module extension %#v: %w", moduleExtensionIdentifier.String(), err)
+	}
+
 	// Store all repos in a B-tree.
 	// TODO: Use a proper encoder!
 	treeBuilder := btree.NewSplitProllyBuilder(
@@ -374,7 +526,6 @@ func (c *baseComputer) ComputeModuleExtensionReposValue(ctx context.Context, key
 		),
 	)
 
-	repos := repoRegistrar.GetRepos()
 	for _, name := range slices.Sorted(maps.Keys(repos)) {
 		repo := repos[name]
 		if err := treeBuilder.PushChild(model_core.NewPatchedMessage(
@@ -396,7 +547,9 @@ func (c *baseComputer) ComputeModuleExtensionReposValue(ctx context.Context, key
 
 	return PatchedModuleExtensionReposValue{
 		Message: &model_analysis_pb.ModuleExtensionRepos_Value{
-			Repos: reposList.Message,
+			Repos:                reposList.Message,
+			RootModuleDirectDeps: rootModuleDirectDeps,
+			Reproducible:         metadata.reproducible,
 		},
 		Patcher: reposList.Patcher,
 	}, nil
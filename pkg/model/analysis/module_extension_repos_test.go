@@ -0,0 +1,161 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleExtensionMetadataGetRootModuleDirectDeps(t *testing.T) {
+	repos := map[string]model_core.PatchedMessage[*model_starlark_pb.Repo, dag.ObjectContentsWalker]{
+		"bar": {},
+		"baz": {},
+		"foo": {},
+	}
+
+	for _, tc := range []struct {
+		name                 string
+		metadata             moduleExtensionMetadata
+		expectedDirectDeps   *model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps
+		expectedErrorMessage string
+	}{
+		{
+			// If the module extension doesn't report its
+			// direct dependencies, no checking should be
+			// performed.
+			name:     "BothNone",
+			metadata: moduleExtensionMetadata{},
+		},
+		{
+			name: "OnlyDirectDeps",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDeps: &moduleExtensionDirectDeps{repos: []string{"foo"}},
+			},
+			expectedErrorMessage: "root_module_direct_deps and root_module_direct_dev_deps must both be specified or both be None",
+		},
+		{
+			name: "OnlyDirectDevDeps",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDevDeps: &moduleExtensionDirectDeps{all: true},
+			},
+			expectedErrorMessage: "root_module_direct_deps and root_module_direct_dev_deps must both be specified or both be None",
+		},
+		{
+			// "all" should expand to all repos declared by
+			// the module extension, sorted by name.
+			name: "DirectDepsAll",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDeps:    &moduleExtensionDirectDeps{all: true},
+				rootModuleDirectDevDeps: &moduleExtensionDirectDeps{},
+			},
+			expectedDirectDeps: &model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps{
+				DirectDeps: []string{"bar", "baz", "foo"},
+			},
+		},
+		{
+			name: "DirectDevDepsAll",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDeps:    &moduleExtensionDirectDeps{},
+				rootModuleDirectDevDeps: &moduleExtensionDirectDeps{all: true},
+			},
+			expectedDirectDeps: &model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps{
+				DirectDevDeps: []string{"bar", "baz", "foo"},
+			},
+		},
+		{
+			name: "BothAll",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDeps:    &moduleExtensionDirectDeps{all: true},
+				rootModuleDirectDevDeps: &moduleExtensionDirectDeps{all: true},
+			},
+			expectedErrorMessage: "root_module_direct_deps and root_module_direct_dev_deps cannot both be \"all\"",
+		},
+		{
+			name: "DirectDepsAllWithDevDeps",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDeps:    &moduleExtensionDirectDeps{all: true},
+				rootModuleDirectDevDeps: &moduleExtensionDirectDeps{repos: []string{"foo"}},
+			},
+			expectedErrorMessage: "root_module_direct_dev_deps must be empty if root_module_direct_deps is \"all\"",
+		},
+		{
+			name: "DirectDevDepsAllWithDeps",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDeps:    &moduleExtensionDirectDeps{repos: []string{"foo"}},
+				rootModuleDirectDevDeps: &moduleExtensionDirectDeps{all: true},
+			},
+			expectedErrorMessage: "root_module_direct_deps must be empty if root_module_direct_dev_deps is \"all\"",
+		},
+		{
+			// Lists of repos should be sorted.
+			name: "Lists",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDeps:    &moduleExtensionDirectDeps{repos: []string{"foo", "bar"}},
+				rootModuleDirectDevDeps: &moduleExtensionDirectDeps{repos: []string{"baz"}},
+			},
+			expectedDirectDeps: &model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps{
+				DirectDeps:    []string{"bar", "foo"},
+				DirectDevDeps: []string{"baz"},
+			},
+		},
+		{
+			name: "EmptyLists",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDeps:    &moduleExtensionDirectDeps{},
+				rootModuleDirectDevDeps: &moduleExtensionDirectDeps{},
+			},
+			expectedDirectDeps: &model_analysis_pb.ModuleExtensionRepos_Value_RootModuleDirectDeps{},
+		},
+		{
+			name: "ListedInBoth",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDeps:    &moduleExtensionDirectDeps{repos: []string{"bar", "foo"}},
+				rootModuleDirectDevDeps: &moduleExtensionDirectDeps{repos: []string{"foo"}},
+			},
+			expectedErrorMessage: "repo \"foo\" is listed in both root_module_direct_deps and root_module_direct_dev_deps",
+		},
+		{
+			name: "ListedTwice",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDeps:    &moduleExtensionDirectDeps{repos: []string{"foo", "foo"}},
+				rootModuleDirectDevDeps: &moduleExtensionDirectDeps{},
+			},
+			expectedErrorMessage: "repo \"foo\" is listed in both root_module_direct_deps and root_module_direct_deps",
+		},
+		{
+			name: "UndeclaredDirectDep",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDeps:    &moduleExtensionDirectDeps{repos: []string{"qux"}},
+				rootModuleDirectDevDeps: &moduleExtensionDirectDeps{},
+			},
+			expectedErrorMessage: "root_module_direct_deps contains repo \"qux\", which is not declared by the module extension",
+		},
+		{
+			name: "UndeclaredDirectDevDep",
+			metadata: moduleExtensionMetadata{
+				rootModuleDirectDeps:    &moduleExtensionDirectDeps{},
+				rootModuleDirectDevDeps: &moduleExtensionDirectDeps{repos: []string{"qux"}},
+			},
+			expectedErrorMessage: "root_module_direct_dev_deps contains repo \"qux\", which is not declared by the module extension",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			directDeps, err := tc.metadata.getRootModuleDirectDeps(repos)
+			if tc.expectedErrorMessage != "" {
+				require.EqualError(t, err, tc.expectedErrorMessage)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expectedDirectDeps == nil {
+				require.Nil(t, directDeps)
+			} else {
+				testutil.RequireEqualProto(t, tc.expectedDirectDeps, directDeps)
+			}
+		})
+	}
+}
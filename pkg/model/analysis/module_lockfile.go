@@ -0,0 +1,543 @@
+package analysis
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"reflect"
+	"slices"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	"github.com/buildbarn/bonanza/pkg/model/core/btree"
+	model_filesystem "github.com/buildbarn/bonanza/pkg/model/filesystem"
+	model_parser "github.com/buildbarn/bonanza/pkg/model/parser"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_filesystem_pb "github.com/buildbarn/bonanza/pkg/proto/model/filesystem"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+
+	"go.starlark.net/starlark"
+)
+
+const (
+	moduleLockfileFilename = "MODULE.bazel.lock"
+
+	// moduleLockfileVersion is the version of the MODULE.bazel.lock
+	// file format that is read and written. This corresponds to the
+	// version used by Bazel 8.
+	moduleLockfileVersion = 18
+
+	// moduleLockfileRegistryFileNotFound is the value stored in
+	// "registryFileHashes" for files that do not exist in a registry.
+	moduleLockfileRegistryFileNotFound = "not found"
+)
+
+// moduleLockfile contains the fields of MODULE.bazel.lock that are
+// read and written. Fields that are only used by Bazel, such as digests
+// of module extension inputs, are discarded when parsing.
+type moduleLockfile struct {
+	LockFileVersion        int                                                 `json:"lockFileVersion"`
+	RegistryFileHashes     map[string]string                                   `json:"registryFileHashes"`
+	SelectedYankedVersions map[string]string                                   `json:"selectedYankedVersions"`
+	ModuleExtensions       map[string]map[string]moduleLockfileModuleExtension `json:"moduleExtensions"`
+}
+
+// moduleLockfileModuleExtension contains the results of evaluating a
+// module extension, as stored in MODULE.bazel.lock.
+type moduleLockfileModuleExtension struct {
+	GeneratedRepoSpecs map[string]moduleLockfileRepoSpec `json:"generatedRepoSpecs"`
+}
+
+// moduleLockfileRepoSpec contains the definition of a repo declared by
+// a module extension, as stored in MODULE.bazel.lock.
+type moduleLockfileRepoSpec struct {
+	RepoRuleID string         `json:"repoRuleId"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+// parseModuleLockfile parses the contents of a MODULE.bazel.lock file.
+// Maps that are absent are replaced by empty maps, so that lockfiles
+// can be compared using reflect.DeepEqual().
+func parseModuleLockfile(data []byte) (*moduleLockfile, error) {
+	var lockfile moduleLockfile
+	if err := json.Unmarshal(data, &lockfile); err != nil {
+		return nil, fmt.Errorf("failed to parse %#v: %w", moduleLockfileFilename, err)
+	}
+	if lockfile.RegistryFileHashes == nil {
+		lockfile.RegistryFileHashes = map[string]string{}
+	}
+	if lockfile.SelectedYankedVersions == nil {
+		lockfile.SelectedYankedVersions = map[string]string{}
+	}
+	if lockfile.ModuleExtensions == nil {
+		lockfile.ModuleExtensions = map[string]map[string]moduleLockfileModuleExtension{}
+	}
+	return &lockfile, nil
+}
+
+// marshalModuleLockfile converts the contents of a MODULE.bazel.lock
+// file to JSON, using the same formatting as Bazel.
+func marshalModuleLockfile(lockfile *moduleLockfile) ([]byte, error) {
+	var contents bytes.Buffer
+	encoder := json.NewEncoder(&contents)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(lockfile); err != nil {
+		return nil, fmt.Errorf("failed to marshal %#v: %w", moduleLockfileFilename, err)
+	}
+	return contents.Bytes(), nil
+}
+
+// registryModuleMetadata contains the fields of a module's
+// metadata.json file stored in a registry that are relevant for
+// determining whether versions of the module have been yanked.
+type registryModuleMetadata struct {
+	YankedVersions map[string]string `json:"yanked_versions"`
+}
+
+type getModuleLockfileContentsEnvironment interface {
+	GetFilePropertiesValue(key *model_analysis_pb.FileProperties_Key) model_core.Message[*model_analysis_pb.FileProperties_Value]
+	GetFileReaderValue(key *model_analysis_pb.FileReader_Key) (*model_filesystem.FileReader, bool)
+	GetRootModuleValue(key *model_analysis_pb.RootModule_Key) model_core.Message[*model_analysis_pb.RootModule_Value]
+}
+
+// getModuleLockfileContents returns the contents of the
+// MODULE.bazel.lock file that is stored in the root module. If no such
+// file exists, nil is returned.
+func (c *baseComputer) getModuleLockfileContents(ctx context.Context, e getModuleLockfileContentsEnvironment) ([]byte, error) {
+	rootModuleValue := e.GetRootModuleValue(&model_analysis_pb.RootModule_Key{})
+	fileReader, gotFileReader := e.GetFileReaderValue(&model_analysis_pb.FileReader_Key{})
+	if !rootModuleValue.IsSet() || !gotFileReader {
+		return nil, evaluation.ErrMissingDependency
+	}
+	rootModuleName, err := label.NewModule(rootModuleValue.Message.RootModuleName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root module name %#v: %w", rootModuleValue.Message.RootModuleName, err)
+	}
+
+	lockfileProperties := e.GetFilePropertiesValue(&model_analysis_pb.FileProperties_Key{
+		CanonicalRepo: rootModuleName.ToModuleInstance(nil).String(),
+		Path:          moduleLockfileFilename,
+	})
+	if !lockfileProperties.IsSet() {
+		return nil, evaluation.ErrMissingDependency
+	}
+	if lockfileProperties.Message.Exists == nil {
+		return nil, nil
+	}
+
+	lockfileContentsEntry, err := model_filesystem.NewFileContentsEntryFromProto(
+		model_core.Message[*model_filesystem_pb.FileContents]{
+			Message:            lockfileProperties.Message.Exists.GetContents(),
+			OutgoingReferences: lockfileProperties.OutgoingReferences,
+		},
+		c.buildSpecificationReference.GetReferenceFormat(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contents for file %#v: %w", moduleLockfileFilename, err)
+	}
+	lockfileData, err := fileReader.FileReadAll(ctx, lockfileContentsEntry, 1<<24)
+	if err != nil {
+		return nil, err
+	}
+	if lockfileData == nil {
+		lockfileData = []byte{}
+	}
+	return lockfileData, nil
+}
+
+func (c *baseComputer) ComputeModuleLockfileValue(ctx context.Context, key *model_analysis_pb.ModuleLockfile_Key, e ModuleLockfileEnvironment) (PatchedModuleLockfileValue, error) {
+	buildSpecification := e.GetBuildSpecificationValue(&model_analysis_pb.BuildSpecification_Key{})
+	if !buildSpecification.IsSet() {
+		return PatchedModuleLockfileValue{}, evaluation.ErrMissingDependency
+	}
+	if buildSpecification.Message.BuildSpecification.GetUseLockfile() == nil {
+		// Lockfile is disabled.
+		return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.ModuleLockfile_Value{}), nil
+	}
+
+	lockfileData, err := c.getModuleLockfileContents(ctx, e)
+	if err != nil {
+		return PatchedModuleLockfileValue{}, err
+	}
+	if lockfileData == nil {
+		return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.ModuleLockfile_Value{}), nil
+	}
+
+	lockfile, err := parseModuleLockfile(lockfileData)
+	if err != nil {
+		return PatchedModuleLockfileValue{}, err
+	}
+	if lockfile.LockFileVersion != moduleLockfileVersion {
+		// Lockfiles using a different version of the file
+		// format are ignored, and are overwritten when the
+		// lockfile is updated.
+		return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.ModuleLockfile_Value{}), nil
+	}
+
+	registryFileHashes := make([]*model_analysis_pb.RegistryFileHash, 0, len(lockfile.RegistryFileHashes))
+	for _, url := range slices.Sorted(maps.Keys(lockfile.RegistryFileHashes)) {
+		registryFileHash := &model_analysis_pb.RegistryFileHash{Url: url}
+		if hash := lockfile.RegistryFileHashes[url]; hash != moduleLockfileRegistryFileNotFound {
+			sha256, err := hex.DecodeString(hash)
+			if err != nil || len(sha256) != 32 {
+				return PatchedModuleLockfileValue{}, fmt.Errorf("invalid SHA-256 hash %#v for registry file %#v in %#v", hash, url, moduleLockfileFilename)
+			}
+			registryFileHash.Sha256 = sha256
+		}
+		registryFileHashes = append(registryFileHashes, registryFileHash)
+	}
+	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.ModuleLockfile_Value{
+		RegistryFileHashes: registryFileHashes,
+	}), nil
+}
+
+// convertStarlarkValueToLockfileJSON converts the value of an attribute
+// of a repo declared by a module extension to a value that can be
+// embedded in MODULE.bazel.lock.
+func convertStarlarkValueToLockfileJSON(v starlark.Value) (any, error) {
+	switch typedV := v.(type) {
+	case starlark.NoneType:
+		return nil, nil
+	case starlark.Bool:
+		return bool(typedV), nil
+	case starlark.Int:
+		i, ok := typedV.Int64()
+		if !ok {
+			return nil, fmt.Errorf("integer %s is out of range", typedV)
+		}
+		return i, nil
+	case starlark.String:
+		return string(typedV), nil
+	case *starlark.List:
+		l := make([]any, 0, typedV.Len())
+		for element := range starlark.Elements(typedV) {
+			convertedElement, err := convertStarlarkValueToLockfileJSON(element)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, convertedElement)
+		}
+		return l, nil
+	case *starlark.Dict:
+		d := make(map[string]any, typedV.Len())
+		for _, item := range typedV.Items() {
+			key, value := item[0], item[1]
+			var keyStr string
+			switch typedKey := key.(type) {
+			case starlark.String:
+				keyStr = string(typedKey)
+			default:
+				if key.Type() != "Label" {
+					return nil, fmt.Errorf("dict key has type %s, while string or Label was expected", key.Type())
+				}
+				keyStr = key.String()
+			}
+			convertedValue, err := convertStarlarkValueToLockfileJSON(value)
+			if err != nil {
+				return nil, err
+			}
+			d[keyStr] = convertedValue
+		}
+		return d, nil
+	default:
+		if v.Type() == "Label" {
+			return v.String(), nil
+		}
+		return nil, fmt.Errorf("value of type %s cannot be stored in %#v", v.Type(), moduleLockfileFilename)
+	}
+}
+
+// getRepoAttributesAsJSON converts the attribute values of a repo
+// declared by a module extension to a map that can be converted to
+// JSON.
+func (c *baseComputer) getRepoAttributesAsJSON(ctx context.Context, attrValues model_core.Message[*model_starlark_pb.Struct_Fields]) (map[string]any, error) {
+	valueDecodingOptions := c.getValueDecodingOptions(ctx, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+		return model_starlark.NewLabel(resolvedLabel), nil
+	})
+	attributes := map[string]any{}
+	var errIter error
+	for name, encodedValue := range model_starlark.AllStructFields(
+		ctx,
+		model_parser.NewStorageBackedParsedObjectReader(
+			c.objectDownloader,
+			c.getValueObjectEncoder(),
+			model_parser.NewMessageListObjectParser[object.LocalReference, model_starlark_pb.List_Element](),
+		),
+		attrValues,
+		&errIter,
+	) {
+		value, err := model_starlark.DecodeValue(
+			encodedValue,
+			/* currentIdentifier = */ nil,
+			valueDecodingOptions,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode attribute %#v: %w", name, err)
+		}
+		attributes[name], err = convertStarlarkValueToLockfileJSON(value)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %#v: %w", name, err)
+		}
+	}
+	if errIter != nil {
+		return nil, errIter
+	}
+	return attributes, nil
+}
+
+type getYankedReasonEnvironment interface {
+	GetHttpFileContentsValue(key *model_analysis_pb.HttpFileContents_Key) model_core.Message[*model_analysis_pb.HttpFileContents_Value]
+}
+
+// getYankedReason returns whether a version of a module obtained from
+// a registry has been yanked, and if so, the reason for yanking it.
+// This information is extracted from the module's metadata.json file.
+func (c *baseComputer) getYankedReason(ctx context.Context, e getYankedReasonEnvironment, fileReader *model_filesystem.FileReader, module *model_analysis_pb.BuildListModule) (string, bool, error) {
+	metadataURL, err := url.JoinPath(module.RegistryUrl, "modules", module.Name, "metadata.json")
+	if err != nil {
+		return "", false, fmt.Errorf("failed to construct URL: %w", err)
+	}
+	metadataContents := e.GetHttpFileContentsValue(&model_analysis_pb.HttpFileContents_Key{
+		Urls: []string{metadataURL},
+	})
+	if !metadataContents.IsSet() {
+		return "", false, evaluation.ErrMissingDependency
+	}
+	if metadataContents.Message.Exists == nil {
+		// Registries are not required to provide metadata.
+		return "", false, nil
+	}
+
+	metadataContentsEntry, err := model_filesystem.NewFileContentsEntryFromProto(
+		model_core.Message[*model_filesystem_pb.FileContents]{
+			Message:            metadataContents.Message.Exists.Contents,
+			OutgoingReferences: metadataContents.OutgoingReferences,
+		},
+		c.buildSpecificationReference.GetReferenceFormat(),
+	)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid contents for file %#v: %w", metadataURL, err)
+	}
+	metadataData, err := fileReader.FileReadAll(ctx, metadataContentsEntry, 1<<20)
+	if err != nil {
+		return "", false, err
+	}
+	var metadata registryModuleMetadata
+	if err := json.Unmarshal(metadataData, &metadata); err != nil {
+		return "", false, fmt.Errorf("failed to parse %#v: %w", metadataURL, err)
+	}
+	yankedReason, isYanked := metadata.YankedVersions[module.Version]
+	return yankedReason, isYanked, nil
+}
+
+func (c *baseComputer) ComputeUpdatedModuleLockfileValue(ctx context.Context, key *model_analysis_pb.UpdatedModuleLockfile_Key, e UpdatedModuleLockfileEnvironment) (PatchedUpdatedModuleLockfileValue, error) {
+	buildSpecification := e.GetBuildSpecificationValue(&model_analysis_pb.BuildSpecification_Key{})
+	if !buildSpecification.IsSet() {
+		return PatchedUpdatedModuleLockfileValue{}, evaluation.ErrMissingDependency
+	}
+	useLockfile := buildSpecification.Message.BuildSpecification.GetUseLockfile()
+	if useLockfile == nil {
+		// Lockfile is disabled.
+		return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.UpdatedModuleLockfile_Value{}), nil
+	}
+
+	roughBuildListValue := e.GetModuleRoughBuildListValue(&model_analysis_pb.ModuleRoughBuildList_Key{})
+	finalBuildListValue := e.GetModuleFinalBuildListValue(&model_analysis_pb.ModuleFinalBuildList_Key{})
+	usedModuleExtensionsValue := e.GetUsedModuleExtensionsValue(&model_analysis_pb.UsedModuleExtensions_Key{})
+	fileReader, gotFileReader := e.GetFileReaderValue(&model_analysis_pb.FileReader_Key{})
+	if !roughBuildListValue.IsSet() || !finalBuildListValue.IsSet() || !usedModuleExtensionsValue.IsSet() || !gotFileReader {
+		return PatchedUpdatedModuleLockfileValue{}, evaluation.ErrMissingDependency
+	}
+
+	registryFileHashes := map[string]string{}
+	for _, registryFileHash := range roughBuildListValue.Message.RegistryFileHashes {
+		if len(registryFileHash.Sha256) == 0 {
+			registryFileHashes[registryFileHash.Url] = moduleLockfileRegistryFileNotFound
+		} else {
+			registryFileHashes[registryFileHash.Url] = hex.EncodeToString(registryFileHash.Sha256)
+		}
+	}
+
+	// Record the repos declared by all module extensions. Unlike
+	// Bazel, we don't store any digests of the module extension's
+	// inputs, as we don't reuse these results in subsequent builds.
+	moduleExtensions := map[string]map[string]moduleLockfileModuleExtension{}
+	missingDependencies := false
+	for _, moduleExtension := range usedModuleExtensionsValue.Message.ModuleExtensions {
+		identifier, err := label.NewCanonicalStarlarkIdentifier(moduleExtension.Identifier)
+		if err != nil {
+			return PatchedUpdatedModuleLockfileValue{}, fmt.Errorf("invalid module extension Starlark identifier %#v: %w", moduleExtension.Identifier, err)
+		}
+		moduleExtensionReposValue := e.GetModuleExtensionReposValue(&model_analysis_pb.ModuleExtensionRepos_Key{
+			ModuleExtension: identifier.ToModuleExtension().String(),
+		})
+		if !moduleExtensionReposValue.IsSet() {
+			missingDependencies = true
+			continue
+		}
+		if moduleExtensionReposValue.Message.Reproducible {
+			// Results of reproducible module extensions
+			// don't need to be recorded, as they can be
+			// recomputed at any time.
+			continue
+		}
+
+		generatedRepoSpecs := map[string]moduleLockfileRepoSpec{}
+		var errIter error
+		for entry := range btree.AllLeaves(
+			ctx,
+			model_parser.NewStorageBackedParsedObjectReader(
+				c.objectDownloader,
+				c.getValueObjectEncoder(),
+				model_parser.NewMessageListObjectParser[object.LocalReference, model_analysis_pb.ModuleExtensionRepos_Value_Repo](),
+			),
+			model_core.Message[[]*model_analysis_pb.ModuleExtensionRepos_Value_Repo]{
+				Message:            moduleExtensionReposValue.Message.Repos,
+				OutgoingReferences: moduleExtensionReposValue.OutgoingReferences,
+			},
+			func(entry model_core.Message[*model_analysis_pb.ModuleExtensionRepos_Value_Repo]) (*model_core_pb.Reference, error) {
+				if parent, ok := entry.Message.Level.(*model_analysis_pb.ModuleExtensionRepos_Value_Repo_Parent_); ok {
+					return parent.Parent.Reference, nil
+				}
+				return nil, nil
+			},
+			&errIter,
+		) {
+			leaf, ok := entry.Message.Level.(*model_analysis_pb.ModuleExtensionRepos_Value_Repo_Leaf)
+			if !ok {
+				return PatchedUpdatedModuleLockfileValue{}, errors.New("not a valid leaf entry")
+			}
+			definition := leaf.Leaf.Definition
+			if definition == nil {
+				return PatchedUpdatedModuleLockfileValue{}, fmt.Errorf("repo %#v declared by module extension %#v has no definition", leaf.Leaf.Name, moduleExtension.Identifier)
+			}
+
+			attributes, err := c.getRepoAttributesAsJSON(
+				ctx,
+				model_core.Message[*model_starlark_pb.Struct_Fields]{
+					Message:            definition.AttrValues,
+					OutgoingReferences: entry.OutgoingReferences,
+				},
+			)
+			if err != nil {
+				return PatchedUpdatedModuleLockfileValue{}, fmt.Errorf("repo %#v declared by module extension %#v: %w", leaf.Leaf.Name, moduleExtension.Identifier, err)
+			}
+
+			generatedRepoSpecs[leaf.Leaf.Name] = moduleLockfileRepoSpec{
+				RepoRuleID: definition.RepositoryRuleIdentifier,
+				Attributes: attributes,
+			}
+		}
+		if errIter != nil {
+			return PatchedUpdatedModuleLockfileValue{}, errIter
+		}
+
+		moduleExtensions[moduleExtension.Identifier] = map[string]moduleLockfileModuleExtension{
+			"general": {
+				GeneratedRepoSpecs: generatedRepoSpecs,
+			},
+		}
+	}
+
+	// Record which of the selected versions of modules have been
+	// yanked from the registries from which they were obtained.
+	selectedYankedVersions := map[string]string{}
+	for _, module := range finalBuildListValue.Message.BuildList {
+		if module.RegistryUrl == "" {
+			// Module has an override.
+			continue
+		}
+		yankedReason, isYanked, err := c.getYankedReason(ctx, e, fileReader, module)
+		if err != nil {
+			if errors.Is(err, evaluation.ErrMissingDependency) {
+				missingDependencies = true
+				continue
+			}
+			return PatchedUpdatedModuleLockfileValue{}, fmt.Errorf("failed to determine whether module %s with version %s has been yanked: %w", module.Name, module.Version, err)
+		}
+		if isYanked {
+			selectedYankedVersions[module.Name+"@"+module.Version] = yankedReason
+		}
+	}
+	if missingDependencies {
+		return PatchedUpdatedModuleLockfileValue{}, evaluation.ErrMissingDependency
+	}
+
+	lockfile := &moduleLockfile{
+		LockFileVersion:        moduleLockfileVersion,
+		RegistryFileHashes:     registryFileHashes,
+		SelectedYankedVersions: selectedYankedVersions,
+		ModuleExtensions:       moduleExtensions,
+	}
+	contents, err := marshalModuleLockfile(lockfile)
+	if err != nil {
+		return PatchedUpdatedModuleLockfileValue{}, err
+	}
+
+	if useLockfile.Error {
+		// In error mode the lockfile may not be changed. Fail
+		// if the lockfile stored in the root module differs
+		// from the one that would have been written. Compare
+		// the parsed contents, so that differences in
+		// formatting and fields that are only written by Bazel
+		// are ignored.
+		existingContents, err := c.getModuleLockfileContents(ctx, e)
+		if err != nil {
+			return PatchedUpdatedModuleLockfileValue{}, err
+		}
+		if existingContents == nil {
+			return PatchedUpdatedModuleLockfileValue{}, fmt.Errorf("lockfile mode is set to error, but %#v does not exist", moduleLockfileFilename)
+		}
+		existingLockfile, err := parseModuleLockfile(existingContents)
+		if err != nil {
+			return PatchedUpdatedModuleLockfileValue{}, err
+		}
+		// Parse the newly generated lockfile as well, so that
+		// attribute values have the same types.
+		newLockfile, err := parseModuleLockfile(contents)
+		if err != nil {
+			return PatchedUpdatedModuleLockfileValue{}, err
+		}
+		if !reflect.DeepEqual(existingLockfile, newLockfile) {
+			return PatchedUpdatedModuleLockfileValue{}, fmt.Errorf("lockfile mode is set to error, but %#v is out of date", moduleLockfileFilename)
+		}
+	}
+
+	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.UpdatedModuleLockfile_Value{
+		Contents: contents,
+	}), nil
+}
+
+// getRegistryFileHashesByURL converts the registry file hashes
+// stored in a MODULE.bazel.lock file to a map that is keyed by URL.
+func getRegistryFileHashesByURL(registryFileHashes []*model_analysis_pb.RegistryFileHash) map[string][]byte {
+	m := make(map[string][]byte, len(registryFileHashes))
+	for _, registryFileHash := range registryFileHashes {
+		m[registryFileHash.Url] = registryFileHash.Sha256
+	}
+	return m
+}
+
+// newRegistryFileHashList converts a map of registry file hashes keyed
+// by URL to a list that is sorted by URL.
+func newRegistryFileHashList(registryFileHashes map[string][]byte) []*model_analysis_pb.RegistryFileHash {
+	l := make([]*model_analysis_pb.RegistryFileHash, 0, len(registryFileHashes))
+	for _, url := range slices.Sorted(maps.Keys(registryFileHashes)) {
+		l = append(l, &model_analysis_pb.RegistryFileHash{
+			Url:    url,
+			Sha256: registryFileHashes[url],
+		})
+	}
+	return l
+}
@@ -0,0 +1,174 @@
+package analysis
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	"github.com/stretchr/testify/require"
+
+	"go.starlark.net/starlark"
+)
+
+func TestConvertStarlarkValueToLockfileJSON(t *testing.T) {
+	thread := &starlark.Thread{}
+
+	t.Run("Scalars", func(t *testing.T) {
+		for _, testCase := range []struct {
+			input    starlark.Value
+			expected any
+		}{
+			{starlark.None, nil},
+			{starlark.True, true},
+			{starlark.MakeInt(42), int64(42)},
+			{starlark.String("hello"), "hello"},
+			{model_starlark.NewLabel(label.MustNewCanonicalLabel("@@example+//:foo").AsResolved()), "@@example+//:foo"},
+		} {
+			converted, err := convertStarlarkValueToLockfileJSON(testCase.input)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, converted)
+		}
+	})
+
+	t.Run("Containers", func(t *testing.T) {
+		// Dicts may be keyed by strings or labels, which are
+		// converted to strings.
+		dict := starlark.NewDict(2)
+		require.NoError(t, dict.SetKey(thread, starlark.String("a"), starlark.NewList([]starlark.Value{
+			starlark.MakeInt(1),
+			starlark.String("b"),
+		})))
+		require.NoError(t, dict.SetKey(
+			thread,
+			model_starlark.NewLabel(label.MustNewCanonicalLabel("@@example+//:bar").AsResolved()),
+			starlark.None,
+		))
+
+		converted, err := convertStarlarkValueToLockfileJSON(dict)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"a":                []any{int64(1), "b"},
+			"@@example+//:bar": nil,
+		}, converted)
+	})
+
+	t.Run("IntegerOutOfRange", func(t *testing.T) {
+		_, err := convertStarlarkValueToLockfileJSON(starlark.MakeBigInt(new(big.Int).Lsh(big.NewInt(1), 100)))
+		require.EqualError(t, err, "integer 1267650600228229401496703205376 is out of range")
+	})
+
+	t.Run("InvalidDictKey", func(t *testing.T) {
+		dict := starlark.NewDict(1)
+		require.NoError(t, dict.SetKey(thread, starlark.MakeInt(1), starlark.None))
+		_, err := convertStarlarkValueToLockfileJSON(dict)
+		require.EqualError(t, err, "dict key has type int, while string or Label was expected")
+	})
+
+	t.Run("UnsupportedType", func(t *testing.T) {
+		_, err := convertStarlarkValueToLockfileJSON(starlark.Float(1.5))
+		require.EqualError(t, err, "value of type float cannot be stored in \"MODULE.bazel.lock\"")
+	})
+}
+
+func TestModuleLockfile(t *testing.T) {
+	lockfile := &moduleLockfile{
+		LockFileVersion: moduleLockfileVersion,
+		RegistryFileHashes: map[string]string{
+			"https://bcr.bazel.build/modules/foo/1.0/MODULE.bazel": "3a5a5d1a5e0ec7ac0f6e0dd1b26e2a7a9fc3d8c0fd1df65b8a7ce73e1e9a1c6b",
+			"https://example.com/modules/foo/1.0/MODULE.bazel":     moduleLockfileRegistryFileNotFound,
+		},
+		SelectedYankedVersions: map[string]string{
+			"bar@2.0": "Contains a security vulnerability",
+		},
+		ModuleExtensions: map[string]map[string]moduleLockfileModuleExtension{
+			"@@foo+//:extensions.bzl%ext": {
+				"general": {
+					GeneratedRepoSpecs: map[string]moduleLockfileRepoSpec{
+						"repo": {
+							RepoRuleID: "@@foo+//:repo.bzl%my_repo",
+							Attributes: map[string]any{
+								"count": int64(3),
+								"srcs":  []any{"@@foo+//:a", "@@foo+//:b"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		contents, err := marshalModuleLockfile(lockfile)
+		require.NoError(t, err)
+		require.Equal(t, `{
+  "lockFileVersion": 18,
+  "registryFileHashes": {
+    "https://bcr.bazel.build/modules/foo/1.0/MODULE.bazel": "3a5a5d1a5e0ec7ac0f6e0dd1b26e2a7a9fc3d8c0fd1df65b8a7ce73e1e9a1c6b",
+    "https://example.com/modules/foo/1.0/MODULE.bazel": "not found"
+  },
+  "selectedYankedVersions": {
+    "bar@2.0": "Contains a security vulnerability"
+  },
+  "moduleExtensions": {
+    "@@foo+//:extensions.bzl%ext": {
+      "general": {
+        "generatedRepoSpecs": {
+          "repo": {
+            "repoRuleId": "@@foo+//:repo.bzl%my_repo",
+            "attributes": {
+              "count": 3,
+              "srcs": [
+                "@@foo+//:a",
+                "@@foo+//:b"
+              ]
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, string(contents))
+
+		parsedLockfile, err := parseModuleLockfile(contents)
+		require.NoError(t, err)
+		reparsedContents, err := marshalModuleLockfile(parsedLockfile)
+		require.NoError(t, err)
+		require.Equal(t, contents, reparsedContents)
+	})
+
+	t.Run("WrittenByBazel", func(t *testing.T) {
+		// Lockfiles written by Bazel use different formatting,
+		// and contain fields that are not written by us. These
+		// should be ignored when comparing lockfiles.
+		bazelLockfile, err := parseModuleLockfile([]byte(`{"lockFileVersion":18,"registryFileHashes":{"https://example.com/modules/foo/1.0/MODULE.bazel":"not found","https://bcr.bazel.build/modules/foo/1.0/MODULE.bazel":"3a5a5d1a5e0ec7ac0f6e0dd1b26e2a7a9fc3d8c0fd1df65b8a7ce73e1e9a1c6b"},"selectedYankedVersions":{"bar@2.0":"Contains a security vulnerability"},"moduleExtensions":{"@@foo+//:extensions.bzl%ext":{"general":{"bzlTransitiveDigest":"abc","usagesDigest":"def","recordedFileInputs":{},"generatedRepoSpecs":{"repo":{"repoRuleId":"@@foo+//:repo.bzl%my_repo","attributes":{"srcs":["@@foo+//:a","@@foo+//:b"],"count":3}}}}}},"facts":{}}`))
+		require.NoError(t, err)
+		contents, err := marshalModuleLockfile(lockfile)
+		require.NoError(t, err)
+		ourLockfile, err := parseModuleLockfile(contents)
+		require.NoError(t, err)
+		require.Equal(t, ourLockfile, bazelLockfile)
+	})
+
+	t.Run("MissingFields", func(t *testing.T) {
+		// Absent maps should be equivalent to empty ones.
+		emptyLockfile, err := parseModuleLockfile([]byte(`{"lockFileVersion":18}`))
+		require.NoError(t, err)
+		contents, err := marshalModuleLockfile(&moduleLockfile{
+			LockFileVersion:        moduleLockfileVersion,
+			RegistryFileHashes:     map[string]string{},
+			SelectedYankedVersions: map[string]string{},
+			ModuleExtensions:       map[string]map[string]moduleLockfileModuleExtension{},
+		})
+		require.NoError(t, err)
+		ourLockfile, err := parseModuleLockfile(contents)
+		require.NoError(t, err)
+		require.Equal(t, ourLockfile, emptyLockfile)
+	})
+
+	t.Run("InvalidJSON", func(t *testing.T) {
+		_, err := parseModuleLockfile([]byte(`{"lockFileVersion":`))
+		require.EqualError(t, err, "failed to parse \"MODULE.bazel.lock\": unexpected end of JSON input")
+	})
+}
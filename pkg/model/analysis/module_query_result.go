@@ -0,0 +1,284 @@
+package analysis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	pg_starlark "github.com/buildbarn/bonanza/pkg/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+
+	"go.starlark.net/starlark"
+)
+
+// moduleGraphCapturingModuleDotBazelHandler is used to extract the
+// version and direct dependencies of a module from its MODULE.bazel
+// file, so that the module graph can be displayed.
+type moduleGraphCapturingModuleDotBazelHandler struct {
+	modulesWithMultipleVersions map[label.Module]OverrideVersions
+
+	version      *label.ModuleVersion
+	dependencies map[label.ModuleInstance]struct{}
+}
+
+func (h *moduleGraphCapturingModuleDotBazelHandler) BazelDep(name label.Module, version *label.ModuleVersion, maxCompatibilityLevel int, repoName label.ApparentRepo, devDependency bool) error {
+	if overrideVersions, ok := h.modulesWithMultipleVersions[name]; ok {
+		v, err := overrideVersions.LookupNearestVersion(version)
+		if err != nil {
+			return fmt.Errorf("invalid dependency of module %#v: %w", name.String(), err)
+		}
+		h.dependencies[name.ToModuleInstance(&v)] = struct{}{}
+	} else {
+		h.dependencies[name.ToModuleInstance(nil)] = struct{}{}
+	}
+	return nil
+}
+
+func (h *moduleGraphCapturingModuleDotBazelHandler) Module(name label.Module, version *label.ModuleVersion, compatibilityLevel int, repoName label.ApparentRepo, bazelCompatibility []string) error {
+	h.version = version
+	return nil
+}
+
+func (moduleGraphCapturingModuleDotBazelHandler) RegisterExecutionPlatforms(platformTargetPatterns []label.ApparentTargetPattern, devDependency bool) error {
+	return nil
+}
+
+func (moduleGraphCapturingModuleDotBazelHandler) RegisterToolchains(toolchainTargetPatterns []label.ApparentTargetPattern, devDependency bool) error {
+	return nil
+}
+
+func (moduleGraphCapturingModuleDotBazelHandler) UseExtension(extensionBzlFile label.ApparentLabel, extensionName label.StarlarkIdentifier, devDependency, isolate bool) (pg_starlark.ModuleExtensionProxy, error) {
+	return pg_starlark.NullModuleExtensionProxy, nil
+}
+
+func (moduleGraphCapturingModuleDotBazelHandler) UseRepoRule(repoRuleBzlFile label.ApparentLabel, repoRuleName string) (pg_starlark.RepoRuleProxy, error) {
+	return func(name label.ApparentRepo, devDependency bool, attrs map[string]starlark.Value) error {
+		return nil
+	}, nil
+}
+
+func (c *baseComputer) ComputeModuleQueryResultValue(ctx context.Context, key *model_analysis_pb.ModuleQueryResult_Key, e ModuleQueryResultEnvironment) (PatchedModuleQueryResultValue, error) {
+	rootModuleValue := e.GetRootModuleValue(&model_analysis_pb.RootModule_Key{})
+	finalBuildListValue := e.GetModuleFinalBuildListValue(&model_analysis_pb.ModuleFinalBuildList_Key{})
+	modulesWithMultipleVersions, gotModulesWithMultipleVersions := e.GetModulesWithMultipleVersionsObjectValue(&model_analysis_pb.ModulesWithMultipleVersionsObject_Key{})
+	if !rootModuleValue.IsSet() || !finalBuildListValue.IsSet() || !gotModulesWithMultipleVersions {
+		return PatchedModuleQueryResultValue{}, evaluation.ErrMissingDependency
+	}
+	rootModuleName, err := label.NewModule(rootModuleValue.Message.RootModuleName)
+	if err != nil {
+		return PatchedModuleQueryResultValue{}, fmt.Errorf("invalid root module name %#v: %w", rootModuleValue.Message.RootModuleName, err)
+	}
+	rootRepo := rootModuleName.ToModuleInstance(nil).GetBareCanonicalRepo()
+
+	// Traverse the MODULE.bazel files of all modules to determine
+	// the edges in the module graph.
+	buildListModules := map[string]*model_analysis_pb.BuildListModule{}
+	for _, module := range finalBuildListValue.Message.BuildList {
+		buildListModules[module.Name] = module
+	}
+	var modules []*model_build_pb.ModuleQueryResult_Module
+	var handlers []*moduleGraphCapturingModuleDotBazelHandler
+	var moduleInstances []label.ModuleInstance
+	if err := c.visitModuleDotBazelFilesBreadthFirst(ctx, e, func(moduleInstance label.ModuleInstance, ignoreDevDependencies bool) pg_starlark.ChildModuleDotBazelHandler {
+		h := &moduleGraphCapturingModuleDotBazelHandler{
+			modulesWithMultipleVersions: modulesWithMultipleVersions,
+			dependencies:                map[label.ModuleInstance]struct{}{},
+		}
+		handlers = append(handlers, h)
+		moduleInstances = append(moduleInstances, moduleInstance)
+		return h
+	}); err != nil {
+		return PatchedModuleQueryResultValue{}, err
+	}
+	modulesByInstance := map[label.ModuleInstance]*model_build_pb.ModuleQueryResult_Module{}
+	for i, moduleInstance := range moduleInstances {
+		h := handlers[i]
+		module := &model_build_pb.ModuleQueryResult_Module{
+			ModuleInstance: moduleInstance.String(),
+			Dependencies: slices.Sorted(func(yield func(string) bool) {
+				for dependency := range h.dependencies {
+					if !yield(dependency.String()) {
+						return
+					}
+				}
+			}),
+		}
+		if version, ok := moduleInstance.GetModuleVersion(); ok {
+			module.Version = version.String()
+		} else if buildListModule, ok := buildListModules[moduleInstance.GetModule().String()]; ok {
+			module.Version = buildListModule.Version
+			module.RegistryUrl = buildListModule.RegistryUrl
+		} else if h.version != nil {
+			module.Version = h.version.String()
+		}
+		modules = append(modules, module)
+		modulesByInstance[moduleInstance] = module
+	}
+
+	// Obtain the definitions of requested repos.
+	missingDependencies := false
+	query := key.Query
+	repos := make([]*model_build_pb.ModuleQueryResult_Repo, 0, len(query.GetRepos()))
+	for _, repoName := range query.GetRepos() {
+		repo := &model_build_pb.ModuleQueryResult_Repo{Name: repoName}
+		repos = append(repos, repo)
+
+		var canonicalRepo label.CanonicalRepo
+		if canonicalRepoStr, ok := strings.CutPrefix(repoName, "@@"); ok {
+			canonicalRepo, err = label.NewCanonicalRepo(canonicalRepoStr)
+			if err != nil {
+				return PatchedModuleQueryResultValue{}, fmt.Errorf("invalid canonical repo %#v: %w", repoName, err)
+			}
+		} else if apparentRepoStr, ok := strings.CutPrefix(repoName, "@"); ok {
+			apparentRepo, err := label.NewApparentRepo(apparentRepoStr)
+			if err != nil {
+				return PatchedModuleQueryResultValue{}, fmt.Errorf("invalid apparent repo %#v: %w", repoName, err)
+			}
+			canonicalRepoNameValue := e.GetCanonicalRepoNameValue(&model_analysis_pb.CanonicalRepoName_Key{
+				FromCanonicalRepo: rootRepo.String(),
+				ToApparentRepo:    apparentRepo.String(),
+			})
+			if !canonicalRepoNameValue.IsSet() {
+				missingDependencies = true
+				continue
+			}
+			if canonicalRepoNameValue.Message.ToCanonicalRepo == "" {
+				// Repo does not exist.
+				continue
+			}
+			canonicalRepo, err = label.NewCanonicalRepo(canonicalRepoNameValue.Message.ToCanonicalRepo)
+			if err != nil {
+				return PatchedModuleQueryResultValue{}, fmt.Errorf("invalid canonical repo %#v: %w", canonicalRepoNameValue.Message.ToCanonicalRepo, err)
+			}
+		} else {
+			return PatchedModuleQueryResultValue{}, fmt.Errorf("repo %#v does not start with \"@\" or \"@@\"", repoName)
+		}
+
+		if _, _, ok := canonicalRepo.GetModuleExtension(); ok {
+			moduleExtensionRepoValue := e.GetModuleExtensionRepoValue(&model_analysis_pb.ModuleExtensionRepo_Key{
+				CanonicalRepo: canonicalRepo.String(),
+			})
+			if !moduleExtensionRepoValue.IsSet() {
+				missingDependencies = true
+				continue
+			}
+			definition := moduleExtensionRepoValue.Message.Definition
+			if definition == nil {
+				return PatchedModuleQueryResultValue{}, fmt.Errorf("repo %#v has no definition", canonicalRepo.String())
+			}
+			attributes, err := c.getRepoAttributesAsJSON(
+				ctx,
+				model_core.Message[*model_starlark_pb.Struct_Fields]{
+					Message:            definition.AttrValues,
+					OutgoingReferences: moduleExtensionRepoValue.OutgoingReferences,
+				},
+			)
+			if err != nil {
+				return PatchedModuleQueryResultValue{}, fmt.Errorf("repo %#v: %w", canonicalRepo.String(), err)
+			}
+			attributesJSON, err := json.Marshal(attributes)
+			if err != nil {
+				return PatchedModuleQueryResultValue{}, fmt.Errorf("failed to marshal attributes of repo %#v: %w", canonicalRepo.String(), err)
+			}
+			repo.CanonicalName = canonicalRepo.String()
+			repo.RepositoryRuleIdentifier = definition.RepositoryRuleIdentifier
+			repo.AttributesJson = string(attributesJSON)
+		} else if module, ok := modulesByInstance[canonicalRepo.GetModuleInstance()]; ok {
+			repo.CanonicalName = canonicalRepo.String()
+			repo.ModuleInstance = module.ModuleInstance
+		}
+	}
+
+	// Obtain properties of requested module extensions.
+	var extensions []*model_build_pb.ModuleQueryResult_Extension
+	if len(query.GetExtensions()) > 0 {
+		usedModuleExtensionsValue := e.GetUsedModuleExtensionsValue(&model_analysis_pb.UsedModuleExtensions_Key{})
+		if !usedModuleExtensionsValue.IsSet() {
+			return PatchedModuleQueryResultValue{}, evaluation.ErrMissingDependency
+		}
+		usedModuleExtensions := map[string]*model_analysis_pb.ModuleExtensionImports{}
+		for _, moduleExtensionImports := range usedModuleExtensionsValue.Message.ModuleExtensionImports {
+			usedModuleExtensions[moduleExtensionImports.Identifier] = moduleExtensionImports
+		}
+
+		rootPackage := rootRepo.GetRootPackage()
+		for _, extensionName := range query.GetExtensions() {
+			separator := strings.LastIndexByte(extensionName, '%')
+			if separator < 0 {
+				return PatchedModuleQueryResultValue{}, fmt.Errorf("module extension %#v does not have the format ${bzl_file_label}%%${extension_name}", extensionName)
+			}
+			apparentBzlFile, err := rootPackage.AppendLabel(extensionName[:separator])
+			if err != nil {
+				return PatchedModuleQueryResultValue{}, fmt.Errorf("invalid label of module extension %#v: %w", extensionName, err)
+			}
+			identifierName, err := label.NewStarlarkIdentifier(extensionName[separator+1:])
+			if err != nil {
+				return PatchedModuleQueryResultValue{}, fmt.Errorf("invalid name of module extension %#v: %w", extensionName, err)
+			}
+			canonicalBzlFile, err := resolveApparent(e, rootRepo, apparentBzlFile)
+			if err != nil {
+				if errors.Is(err, evaluation.ErrMissingDependency) {
+					missingDependencies = true
+					continue
+				}
+				return PatchedModuleQueryResultValue{}, err
+			}
+			identifier := canonicalBzlFile.AppendStarlarkIdentifier(identifierName)
+			moduleExtension, ok := usedModuleExtensions[identifier.String()]
+			if !ok {
+				return PatchedModuleQueryResultValue{}, fmt.Errorf("module extension %#v is not used by any module", identifier.String())
+			}
+
+			moduleExtensionReposValue := e.GetModuleExtensionReposValue(&model_analysis_pb.ModuleExtensionRepos_Key{
+				ModuleExtension: identifier.ToModuleExtension().String(),
+			})
+			moduleExtensionRepoNamesValue := e.GetModuleExtensionRepoNamesValue(&model_analysis_pb.ModuleExtensionRepoNames_Key{
+				ModuleExtension: identifier.ToModuleExtension().String(),
+			})
+			if !moduleExtensionReposValue.IsSet() || !moduleExtensionRepoNamesValue.IsSet() {
+				missingDependencies = true
+				continue
+			}
+
+			extension := &model_build_pb.ModuleQueryResult_Extension{
+				Name:       extensionName,
+				Identifier: identifier.String(),
+				Repos:      moduleExtensionRepoNamesValue.Message.RepoNames,
+			}
+			for _, user := range moduleExtension.Users {
+				extension.Users = append(extension.Users, &model_build_pb.ModuleQueryResult_Extension_User{
+					ModuleInstance:   user.ModuleInstance,
+					ImportedRepos:    user.ImportedRepos,
+					DevImportedRepos: user.DevImportedRepos,
+				})
+			}
+			if directDeps := moduleExtensionReposValue.Message.RootModuleDirectDeps; directDeps != nil {
+				extension.RootModuleDirectDeps = &model_build_pb.ModuleQueryResult_Extension_RootModuleDirectDeps{
+					DirectDeps:    directDeps.DirectDeps,
+					DirectDevDeps: directDeps.DirectDevDeps,
+				}
+			}
+			extensions = append(extensions, extension)
+		}
+	}
+	if missingDependencies {
+		return PatchedModuleQueryResultValue{}, evaluation.ErrMissingDependency
+	}
+
+	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.ModuleQueryResult_Value{
+		Result: &model_build_pb.ModuleQueryResult{
+			Modules:    modules,
+			Repos:      repos,
+			Extensions: extensions,
+		},
+	}), nil
+}
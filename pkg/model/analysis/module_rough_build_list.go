@@ -1,7 +1,10 @@
 package analysis
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/url"
@@ -137,8 +140,10 @@ func (c *baseComputer) ComputeModuleRoughBuildListValue(ctx context.Context, key
 	rootModuleValue := e.GetRootModuleValue(&model_analysis_pb.RootModule_Key{})
 	modulesWithOverridesValue := e.GetModulesWithOverridesValue(&model_analysis_pb.ModulesWithOverrides_Key{})
 	registryURLsValue := e.GetModuleRegistryUrlsValue(&model_analysis_pb.ModuleRegistryUrls_Key{})
+	buildSpecification := e.GetBuildSpecificationValue(&model_analysis_pb.BuildSpecification_Key{})
+	lockfileValue := e.GetModuleLockfileValue(&model_analysis_pb.ModuleLockfile_Key{})
 	fileReader, gotFileReader := e.GetFileReaderValue(&model_analysis_pb.FileReader_Key{})
-	if !rootModuleValue.IsSet() || !modulesWithOverridesValue.IsSet() || !registryURLsValue.IsSet() || !gotFileReader {
+	if !rootModuleValue.IsSet() || !modulesWithOverridesValue.IsSet() || !registryURLsValue.IsSet() || !buildSpecification.IsSet() || !lockfileValue.IsSet() || !gotFileReader {
 		return PatchedModuleRoughBuildListValue{}, evaluation.ErrMissingDependency
 	}
 
@@ -167,12 +172,23 @@ func (c *baseComputer) ComputeModuleRoughBuildListValue(ctx context.Context, key
 	registryURLs := registryURLsValue.Message.RegistryUrls
 	var buildList roughBuildList
 
+	// If MODULE.bazel.lock contains hashes of files in registries,
+	// we can skip fetching files that are known not to exist. Files
+	// that do exist are requested by integrity, so that they are
+	// validated and can be obtained from the local cache without
+	// fetching them. In error mode, we may not fetch files whose
+	// hashes are absent.
+	lockfileRegistryFileHashes := getRegistryFileHashesByURL(lockfileValue.Message.RegistryFileHashes)
+	lockfileErrorMode := buildSpecification.Message.BuildSpecification.GetUseLockfile().GetError()
+	registryFileHashes := map[string][]byte{}
+
 ProcessModule:
 	for len(modulesToCheck) > 0 {
 		module := modulesToCheck[0]
 		modulesToCheck = modulesToCheck[1:]
 		var moduleFileContents model_core.Message[*model_filesystem_pb.FileContents]
 		var buildListEntry *model_analysis_pb.BuildListModule
+		var moduleFileURL string
 		if versions, ok := modulesWithOverrides[module.name]; ok {
 			// An override for the module exists. This means
 			// that we can access its sources directly and
@@ -201,16 +217,36 @@ ProcessModule:
 			// yet, as there is no guarantee that this is
 			// the definitive version to load.
 			for _, registryURL := range registryURLs {
-				moduleFileURL, err := getModuleDotBazelURL(registryURL, module.name, module.version)
+				var err error
+				moduleFileURL, err = getModuleDotBazelURL(registryURL, module.name, module.version)
 				if err != nil {
 					return PatchedModuleRoughBuildListValue{}, fmt.Errorf("failed to construct URL for module %s with version %s in registry %#v: %w", module.name, module.version, registryURL, err)
 				}
-				httpFileContents := e.GetHttpFileContentsValue(&model_analysis_pb.HttpFileContents_Key{Urls: []string{moduleFileURL}})
+				httpFileContentsKey := &model_analysis_pb.HttpFileContents_Key{Urls: []string{moduleFileURL}}
+				if expectedHash, ok := lockfileRegistryFileHashes[moduleFileURL]; !ok {
+					if lockfileErrorMode {
+						return PatchedModuleRoughBuildListValue{}, fmt.Errorf("%s does not contain a hash for %#v, while the lockfile mode is set to error", moduleLockfileFilename, moduleFileURL)
+					}
+				} else if len(expectedHash) == 0 {
+					// Lockfile states that the file does
+					// not exist in this registry.
+					registryFileHashes[moduleFileURL] = nil
+					continue
+				} else {
+					httpFileContentsKey.Integrity = "sha256-" + base64.StdEncoding.EncodeToString(expectedHash)
+				}
+
+				httpFileContents := e.GetHttpFileContentsValue(httpFileContentsKey)
 				if !httpFileContents.IsSet() {
 					missingDependencies = true
 					continue ProcessModule
 				}
-				if httpFileContents.Message.Exists != nil {
+				if httpFileContents.Message.Exists == nil {
+					if len(lockfileRegistryFileHashes[moduleFileURL]) > 0 {
+						return PatchedModuleRoughBuildListValue{}, fmt.Errorf("file %#v no longer exists, even though it is listed in %s", moduleFileURL, moduleLockfileFilename)
+					}
+					registryFileHashes[moduleFileURL] = nil
+				} else {
 					moduleFileContents = model_core.Message[*model_filesystem_pb.FileContents]{
 						Message:            httpFileContents.Message.Exists.Contents,
 						OutgoingReferences: httpFileContents.OutgoingReferences,
@@ -241,6 +277,15 @@ ProcessModule:
 		if err != nil {
 			return PatchedModuleRoughBuildListValue{}, err
 		}
+		if moduleFileURL != "" {
+			// Record the hash of the MODULE.bazel file,
+			// so that it can be stored in the lockfile.
+			hash := sha256.Sum256(moduleFileData)
+			if expectedHash, ok := lockfileRegistryFileHashes[moduleFileURL]; ok && !bytes.Equal(hash[:], expectedHash) {
+				return PatchedModuleRoughBuildListValue{}, fmt.Errorf("file %#v has SHA-256 hash %x, while %s expects %x", moduleFileURL, hash, moduleLockfileFilename, expectedHash)
+			}
+			registryFileHashes[moduleFileURL] = hash[:]
+		}
 
 		handler := bazelDepCapturingModuleDotBazelHandler{
 			ignoreDevDependencies: ignoreDevDependencies,
@@ -255,6 +300,7 @@ ProcessModule:
 				GetRootPackage().
 				AppendTargetName(moduleDotBazelTargetName),
 			nil,
+			c.printFromStarlark,
 			pg_starlark.NewOverrideIgnoringRootModuleDotBazelHandler(&handler),
 		); err != nil {
 			return PatchedModuleRoughBuildListValue{}, err
@@ -297,6 +343,7 @@ ProcessModule:
 
 	sort.Sort(buildList)
 	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.ModuleRoughBuildList_Value{
-		BuildList: buildList.Slice,
+		BuildList:          buildList.Slice,
+		RegistryFileHashes: newRegistryFileHashList(registryFileHashes),
 	}), nil
 }
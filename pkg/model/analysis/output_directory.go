@@ -0,0 +1,128 @@
+package analysis
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+)
+
+// configurationChecksumSizeBytes is the number of bytes of the
+// configuration checksum that is embedded in output paths. It is kept
+// short to prevent paths from getting excessively long, while still
+// making collisions between configurations unlikely.
+const configurationChecksumSizeBytes = 6
+
+// outputDirectory contains the properties of a configuration that are
+// needed to compute paths of output files built in that configuration.
+type outputDirectory struct {
+	mnemonic              string
+	configurationChecksum []byte
+}
+
+// newFileOwner returns the owner of an output file that is created by
+// a target in the configuration.
+func (od *outputDirectory) newFileOwner(targetName string) *model_starlark_pb.File_Owner {
+	return &model_starlark_pb.File_Owner{
+		Cfg:        od.configurationChecksum,
+		TargetName: targetName,
+		Mnemonic:   od.mnemonic,
+	}
+}
+
+// getBinDirectoryPath returns the path of the directory in which
+// output files of the configuration are stored.
+func (od *outputDirectory) getBinDirectoryPath() string {
+	return model_starlark.GetBinDirectoryPath(od.mnemonic, od.configurationChecksum)
+}
+
+// getConfigurationChecksum computes a short checksum of a configuration
+// that can be embedded in output paths. As configuration messages are
+// canonical, the checksum is derived from the configuration's
+// reference.
+func getConfigurationChecksum(configurationReference model_core.Message[*model_core_pb.Reference]) ([]byte, error) {
+	var rawReference []byte
+	if configurationReference.Message != nil {
+		// Non-empty configuration.
+		index, err := model_core.GetIndexFromReferenceMessage(configurationReference.Message, configurationReference.OutgoingReferences.GetDegree())
+		if err != nil {
+			return nil, fmt.Errorf("invalid configuration reference: %w", err)
+		}
+		rawReference = configurationReference.OutgoingReferences.GetOutgoingReference(index).GetRawReference()
+	}
+	checksum := sha256.Sum256(rawReference)
+	return checksum[:configurationChecksumSizeBytes], nil
+}
+
+// sanitizeMnemonic replaces characters that are not permitted to be
+// part of an output directory name.
+func sanitizeMnemonic(mnemonic string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '_' || r == '.' {
+			return r
+		}
+		return '_'
+	}, mnemonic)
+}
+
+type getOutputDirectoryEnvironment interface {
+	GetTargetValue(*model_analysis_pb.Target_Key) model_core.Message[*model_analysis_pb.Target_Value]
+}
+
+// getOutputDirectory returns the properties of the output directory of
+// a configuration. Similar to Bazel, the name of the output directory
+// starts with a mnemonic. As we don't have a notion of CPUs and
+// compilation modes, the name of the target platform is used.
+func (c *baseComputer) getOutputDirectory(ctx context.Context, e getOutputDirectoryEnvironment, configurationReference model_core.Message[*model_core_pb.Reference]) (*outputDirectory, error) {
+	configurationChecksum, err := getConfigurationChecksum(configurationReference)
+	if err != nil {
+		return nil, err
+	}
+
+	commandLineOptionPlatformsLabelStr := commandLineOptionPlatformsLabel.String()
+	configuration, err := c.getConfigurationByReference(ctx, configurationReference)
+	if err != nil {
+		return nil, err
+	}
+	platformsOverride, err := c.getBuildSettingOverride(ctx, configuration, commandLineOptionPlatformsLabelStr)
+	if err != nil {
+		return nil, err
+	}
+	var platformLabelStr string
+	if platformsOverride.IsSet() {
+		platformLabel, ok := platformsOverride.Message.Value.GetKind().(*model_starlark_pb.Value_Label)
+		if !ok {
+			return nil, fmt.Errorf("build setting override for %#v is not a label", commandLineOptionPlatformsLabelStr)
+		}
+		platformLabelStr = platformLabel.Label
+	} else {
+		targetValue := e.GetTargetValue(&model_analysis_pb.Target_Key{
+			Label: commandLineOptionPlatformsLabelStr,
+		})
+		if !targetValue.IsSet() {
+			return nil, evaluation.ErrMissingDependency
+		}
+		labelSetting, ok := targetValue.Message.Definition.GetKind().(*model_starlark_pb.Target_Definition_LabelSetting)
+		if !ok {
+			return nil, fmt.Errorf("target %#v is not a label setting", commandLineOptionPlatformsLabelStr)
+		}
+		platformLabelStr = labelSetting.LabelSetting.BuildSettingDefault
+	}
+	platformLabel, err := label.NewCanonicalLabel(platformLabelStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target platform label %#v: %w", platformLabelStr, err)
+	}
+
+	return &outputDirectory{
+		mnemonic:              sanitizeMnemonic(platformLabel.GetTargetName().String()),
+		configurationChecksum: configurationChecksum,
+	}, nil
+}
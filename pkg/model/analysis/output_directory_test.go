@@ -0,0 +1,121 @@
+package analysis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetConfigurationChecksum(t *testing.T) {
+	t.Run("EmptyConfiguration", func(t *testing.T) {
+		// The empty configuration has no reference. Its
+		// checksum is derived from an empty byte slice.
+		checksum, err := getConfigurationChecksum(model_core.NewSimpleMessage[*model_core_pb.Reference](nil))
+		require.NoError(t, err)
+		emptyChecksum := sha256.Sum256(nil)
+		require.Equal(t, emptyChecksum[:configurationChecksumSizeBytes], checksum)
+	})
+
+	t.Run("NonEmptyConfiguration", func(t *testing.T) {
+		// Distinct configurations should yield distinct
+		// checksums, while storing the same configuration
+		// twice should yield the same checksum.
+		objectDownloader := testObjectDownloader{}
+		getChecksum := func(platformLabel string) []byte {
+			configurationReference, _ := storeTestConfiguration(t, objectDownloader, &model_analysis_pb.Configuration{
+				BuildSettingOverrides: []*model_analysis_pb.Configuration_BuildSettingOverride{
+					newLabelBuildSettingOverride("@@bazel_tools+//command_line_option:platforms", platformLabel),
+				},
+			}).SortAndSetReferences()
+			checksum, err := getConfigurationChecksum(configurationReference)
+			require.NoError(t, err)
+			require.Len(t, checksum, configurationChecksumSizeBytes)
+			return checksum
+		}
+
+		checksum1 := getChecksum("@@example+//platforms:linux_aarch64")
+		checksum2 := getChecksum("@@example+//platforms:linux_x86_64")
+		require.NotEqual(t, checksum1, checksum2)
+		require.Equal(t, checksum1, getChecksum("@@example+//platforms:linux_aarch64"))
+
+		emptyChecksum, err := getConfigurationChecksum(model_core.NewSimpleMessage[*model_core_pb.Reference](nil))
+		require.NoError(t, err)
+		require.NotEqual(t, emptyChecksum, checksum1)
+	})
+}
+
+func TestSanitizeMnemonic(t *testing.T) {
+	require.Equal(t, "linux_x86-64.v2", sanitizeMnemonic("linux_x86-64.v2"))
+	require.Equal(t, "linux_aarch64_gpu_", sanitizeMnemonic("linux aarch64+gpu!"))
+	require.Equal(t, "__", sanitizeMnemonic("é/"))
+}
+
+func TestGetOutputDirectory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	objectDownloader := testObjectDownloader{}
+	c := newTestBaseComputer(objectDownloader)
+
+	e := NewMockConfiguredTargetEnvironment(ctrl)
+	e.EXPECT().GetTargetValue(gomock.Any()).DoAndReturn(getTestPlatformTargetValue).AnyTimes()
+
+	t.Run("DefaultPlatform", func(t *testing.T) {
+		// Without any overrides, the mnemonic should be derived
+		// from the default value of --platforms.
+		od, err := c.getOutputDirectory(ctx, e, model_core.NewSimpleMessage[*model_core_pb.Reference](nil))
+		require.NoError(t, err)
+		emptyChecksum := sha256.Sum256(nil)
+		require.Equal(t, &outputDirectory{
+			mnemonic:              "host",
+			configurationChecksum: emptyChecksum[:configurationChecksumSizeBytes],
+		}, od)
+		require.Equal(
+			t,
+			"bazel-out/host-"+hex.EncodeToString(emptyChecksum[:configurationChecksumSizeBytes])+"/bin",
+			od.getBinDirectoryPath(),
+		)
+	})
+
+	t.Run("PlatformsOverride", func(t *testing.T) {
+		configurationReference, _ := storeTestConfiguration(t, objectDownloader, &model_analysis_pb.Configuration{
+			BuildSettingOverrides: []*model_analysis_pb.Configuration_BuildSettingOverride{
+				newLabelBuildSettingOverride("@@bazel_tools+//command_line_option:platforms", "@@example+//platforms:linux_aarch64"),
+			},
+		}).SortAndSetReferences()
+		configurationChecksum, err := getConfigurationChecksum(configurationReference)
+		require.NoError(t, err)
+
+		od, err := c.getOutputDirectory(ctx, e, configurationReference)
+		require.NoError(t, err)
+		require.Equal(t, "linux_aarch64", od.mnemonic)
+		require.Equal(t, configurationChecksum, od.configurationChecksum)
+
+		fileOwner := od.newFileOwner("foo")
+		require.Equal(t, "linux_aarch64", fileOwner.Mnemonic)
+		require.Equal(t, configurationChecksum, fileOwner.Cfg)
+		require.Equal(t, "foo", fileOwner.TargetName)
+		require.Equal(
+			t,
+			"bazel-out/linux_aarch64-"+hex.EncodeToString(configurationChecksum)+"/bin",
+			od.getBinDirectoryPath(),
+		)
+	})
+
+	t.Run("NotALabelSetting", func(t *testing.T) {
+		e := NewMockConfiguredTargetEnvironment(ctrl)
+		e.EXPECT().GetTargetValue(testutil.EqProto(t, &model_analysis_pb.Target_Key{
+			Label: "@@bazel_tools+//command_line_option:platforms",
+		})).Return(model_core.NewSimpleMessage(newTestRuleTargetValue()))
+		_, err := c.getOutputDirectory(ctx, e, model_core.NewSimpleMessage[*model_core_pb.Reference](nil))
+		require.EqualError(t, err, "target \"@@bazel_tools+//command_line_option:platforms\" is not a label setting")
+	})
+}
@@ -96,6 +96,9 @@ func (c *baseComputer) ComputePackageValue(ctx context.Context, key *model_analy
 		if err := c.preloadBzlGlobals(e, canonicalPackage, program, builtinsModuleNames); err != nil {
 			return PatchedPackageValue{}, err
 		}
+		if err := c.checkBzlLoadVisibility(ctx, e, buildFileLabel, program, builtinsModuleNames); err != nil {
+			return PatchedPackageValue{}, err
+		}
 
 		thread.SetLocal(model_starlark.CanonicalPackageKey, canonicalPackage)
 		thread.SetLocal(model_starlark.ValueEncodingOptionsKey, c.getValueEncodingOptions(buildFileLabel))
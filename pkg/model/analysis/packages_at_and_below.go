@@ -0,0 +1,206 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_filesystem "github.com/buildbarn/bonanza/pkg/model/filesystem"
+	model_parser "github.com/buildbarn/bonanza/pkg/model/parser"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_filesystem_pb "github.com/buildbarn/bonanza/pkg/proto/model/filesystem"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+)
+
+// directoryContainsBuildFile returns true if a directory contains a
+// BUILD.bazel or BUILD file, meaning that it is the root of a package.
+func directoryContainsBuildFile(d *model_filesystem.Directory) bool {
+	files := d.Leaves.Message.Files
+	symlinks := d.Leaves.Message.Symlinks
+	for _, buildFileName := range buildDotBazelTargetNames {
+		n := buildFileName.String()
+		if _, ok := sort.Find(
+			len(files),
+			func(i int) int { return strings.Compare(n, files[i].Name) },
+		); ok {
+			return true
+		}
+		if _, ok := sort.Find(
+			len(symlinks),
+			func(i int) int { return strings.Compare(n, symlinks[i].Name) },
+		); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *baseComputer) ComputePackagesAtAndBelowValue(ctx context.Context, key *model_analysis_pb.PackagesAtAndBelow_Key, e PackagesAtAndBelowEnvironment) (PatchedPackagesAtAndBelowValue, error) {
+	basePackage, err := label.NewCanonicalPackage(key.BasePackage)
+	if err != nil {
+		return PatchedPackagesAtAndBelowValue{}, fmt.Errorf("invalid base package: %w", err)
+	}
+	canonicalRepo := basePackage.GetCanonicalRepo()
+
+	directoryAccessParametersValue := e.GetDirectoryAccessParametersValue(&model_analysis_pb.DirectoryAccessParameters_Key{})
+	repoValue := e.GetRepoValue(&model_analysis_pb.Repo_Key{
+		CanonicalRepo: canonicalRepo.String(),
+	})
+	repoDefaultAttrsValue := e.GetRepoDefaultAttrsValue(&model_analysis_pb.RepoDefaultAttrs_Key{
+		CanonicalRepo: canonicalRepo.String(),
+	})
+	rootModuleValue := e.GetRootModuleValue(&model_analysis_pb.RootModule_Key{})
+	if !directoryAccessParametersValue.IsSet() || !repoValue.IsSet() || !repoDefaultAttrsValue.IsSet() || !rootModuleValue.IsSet() {
+		return PatchedPackagesAtAndBelowValue{}, evaluation.ErrMissingDependency
+	}
+
+	var ignorer model_filesystem.DirectoryIgnorer
+	ignorer.AddIgnoreDirectoriesPatterns(repoDefaultAttrsValue.Message.IgnoredDirectories)
+
+	// The .bazelignore file is only respected for the root module.
+	rootModule, err := label.NewModule(rootModuleValue.Message.RootModuleName)
+	if err != nil {
+		return PatchedPackagesAtAndBelowValue{}, fmt.Errorf("invalid root module name %#v: %w", rootModuleValue.Message.RootModuleName, err)
+	}
+	if canonicalRepo == rootModule.ToModuleInstance(nil).GetBareCanonicalRepo() {
+		bazelIgnoreFileName := label.MustNewTargetName(".bazelignore")
+		bazelIgnoreFileProperties := e.GetFilePropertiesValue(&model_analysis_pb.FileProperties_Key{
+			CanonicalRepo: canonicalRepo.String(),
+			Path:          bazelIgnoreFileName.String(),
+		})
+		fileReader, gotFileReader := e.GetFileReaderValue(&model_analysis_pb.FileReader_Key{})
+		if !bazelIgnoreFileProperties.IsSet() || !gotFileReader {
+			return PatchedPackagesAtAndBelowValue{}, evaluation.ErrMissingDependency
+		}
+		if exists := bazelIgnoreFileProperties.Message.Exists; exists != nil {
+			bazelIgnoreFileLabel := canonicalRepo.GetRootPackage().AppendTargetName(bazelIgnoreFileName)
+			bazelIgnoreContentsEntry, err := model_filesystem.NewFileContentsEntryFromProto(
+				model_core.Message[*model_filesystem_pb.FileContents]{
+					Message:            exists.GetContents(),
+					OutgoingReferences: bazelIgnoreFileProperties.OutgoingReferences,
+				},
+				c.buildSpecificationReference.GetReferenceFormat(),
+			)
+			if err != nil {
+				return PatchedPackagesAtAndBelowValue{}, fmt.Errorf("invalid contents for file %#v: %w", bazelIgnoreFileLabel.String(), err)
+			}
+			bazelIgnoreData, err := fileReader.FileReadAll(ctx, bazelIgnoreContentsEntry, 1<<20)
+			if err != nil {
+				return PatchedPackagesAtAndBelowValue{}, err
+			}
+			ignorer.AddBazelIgnore(string(bazelIgnoreData))
+		}
+	}
+
+	directoryAccessParameters, err := model_filesystem.NewDirectoryAccessParametersFromProto(
+		directoryAccessParametersValue.Message.DirectoryAccessParameters,
+		c.buildSpecificationReference.GetReferenceFormat(),
+	)
+	if err != nil {
+		return PatchedPackagesAtAndBelowValue{}, fmt.Errorf("invalid directory access parameters: %w", err)
+	}
+	directoryClusterReader := model_parser.NewStorageBackedParsedObjectReader(
+		c.objectDownloader,
+		directoryAccessParameters.GetEncoder(),
+		model_filesystem.NewDirectoryClusterObjectParser[object.LocalReference](
+			model_parser.NewStorageBackedParsedObjectReader(
+				c.objectDownloader,
+				directoryAccessParameters.GetEncoder(),
+				model_parser.NewMessageObjectParser[object.LocalReference, model_filesystem_pb.Leaves](),
+			),
+		),
+	)
+	getDirectory := func(directoryInfo model_filesystem.DirectoryInfo) (*model_filesystem.Directory, error) {
+		cluster, _, err := directoryClusterReader.ReadParsedObject(ctx, directoryInfo.ClusterReference)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read directory cluster: %w", err)
+		}
+		if directoryInfo.DirectoryIndex >= uint(len(cluster)) {
+			return nil, fmt.Errorf("directory index %d exceeds directory cluster size %d", directoryInfo.DirectoryIndex, len(cluster))
+		}
+		return &cluster[directoryInfo.DirectoryIndex], nil
+	}
+
+	directoryInfo, err := model_filesystem.NewDirectoryInfoFromDirectoryReference(
+		model_core.Message[*model_filesystem_pb.DirectoryReference]{
+			Message:            repoValue.Message.RootDirectoryReference,
+			OutgoingReferences: repoValue.OutgoingReferences,
+		},
+	)
+	if err != nil {
+		return PatchedPackagesAtAndBelowValue{}, fmt.Errorf("failed to create directory info for canonical repo %#v: %w", canonicalRepo.String(), err)
+	}
+
+	// Walk down to the directory corresponding to the base package.
+	// If it does not exist or is ignored, there are no packages to
+	// report.
+	d, err := getDirectory(directoryInfo)
+	if err != nil {
+		return PatchedPackagesAtAndBelowValue{}, err
+	}
+	basePackagePath := basePackage.GetPackagePath()
+	if basePackagePath != "" {
+		var currentPath string
+		for _, component := range strings.Split(basePackagePath, "/") {
+			currentPath = path.Join(currentPath, component)
+			directories := d.Directories
+			i, ok := sort.Find(
+				len(directories),
+				func(i int) int { return strings.Compare(component, directories[i].Name.String()) },
+			)
+			if !ok || ignorer.IsIgnored(currentPath) {
+				return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.PackagesAtAndBelow_Value{}), nil
+			}
+			d, err = getDirectory(directories[i].Info)
+			if err != nil {
+				return PatchedPackagesAtAndBelowValue{}, err
+			}
+		}
+	}
+
+	// Recursively traverse all directories below the base package,
+	// reporting the ones that contain a BUILD file.
+	var packagesBelowBasePackage []string
+	type pendingDirectory struct {
+		relativePath string
+		info         model_filesystem.DirectoryInfo
+	}
+	var pendingDirectories []pendingDirectory
+	pushChildren := func(d *model_filesystem.Directory, relativePath string) {
+		for _, child := range d.Directories {
+			childRelativePath := path.Join(relativePath, child.Name.String())
+			if !ignorer.IsIgnored(path.Join(basePackagePath, childRelativePath)) {
+				pendingDirectories = append(pendingDirectories, pendingDirectory{
+					relativePath: childRelativePath,
+					info:         child.Info,
+				})
+			}
+		}
+	}
+	pushChildren(d, "")
+	for len(pendingDirectories) > 0 {
+		current := pendingDirectories[len(pendingDirectories)-1]
+		pendingDirectories = pendingDirectories[:len(pendingDirectories)-1]
+		child, err := getDirectory(current.info)
+		if err != nil {
+			return PatchedPackagesAtAndBelowValue{}, err
+		}
+		if directoryContainsBuildFile(child) {
+			packagesBelowBasePackage = append(packagesBelowBasePackage, current.relativePath)
+		}
+		pushChildren(child, current.relativePath)
+	}
+	slices.Sort(packagesBelowBasePackage)
+
+	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.PackagesAtAndBelow_Value{
+		PackageAtBasePackage:     directoryContainsBuildFile(d),
+		PackagesBelowBasePackage: packagesBelowBasePackage,
+	}), nil
+}
@@ -0,0 +1,264 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_encoding "github.com/buildbarn/bonanza/pkg/model/encoding"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_filesystem_pb "github.com/buildbarn/bonanza/pkg/proto/model/filesystem"
+	object_pb "github.com/buildbarn/bonanza/pkg/proto/storage/object"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+// newTestPackageDirectory creates a Directory message for a directory
+// that contains the provided subdirectories. If isPackage is set, the
+// directory also contains a BUILD.bazel file.
+func newTestPackageDirectory(isPackage bool, directories ...*model_filesystem_pb.DirectoryNode) *model_filesystem_pb.Directory {
+	leaves := &model_filesystem_pb.Leaves{}
+	if isPackage {
+		leaves.Files = []*model_filesystem_pb.FileNode{{
+			Name:       "BUILD.bazel",
+			Properties: &model_filesystem_pb.FileProperties{},
+		}}
+	}
+	return &model_filesystem_pb.Directory{
+		Leaves: &model_filesystem_pb.Directory_LeavesInline{
+			LeavesInline: leaves,
+		},
+		Directories: directories,
+	}
+}
+
+func newTestDirectoryNode(name string, directory *model_filesystem_pb.Directory) *model_filesystem_pb.DirectoryNode {
+	return &model_filesystem_pb.DirectoryNode{
+		Name: name,
+		Contents: &model_filesystem_pb.DirectoryNode_ContentsInline{
+			ContentsInline: directory,
+		},
+	}
+}
+
+func TestComputePackagesAtAndBelowValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	// Store a repo containing the following packages in storage:
+	//
+	//     //
+	//     //node_modules/foo  (listed in .bazelignore)
+	//     //src
+	//     //src/lib
+	//     //src/lib/testdata  (matched by ignore_directories())
+	//     //src/testdata      (matched by ignore_directories())
+	//     //third_party/zlib
+	objectDownloader := testObjectDownloader{}
+	rootDirectory, _, err := model_core.MarshalAndEncodePatchedMessage(
+		model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](
+			newTestPackageDirectory(
+				true,
+				newTestDirectoryNode("node_modules", newTestPackageDirectory(
+					false,
+					newTestDirectoryNode("foo", newTestPackageDirectory(true)),
+				)),
+				newTestDirectoryNode("src", newTestPackageDirectory(
+					true,
+					newTestDirectoryNode("lib", newTestPackageDirectory(
+						true,
+						newTestDirectoryNode("testdata", newTestPackageDirectory(true)),
+					)),
+					newTestDirectoryNode("testdata", newTestPackageDirectory(true)),
+				)),
+				newTestDirectoryNode("third_party", newTestPackageDirectory(
+					false,
+					newTestDirectoryNode("zlib", newTestPackageDirectory(true)),
+				)),
+			),
+		),
+		object.ReferenceFormat{},
+		model_encoding.NewChainedBinaryEncoder(nil),
+	)
+	require.NoError(t, err)
+	objectDownloader[rootDirectory.GetReference()] = rootDirectory
+
+	bazelIgnore := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("# Installed by npm.\nnode_modules\n"))
+	objectDownloader[bazelIgnore.GetReference()] = bazelIgnore
+
+	c := newTestBaseComputer(objectDownloader)
+	fileReader, err := c.ComputeFileReaderValue(ctx, &model_analysis_pb.FileReader_Key{}, func() FileReaderEnvironment {
+		e := NewMockFileReaderEnvironment(ctrl)
+		e.EXPECT().GetFileAccessParametersValue(gomock.Any()).Return(model_core.NewSimpleMessage(&model_analysis_pb.FileAccessParameters_Value{
+			FileAccessParameters: &model_filesystem_pb.FileAccessParameters{},
+		}))
+		return e
+	}())
+	require.NoError(t, err)
+
+	// expectRepo sets up expectations for the dependencies that
+	// are needed to traverse a repo, regardless of whether it
+	// belongs to the root module.
+	expectRepo := func(e *MockPackagesAtAndBelowEnvironment, canonicalRepo string, ignoredDirectories []string) {
+		e.EXPECT().GetDirectoryAccessParametersValue(gomock.Any()).
+			Return(model_core.NewSimpleMessage(&model_analysis_pb.DirectoryAccessParameters_Value{
+				DirectoryAccessParameters: &model_filesystem_pb.DirectoryAccessParameters{},
+			})).AnyTimes()
+		e.EXPECT().GetRepoValue(testutil.EqProto(t, &model_analysis_pb.Repo_Key{
+			CanonicalRepo: canonicalRepo,
+		})).Return(model_core.Message[*model_analysis_pb.Repo_Value]{
+			Message: &model_analysis_pb.Repo_Value{
+				RootDirectoryReference: &model_filesystem_pb.DirectoryReference{
+					Reference: &model_core_pb.Reference{
+						Index: 1,
+					},
+					DirectoriesCount: 3,
+				},
+			},
+			OutgoingReferences: object.OutgoingReferencesList{
+				rootDirectory.GetReference(),
+			},
+		}).AnyTimes()
+		e.EXPECT().GetRepoDefaultAttrsValue(testutil.EqProto(t, &model_analysis_pb.RepoDefaultAttrs_Key{
+			CanonicalRepo: canonicalRepo,
+		})).Return(model_core.NewSimpleMessage(&model_analysis_pb.RepoDefaultAttrs_Value{
+			IgnoredDirectories: ignoredDirectories,
+		})).AnyTimes()
+		e.EXPECT().GetRootModuleValue(gomock.Any()).
+			Return(model_core.NewSimpleMessage(&model_analysis_pb.RootModule_Value{
+				RootModuleName: "mymodule",
+			})).AnyTimes()
+	}
+
+	// expectBazelIgnore sets up expectations for reading the root
+	// module's .bazelignore file.
+	expectBazelIgnore := func(e *MockPackagesAtAndBelowEnvironment) {
+		e.EXPECT().GetFilePropertiesValue(testutil.EqProto(t, &model_analysis_pb.FileProperties_Key{
+			CanonicalRepo: "mymodule+",
+			Path:          ".bazelignore",
+		})).Return(model_core.Message[*model_analysis_pb.FileProperties_Value]{
+			Message: &model_analysis_pb.FileProperties_Value{
+				Exists: &model_filesystem_pb.FileProperties{
+					Contents: &model_filesystem_pb.FileContents{
+						Reference: &model_core_pb.Reference{
+							Index: 1,
+						},
+						TotalSizeBytes: uint64(len(bazelIgnore.GetPayload())),
+					},
+				},
+			},
+			OutgoingReferences: object.OutgoingReferencesList{
+				bazelIgnore.GetReference(),
+			},
+		}).AnyTimes()
+		e.EXPECT().GetFileReaderValue(gomock.Any()).Return(fileReader, true).AnyTimes()
+	}
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		e := NewMockPackagesAtAndBelowEnvironment(ctrl)
+		e.EXPECT().GetDirectoryAccessParametersValue(gomock.Any()).
+			Return(model_core.Message[*model_analysis_pb.DirectoryAccessParameters_Value]{})
+		e.EXPECT().GetRepoValue(gomock.Any()).
+			Return(model_core.Message[*model_analysis_pb.Repo_Value]{})
+		e.EXPECT().GetRepoDefaultAttrsValue(gomock.Any()).
+			Return(model_core.Message[*model_analysis_pb.RepoDefaultAttrs_Value]{})
+		e.EXPECT().GetRootModuleValue(gomock.Any()).
+			Return(model_core.Message[*model_analysis_pb.RootModule_Value]{})
+
+		_, err := c.ComputePackagesAtAndBelowValue(ctx, &model_analysis_pb.PackagesAtAndBelow_Key{
+			BasePackage: "@@mymodule+",
+		}, e)
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+	})
+
+	t.Run("RootModuleIgnoredDirectories", func(t *testing.T) {
+		// In the root module, directories listed in
+		// .bazelignore and ones matched by the patterns
+		// provided to ignore_directories() should both be
+		// skipped.
+		e := NewMockPackagesAtAndBelowEnvironment(ctrl)
+		expectRepo(e, "mymodule+", []string{"**/testdata"})
+		expectBazelIgnore(e)
+
+		packages, err := c.ComputePackagesAtAndBelowValue(ctx, &model_analysis_pb.PackagesAtAndBelow_Key{
+			BasePackage: "@@mymodule+",
+		}, e)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.PackagesAtAndBelow_Value{
+			PackageAtBasePackage: true,
+			PackagesBelowBasePackage: []string{
+				"src",
+				"src/lib",
+				"third_party/zlib",
+			},
+		}, packages.Message)
+	})
+
+	t.Run("RootModuleNestedBasePackage", func(t *testing.T) {
+		// Patterns should be matched against the path relative
+		// to the root of the repo, not the base package.
+		e := NewMockPackagesAtAndBelowEnvironment(ctrl)
+		expectRepo(e, "mymodule+", []string{"src/lib/*"})
+		expectBazelIgnore(e)
+
+		packages, err := c.ComputePackagesAtAndBelowValue(ctx, &model_analysis_pb.PackagesAtAndBelow_Key{
+			BasePackage: "@@mymodule+//src",
+		}, e)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.PackagesAtAndBelow_Value{
+			PackageAtBasePackage: true,
+			PackagesBelowBasePackage: []string{
+				"lib",
+				"testdata",
+			},
+		}, packages.Message)
+	})
+
+	t.Run("RootModuleIgnoredBasePackage", func(t *testing.T) {
+		// If the base package itself or one of its parents is
+		// ignored, no packages should be reported.
+		for _, basePackage := range []string{
+			"@@mymodule+//node_modules",
+			"@@mymodule+//node_modules/foo",
+			"@@mymodule+//src/testdata",
+		} {
+			e := NewMockPackagesAtAndBelowEnvironment(ctrl)
+			expectRepo(e, "mymodule+", []string{"**/testdata"})
+			expectBazelIgnore(e)
+
+			packages, err := c.ComputePackagesAtAndBelowValue(ctx, &model_analysis_pb.PackagesAtAndBelow_Key{
+				BasePackage: basePackage,
+			}, e)
+			require.NoError(t, err)
+			testutil.RequireEqualProto(t, &model_analysis_pb.PackagesAtAndBelow_Value{}, packages.Message)
+		}
+	})
+
+	t.Run("NonRootModuleIgnoresBazelIgnore", func(t *testing.T) {
+		// The .bazelignore file should only be respected for
+		// the root module. Other repos should not cause it to
+		// be loaded.
+		e := NewMockPackagesAtAndBelowEnvironment(ctrl)
+		expectRepo(e, "other+", []string{"**/testdata"})
+
+		packages, err := c.ComputePackagesAtAndBelowValue(ctx, &model_analysis_pb.PackagesAtAndBelow_Key{
+			BasePackage: "@@other+",
+		}, e)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.PackagesAtAndBelow_Value{
+			PackageAtBasePackage: true,
+			PackagesBelowBasePackage: []string{
+				"node_modules/foo",
+				"src",
+				"src/lib",
+				"third_party/zlib",
+			},
+		}, packages.Message)
+	})
+}
@@ -0,0 +1,185 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type runningOperationKey struct {
+	messageName protoreflect.FullName
+	data        string
+}
+
+type runningOperation struct {
+	description string
+	startTime   time.Time
+	isAction    bool
+}
+
+// ProgressTracker keeps track of the progress of a build, so that it
+// can be reported to the client. It counts the number of targets that
+// have been configured and the number of actions that have been
+// executed. It also tracks long running operations, such as the
+// execution of actions and fetching of repos.
+type ProgressTracker struct {
+	lock                sync.Mutex
+	configuredTargets   uint64
+	completedActions    uint64
+	runningOperations   map[runningOperationKey]*runningOperation
+	suspendedOperations map[runningOperationKey]*runningOperation
+}
+
+// NewProgressTracker creates a ProgressTracker that does not have any
+// progress recorded.
+func NewProgressTracker() *ProgressTracker {
+	return &ProgressTracker{
+		runningOperations:   map[runningOperationKey]*runningOperation{},
+		suspendedOperations: map[runningOperationKey]*runningOperation{},
+	}
+}
+
+// NewComputer wraps an existing computer, so that all computations
+// performed by it are recorded by the ProgressTracker.
+func (pt *ProgressTracker) NewComputer(base evaluation.Computer) evaluation.Computer {
+	return &progressTrackingComputer{
+		base:    base,
+		tracker: pt,
+	}
+}
+
+// GetProgress returns the progress of the build at the current point
+// in time.
+func (pt *ProgressTracker) GetProgress() *model_build_pb.Progress {
+	pt.lock.Lock()
+	defer pt.lock.Unlock()
+
+	progress := &model_build_pb.Progress{
+		ConfiguredTargets: pt.configuredTargets,
+		CompletedActions:  pt.completedActions,
+		RunningOperations: make([]*model_build_pb.Progress_Operation, 0, len(pt.runningOperations)),
+	}
+	for _, operation := range pt.runningOperations {
+		progress.RunningOperations = append(progress.RunningOperations, &model_build_pb.Progress_Operation{
+			Description: operation.description,
+			StartTime:   timestamppb.New(operation.startTime),
+			IsAction:    operation.isAction,
+		})
+	}
+	slices.SortFunc(progress.RunningOperations, func(a, b *model_build_pb.Progress_Operation) int {
+		return a.StartTime.AsTime().Compare(b.StartTime.AsTime())
+	})
+	return progress
+}
+
+// getRunningOperationDescription returns a human readable description
+// of the computation of a key, if the computation of the key may take
+// a long time to complete.
+func getRunningOperationDescription(key proto.Message) (string, bool, bool) {
+	switch k := key.(type) {
+	case *model_analysis_pb.ActionResult_Key:
+		return "Executing action", true, true
+	case *model_analysis_pb.HttpArchiveContents_Key:
+		if len(k.Urls) > 0 {
+			return "Downloading " + k.Urls[0], false, true
+		}
+	case *model_analysis_pb.HttpFileContents_Key:
+		if len(k.Urls) > 0 {
+			return "Downloading " + k.Urls[0], false, true
+		}
+	case *model_analysis_pb.ModuleExtensionRepos_Key:
+		return "Evaluating module extension " + k.ModuleExtension, false, true
+	case *model_analysis_pb.Repo_Key:
+		return "Fetching repository @@" + k.CanonicalRepo, false, true
+	}
+	return "", false, false
+}
+
+// startOperation records that the computation of a key has started.
+// Computations may be restarted if dependencies are missing. The
+// original start time is retained in that case.
+func (pt *ProgressTracker) startOperation(key proto.Message) (runningOperationKey, bool) {
+	description, isAction, ok := getRunningOperationDescription(key)
+	if !ok {
+		return runningOperationKey{}, false
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(key)
+	if err != nil {
+		return runningOperationKey{}, false
+	}
+	operationKey := runningOperationKey{
+		messageName: key.ProtoReflect().Descriptor().FullName(),
+		data:        string(data),
+	}
+
+	pt.lock.Lock()
+	defer pt.lock.Unlock()
+	if operation, ok := pt.suspendedOperations[operationKey]; ok {
+		delete(pt.suspendedOperations, operationKey)
+		pt.runningOperations[operationKey] = operation
+	} else if _, ok := pt.runningOperations[operationKey]; !ok {
+		pt.runningOperations[operationKey] = &runningOperation{
+			description: description,
+			startTime:   time.Now(),
+			isAction:    isAction,
+		}
+	}
+	return operationKey, true
+}
+
+// finishOperation records that a computation of a key has finished.
+// If the computation failed due to missing dependencies, the key is
+// suspended until the computation is restarted. It is no longer
+// reported as running, as it is merely waiting for its dependencies
+// to be computed.
+func (pt *ProgressTracker) finishOperation(key proto.Message, operationKey runningOperationKey, hasOperation bool, err error) {
+	pt.lock.Lock()
+	defer pt.lock.Unlock()
+	if hasOperation {
+		if operation, ok := pt.runningOperations[operationKey]; ok {
+			delete(pt.runningOperations, operationKey)
+			if errors.Is(err, evaluation.ErrMissingDependency) {
+				pt.suspendedOperations[operationKey] = operation
+			}
+		}
+	}
+	if err == nil {
+		switch key.(type) {
+		case *model_analysis_pb.ActionResult_Key:
+			pt.completedActions++
+		case *model_analysis_pb.ConfiguredTarget_Key:
+			pt.configuredTargets++
+		}
+	}
+}
+
+type progressTrackingComputer struct {
+	base    evaluation.Computer
+	tracker *ProgressTracker
+}
+
+func (c *progressTrackingComputer) ComputeMessageValue(ctx context.Context, key model_core.Message[proto.Message], e evaluation.Environment) (model_core.PatchedMessage[proto.Message, dag.ObjectContentsWalker], error) {
+	operationKey, hasOperation := c.tracker.startOperation(key.Message)
+	value, err := c.base.ComputeMessageValue(ctx, key, e)
+	c.tracker.finishOperation(key.Message, operationKey, hasOperation, err)
+	return value, err
+}
+
+func (c *progressTrackingComputer) ComputeNativeValue(ctx context.Context, key model_core.Message[proto.Message], e evaluation.Environment) (any, error) {
+	operationKey, hasOperation := c.tracker.startOperation(key.Message)
+	value, err := c.base.ComputeNativeValue(ctx, key, e)
+	c.tracker.finishOperation(key.Message, operationKey, hasOperation, err)
+	return value, err
+}
@@ -0,0 +1,167 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestProgressTracker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	type computeResult = model_core.PatchedMessage[proto.Message, dag.ObjectContentsWalker]
+	successfulResult := model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker, proto.Message](&emptypb.Empty{})
+	configuredTargetKey := model_core.NewSimpleMessage[proto.Message](&model_analysis_pb.ConfiguredTarget_Key{Label: "@@main+//:foo"})
+	actionResultKey := model_core.NewSimpleMessage[proto.Message](&model_analysis_pb.ActionResult_Key{})
+	repoKey := model_core.NewSimpleMessage[proto.Message](&model_analysis_pb.Repo_Key{CanonicalRepo: "foo+"})
+
+	t.Run("Initial", func(t *testing.T) {
+		testutil.RequireEqualProto(t, &model_build_pb.Progress{}, NewProgressTracker().GetProgress())
+	})
+
+	t.Run("ConfiguredTargets", func(t *testing.T) {
+		// Only successfully configured targets should be
+		// counted. Configuring targets is not considered a long
+		// running operation.
+		pt := NewProgressTracker()
+		baseComputer := NewMockComputer(ctrl)
+		computer := pt.NewComputer(baseComputer)
+
+		baseComputer.EXPECT().ComputeMessageValue(ctx, configuredTargetKey, nil).
+			DoAndReturn(func(ctx context.Context, key model_core.Message[proto.Message], e evaluation.Environment) (computeResult, error) {
+				require.Empty(t, pt.GetProgress().RunningOperations)
+				return computeResult{}, evaluation.ErrMissingDependency
+			})
+		_, err := computer.ComputeMessageValue(ctx, configuredTargetKey, nil)
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+		require.Equal(t, uint64(0), pt.GetProgress().ConfiguredTargets)
+
+		baseComputer.EXPECT().ComputeMessageValue(ctx, configuredTargetKey, nil).
+			Return(computeResult{}, errors.New("analysis failed"))
+		_, err = computer.ComputeMessageValue(ctx, configuredTargetKey, nil)
+		require.EqualError(t, err, "analysis failed")
+		require.Equal(t, uint64(0), pt.GetProgress().ConfiguredTargets)
+
+		baseComputer.EXPECT().ComputeMessageValue(ctx, configuredTargetKey, nil).
+			Return(successfulResult, nil)
+		_, err = computer.ComputeMessageValue(ctx, configuredTargetKey, nil)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_build_pb.Progress{
+			ConfiguredTargets: 1,
+		}, pt.GetProgress())
+	})
+
+	t.Run("CompletedActions", func(t *testing.T) {
+		// Actions should be reported as running while they
+		// are being executed, and counted once they complete.
+		pt := NewProgressTracker()
+		baseComputer := NewMockComputer(ctrl)
+		computer := pt.NewComputer(baseComputer)
+
+		baseComputer.EXPECT().ComputeMessageValue(ctx, actionResultKey, nil).
+			DoAndReturn(func(ctx context.Context, key model_core.Message[proto.Message], e evaluation.Environment) (computeResult, error) {
+				progress := pt.GetProgress()
+				require.Len(t, progress.RunningOperations, 1)
+				require.Equal(t, "Executing action", progress.RunningOperations[0].Description)
+				require.True(t, progress.RunningOperations[0].IsAction)
+				return successfulResult, nil
+			})
+		_, err := computer.ComputeMessageValue(ctx, actionResultKey, nil)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_build_pb.Progress{
+			CompletedActions: 1,
+		}, pt.GetProgress())
+	})
+
+	t.Run("FailedOperation", func(t *testing.T) {
+		// Operations that fail should no longer be reported as
+		// running, nor should they be counted as completed.
+		pt := NewProgressTracker()
+		baseComputer := NewMockComputer(ctrl)
+		computer := pt.NewComputer(baseComputer)
+
+		baseComputer.EXPECT().ComputeMessageValue(ctx, actionResultKey, nil).
+			Return(computeResult{}, errors.New("action failed"))
+		_, err := computer.ComputeMessageValue(ctx, actionResultKey, nil)
+		require.EqualError(t, err, "action failed")
+		testutil.RequireEqualProto(t, &model_build_pb.Progress{}, pt.GetProgress())
+	})
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		// Operations waiting for their dependencies to be
+		// computed should not be reported as running. Once
+		// restarted, the original start time should be
+		// reported.
+		pt := NewProgressTracker()
+		baseComputer := NewMockComputer(ctrl)
+		computer := pt.NewComputer(baseComputer)
+
+		var firstProgress *model_build_pb.Progress
+		baseComputer.EXPECT().ComputeNativeValue(ctx, repoKey, nil).
+			DoAndReturn(func(ctx context.Context, key model_core.Message[proto.Message], e evaluation.Environment) (any, error) {
+				firstProgress = pt.GetProgress()
+				return nil, evaluation.ErrMissingDependency
+			})
+		_, err := computer.ComputeNativeValue(ctx, repoKey, nil)
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+		require.Len(t, firstProgress.RunningOperations, 1)
+		require.Equal(t, "Fetching repository @@foo+", firstProgress.RunningOperations[0].Description)
+		require.False(t, firstProgress.RunningOperations[0].IsAction)
+		testutil.RequireEqualProto(t, &model_build_pb.Progress{}, pt.GetProgress())
+
+		baseComputer.EXPECT().ComputeNativeValue(ctx, repoKey, nil).
+			DoAndReturn(func(ctx context.Context, key model_core.Message[proto.Message], e evaluation.Environment) (any, error) {
+				testutil.RequireEqualProto(t, firstProgress, pt.GetProgress())
+				return 123, nil
+			})
+		value, err := computer.ComputeNativeValue(ctx, repoKey, nil)
+		require.NoError(t, err)
+		require.Equal(t, 123, value)
+		testutil.RequireEqualProto(t, &model_build_pb.Progress{}, pt.GetProgress())
+	})
+
+	t.Run("ConcurrentOperations", func(t *testing.T) {
+		// Multiple operations may run at the same time. They
+		// should be reported separately.
+		pt := NewProgressTracker()
+		baseComputer := NewMockComputer(ctrl)
+		computer := pt.NewComputer(baseComputer)
+
+		baseComputer.EXPECT().ComputeNativeValue(ctx, repoKey, nil).
+			DoAndReturn(func(ctx context.Context, key model_core.Message[proto.Message], e evaluation.Environment) (any, error) {
+				baseComputer.EXPECT().ComputeMessageValue(ctx, actionResultKey, nil).
+					DoAndReturn(func(ctx context.Context, key model_core.Message[proto.Message], e evaluation.Environment) (computeResult, error) {
+						progress := pt.GetProgress()
+						require.Len(t, progress.RunningOperations, 2)
+						require.Equal(t, "Fetching repository @@foo+", progress.RunningOperations[0].Description)
+						require.Equal(t, "Executing action", progress.RunningOperations[1].Description)
+						return successfulResult, nil
+					})
+				_, err := computer.ComputeMessageValue(ctx, actionResultKey, nil)
+				require.NoError(t, err)
+
+				progress := pt.GetProgress()
+				require.Len(t, progress.RunningOperations, 1)
+				require.Equal(t, uint64(1), progress.CompletedActions)
+				return 123, nil
+			})
+		_, err := computer.ComputeNativeValue(ctx, repoKey, nil)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_build_pb.Progress{
+			CompletedActions: 1,
+		}, pt.GetProgress())
+	})
+}
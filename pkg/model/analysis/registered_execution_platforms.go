@@ -152,6 +152,7 @@ func (h *registeredExecutionPlatformExtractingModuleDotBazelHandler) RegisterExe
 				*h.executionPlatforms = append(*h.executionPlatforms, &model_analysis_pb.ExecutionPlatform{
 					Constraints:       constraints,
 					ExecPkixPublicKey: execPKIXPublicKey,
+					Label:             canonicalPlatformLabelStr,
 				})
 			}
 			if iterErr != nil {
@@ -10,7 +10,6 @@ import (
 	"github.com/buildbarn/bonanza/pkg/evaluation"
 	"github.com/buildbarn/bonanza/pkg/label"
 	model_core "github.com/buildbarn/bonanza/pkg/model/core"
-	"github.com/buildbarn/bonanza/pkg/model/core/btree"
 	model_parser "github.com/buildbarn/bonanza/pkg/model/parser"
 	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
 	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
@@ -173,59 +172,14 @@ func (h *registeredToolchainExtractingModuleDotBazelHandler) RegisterToolchains(
 					missingDependencies = true
 				}
 
-				// Annoyingly enough, target_compatible_with is
-				// configurable. Expand select() expressions.
-				var targetCompatibleWithLabels []string
-				for _, selectGroup := range ruleTarget.RuleTarget.TargetCompatibleWith {
-					targetCompatibleWithValue, err := getValueFromSelectGroup(h.environment, selectGroup, false)
-					if err != nil {
-						return err
-					}
-					targetCompatibleWithList, ok := targetCompatibleWithValue.Kind.(*model_starlark_pb.Value_List)
-					if !ok {
-						return fmt.Errorf("target_compatible_with of toolchain %#v is not a list", toolchainLabelStr)
-					}
-
-					var errIter error
-					for element := range btree.AllLeaves(
-						h.context,
-						model_parser.NewStorageBackedParsedObjectReader(
-							h.computer.objectDownloader,
-							h.computer.getValueObjectEncoder(),
-							model_parser.NewMessageListObjectParser[object.LocalReference, model_starlark_pb.List_Element](),
-						),
-						model_core.Message[[]*model_starlark_pb.List_Element]{
-							Message:            targetCompatibleWithList.List.Elements,
-							OutgoingReferences: targetValue.OutgoingReferences,
-						},
-						func(element model_core.Message[*model_starlark_pb.List_Element]) (*model_core_pb.Reference, error) {
-							if level, ok := element.Message.Level.(*model_starlark_pb.List_Element_Parent_); ok {
-								return level.Parent.Reference, nil
-							}
-							return nil, nil
-						},
-						&errIter,
-					) {
-						level, ok := element.Message.Level.(*model_starlark_pb.List_Element_Leaf)
-						if !ok {
-							return fmt.Errorf("invalid list element level type for target_compatible_with of toolchain %#v", toolchainLabelStr)
-						}
-						label, ok := level.Leaf.Kind.(*model_starlark_pb.Value_Label)
-						if !ok {
-							return fmt.Errorf("invalid list element type for target_compatible_with of toolchain %#v", toolchainLabelStr)
-						}
-						targetCompatibleWithLabels = append(targetCompatibleWithLabels, label.Label)
-					}
-					if errIter != nil {
-						return err
-					}
-				}
-
-				targetCompatibleWith, err := h.computer.constraintValuesToConstraints(
+				targetCompatibleWith, err := h.computer.getTargetCompatibleWith(
 					h.context,
 					h.environment,
-					toolchainPackage,
-					targetCompatibleWithLabels,
+					toolchainLabel,
+					model_core.Message[*model_starlark_pb.RuleTarget]{
+						Message:            ruleTarget.RuleTarget,
+						OutgoingReferences: targetValue.OutgoingReferences,
+					},
 				)
 				if err != nil {
 					if !errors.Is(err, evaluation.ErrMissingDependency) {
@@ -47,7 +47,13 @@ import (
 	"go.starlark.net/syntax"
 )
 
-var externalDirectoryName = path.MustNewComponent("external")
+var (
+	externalDirectoryName = path.MustNewComponent("external")
+	// readdirDirectoryName is the name of the directory in the
+	// input root in which the entries of directories outside the
+	// input root are recreated by path.readdir().
+	readdirDirectoryName = path.MustNewComponent("readdir")
+)
 
 type jsonOrderedMapEntry[T any] struct {
 	key   string
@@ -544,6 +550,103 @@ func (r *changeTrackingDirectoryExistingFileResolver) getFile() (*changeTracking
 	return nil, errors.New("file does not exist")
 }
 
+// changeTrackingDirectorySymlinkFollowingResolver resolves paths
+// against the input root, expanding any symbolic links that are
+// encountered along the way. Whenever resolution enters the directory
+// of an external repo that is not part of the input root, the repo is
+// added to it.
+//
+// If resolution ends up at a location that is not part of the input
+// root, gotScope is cleared. In that case the path needs to be resolved
+// against the file system of a worker of the repo platform instead.
+type changeTrackingDirectorySymlinkFollowingResolver struct {
+	path.TerminalNameTrackingComponentWalker
+
+	context  *moduleOrRepositoryContext
+	stack    util.NonEmptyStack[*changeTrackingDirectory]
+	gotScope bool
+}
+
+func (r *changeTrackingDirectorySymlinkFollowingResolver) OnAbsolute() (path.ComponentWalker, error) {
+	r.stack.PopAll()
+	r.gotScope = true
+	return r, nil
+}
+
+func (r *changeTrackingDirectorySymlinkFollowingResolver) OnRelative() (path.ComponentWalker, error) {
+	r.gotScope = true
+	return r, nil
+}
+
+func (r *changeTrackingDirectorySymlinkFollowingResolver) OnDriveLetter(driveLetter rune) (path.ComponentWalker, error) {
+	return nil, errors.New("drive letters are not supported")
+}
+
+// loadCurrentDirectory loads the contents of the directory at the top
+// of the stack. If the directory is the one containing external repos
+// and the provided name refers to a repo, the repo is added to it.
+func (r *changeTrackingDirectorySymlinkFollowingResolver) loadCurrentDirectory(name path.Component) (*changeTrackingDirectory, error) {
+	mrc := r.context
+	d := r.stack.Peek()
+	if err := d.maybeLoadContents(mrc.directoryLoadOptions); err != nil {
+		return nil, err
+	}
+	if d == mrc.inputRootDirectory.directories[externalDirectoryName] {
+		if _, ok := d.directories[name]; !ok {
+			if err := mrc.maybeAddExternalRepo(name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return d, nil
+}
+
+func (r *changeTrackingDirectorySymlinkFollowingResolver) OnDirectory(name path.Component) (path.GotDirectoryOrSymlink, error) {
+	d, err := r.loadCurrentDirectory(name)
+	if err != nil {
+		return nil, err
+	}
+	if dChild, ok := d.directories[name]; ok {
+		r.stack.Push(dChild)
+		return path.GotDirectory{
+			Child:        r,
+			IsReversible: true,
+		}, nil
+	}
+	if target, ok := d.symlinks[name]; ok {
+		r.gotScope = false
+		return path.GotSymlink{
+			Parent: r,
+			Target: path.UNIXFormat.NewParser(target),
+		}, nil
+	}
+	return nil, errDirectoryDoesNotExist
+}
+
+func (r *changeTrackingDirectorySymlinkFollowingResolver) OnTerminal(name path.Component) (*path.GotSymlink, error) {
+	d, err := r.loadCurrentDirectory(name)
+	if err != nil {
+		return nil, err
+	}
+	if target, ok := d.symlinks[name]; ok {
+		r.gotScope = false
+		return &path.GotSymlink{
+			Parent: r,
+			Target: path.UNIXFormat.NewParser(target),
+		}, nil
+	}
+	return r.TerminalNameTrackingComponentWalker.OnTerminal(name)
+}
+
+func (r *changeTrackingDirectorySymlinkFollowingResolver) OnUp() (path.ComponentWalker, error) {
+	if _, ok := r.stack.PopSingle(); !ok {
+		// Path resolves to a location above the input root.
+		r.gotScope = false
+		return path.VoidComponentWalker, nil
+	}
+	return r, nil
+}
+
 type changeTrackingDirectoryNewDirectoryResolver struct {
 	loadOptions *changeTrackingDirectoryLoadOptions
 	stack       util.NonEmptyStack[*changeTrackingDirectory]
@@ -1054,6 +1157,7 @@ type moduleOrRepositoryContextEnvironment interface {
 	GetHttpArchiveContentsValue(*model_analysis_pb.HttpArchiveContents_Key) model_core.Message[*model_analysis_pb.HttpArchiveContents_Value]
 	GetHttpFileContentsValue(*model_analysis_pb.HttpFileContents_Key) model_core.Message[*model_analysis_pb.HttpFileContents_Value]
 	GetRegisteredRepoPlatformValue(*model_analysis_pb.RegisteredRepoPlatform_Key) model_core.Message[*model_analysis_pb.RegisteredRepoPlatform_Value]
+	GetRepoEnvironmentVariableValue(*model_analysis_pb.RepoEnvironmentVariable_Key) model_core.Message[*model_analysis_pb.RepoEnvironmentVariable_Value]
 	GetRepoValue(*model_analysis_pb.Repo_Key) model_core.Message[*model_analysis_pb.Repo_Value]
 	GetRootModuleValue(*model_analysis_pb.RootModule_Key) model_core.Message[*model_analysis_pb.RootModule_Value]
 	GetStableInputRootPathObjectValue(*model_analysis_pb.StableInputRootPathObject_Key) (*model_starlark.BarePath, bool)
@@ -1075,6 +1179,7 @@ type moduleOrRepositoryContext struct {
 	fileReader                         *model_filesystem.FileReader
 	pathUnpackerInto                   unpack.UnpackerInto[*model_starlark.BarePath]
 	repoPlatform                       model_core.Message[*model_analysis_pb.RegisteredRepoPlatform_Value]
+	stableInputRootPath                *model_starlark.BarePath
 	virtualRootScopeWalkerFactory      *path.VirtualRootScopeWalkerFactory
 
 	inputRootDirectory *changeTrackingDirectory
@@ -1211,6 +1316,7 @@ func (mrc *moduleOrRepositoryContext) maybeGetStableInputRootPath() error {
 		}
 
 		mrc.defaultWorkingDirectoryPath = defaultWorkingDirectoryPath
+		mrc.stableInputRootPath = stableInputRootPath
 		externalPath := stableInputRootPath.Append(externalDirectoryName)
 		mrc.pathUnpackerInto = &externalRepoAddingPathUnpackerInto{
 			context: mrc,
@@ -1775,7 +1881,7 @@ func (mrc *moduleOrRepositoryContext) doFile(thread *starlark.Thread, b *starlar
 	return starlark.None, nil
 }
 
-func (moduleOrRepositoryContext) doGetenv(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+func (mrc *moduleOrRepositoryContext) doGetenv(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
 	var name string
 	var defaultValue *string
 	if err := starlark.UnpackArgs(
@@ -1786,7 +1892,28 @@ func (moduleOrRepositoryContext) doGetenv(thread *starlark.Thread, b *starlark.B
 		return nil, err
 	}
 
-	// TODO: Provide a real implementation!
+	// Environment variables provided by the client through
+	// --action_env and --repo_env take precedence.
+	mrc.maybeGetRepoPlatform()
+	environmentVariable := mrc.environment.GetRepoEnvironmentVariableValue(&model_analysis_pb.RepoEnvironmentVariable_Key{
+		Name: name,
+	})
+	if !environmentVariable.IsSet() || !mrc.repoPlatform.IsSet() {
+		return nil, evaluation.ErrMissingDependency
+	}
+	if environmentVariable.Message.IsSet {
+		return starlark.String(environmentVariable.Message.Value), nil
+	}
+
+	// Fall back to the environment of the platform that is used
+	// to execute repository rules, which is also exposed through
+	// repository_ctx.os.environ.
+	for _, entry := range mrc.repoPlatform.Message.RepositoryOsEnviron {
+		if entry.Name == name {
+			return starlark.String(entry.Value), nil
+		}
+	}
+
 	if defaultValue == nil {
 		return starlark.None, nil
 	}
@@ -2141,60 +2268,54 @@ func (mrc *moduleOrRepositoryContext) doWhich(thread *starlark.Thread, b *starla
 	}
 }
 
-func (mrc *moduleOrRepositoryContext) Exists(p *model_starlark.BarePath) (bool, error) {
-	mrc.maybeGetDirectoryCreationParameters()
-	if err := mrc.maybeGetStableInputRootPath(); err != nil {
-		return false, err
-	}
-	if mrc.directoryLoadOptions == nil {
-		return false, evaluation.ErrMissingDependency
+// runShellCommandOnRepoPlatform runs a shell command on a worker of the
+// repo platform, with the contents of the input root placed at the
+// stable input root path. This can be used to inspect paths that are
+// not part of the input root (e.g., files provided by the operating
+// system or stored in the home directory).
+func (mrc *moduleOrRepositoryContext) runShellCommandOnRepoPlatform(command string) (int64, []byte, error) {
+	exitCode, outputs, err := mrc.runShellCommandOnRepoPlatformWithOutputs(command, nil)
+	if err != nil {
+		return 0, nil, err
 	}
 
-	r := &changeTrackingDirectoryExistingFileResolver{
-		loadOptions: mrc.directoryLoadOptions,
-		stack:       util.NewNonEmptyStack(mrc.inputRootDirectory),
-	}
-	if err := path.Resolve(p, mrc.virtualRootScopeWalkerFactory.New(r)); err != nil {
-		if errors.Is(err, errDirectoryDoesNotExist) {
-			return false, nil
-		}
-		return false, fmt.Errorf("cannot resolve %#v: %w", p.GetUNIXString(), err)
+	// Capture the command's standard output.
+	stdoutEntry, err := model_filesystem.NewFileContentsEntryFromProto(
+		model_core.Message[*model_filesystem_pb.FileContents]{
+			Message:            outputs.Message.Stdout,
+			OutgoingReferences: outputs.OutgoingReferences,
+		},
+		mrc.computer.buildSpecificationReference.GetReferenceFormat(),
+	)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid standard output entry: %w", err)
 	}
-	if r.gotScope {
-		if r.TerminalName == nil {
-			// No trailing filename, meaning the path corresponds to
-			// the directory that we're in right now.
-			return true, nil
-		}
-
-		d := r.stack.Peek()
-		if _, ok := d.directories[*r.TerminalName]; ok {
-			return true, nil
-		}
-		if _, ok := d.files[*r.TerminalName]; ok {
-			return true, nil
-		}
-		if _, ok := d.symlinks[*r.TerminalName]; ok {
-			return true, nil
-		}
-		return false, nil
+	stdout, err := mrc.fileReader.FileReadAll(mrc.context, stdoutEntry, 1<<20)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read standard output: %w", err)
 	}
+	return exitCode, stdout, nil
+}
 
-	// Path resolves to a location that is not part of the input
-	// root (e.g., a file provided by the operating system or stored
-	// in the home directory). Invoke "test -e" to check the file's
-	// existence.
+// runShellCommandOnRepoPlatformWithOutputs is identical to
+// runShellCommandOnRepoPlatform, except that it returns the outputs of
+// the command as is. Paths in the input root matching the provided
+// pattern are captured as part of the output root.
+func (mrc *moduleOrRepositoryContext) runShellCommandOnRepoPlatformWithOutputs(command string, outputPathPattern *model_command_pb.PathPattern) (int64, model_core.Message[*model_command_pb.Outputs], error) {
+	var badOutputs model_core.Message[*model_command_pb.Outputs]
 	mrc.maybeGetCommandEncoder()
 	mrc.maybeGetDirectoryCreationParametersMessage()
 	mrc.maybeGetFileCreationParameters()
 	mrc.maybeGetFileCreationParametersMessage()
+	mrc.maybeGetFileReader()
 	mrc.maybeGetRepoPlatform()
 	if mrc.commandEncoder == nil ||
 		mrc.directoryCreationParametersMessage == nil ||
 		mrc.fileCreationParameters == nil ||
 		mrc.fileCreationParametersMessage == nil ||
+		mrc.fileReader == nil ||
 		!mrc.repoPlatform.IsSet() {
-		return false, evaluation.ErrMissingDependency
+		return 0, badOutputs, evaluation.ErrMissingDependency
 	}
 
 	environment := map[string]string{}
@@ -2206,7 +2327,7 @@ func (mrc *moduleOrRepositoryContext) Exists(p *model_starlark.BarePath) (bool,
 	referenceFormat := mrc.computer.buildSpecificationReference.GetReferenceFormat()
 	environmentVariableList, err := mrc.computer.convertDictToEnvironmentVariableList(environment, mrc.commandEncoder)
 	if err != nil {
-		return false, err
+		return 0, badOutputs, err
 	}
 
 	commandContents, commandMetadata, err := model_core.MarshalAndEncodePatchedMessage(
@@ -2225,13 +2346,14 @@ func (mrc *moduleOrRepositoryContext) Exists(p *model_starlark.BarePath) (bool,
 					},
 					{
 						Level: &model_command_pb.ArgumentList_Element_Leaf{
-							Leaf: shellquote.Join("test", "-e", p.GetUNIXString()),
+							Leaf: command,
 						},
 					},
 				},
 				EnvironmentVariables:        environmentVariableList.Message,
 				DirectoryCreationParameters: mrc.directoryCreationParametersMessage,
 				FileCreationParameters:      mrc.fileCreationParametersMessage,
+				OutputPathPattern:           outputPathPattern,
 				WorkingDirectory:            "/",
 				NeedsStableInputRootPath:    true,
 			},
@@ -2241,12 +2363,12 @@ func (mrc *moduleOrRepositoryContext) Exists(p *model_starlark.BarePath) (bool,
 		mrc.commandEncoder,
 	)
 	if err != nil {
-		return false, fmt.Errorf("failed to create command: %w", err)
+		return 0, badOutputs, fmt.Errorf("failed to create command: %w", err)
 	}
 
 	inputRootReference, err := mrc.computer.createMerkleTreeFromChangeTrackingDirectory(mrc.context, mrc.inputRootDirectory, mrc.directoryCreationParameters, mrc.fileCreationParameters, mrc.patchedFiles)
 	if err != nil {
-		return false, fmt.Errorf("failed to create Merkle tree of root directory: %w", err)
+		return 0, badOutputs, fmt.Errorf("failed to create Merkle tree of root directory: %w", err)
 	}
 
 	// Execute the command.
@@ -2264,21 +2386,253 @@ func (mrc *moduleOrRepositoryContext) Exists(p *model_starlark.BarePath) (bool,
 		Patcher: keyPatcher,
 	})
 	if !actionResult.IsSet() {
+		return 0, badOutputs, evaluation.ErrMissingDependency
+	}
+
+	outputs, err := mrc.computer.getOutputsFromActionResult(mrc.context, actionResult, mrc.directoryCreationParameters.DirectoryAccessParameters)
+	if err != nil {
+		return 0, badOutputs, fmt.Errorf("failed to obtain outputs from action result: %w", err)
+	}
+	return actionResult.Message.ExitCode, outputs, nil
+}
+
+func (mrc *moduleOrRepositoryContext) Exists(p *model_starlark.BarePath) (bool, error) {
+	mrc.maybeGetDirectoryCreationParameters()
+	if err := mrc.maybeGetStableInputRootPath(); err != nil {
+		return false, err
+	}
+	if mrc.directoryLoadOptions == nil {
 		return false, evaluation.ErrMissingDependency
 	}
-	return actionResult.Message.ExitCode == 0, nil
+
+	r := &changeTrackingDirectoryExistingFileResolver{
+		loadOptions: mrc.directoryLoadOptions,
+		stack:       util.NewNonEmptyStack(mrc.inputRootDirectory),
+	}
+	if err := path.Resolve(p, mrc.virtualRootScopeWalkerFactory.New(r)); err != nil {
+		if errors.Is(err, errDirectoryDoesNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("cannot resolve %#v: %w", p.GetUNIXString(), err)
+	}
+	if r.gotScope {
+		if r.TerminalName == nil {
+			// No trailing filename, meaning the path corresponds to
+			// the directory that we're in right now.
+			return true, nil
+		}
+
+		d := r.stack.Peek()
+		if _, ok := d.directories[*r.TerminalName]; ok {
+			return true, nil
+		}
+		if _, ok := d.files[*r.TerminalName]; ok {
+			return true, nil
+		}
+		if _, ok := d.symlinks[*r.TerminalName]; ok {
+			return true, nil
+		}
+		return false, nil
+	}
+
+	// Path resolves to a location that is not part of the input
+	// root. Invoke "test -e" to check the file's existence.
+	exitCode, _, err := mrc.runShellCommandOnRepoPlatform(shellquote.Join("test", "-e", p.GetUNIXString()))
+	if err != nil {
+		return false, err
+	}
+	return exitCode == 0, nil
 }
 
-func (moduleOrRepositoryContext) IsDir(*model_starlark.BarePath) (bool, error) {
-	return false, errors.New("TODO: Implement path.is_dir()!")
+// resolveFollowingSymlinks resolves a path against the input root,
+// expanding any symbolic links that are encountered. In addition to
+// the resolver, it returns the path at which resolution ended, with
+// all symbolic links removed.
+func (mrc *moduleOrRepositoryContext) resolveFollowingSymlinks(p *model_starlark.BarePath) (*changeTrackingDirectorySymlinkFollowingResolver, *path.Builder, error) {
+	mrc.maybeGetDirectoryCreationParameters()
+	if err := mrc.maybeGetStableInputRootPath(); err != nil {
+		return nil, nil, err
+	}
+	if mrc.directoryLoadOptions == nil {
+		return nil, nil, evaluation.ErrMissingDependency
+	}
+
+	r := &changeTrackingDirectorySymlinkFollowingResolver{
+		context: mrc,
+		stack:   util.NewNonEmptyStack(mrc.inputRootDirectory),
+	}
+	resolvedPath, scopeWalker := path.RootBuilder.Join(
+		path.NewLoopDetectingScopeWalker(
+			mrc.virtualRootScopeWalkerFactory.New(r),
+		),
+	)
+	if err := path.Resolve(p, scopeWalker); err != nil {
+		return nil, nil, err
+	}
+	return r, resolvedPath, nil
 }
 
-func (moduleOrRepositoryContext) Readdir(*model_starlark.BarePath) ([]path.Component, error) {
-	return nil, errors.New("TODO: Implement path.readdir()!")
+func (mrc *moduleOrRepositoryContext) IsDir(p *model_starlark.BarePath) (bool, error) {
+	r, resolvedPath, err := mrc.resolveFollowingSymlinks(p)
+	if err != nil {
+		if errors.Is(err, errDirectoryDoesNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("cannot resolve %#v: %w", p.GetUNIXString(), err)
+	}
+	if r.gotScope {
+		if r.TerminalName == nil {
+			return true, nil
+		}
+		d := r.stack.Peek()
+		_, ok := d.directories[*r.TerminalName]
+		return ok, nil
+	}
+
+	// Path resolves to a location that is not part of the input
+	// root. Invoke "test -d" to check whether it's a directory.
+	exitCode, _, err := mrc.runShellCommandOnRepoPlatform(shellquote.Join("test", "-d", resolvedPath.GetUNIXString()))
+	if err != nil {
+		return false, err
+	}
+	return exitCode == 0, nil
+}
+
+// getSortedDirectoryEntryNames returns the names of all entries in a
+// directory, regardless of their type, in sorted order.
+func getSortedDirectoryEntryNames(d *changeTrackingDirectory, loadOptions *changeTrackingDirectoryLoadOptions) ([]path.Component, error) {
+	if err := d.maybeLoadContents(loadOptions); err != nil {
+		return nil, err
+	}
+	names := make([]path.Component, 0, len(d.directories)+len(d.files)+len(d.symlinks))
+	for name := range d.directories {
+		names = append(names, name)
+	}
+	for name := range d.files {
+		names = append(names, name)
+	}
+	for name := range d.symlinks {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b path.Component) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return names, nil
+}
+
+func (mrc *moduleOrRepositoryContext) Readdir(p *model_starlark.BarePath, mustWatch bool) ([]path.Component, error) {
+	r, resolvedPath, err := mrc.resolveFollowingSymlinks(p)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve %#v: %w", p.GetUNIXString(), err)
+	}
+	if r.gotScope {
+		// The contents of the input root are derived from
+		// the repo's dependencies, meaning that the listing is
+		// implicitly watched for changes.
+		d := r.stack.Peek()
+		if r.TerminalName != nil {
+			dChild, ok := d.directories[*r.TerminalName]
+			if !ok {
+				return nil, fmt.Errorf("path %#v does not resolve to a directory", p.GetUNIXString())
+			}
+			d = dChild
+		}
+		return getSortedDirectoryEntryNames(d, mrc.directoryLoadOptions)
+	}
+
+	// Path resolves to a location that is not part of the input
+	// root. Results of commands executed on the repo platform are
+	// cached, meaning that changes to such directories cannot be
+	// observed.
+	resolvedPathStr := resolvedPath.GetUNIXString()
+	if mustWatch {
+		return nil, fmt.Errorf("cannot watch directory %#v, as it is not part of the input root", resolvedPathStr)
+	}
+	if _, ok := mrc.inputRootDirectory.directories[readdirDirectoryName]; ok {
+		return nil, fmt.Errorf("input root already contains a directory named %#v", readdirDirectoryName.String())
+	}
+
+	// Invoke a shell command that creates an empty file in the
+	// input root for each entry in the directory, and capture
+	// those files. Unlike parsing the output of "ls", this works
+	// for file names containing newline characters.
+	readdirPathStr := shellquote.Join(mrc.stableInputRootPath.Append(readdirDirectoryName).GetUNIXString())
+	exitCode, outputs, err := mrc.runShellCommandOnRepoPlatformWithOutputs(
+		shellquote.Join("cd", "--", resolvedPathStr)+
+			" && mkdir -- "+readdirPathStr+
+			" && for f in .* *; do"+
+			" case \"$f\" in"+
+			" .|..) ;;"+
+			" *) if [ -e \"$f\" ] || [ -L \"$f\" ]; then : > "+readdirPathStr+"/\"$f\"; fi ;;"+
+			" esac;"+
+			" done",
+		&model_command_pb.PathPattern{
+			Children: &model_command_pb.PathPattern_ChildrenInline{
+				ChildrenInline: &model_command_pb.PathPattern_Children{
+					Children: []*model_command_pb.PathPattern_Child{{
+						Name:    readdirDirectoryName.String(),
+						Pattern: &model_command_pb.PathPattern{},
+					}},
+				},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if exitCode != 0 {
+		return nil, fmt.Errorf("path %#v does not resolve to a directory", resolvedPathStr)
+	}
+
+	var outputRootDirectory changeTrackingDirectory
+	if err := outputRootDirectory.setContents(
+		model_core.Message[*model_filesystem_pb.Directory]{
+			Message:            outputs.Message.OutputRoot,
+			OutgoingReferences: outputs.OutgoingReferences,
+		},
+		mrc.directoryLoadOptions,
+	); err != nil {
+		return nil, fmt.Errorf("failed load output root: %w", err)
+	}
+	if err := outputRootDirectory.maybeLoadContents(mrc.directoryLoadOptions); err != nil {
+		return nil, err
+	}
+	readdirDirectory, ok := outputRootDirectory.directories[readdirDirectoryName]
+	if !ok {
+		return nil, fmt.Errorf("output root does not contain a directory named %#v", readdirDirectoryName.String())
+	}
+	return getSortedDirectoryEntryNames(readdirDirectory, mrc.directoryLoadOptions)
 }
 
-func (moduleOrRepositoryContext) Realpath(*model_starlark.BarePath) (*model_starlark.BarePath, error) {
-	return nil, errors.New("TODO: Implement path.realpath()!")
+func (mrc *moduleOrRepositoryContext) Realpath(p *model_starlark.BarePath) (*model_starlark.BarePath, error) {
+	r, resolvedPath, err := mrc.resolveFollowingSymlinks(p)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve %#v: %w", p.GetUNIXString(), err)
+	}
+	resolvedPathStr := resolvedPath.GetUNIXString()
+	if !r.gotScope {
+		// Path resolves to a location that is not part of the
+		// input root, which may contain symbolic links that we
+		// can't expand ourselves. Invoke "realpath" to resolve
+		// the remainder of the path.
+		exitCode, stdout, err := mrc.runShellCommandOnRepoPlatform(shellquote.Join("realpath", "--", resolvedPathStr))
+		if err != nil {
+			return nil, err
+		}
+		if exitCode != 0 {
+			return nil, fmt.Errorf("failed to resolve path %#v", resolvedPathStr)
+		}
+		resolvedPathStr = strings.TrimSuffix(string(stdout), "\n")
+	}
+
+	var resolver model_starlark.PathResolver
+	if err := path.Resolve(
+		path.UNIXFormat.NewParser(resolvedPathStr),
+		path.NewAbsoluteScopeWalker(&resolver),
+	); err != nil {
+		return nil, fmt.Errorf("failed to resolve path %#v: %w", resolvedPathStr, err)
+	}
+	return resolver.CurrentPath, nil
 }
 
 // externalRepoAddingPathUnpackerInto is a decorator for
@@ -2293,43 +2647,49 @@ type externalRepoAddingPathUnpackerInto struct {
 }
 
 func (ui *externalRepoAddingPathUnpackerInto) maybeAddExternalRepo(bp *model_starlark.BarePath) error {
-	mrc := ui.context
 	if components := bp.GetRelativeTo(ui.externalPath); len(components) >= 1 {
-		repoName := components[0]
-		if !slices.Equal(mrc.subdirectoryComponents, []path.Component{externalDirectoryName, repoName}) {
-			// Path belongs to an external repo that is
-			// different from the repo that is currently
-			// being constructed.
-			externalDirectory, err := mrc.inputRootDirectory.getOrCreateDirectory(externalDirectoryName)
-			if err != nil {
-				return fmt.Errorf("Failed to create directory %#v: %w", externalDirectoryName.String(), err)
-			}
-			if err := externalDirectory.maybeLoadContents(mrc.directoryLoadOptions); err != nil {
-				return fmt.Errorf("failed to load contents of %#v directory: %w", err)
-			}
+		return ui.context.maybeAddExternalRepo(components[0])
+	}
+	return nil
+}
 
-			if _, ok := externalDirectory.directories[repoName]; !ok {
-				// External repo does not exist within
-				// the input root. Fetch it.
-				repo := mrc.environment.GetRepoValue(&model_analysis_pb.Repo_Key{
-					CanonicalRepo: repoName.String(),
-				})
-				if !repo.IsSet() {
-					return evaluation.ErrMissingDependency
-				}
-				repoDirectory, err := externalDirectory.getOrCreateDirectory(repoName)
-				if err != nil {
-					return fmt.Errorf("failed to create directory for repo: %w", err)
-				}
-				rootDirectoryReference := repo.Message.RootDirectoryReference
-				if rootDirectoryReference == nil {
-					return errors.New("root directory reference is not set")
-				}
-				repoDirectory.currentReference = model_core.Message[*model_filesystem_pb.DirectoryReference]{
-					Message:            rootDirectoryReference,
-					OutgoingReferences: repo.OutgoingReferences,
-				}
-			}
+// maybeAddExternalRepo ensures that the contents of an external repo
+// are present in the input root, so that they may be accessed by
+// commands and functions like path.readdir().
+func (mrc *moduleOrRepositoryContext) maybeAddExternalRepo(repoName path.Component) error {
+	if slices.Equal(mrc.subdirectoryComponents, []path.Component{externalDirectoryName, repoName}) {
+		// Repo is the one that is currently being constructed.
+		return nil
+	}
+
+	externalDirectory, err := mrc.inputRootDirectory.getOrCreateDirectory(externalDirectoryName)
+	if err != nil {
+		return fmt.Errorf("Failed to create directory %#v: %w", externalDirectoryName.String(), err)
+	}
+	if err := externalDirectory.maybeLoadContents(mrc.directoryLoadOptions); err != nil {
+		return fmt.Errorf("failed to load contents of %#v directory: %w", externalDirectoryName.String(), err)
+	}
+
+	if _, ok := externalDirectory.directories[repoName]; !ok {
+		// External repo does not exist within the input root.
+		// Fetch it.
+		repo := mrc.environment.GetRepoValue(&model_analysis_pb.Repo_Key{
+			CanonicalRepo: repoName.String(),
+		})
+		if !repo.IsSet() {
+			return evaluation.ErrMissingDependency
+		}
+		repoDirectory, err := externalDirectory.getOrCreateDirectory(repoName)
+		if err != nil {
+			return fmt.Errorf("failed to create directory for repo: %w", err)
+		}
+		rootDirectoryReference := repo.Message.RootDirectoryReference
+		if rootDirectoryReference == nil {
+			return errors.New("root directory reference is not set")
+		}
+		repoDirectory.currentReference = model_core.Message[*model_filesystem_pb.DirectoryReference]{
+			Message:            rootDirectoryReference,
+			OutgoingReferences: repo.OutgoingReferences,
 		}
 	}
 	return nil
@@ -2624,6 +2984,22 @@ func (c *baseComputer) fetchRepo(ctx context.Context, canonicalRepo label.Canoni
 		return PatchedRepoValue{}, fmt.Errorf("unknown attribute %#v", slices.Min(slices.Collect(maps.Keys(attrValues))))
 	}
 
+	// Declare dependencies on all environment variables listed in
+	// the repository rule's environ attribute, so that the repo
+	// gets refetched if any of them changes. This is done even if
+	// the implementation function does not call getenv().
+	missingEnvironmentVariables := false
+	for _, name := range repositoryRule.Environ {
+		if !e.GetRepoEnvironmentVariableValue(&model_analysis_pb.RepoEnvironmentVariable_Key{
+			Name: name,
+		}).IsSet() {
+			missingEnvironmentVariables = true
+		}
+	}
+	if missingEnvironmentVariables {
+		return PatchedRepoValue{}, evaluation.ErrMissingDependency
+	}
+
 	for name, value := range repositoryRule.Attrs.Private {
 		attrs[name] = value
 	}
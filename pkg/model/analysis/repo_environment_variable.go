@@ -0,0 +1,32 @@
+package analysis
+
+import (
+	"context"
+	"sort"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+)
+
+func (c *baseComputer) ComputeRepoEnvironmentVariableValue(ctx context.Context, key *model_analysis_pb.RepoEnvironmentVariable_Key, e RepoEnvironmentVariableEnvironment) (PatchedRepoEnvironmentVariableValue, error) {
+	buildSpecification := e.GetBuildSpecificationValue(&model_analysis_pb.BuildSpecification_Key{})
+	if !buildSpecification.IsSet() {
+		return PatchedRepoEnvironmentVariableValue{}, evaluation.ErrMissingDependency
+	}
+
+	// Environment variables are sorted by name, meaning we can
+	// perform a binary search.
+	environmentVariables := buildSpecification.Message.BuildSpecification.GetRepoEnvironmentVariables()
+	if index := sort.Search(
+		len(environmentVariables),
+		func(i int) bool { return environmentVariables[i].Name >= key.Name },
+	); index < len(environmentVariables) && environmentVariables[index].Name == key.Name {
+		return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.RepoEnvironmentVariable_Value{
+			IsSet: true,
+			Value: environmentVariables[index].Value,
+		}), nil
+	}
+	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.RepoEnvironmentVariable_Value{}), nil
+}
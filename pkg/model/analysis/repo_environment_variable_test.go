@@ -0,0 +1,77 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestComputeRepoEnvironmentVariableValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	c := newTestBaseComputer(testObjectDownloader{})
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		e := NewMockRepoEnvironmentVariableEnvironment(ctrl)
+		e.EXPECT().GetBuildSpecificationValue(gomock.Any()).Return(model_core.Message[*model_analysis_pb.BuildSpecification_Value]{})
+
+		_, err := c.ComputeRepoEnvironmentVariableValue(ctx, &model_analysis_pb.RepoEnvironmentVariable_Key{
+			Name: "PATH",
+		}, e)
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+	})
+
+	// The client provides the environment variables sorted by name,
+	// having --repo_env already applied on top of --action_env.
+	e := NewMockRepoEnvironmentVariableEnvironment(ctrl)
+	e.EXPECT().GetBuildSpecificationValue(gomock.Any()).Return(model_core.NewSimpleMessage(&model_analysis_pb.BuildSpecification_Value{
+		BuildSpecification: &model_build_pb.BuildSpecification{
+			RepoEnvironmentVariables: []*model_build_pb.EnvironmentVariable{
+				{Name: "CC", Value: "clang"},
+				{Name: "EMPTY", Value: ""},
+				{Name: "PATH", Value: "/usr/bin:/bin"},
+			},
+		},
+	})).AnyTimes()
+
+	t.Run("Set", func(t *testing.T) {
+		environmentVariable, err := c.ComputeRepoEnvironmentVariableValue(ctx, &model_analysis_pb.RepoEnvironmentVariable_Key{
+			Name: "PATH",
+		}, e)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.RepoEnvironmentVariable_Value{
+			IsSet: true,
+			Value: "/usr/bin:/bin",
+		}, environmentVariable.Message)
+	})
+
+	t.Run("SetToEmptyString", func(t *testing.T) {
+		// Variables that are set to the empty string should
+		// be distinguishable from ones that are unset.
+		environmentVariable, err := c.ComputeRepoEnvironmentVariableValue(ctx, &model_analysis_pb.RepoEnvironmentVariable_Key{
+			Name: "EMPTY",
+		}, e)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.RepoEnvironmentVariable_Value{
+			IsSet: true,
+		}, environmentVariable.Message)
+	})
+
+	t.Run("Unset", func(t *testing.T) {
+		for _, name := range []string{"AR", "DISPLAY", "ZZZ"} {
+			environmentVariable, err := c.ComputeRepoEnvironmentVariableValue(ctx, &model_analysis_pb.RepoEnvironmentVariable_Key{
+				Name: name,
+			}, e)
+			require.NoError(t, err)
+			testutil.RequireEqualProto(t, &model_analysis_pb.RepoEnvironmentVariable_Value{}, environmentVariable.Message)
+		}
+	})
+}
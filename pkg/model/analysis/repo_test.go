@@ -0,0 +1,241 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_filesystem "github.com/buildbarn/bonanza/pkg/model/filesystem"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	"github.com/stretchr/testify/require"
+
+	"go.starlark.net/starlark"
+	"go.uber.org/mock/gomock"
+)
+
+func mustNewBarePath(t *testing.T, p string) *model_starlark.BarePath {
+	var resolver model_starlark.PathResolver
+	require.NoError(t, path.Resolve(path.UNIXFormat.NewParser(p), path.NewAbsoluteScopeWalker(&resolver)))
+	return resolver.CurrentPath
+}
+
+// newSymlinkTestRepositoryContext creates a moduleOrRepositoryContext
+// whose input root is stored at /stable, and contains the following
+// files:
+//
+//	/stable/foo/a
+//	/stable/foo/b/
+//	/stable/foo/c -> b
+//	/stable/link -> foo
+//	/stable/absolute -> /stable/foo/b
+//	/stable/outside -> /usr/lib
+//	/stable/loop -> loop
+func newSymlinkTestRepositoryContext(t *testing.T) *moduleOrRepositoryContext {
+	virtualRootScopeWalkerFactory, err := path.NewVirtualRootScopeWalkerFactory(mustNewBarePath(t, "/stable"), nil)
+	require.NoError(t, err)
+	return &moduleOrRepositoryContext{
+		directoryCreationParameters:   &model_filesystem.DirectoryCreationParameters{},
+		directoryLoadOptions:          &changeTrackingDirectoryLoadOptions{},
+		virtualRootScopeWalkerFactory: virtualRootScopeWalkerFactory,
+		inputRootDirectory: &changeTrackingDirectory{
+			directories: map[path.Component]*changeTrackingDirectory{
+				path.MustNewComponent("foo"): {
+					directories: map[path.Component]*changeTrackingDirectory{
+						path.MustNewComponent("b"): {},
+					},
+					files: map[path.Component]*changeTrackingFile{
+						path.MustNewComponent("a"): {},
+					},
+					symlinks: map[path.Component]string{
+						path.MustNewComponent("c"): "b",
+					},
+				},
+			},
+			symlinks: map[path.Component]string{
+				path.MustNewComponent("absolute"): "/stable/foo/b",
+				path.MustNewComponent("link"):     "foo",
+				path.MustNewComponent("loop"):     "loop",
+				path.MustNewComponent("outside"):  "/usr/lib",
+			},
+		},
+	}
+}
+
+func TestModuleOrRepositoryContextIsDir(t *testing.T) {
+	mrc := newSymlinkTestRepositoryContext(t)
+
+	for p, expected := range map[string]bool{
+		"/stable":              true,
+		"/stable/foo":          true,
+		"/stable/foo/a":        false,
+		"/stable/foo/b":        true,
+		"/stable/foo/c":        true,
+		"/stable/foo/missing":  false,
+		"/stable/link":         true,
+		"/stable/link/a":       false,
+		"/stable/link/c":       true,
+		"/stable/absolute":     true,
+		"/stable/missing/file": false,
+	} {
+		t.Run(p, func(t *testing.T) {
+			isDir, err := mrc.IsDir(mustNewBarePath(t, p))
+			require.NoError(t, err)
+			require.Equal(t, expected, isDir)
+		})
+	}
+}
+
+func TestModuleOrRepositoryContextReaddir(t *testing.T) {
+	mrc := newSymlinkTestRepositoryContext(t)
+
+	t.Run("Root", func(t *testing.T) {
+		names, err := mrc.Readdir(mustNewBarePath(t, "/stable"), false)
+		require.NoError(t, err)
+		require.Equal(t, []path.Component{
+			path.MustNewComponent("absolute"),
+			path.MustNewComponent("foo"),
+			path.MustNewComponent("link"),
+			path.MustNewComponent("loop"),
+			path.MustNewComponent("outside"),
+		}, names)
+	})
+
+	t.Run("ThroughSymlink", func(t *testing.T) {
+		// Entries should be sorted, regardless of their type.
+		names, err := mrc.Readdir(mustNewBarePath(t, "/stable/link"), false)
+		require.NoError(t, err)
+		require.Equal(t, []path.Component{
+			path.MustNewComponent("a"),
+			path.MustNewComponent("b"),
+			path.MustNewComponent("c"),
+		}, names)
+	})
+
+	t.Run("EmptyDirectory", func(t *testing.T) {
+		names, err := mrc.Readdir(mustNewBarePath(t, "/stable/link/c"), false)
+		require.NoError(t, err)
+		require.Empty(t, names)
+	})
+
+	t.Run("RegularFile", func(t *testing.T) {
+		_, err := mrc.Readdir(mustNewBarePath(t, "/stable/foo/a"), false)
+		require.EqualError(t, err, "path \"/stable/foo/a\" does not resolve to a directory")
+	})
+
+	t.Run("WatchOutsideInputRoot", func(t *testing.T) {
+		// Changes to directories outside the input root cannot
+		// be observed. Requesting that they are watched should
+		// fail.
+		_, err := mrc.Readdir(mustNewBarePath(t, "/stable/outside"), true)
+		require.EqualError(t, err, "cannot watch directory \"/usr/lib\", as it is not part of the input root")
+	})
+}
+
+func TestModuleOrRepositoryContextResolveFollowingSymlinks(t *testing.T) {
+	mrc := newSymlinkTestRepositoryContext(t)
+
+	t.Run("InsideInputRoot", func(t *testing.T) {
+		for p, expected := range map[string]string{
+			"/stable/foo/c":        "/stable/foo/b",
+			"/stable/link/c":       "/stable/foo/b",
+			"/stable/link/../link": "/stable/foo",
+			"/stable/absolute":     "/stable/foo/b",
+		} {
+			t.Run(p, func(t *testing.T) {
+				r, resolvedPath, err := mrc.resolveFollowingSymlinks(mustNewBarePath(t, p))
+				require.NoError(t, err)
+				require.True(t, r.gotScope)
+				require.Equal(t, expected, resolvedPath.GetUNIXString())
+			})
+		}
+	})
+
+	t.Run("OutsideInputRoot", func(t *testing.T) {
+		// Symbolic links pointing outside the input root
+		// need to be resolved on the repo platform. Resolution
+		// should at least expand the symbolic links inside
+		// the input root.
+		r, resolvedPath, err := mrc.resolveFollowingSymlinks(mustNewBarePath(t, "/stable/outside/x"))
+		require.NoError(t, err)
+		require.False(t, r.gotScope)
+		require.Equal(t, "/usr/lib/x", resolvedPath.GetUNIXString())
+	})
+
+	t.Run("SymlinkLoop", func(t *testing.T) {
+		_, _, err := mrc.resolveFollowingSymlinks(mustNewBarePath(t, "/stable/loop"))
+		require.Error(t, err)
+	})
+}
+
+func TestModuleOrRepositoryContextGetenv(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	thread := &starlark.Thread{}
+	getenv := starlark.NewBuiltin("getenv", nil)
+
+	// Variables provided through --action_env and --repo_env are
+	// served by the RepoEnvironmentVariable function. Others are
+	// obtained from the environment of the repo platform.
+	environmentVariables := map[string]string{
+		"CC":   "clang",
+		"PATH": "/opt/bin:/usr/bin",
+	}
+	e := NewMockRepoEnvironment(ctrl)
+	e.EXPECT().GetRegisteredRepoPlatformValue(gomock.Any()).Return(model_core.NewSimpleMessage(&model_analysis_pb.RegisteredRepoPlatform_Value{
+		RepositoryOsEnviron: []*model_analysis_pb.RegisteredRepoPlatform_Value_EnvironmentVariable{
+			{Name: "HOME", Value: "/home/builder"},
+			{Name: "PATH", Value: "/usr/bin:/bin"},
+		},
+	})).AnyTimes()
+	e.EXPECT().GetRepoEnvironmentVariableValue(gomock.Any()).
+		DoAndReturn(func(key *model_analysis_pb.RepoEnvironmentVariable_Key) model_core.Message[*model_analysis_pb.RepoEnvironmentVariable_Value] {
+			value, ok := environmentVariables[key.Name]
+			return model_core.NewSimpleMessage(&model_analysis_pb.RepoEnvironmentVariable_Value{
+				IsSet: ok,
+				Value: value,
+			})
+		}).
+		AnyTimes()
+	mrc := &moduleOrRepositoryContext{environment: e}
+
+	t.Run("ProvidedByClient", func(t *testing.T) {
+		value, err := mrc.doGetenv(thread, getenv, starlark.Tuple{starlark.String("CC")}, nil)
+		require.NoError(t, err)
+		require.Equal(t, starlark.String("clang"), value)
+	})
+
+	t.Run("ClientOverridesRepoPlatform", func(t *testing.T) {
+		value, err := mrc.doGetenv(thread, getenv, starlark.Tuple{starlark.String("PATH")}, nil)
+		require.NoError(t, err)
+		require.Equal(t, starlark.String("/opt/bin:/usr/bin"), value)
+	})
+
+	t.Run("ProvidedByRepoPlatform", func(t *testing.T) {
+		value, err := mrc.doGetenv(thread, getenv, starlark.Tuple{starlark.String("HOME")}, nil)
+		require.NoError(t, err)
+		require.Equal(t, starlark.String("/home/builder"), value)
+	})
+
+	t.Run("Unset", func(t *testing.T) {
+		value, err := mrc.doGetenv(thread, getenv, starlark.Tuple{starlark.String("DISPLAY")}, nil)
+		require.NoError(t, err)
+		require.Equal(t, starlark.None, value)
+	})
+
+	t.Run("UnsetWithDefault", func(t *testing.T) {
+		value, err := mrc.doGetenv(thread, getenv, starlark.Tuple{starlark.String("DISPLAY"), starlark.String(":0")}, nil)
+		require.NoError(t, err)
+		require.Equal(t, starlark.String(":0"), value)
+	})
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		e := NewMockRepoEnvironment(ctrl)
+		e.EXPECT().GetRegisteredRepoPlatformValue(gomock.Any()).Return(model_core.Message[*model_analysis_pb.RegisteredRepoPlatform_Value]{})
+		e.EXPECT().GetRepoEnvironmentVariableValue(gomock.Any()).Return(model_core.Message[*model_analysis_pb.RepoEnvironmentVariable_Value]{})
+		mrc := &moduleOrRepositoryContext{environment: e}
+
+		_, err := mrc.doGetenv(thread, getenv, starlark.Tuple{starlark.String("CC")}, nil)
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+	})
+}
@@ -19,6 +19,7 @@ import (
 type RepositoryRule struct {
 	Implementation starlark.Callable
 	Attrs          AttrsDict
+	Environ        []string
 }
 
 func (c *baseComputer) ComputeRepositoryRuleObjectValue(ctx context.Context, key *model_analysis_pb.RepositoryRuleObject_Key, e RepositoryRuleObjectEnvironment) (*RepositoryRule, error) {
@@ -59,7 +60,8 @@ func (c *baseComputer) ComputeRepositoryRuleObjectValue(ctx context.Context, key
 				OutgoingReferences: repositoryRuleValue.OutgoingReferences,
 			},
 		)),
-		Attrs: attrs,
+		Attrs:   attrs,
+		Environ: d.Definition.Environ,
 	}, nil
 }
 
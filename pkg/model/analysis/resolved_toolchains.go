@@ -95,9 +95,9 @@ func (c *baseComputer) ComputeResolvedToolchainsValue(ctx context.Context, key m
 	// matching toolchain for all mandatory toolchain types.
 	executionPlatforms := compatibleExecutionPlatforms.Message.ExecutionPlatforms
 	toolchainTypeHasAtLeastOneMatchingExecutionPlatform := make([]bool, len(compatibleToolchainsByType))
-CheckExecutionPlatform:
 	for _, executionPlatform := range executionPlatforms {
 		resolvedToolchains := make([]*model_analysis_pb.RegisteredToolchain, 0, len(compatibleToolchainsByType))
+		foundAllMandatoryToolchains := true
 	CheckToolchainType:
 		for i, toolchainsForType := range compatibleToolchainsByType {
 			for _, toolchain := range toolchainsForType {
@@ -108,13 +108,20 @@ CheckExecutionPlatform:
 				}
 			}
 
-			// Did not find any compatible toolchain.
+			// Did not find any compatible toolchain. Continue
+			// checking the other toolchain types, so that a
+			// meaningful error can be reported if none of
+			// the execution platforms are suitable.
 			if key.Message.Toolchains[i].Mandatory {
-				continue CheckExecutionPlatform
+				foundAllMandatoryToolchains = false
+				continue
 			}
 			toolchainTypeHasAtLeastOneMatchingExecutionPlatform[i] = true
 			resolvedToolchains = append(resolvedToolchains, nil)
 		}
+		if !foundAllMandatoryToolchains {
+			continue
+		}
 
 		// Found an execution platform for which all mandatory
 		// toolchain types have a compatible toolchain.
@@ -156,6 +163,7 @@ CheckExecutionPlatform:
 		}
 		return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.ResolvedToolchains_Value{
 			ToolchainIdentifiers: toolchainIdentifiers,
+			ExecutionPlatform:    executionPlatform,
 		}), nil
 	}
 
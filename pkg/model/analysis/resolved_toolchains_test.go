@@ -0,0 +1,220 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestComputeResolvedToolchainsValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	c := newTestBaseComputer(testObjectDownloader{})
+
+	linuxX86_64 := &model_analysis_pb.ExecutionPlatform{
+		Label: "@@example+//platforms:linux_x86_64",
+		Constraints: []*model_analysis_pb.Constraint{
+			{Setting: "@@platforms+//cpu:cpu", Value: "@@platforms+//cpu:x86_64"},
+			{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:linux"},
+		},
+	}
+	macOSAarch64 := &model_analysis_pb.ExecutionPlatform{
+		Label: "@@example+//platforms:macos_aarch64",
+		Constraints: []*model_analysis_pb.Constraint{
+			{Setting: "@@platforms+//cpu:cpu", Value: "@@platforms+//cpu:aarch64"},
+			{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:macos"},
+		},
+	}
+	e := NewMockResolvedToolchainsEnvironment(ctrl)
+	visibleTargets := map[string]string{
+		"@@toolchains+//cc:linux_x86_64": "@@toolchains+//cc:linux_x86_64",
+		// Toolchains may refer to aliases, which need to be
+		// expanded.
+		"@@toolchains+//cc:macos_alias": "@@toolchains+//cc:macos_aarch64",
+	}
+	e.EXPECT().GetVisibleTargetValue(gomock.Any()).
+		DoAndReturn(getTestVisibleTargetValueFromMap(visibleTargets)).AnyTimes()
+	e.EXPECT().GetCompatibleExecutionPlatformsValue(gomock.Any()).
+		DoAndReturn(func(key *model_analysis_pb.CompatibleExecutionPlatforms_Key) model_core.Message[*model_analysis_pb.CompatibleExecutionPlatforms_Value] {
+			var executionPlatforms []*model_analysis_pb.ExecutionPlatform
+			for _, executionPlatform := range []*model_analysis_pb.ExecutionPlatform{
+				macOSAarch64,
+				linuxX86_64,
+			} {
+				if constraintsAreCompatible(executionPlatform.Constraints, key.Constraints) {
+					executionPlatforms = append(executionPlatforms, executionPlatform)
+				}
+			}
+			return model_core.NewSimpleMessage(&model_analysis_pb.CompatibleExecutionPlatforms_Value{
+				ExecutionPlatforms: executionPlatforms,
+			})
+		}).AnyTimes()
+	compatibleToolchainsForType := map[string][]*model_analysis_pb.RegisteredToolchain{
+		"@@rules_cc+//cc:toolchain_type": {
+			{
+				ExecCompatibleWith: []*model_analysis_pb.Constraint{
+					{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:linux"},
+				},
+				Toolchain: "@@toolchains+//cc:linux_x86_64",
+				Package:   "@@toolchains+//cc",
+			},
+			{
+				ExecCompatibleWith: []*model_analysis_pb.Constraint{
+					{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:macos"},
+				},
+				Toolchain: "@@toolchains+//cc:macos_alias",
+				Package:   "@@toolchains+//cc",
+			},
+		},
+		"@@rules_go+//go:toolchain_type": {
+			{
+				ExecCompatibleWith: []*model_analysis_pb.Constraint{
+					{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:linux"},
+				},
+				Toolchain: "@@toolchains+//cc:linux_x86_64",
+				Package:   "@@toolchains+//cc",
+			},
+		},
+		"@@rules_python+//python:toolchain_type": {},
+		"@@rules_swift+//swift:toolchain_type": {
+			{
+				ExecCompatibleWith: []*model_analysis_pb.Constraint{
+					{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:macos"},
+				},
+				Toolchain: "@@toolchains+//cc:macos_alias",
+				Package:   "@@toolchains+//cc",
+			},
+		},
+		"@@rules_windows+//:toolchain_type": {
+			{
+				ExecCompatibleWith: []*model_analysis_pb.Constraint{
+					{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:windows"},
+				},
+				Toolchain: "@@toolchains+//windows:toolchain",
+				Package:   "@@toolchains+//windows",
+			},
+		},
+	}
+	e.EXPECT().GetCompatibleToolchainsForTypeValue(gomock.Any()).
+		DoAndReturn(getTestValueFromMap(
+			compatibleToolchainsForType,
+			func(key model_core.PatchedMessage[*model_analysis_pb.CompatibleToolchainsForType_Key, dag.ObjectContentsWalker]) string {
+				return key.Message.ToolchainType
+			},
+			func(toolchains []*model_analysis_pb.RegisteredToolchain) *model_analysis_pb.CompatibleToolchainsForType_Value {
+				return &model_analysis_pb.CompatibleToolchainsForType_Value{Toolchains: toolchains}
+			},
+		)).AnyTimes()
+
+	t.Run("FirstExecutionPlatform", func(t *testing.T) {
+		// Without any constraints, the first execution platform
+		// having a compatible toolchain should be picked.
+		resolvedToolchains, err := c.ComputeResolvedToolchainsValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.ResolvedToolchains_Key{
+				Toolchains: []*model_starlark_pb.ToolchainType{
+					{ToolchainType: "@@rules_cc+//cc:toolchain_type", Mandatory: true},
+				},
+			}),
+			e,
+		)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.ResolvedToolchains_Value{
+			ToolchainIdentifiers: []string{"@@toolchains+//cc:macos_aarch64"},
+			ExecutionPlatform:    macOSAarch64,
+		}, resolvedToolchains.Message)
+	})
+
+	t.Run("ExecCompatibleWith", func(t *testing.T) {
+		// Exec groups may place constraints on the execution
+		// platform, causing different toolchains to be
+		// selected.
+		resolvedToolchains, err := c.ComputeResolvedToolchainsValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.ResolvedToolchains_Key{
+				ExecCompatibleWith: []*model_analysis_pb.Constraint{
+					{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:linux"},
+				},
+				Toolchains: []*model_starlark_pb.ToolchainType{
+					{ToolchainType: "@@rules_cc+//cc:toolchain_type", Mandatory: true},
+					{ToolchainType: "@@rules_python+//python:toolchain_type", Mandatory: false},
+				},
+			}),
+			e,
+		)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.ResolvedToolchains_Value{
+			ToolchainIdentifiers: []string{"@@toolchains+//cc:linux_x86_64", ""},
+			ExecutionPlatform:    linuxX86_64,
+		}, resolvedToolchains.Message)
+	})
+
+	t.Run("NoToolchainsForType", func(t *testing.T) {
+		_, err := c.ComputeResolvedToolchainsValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.ResolvedToolchains_Key{
+				Toolchains: []*model_starlark_pb.ToolchainType{
+					{ToolchainType: "@@rules_python+//python:toolchain_type", Mandatory: true},
+				},
+			}),
+			e,
+		)
+		require.EqualError(t, err, "dependency on toolchain type \"@@rules_python+//python:toolchain_type\" is mandatory, but no toolchains exist that are compatible with the target")
+	})
+
+	t.Run("NoCompatibleExecutionPlatform", func(t *testing.T) {
+		_, err := c.ComputeResolvedToolchainsValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.ResolvedToolchains_Key{
+				Toolchains: []*model_starlark_pb.ToolchainType{
+					{ToolchainType: "@@rules_windows+//:toolchain_type", Mandatory: true},
+				},
+			}),
+			e,
+		)
+		require.EqualError(t, err, "dependency on toolchain type \"@@rules_windows+//:toolchain_type\" is mandatory, but none of the 1 toolchains that are compatible with the target are also compatible with any of the 2 execution platforms")
+	})
+
+	t.Run("ExecCompatibleWithExcludesAllPlatforms", func(t *testing.T) {
+		// The exec group constraints exclude the only
+		// execution platform on which the macOS toolchain can
+		// be used.
+		_, err := c.ComputeResolvedToolchainsValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.ResolvedToolchains_Key{
+				ExecCompatibleWith: []*model_analysis_pb.Constraint{
+					{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:windows"},
+				},
+				Toolchains: []*model_starlark_pb.ToolchainType{
+					{ToolchainType: "@@rules_cc+//cc:toolchain_type", Mandatory: true},
+				},
+			}),
+			e,
+		)
+		require.EqualError(t, err, "dependency on toolchain type \"@@rules_cc+//cc:toolchain_type\" is mandatory, but none of the 2 toolchains that are compatible with the target are also compatible with any of the 0 execution platforms")
+	})
+
+	t.Run("NoSingleExecutionPlatform", func(t *testing.T) {
+		// Both toolchain types have a compatible execution
+		// platform, but not the same one.
+		_, err := c.ComputeResolvedToolchainsValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.ResolvedToolchains_Key{
+				Toolchains: []*model_starlark_pb.ToolchainType{
+					{ToolchainType: "@@rules_go+//go:toolchain_type", Mandatory: true},
+					{ToolchainType: "@@rules_swift+//swift:toolchain_type", Mandatory: true},
+				},
+			}),
+			e,
+		)
+		require.EqualError(t, err, "even though all mandatory toolchain types have at least one toolchain that is compatible with one of the 2 execution platforms, no single execution platform exists for which all mandatory toolchain types have at least one compatible toolchain")
+	})
+}
@@ -0,0 +1,132 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	"github.com/buildbarn/bonanza/pkg/model/core/btree"
+	model_parser "github.com/buildbarn/bonanza/pkg/model/parser"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+)
+
+type getTargetCompatibleWithEnvironment interface {
+	constraintValuesToConstraintsEnvironment
+	getValueFromSelectGroupEnvironment
+}
+
+// getTargetCompatibleWith returns the constraints that a target
+// platform needs to provide to build a rule target, based on the
+// labels provided to the target's target_compatible_with attribute.
+func (c *baseComputer) getTargetCompatibleWith(ctx context.Context, e getTargetCompatibleWithEnvironment, targetLabel label.CanonicalLabel, ruleTarget model_core.Message[*model_starlark_pb.RuleTarget]) ([]*model_analysis_pb.Constraint, error) {
+	// Annoyingly enough, target_compatible_with is configurable.
+	// Expand select() expressions.
+	targetLabelStr := targetLabel.String()
+	var targetCompatibleWithLabels []string
+	for _, selectGroup := range ruleTarget.Message.TargetCompatibleWith {
+		targetCompatibleWithValue, err := getValueFromSelectGroup(e, selectGroup, false)
+		if err != nil {
+			return nil, err
+		}
+		targetCompatibleWithList, ok := targetCompatibleWithValue.Kind.(*model_starlark_pb.Value_List)
+		if !ok {
+			return nil, fmt.Errorf("target_compatible_with of target %#v is not a list", targetLabelStr)
+		}
+
+		var errIter error
+		for element := range btree.AllLeaves(
+			ctx,
+			model_parser.NewStorageBackedParsedObjectReader(
+				c.objectDownloader,
+				c.getValueObjectEncoder(),
+				model_parser.NewMessageListObjectParser[object.LocalReference, model_starlark_pb.List_Element](),
+			),
+			model_core.Message[[]*model_starlark_pb.List_Element]{
+				Message:            targetCompatibleWithList.List.Elements,
+				OutgoingReferences: ruleTarget.OutgoingReferences,
+			},
+			func(element model_core.Message[*model_starlark_pb.List_Element]) (*model_core_pb.Reference, error) {
+				if level, ok := element.Message.Level.(*model_starlark_pb.List_Element_Parent_); ok {
+					return level.Parent.Reference, nil
+				}
+				return nil, nil
+			},
+			&errIter,
+		) {
+			level, ok := element.Message.Level.(*model_starlark_pb.List_Element_Leaf)
+			if !ok {
+				return nil, fmt.Errorf("invalid list element level type for target_compatible_with of target %#v", targetLabelStr)
+			}
+			label, ok := level.Leaf.Kind.(*model_starlark_pb.Value_Label)
+			if !ok {
+				return nil, fmt.Errorf("invalid list element type for target_compatible_with of target %#v", targetLabelStr)
+			}
+			targetCompatibleWithLabels = append(targetCompatibleWithLabels, label.Label)
+		}
+		if errIter != nil {
+			return nil, errIter
+		}
+	}
+
+	return c.constraintValuesToConstraints(ctx, e, targetLabel.GetCanonicalPackage(), targetCompatibleWithLabels)
+}
+
+func (c *baseComputer) ComputeTargetCompatibilityValue(ctx context.Context, key model_core.Message[*model_analysis_pb.TargetCompatibility_Key], e TargetCompatibilityEnvironment) (PatchedTargetCompatibilityValue, error) {
+	targetLabel, err := label.NewCanonicalLabel(key.Message.Label)
+	if err != nil {
+		return PatchedTargetCompatibilityValue{}, fmt.Errorf("invalid target label: %w", err)
+	}
+	targetValue := e.GetTargetValue(&model_analysis_pb.Target_Key{
+		Label: targetLabel.String(),
+	})
+	if !targetValue.IsSet() {
+		return PatchedTargetCompatibilityValue{}, evaluation.ErrMissingDependency
+	}
+	ruleTarget, ok := targetValue.Message.Definition.GetKind().(*model_starlark_pb.Target_Definition_RuleTarget)
+	if !ok {
+		// Only rule targets have a target_compatible_with
+		// attribute. All other targets can always be built.
+		return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.TargetCompatibility_Value{
+			Compatible: true,
+		}), nil
+	}
+
+	targetPlatformConstraints := e.GetTargetPlatformConstraintsValue(
+		model_core.NewPatchedMessageFromExisting(
+			model_core.Message[*model_analysis_pb.TargetPlatformConstraints_Key]{
+				Message: &model_analysis_pb.TargetPlatformConstraints_Key{
+					ConfigurationReference: key.Message.ConfigurationReference,
+				},
+				OutgoingReferences: key.OutgoingReferences,
+			},
+			func(index int) dag.ObjectContentsWalker {
+				return dag.ExistingObjectContentsWalker
+			},
+		),
+	)
+	targetCompatibleWith, err := c.getTargetCompatibleWith(
+		ctx,
+		e,
+		targetLabel,
+		model_core.Message[*model_starlark_pb.RuleTarget]{
+			Message:            ruleTarget.RuleTarget,
+			OutgoingReferences: targetValue.OutgoingReferences,
+		},
+	)
+	if err != nil {
+		return PatchedTargetCompatibilityValue{}, err
+	}
+	if !targetPlatformConstraints.IsSet() {
+		return PatchedTargetCompatibilityValue{}, evaluation.ErrMissingDependency
+	}
+
+	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.TargetCompatibility_Value{
+		Compatible: constraintsAreCompatible(targetPlatformConstraints.Message.Constraints, targetCompatibleWith),
+	}), nil
+}
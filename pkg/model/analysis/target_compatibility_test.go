@@ -0,0 +1,171 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func newTestRuleTargetValue(targetCompatibleWith ...string) *model_analysis_pb.Target_Value {
+	elements := make([]*model_starlark_pb.List_Element, 0, len(targetCompatibleWith))
+	for _, constraintValue := range targetCompatibleWith {
+		elements = append(elements, &model_starlark_pb.List_Element{
+			Level: &model_starlark_pb.List_Element_Leaf{
+				Leaf: newTestLabelValue(constraintValue),
+			},
+		})
+	}
+	return &model_analysis_pb.Target_Value{
+		Definition: &model_starlark_pb.Target_Definition{
+			Kind: &model_starlark_pb.Target_Definition_RuleTarget{
+				RuleTarget: &model_starlark_pb.RuleTarget{
+					RuleIdentifier: "@@example+//:rules.bzl%my_rule",
+					TargetCompatibleWith: []*model_starlark_pb.Select_Group{{
+						NoMatch: &model_starlark_pb.Select_Group_NoMatchValue{
+							NoMatchValue: &model_starlark_pb.Value{
+								Kind: &model_starlark_pb.Value_List{
+									List: &model_starlark_pb.List{
+										Elements: elements,
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestComputeTargetCompatibilityValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	c := newTestBaseComputer(testObjectDownloader{})
+
+	e := NewMockTargetCompatibilityEnvironment(ctrl)
+	targets := map[string]*model_analysis_pb.Target_Value{
+		"@@example+//:anywhere": newTestRuleTargetValue(),
+		"@@example+//:linux_only": newTestRuleTargetValue(
+			"@@platforms+//os:linux",
+		),
+		"@@example+//:linux_x86_64_only": newTestRuleTargetValue(
+			"@@platforms+//cpu:x86_64",
+			"@@platforms+//os:linux",
+		),
+		"@@example+//:source.txt": {
+			Definition: &model_starlark_pb.Target_Definition{
+				Kind: &model_starlark_pb.Target_Definition_SourceFileTarget{
+					SourceFileTarget: &model_starlark_pb.SourceFileTarget{},
+				},
+			},
+		},
+	}
+	e.EXPECT().GetTargetValue(gomock.Any()).
+		DoAndReturn(getTestTargetValueFromMap(targets)).AnyTimes()
+	visibleTargets := map[string]string{
+		"@@platforms+//cpu:x86_64": "@@platforms+//cpu:x86_64",
+		"@@platforms+//os:linux":   "@@platforms+//os:linux",
+	}
+	e.EXPECT().GetVisibleTargetValue(gomock.Any()).
+		DoAndReturn(getTestVisibleTargetValueFromMap(visibleTargets)).AnyTimes()
+	configuredTargets := map[string][]*model_starlark_pb.Struct{
+		"@@platforms+//cpu:x86_64": {
+			newTestConstraintValueInfo("@@platforms+//cpu:cpu", "", "@@platforms+//cpu:x86_64"),
+		},
+		"@@platforms+//os:linux": {
+			newTestConstraintValueInfo("@@platforms+//os:os", "", "@@platforms+//os:linux"),
+		},
+	}
+	e.EXPECT().GetConfiguredTargetValue(gomock.Any()).
+		DoAndReturn(getTestConfiguredTargetValueFromMap(configuredTargets)).AnyTimes()
+	e.EXPECT().GetTargetPlatformConstraintsValue(gomock.Any()).
+		Return(model_core.NewSimpleMessage(&model_analysis_pb.TargetPlatformConstraints_Value{
+			Constraints: []*model_analysis_pb.Constraint{
+				{Setting: "@@platforms+//cpu:cpu", Value: "@@platforms+//cpu:aarch64"},
+				{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:linux"},
+			},
+		})).AnyTimes()
+
+	for targetLabel, expectedCompatible := range map[string]bool{
+		// Only rule targets have target_compatible_with.
+		"@@example+//:source.txt": true,
+		// Rule targets without constraints can be built for
+		// any platform.
+		"@@example+//:anywhere": true,
+		// The target platform provides all constraints.
+		"@@example+//:linux_only": true,
+		// The target platform has a different CPU.
+		"@@example+//:linux_x86_64_only": false,
+	} {
+		t.Run(targetLabel, func(t *testing.T) {
+			targetCompatibility, err := c.ComputeTargetCompatibilityValue(
+				ctx,
+				model_core.NewSimpleMessage(&model_analysis_pb.TargetCompatibility_Key{
+					Label: targetLabel,
+				}),
+				e,
+			)
+			require.NoError(t, err)
+			require.Equal(t, expectedCompatible, targetCompatibility.Message.Compatible)
+		})
+	}
+
+	t.Run("MissingConstraintValue", func(t *testing.T) {
+		// If the ConstraintValueInfo provider of one of the
+		// constraint values is not available yet, evaluation
+		// needs to be retried.
+		delete(configuredTargets, "@@platforms+//os:linux")
+		_, err := c.ComputeTargetCompatibilityValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.TargetCompatibility_Key{
+				Label: "@@example+//:linux_only",
+			}),
+			e,
+		)
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+	})
+}
+
+func TestConstraintsAreCompatible(t *testing.T) {
+	actual := []*model_analysis_pb.Constraint{
+		{Setting: "@@platforms+//cpu:cpu", Value: "@@platforms+//cpu:x86_64"},
+		{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:linux"},
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		require.True(t, constraintsAreCompatible(actual, nil))
+	})
+
+	t.Run("Subset", func(t *testing.T) {
+		require.True(t, constraintsAreCompatible(actual, []*model_analysis_pb.Constraint{
+			{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:linux"},
+		}))
+	})
+
+	t.Run("DifferentValue", func(t *testing.T) {
+		require.False(t, constraintsAreCompatible(actual, []*model_analysis_pb.Constraint{
+			{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:windows"},
+		}))
+	})
+
+	t.Run("MissingSetting", func(t *testing.T) {
+		require.False(t, constraintsAreCompatible(actual, []*model_analysis_pb.Constraint{
+			{Setting: "@@platforms+//os:libc", Value: "@@platforms+//os:glibc"},
+		}))
+	})
+
+	t.Run("MissingSettingWithDefaultValue", func(t *testing.T) {
+		// Platforms that lack a constraint setting implicitly
+		// use its default value.
+		require.True(t, constraintsAreCompatible(actual, []*model_analysis_pb.Constraint{
+			{Setting: "@@platforms+//os:libc", Value: ""},
+		}))
+	})
+}
@@ -85,12 +85,111 @@ func (c *baseComputer) expandCanonicalTargetPattern(
 	}
 }
 
+// newTargetLabelsBuilder creates a B-tree builder for the list of
+// target labels that is returned as part of a TargetPatternExpansion
+// value.
+func (c *baseComputer) newTargetLabelsBuilder() btree.Builder[*model_analysis_pb.TargetPatternExpansion_Value_TargetLabel, dag.ObjectContentsWalker] {
+	return btree.NewSplitProllyBuilder(
+		/* minimumSizeBytes = */ 32*1024,
+		/* maximumSizeBytes = */ 128*1024,
+		btree.NewObjectCreatingNodeMerger(
+			model_encoding.NewChainedBinaryEncoder(nil),
+			c.buildSpecificationReference.GetReferenceFormat(),
+			/* parentNodeComputer = */ func(contents *object.Contents, childNodes []*model_analysis_pb.TargetPatternExpansion_Value_TargetLabel, outgoingReferences object.OutgoingReferences, metadata []dag.ObjectContentsWalker) (model_core.PatchedMessage[*model_analysis_pb.TargetPatternExpansion_Value_TargetLabel, dag.ObjectContentsWalker], error) {
+				patcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
+				return model_core.NewPatchedMessage(
+					&model_analysis_pb.TargetPatternExpansion_Value_TargetLabel{
+						Level: &model_analysis_pb.TargetPatternExpansion_Value_TargetLabel_Parent_{
+							Parent: &model_analysis_pb.TargetPatternExpansion_Value_TargetLabel_Parent{
+								Reference: patcher.AddReference(contents.GetReference(), dag.NewSimpleObjectContentsWalker(contents, metadata)),
+							},
+						},
+					},
+					patcher,
+				), nil
+			},
+		),
+	)
+}
+
+// addPackageTargetsToTargetLabels pushes the labels of all targets in a
+// package that are matched by a wildcard target pattern into a B-tree
+// builder. Rule targets, aliases and label settings are always matched.
+// File targets are only matched if requested.
+func (c *baseComputer) addPackageTargetsToTargetLabels(
+	ctx context.Context,
+	treeBuilder btree.Builder[*model_analysis_pb.TargetPatternExpansion_Value_TargetLabel, dag.ObjectContentsWalker],
+	canonicalPackage label.CanonicalPackage,
+	packageValue model_core.Message[*model_analysis_pb.Package_Value],
+	includeFileTargets bool,
+) error {
+	var errIter error
+	for entry := range btree.AllLeaves(
+		ctx,
+		model_parser.NewStorageBackedParsedObjectReader(
+			c.objectDownloader,
+			c.getValueObjectEncoder(),
+			model_parser.NewMessageListObjectParser[object.LocalReference, model_analysis_pb.Package_Value_Target](),
+		),
+		model_core.Message[[]*model_analysis_pb.Package_Value_Target]{
+			Message:            packageValue.Message.Targets,
+			OutgoingReferences: packageValue.OutgoingReferences,
+		},
+		func(entry model_core.Message[*model_analysis_pb.Package_Value_Target]) (*model_core_pb.Reference, error) {
+			if level, ok := entry.Message.Level.(*model_analysis_pb.Package_Value_Target_Parent_); ok {
+				return level.Parent.Reference, nil
+			}
+			return nil, nil
+		},
+		&errIter,
+	) {
+		level, ok := entry.Message.Level.(*model_analysis_pb.Package_Value_Target_Leaf)
+		if !ok {
+			return errors.New("not a valid leaf entry")
+		}
+		reportTarget := false
+		switch level.Leaf.Definition.GetKind().(type) {
+		case *model_starlark_pb.Target_Definition_Alias:
+			reportTarget = true
+		case *model_starlark_pb.Target_Definition_LabelSetting:
+			reportTarget = true
+		case *model_starlark_pb.Target_Definition_PredeclaredOutputFileTarget:
+			if includeFileTargets {
+				reportTarget = true
+			}
+		case *model_starlark_pb.Target_Definition_RuleTarget:
+			reportTarget = true
+		case *model_starlark_pb.Target_Definition_SourceFileTarget:
+			if includeFileTargets {
+				reportTarget = true
+			}
+		}
+		if reportTarget {
+			targetName, err := label.NewTargetName(level.Leaf.Name)
+			if err != nil {
+				return fmt.Errorf("invalid target name %#v: %w", level.Leaf.Name, err)
+			}
+			if err := treeBuilder.PushChild(model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](
+				&model_analysis_pb.TargetPatternExpansion_Value_TargetLabel{
+					Level: &model_analysis_pb.TargetPatternExpansion_Value_TargetLabel_Leaf{
+						Leaf: canonicalPackage.AppendTargetName(targetName).String(),
+					},
+				},
+			)); err != nil {
+				return err
+			}
+		}
+	}
+	return errIter
+}
+
 func (c *baseComputer) ComputeTargetPatternExpansionValue(ctx context.Context, key *model_analysis_pb.TargetPatternExpansion_Key, e TargetPatternExpansionEnvironment) (PatchedTargetPatternExpansionValue, error) {
 	canonicalTargetPattern, err := label.NewCanonicalTargetPattern(key.TargetPattern)
 	if err != nil {
 		return PatchedTargetPatternExpansionValue{}, fmt.Errorf("invalid target pattern: %w", err)
 	}
 
+	treeBuilder := c.newTargetLabelsBuilder()
 	if initialTarget, includeFileTargets, ok := canonicalTargetPattern.AsSinglePackageTargetPattern(); ok {
 		// Target pattern of shape "@@a+//b:all",
 		// "@@a+//b:all-targets" or "@@a+//b:*".
@@ -127,106 +226,70 @@ func (c *baseComputer) ComputeTargetPatternExpansionValue(ctx context.Context, k
 			}), nil
 		}
 
-		treeBuilder := btree.NewSplitProllyBuilder(
-			/* minimumSizeBytes = */ 32*1024,
-			/* maximumSizeBytes = */ 128*1024,
-			btree.NewObjectCreatingNodeMerger(
-				model_encoding.NewChainedBinaryEncoder(nil),
-				c.buildSpecificationReference.GetReferenceFormat(),
-				/* parentNodeComputer = */ func(contents *object.Contents, childNodes []*model_analysis_pb.TargetPatternExpansion_Value_TargetLabel, outgoingReferences object.OutgoingReferences, metadata []dag.ObjectContentsWalker) (model_core.PatchedMessage[*model_analysis_pb.TargetPatternExpansion_Value_TargetLabel, dag.ObjectContentsWalker], error) {
-					patcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
-					return model_core.NewPatchedMessage(
-						&model_analysis_pb.TargetPatternExpansion_Value_TargetLabel{
-							Level: &model_analysis_pb.TargetPatternExpansion_Value_TargetLabel_Parent_{
-								Parent: &model_analysis_pb.TargetPatternExpansion_Value_TargetLabel_Parent{
-									Reference: patcher.AddReference(contents.GetReference(), dag.NewSimpleObjectContentsWalker(contents, metadata)),
-								},
-							},
-						},
-						patcher,
-					), nil
-				},
-			),
-		)
+		if err := c.addPackageTargetsToTargetLabels(ctx, treeBuilder, canonicalPackage, packageValue, includeFileTargets); err != nil {
+			return PatchedTargetPatternExpansionValue{}, err
+		}
+	} else if basePackage, includeFileTargets, ok := canonicalTargetPattern.AsRecursiveTargetPattern(); ok {
+		// Target pattern of shape "@@a+//b/..." or "@@a+//b/...:*".
+		packagesAtAndBelow := e.GetPackagesAtAndBelowValue(&model_analysis_pb.PackagesAtAndBelow_Key{
+			BasePackage: basePackage.String(),
+		})
+		if !packagesAtAndBelow.IsSet() {
+			return PatchedTargetPatternExpansionValue{}, evaluation.ErrMissingDependency
+		}
 
-		var errIter error
-		for entry := range btree.AllLeaves(
-			ctx,
-			model_parser.NewStorageBackedParsedObjectReader(
-				c.objectDownloader,
-				c.getValueObjectEncoder(),
-				model_parser.NewMessageListObjectParser[object.LocalReference, model_analysis_pb.Package_Value_Target](),
-			),
-			model_core.Message[[]*model_analysis_pb.Package_Value_Target]{
-				Message:            packageValue.Message.Targets,
-				OutgoingReferences: packageValue.OutgoingReferences,
-			},
-			func(entry model_core.Message[*model_analysis_pb.Package_Value_Target]) (*model_core_pb.Reference, error) {
-				if level, ok := entry.Message.Level.(*model_analysis_pb.Package_Value_Target_Parent_); ok {
-					return level.Parent.Reference, nil
-				}
-				return nil, nil
-			},
-			&errIter,
-		) {
-			level, ok := entry.Message.Level.(*model_analysis_pb.Package_Value_Target_Leaf)
-			if !ok {
-				return PatchedTargetPatternExpansionValue{}, errors.New("not a valid leaf entry")
-			}
-			reportTarget := false
-			switch level.Leaf.Definition.GetKind().(type) {
-			case *model_starlark_pb.Target_Definition_Alias:
-				reportTarget = true
-			case *model_starlark_pb.Target_Definition_LabelSetting:
-				reportTarget = true
-			case *model_starlark_pb.Target_Definition_PredeclaredOutputFileTarget:
-				if includeFileTargets {
-					reportTarget = true
-				}
-			case *model_starlark_pb.Target_Definition_RuleTarget:
-				reportTarget = true
-			case *model_starlark_pb.Target_Definition_SourceFileTarget:
-				if includeFileTargets {
-					reportTarget = true
-				}
+		var canonicalPackages []label.CanonicalPackage
+		if packagesAtAndBelow.Message.PackageAtBasePackage {
+			canonicalPackages = append(canonicalPackages, basePackage)
+		}
+		basePackageStr := basePackage.String()
+		if basePackage.GetPackagePath() == "" {
+			basePackageStr += "/"
+		}
+		for _, packagePath := range packagesAtAndBelow.Message.PackagesBelowBasePackage {
+			canonicalPackage, err := label.NewCanonicalPackage(basePackageStr + "/" + packagePath)
+			if err != nil {
+				return PatchedTargetPatternExpansionValue{}, fmt.Errorf("invalid package path %#v: %w", packagePath, err)
 			}
-			if reportTarget {
-				targetName, err := label.NewTargetName(level.Leaf.Name)
-				if err != nil {
-					return PatchedTargetPatternExpansionValue{}, fmt.Errorf("invalid target name %#v: %w", level.Leaf.Name, err)
-				}
-				if err := treeBuilder.PushChild(model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](
-					&model_analysis_pb.TargetPatternExpansion_Value_TargetLabel{
-						Level: &model_analysis_pb.TargetPatternExpansion_Value_TargetLabel_Leaf{
-							Leaf: canonicalPackage.AppendTargetName(targetName).String(),
-						},
-					},
-				)); err != nil {
-					return PatchedTargetPatternExpansionValue{}, err
-				}
+			canonicalPackages = append(canonicalPackages, canonicalPackage)
+		}
+
+		// Request the values of all packages before processing
+		// them, so that missing dependencies are reported in
+		// a single pass.
+		packageValues := make([]model_core.Message[*model_analysis_pb.Package_Value], 0, len(canonicalPackages))
+		missingDependencies := false
+		for _, canonicalPackage := range canonicalPackages {
+			packageValue := e.GetPackageValue(&model_analysis_pb.Package_Key{
+				Label: canonicalPackage.String(),
+			})
+			if !packageValue.IsSet() {
+				missingDependencies = true
 			}
+			packageValues = append(packageValues, packageValue)
 		}
-		if errIter != nil {
-			return PatchedTargetPatternExpansionValue{}, errIter
+		if missingDependencies {
+			return PatchedTargetPatternExpansionValue{}, evaluation.ErrMissingDependency
 		}
 
-		targetLabelsList, err := treeBuilder.FinalizeList()
-		if err != nil {
-			return PatchedTargetPatternExpansionValue{}, err
+		for i, canonicalPackage := range canonicalPackages {
+			if err := c.addPackageTargetsToTargetLabels(ctx, treeBuilder, canonicalPackage, packageValues[i], includeFileTargets); err != nil {
+				return PatchedTargetPatternExpansionValue{}, err
+			}
 		}
-
-		return PatchedTargetPatternExpansionValue{
-			Message: &model_analysis_pb.TargetPatternExpansion_Value{
-				TargetLabels: targetLabelsList.Message,
-			},
-			Patcher: targetLabelsList.Patcher,
-		}, nil
+	} else {
+		return PatchedTargetPatternExpansionValue{}, errors.New("target pattern does not require any expansion")
 	}
 
-	if basePackage, includeFileTargets, ok := canonicalTargetPattern.AsRecursiveTargetPattern(); ok {
-		// Target pattern of shape "@@a+//b/..." or "@@a+//b/...:*".
-		return PatchedTargetPatternExpansionValue{}, fmt.Errorf("TODO: recursive target pattern %#v %#v", basePackage.String(), includeFileTargets)
+	targetLabelsList, err := treeBuilder.FinalizeList()
+	if err != nil {
+		return PatchedTargetPatternExpansionValue{}, err
 	}
 
-	return PatchedTargetPatternExpansionValue{}, errors.New("target pattern does not require any expansion")
+	return PatchedTargetPatternExpansionValue{
+		Message: &model_analysis_pb.TargetPatternExpansion_Value{
+			TargetLabels: targetLabelsList.Message,
+		},
+		Patcher: targetLabelsList.Patcher,
+	}, nil
 }
@@ -0,0 +1,156 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	"github.com/buildbarn/bonanza/pkg/model/core/btree"
+	model_parser "github.com/buildbarn/bonanza/pkg/model/parser"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+)
+
+var commandLineOptionPlatformsLabel = label.MustNewCanonicalLabel("@@bazel_tools+//command_line_option:platforms")
+
+// getBuildSettingOverride looks up the value that is assigned to a
+// build setting in a configuration. If the configuration does not
+// contain an override for the build setting, an unset message is
+// returned, meaning that the build setting's default value applies.
+func (c *baseComputer) getBuildSettingOverride(ctx context.Context, configuration model_core.Message[*model_analysis_pb.Configuration], buildSettingLabel string) (model_core.Message[*model_analysis_pb.Configuration_BuildSettingOverride_Leaf], error) {
+	override, err := btree.Find(
+		ctx,
+		model_parser.NewStorageBackedParsedObjectReader(
+			c.objectDownloader,
+			c.getValueObjectEncoder(),
+			model_parser.NewMessageListObjectParser[object.LocalReference, model_analysis_pb.Configuration_BuildSettingOverride](),
+		),
+		model_core.Message[[]*model_analysis_pb.Configuration_BuildSettingOverride]{
+			Message:            configuration.Message.BuildSettingOverrides,
+			OutgoingReferences: configuration.OutgoingReferences,
+		},
+		func(entry *model_analysis_pb.Configuration_BuildSettingOverride) (int, *model_core_pb.Reference) {
+			switch level := entry.Level.(type) {
+			case *model_analysis_pb.Configuration_BuildSettingOverride_Leaf_:
+				return strings.Compare(buildSettingLabel, level.Leaf.Label), nil
+			case *model_analysis_pb.Configuration_BuildSettingOverride_Parent_:
+				return strings.Compare(buildSettingLabel, level.Parent.FirstLabel), level.Parent.Reference
+			default:
+				return 0, nil
+			}
+		},
+	)
+	if err != nil || !override.IsSet() {
+		return model_core.Message[*model_analysis_pb.Configuration_BuildSettingOverride_Leaf]{}, err
+	}
+	leaf, ok := override.Message.Level.(*model_analysis_pb.Configuration_BuildSettingOverride_Leaf_)
+	if !ok {
+		return model_core.Message[*model_analysis_pb.Configuration_BuildSettingOverride_Leaf]{}, errors.New("build setting override is not a valid leaf")
+	}
+	return model_core.Message[*model_analysis_pb.Configuration_BuildSettingOverride_Leaf]{
+		Message:            leaf.Leaf,
+		OutgoingReferences: override.OutgoingReferences,
+	}, nil
+}
+
+func (c *baseComputer) ComputeTargetPlatformConstraintsValue(ctx context.Context, key model_core.Message[*model_analysis_pb.TargetPlatformConstraints_Key], e TargetPlatformConstraintsEnvironment) (PatchedTargetPlatformConstraintsValue, error) {
+	// Obtain the label of the target platform, either from the
+	// configuration or the default value of the build setting.
+	configuration, err := c.getConfigurationByReference(
+		ctx,
+		model_core.Message[*model_core_pb.Reference]{
+			Message:            key.Message.ConfigurationReference,
+			OutgoingReferences: key.OutgoingReferences,
+		},
+	)
+	if err != nil {
+		return PatchedTargetPlatformConstraintsValue{}, err
+	}
+	commandLineOptionPlatformsLabelStr := commandLineOptionPlatformsLabel.String()
+	platformOverride, err := c.getBuildSettingOverride(ctx, configuration, commandLineOptionPlatformsLabelStr)
+	if err != nil {
+		return PatchedTargetPlatformConstraintsValue{}, err
+	}
+	var platformLabel string
+	if platformOverride.IsSet() {
+		labelValue, ok := platformOverride.Message.Value.GetKind().(*model_starlark_pb.Value_Label)
+		if !ok {
+			return PatchedTargetPlatformConstraintsValue{}, fmt.Errorf("build setting override for %#v is not a label", commandLineOptionPlatformsLabelStr)
+		}
+		platformLabel = labelValue.Label
+	} else {
+		targetValue := e.GetTargetValue(&model_analysis_pb.Target_Key{
+			Label: commandLineOptionPlatformsLabelStr,
+		})
+		if !targetValue.IsSet() {
+			return PatchedTargetPlatformConstraintsValue{}, evaluation.ErrMissingDependency
+		}
+		labelSetting, ok := targetValue.Message.Definition.GetKind().(*model_starlark_pb.Target_Definition_LabelSetting)
+		if !ok {
+			return PatchedTargetPlatformConstraintsValue{}, fmt.Errorf("target %#v is not a label setting", commandLineOptionPlatformsLabelStr)
+		}
+		platformLabel = labelSetting.LabelSetting.BuildSettingDefault
+	}
+
+	// The platform may be an alias(), which needs to be expanded.
+	// The label is provided by the user as opposed to being a
+	// dependency of a target, so resolve it from within its own
+	// package to skip visibility checks. Don't use any
+	// configuration, as that would require the target platform we
+	// are trying to compute.
+	canonicalPlatformLabel, err := label.NewCanonicalLabel(platformLabel)
+	if err != nil {
+		return PatchedTargetPlatformConstraintsValue{}, fmt.Errorf("invalid platform label %#v: %w", platformLabel, err)
+	}
+	visiblePlatformTarget := e.GetVisibleTargetValue(
+		model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](
+			&model_analysis_pb.VisibleTarget_Key{
+				FromPackage: canonicalPlatformLabel.GetCanonicalPackage().String(),
+				ToLabel:     platformLabel,
+			},
+		),
+	)
+	if !visiblePlatformTarget.IsSet() {
+		return PatchedTargetPlatformConstraintsValue{}, evaluation.ErrMissingDependency
+	}
+	platformLabel = visiblePlatformTarget.Message.Label
+
+	platformInfoProvider, err := getProviderFromConfiguredTarget(
+		e,
+		platformLabel,
+		model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker, *model_core_pb.Reference](nil),
+		platformInfoProviderIdentifier,
+	)
+	if err != nil {
+		return PatchedTargetPlatformConstraintsValue{}, fmt.Errorf("failed to obtain PlatformInfo provider for target %#v: %w", platformLabel, err)
+	}
+	constraintsValue, err := model_starlark.GetStructFieldValue(
+		ctx,
+		model_parser.NewStorageBackedParsedObjectReader(
+			c.objectDownloader,
+			c.getValueObjectEncoder(),
+			model_parser.NewMessageListObjectParser[object.LocalReference, model_starlark_pb.List_Element](),
+		),
+		platformInfoProvider,
+		"constraints",
+	)
+	if err != nil {
+		return PatchedTargetPlatformConstraintsValue{}, fmt.Errorf("failed to obtain constraints field of PlatformInfo provider for target %#v: %w", platformLabel, err)
+	}
+	constraints, err := c.extractFromPlatformInfoConstraints(ctx, constraintsValue)
+	if err != nil {
+		return PatchedTargetPlatformConstraintsValue{}, fmt.Errorf("failed to extract constraints from PlatformInfo provider for target %#v: %w", platformLabel, err)
+	}
+
+	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.TargetPlatformConstraints_Value{
+		Constraints: constraints,
+	}), nil
+}
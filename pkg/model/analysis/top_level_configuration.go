@@ -0,0 +1,151 @@
+package analysis
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	model_core_pb "github.com/buildbarn/bonanza/pkg/proto/model/core"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+)
+
+var commandLineOptionHostPlatformLabel = label.MustNewCanonicalLabel("@@bazel_tools+//command_line_option:host_platform")
+
+type createTopLevelConfigurationEnvironment interface {
+	resolveApparentEnvironment
+	GetTargetValue(*model_analysis_pb.Target_Key) model_core.Message[*model_analysis_pb.Target_Value]
+}
+
+// topLevelConfiguration holds the configuration that is used to
+// analyze targets that are requested as part of the build.
+type topLevelConfiguration struct {
+	contents *object.Contents
+	metadata []dag.ObjectContentsWalker
+}
+
+// getPatchedReference returns a reference to the top-level
+// configuration that can be embedded into evaluation keys. If no build
+// setting overrides are in place, a nil reference is returned.
+func (tlc *topLevelConfiguration) getPatchedReference() model_core.PatchedMessage[*model_core_pb.Reference, dag.ObjectContentsWalker] {
+	if tlc.contents == nil {
+		return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker, *model_core_pb.Reference](nil)
+	}
+	patcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
+	return model_core.NewPatchedMessage(
+		patcher.AddReference(
+			tlc.contents.GetReference(),
+			dag.NewSimpleObjectContentsWalker(tlc.contents, tlc.metadata),
+		),
+		patcher,
+	)
+}
+
+// getID returns an identifier of the top-level configuration that can
+// be reported to clients. If no build setting overrides are in place,
+// the identifier is "default".
+func (tlc *topLevelConfiguration) getID() string {
+	if tlc.contents == nil {
+		return "default"
+	}
+	return hex.EncodeToString(tlc.contents.GetReference().GetHash())
+}
+
+// newLabelBuildSettingOverride creates a leaf entry for a configuration
+// that assigns a label value to a build setting.
+func newLabelBuildSettingOverride(buildSettingLabel, value string) *model_analysis_pb.Configuration_BuildSettingOverride {
+	return &model_analysis_pb.Configuration_BuildSettingOverride{
+		Level: &model_analysis_pb.Configuration_BuildSettingOverride_Leaf_{
+			Leaf: &model_analysis_pb.Configuration_BuildSettingOverride_Leaf{
+				Label: buildSettingLabel,
+				Value: &model_starlark_pb.Value{
+					Kind: &model_starlark_pb.Value_Label{
+						Label: value,
+					},
+				},
+			},
+		},
+	}
+}
+
+// createTopLevelConfiguration creates the configuration that is used
+// to analyze targets that are requested as part of the build. It
+// contains build setting overrides for command line flags that were
+// provided by the client, such as --platforms and --host_platform.
+func (c *baseComputer) createTopLevelConfiguration(e createTopLevelConfigurationEnvironment, rootRepo label.CanonicalRepo, buildSpecification *model_build_pb.BuildSpecification) (*topLevelConfiguration, error) {
+	// Overrides are listed in the order in which they need to be
+	// stored in the configuration, namely sorted by label.
+	rootPackage := rootRepo.GetRootPackage()
+	var buildSettingOverrides []*model_analysis_pb.Configuration_BuildSettingOverride
+	missingDependencies := false
+	for _, flag := range []struct {
+		buildSetting label.CanonicalLabel
+		value        string
+	}{
+		{commandLineOptionHostPlatformLabel, buildSpecification.HostPlatform},
+		{commandLineOptionPlatformsLabel, buildSpecification.TargetPlatform},
+	} {
+		if flag.value == "" {
+			continue
+		}
+		buildSettingLabelStr := flag.buildSetting.String()
+		apparentPlatformLabel, err := rootPackage.AppendLabel(flag.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %#v for build setting %#v: %w", flag.value, buildSettingLabelStr, err)
+		}
+		canonicalPlatformLabel, err := resolveApparent(e, rootRepo, apparentPlatformLabel)
+		if err != nil {
+			if errors.Is(err, evaluation.ErrMissingDependency) {
+				missingDependencies = true
+				continue
+			}
+			return nil, fmt.Errorf("failed to resolve value %#v for build setting %#v: %w", flag.value, buildSettingLabelStr, err)
+		}
+
+		// Only store the build setting override if its value
+		// differs from the default value. This ensures that the
+		// configuration remains canonical.
+		targetValue := e.GetTargetValue(&model_analysis_pb.Target_Key{
+			Label: buildSettingLabelStr,
+		})
+		if !targetValue.IsSet() {
+			missingDependencies = true
+			continue
+		}
+		labelSetting, ok := targetValue.Message.Definition.GetKind().(*model_starlark_pb.Target_Definition_LabelSetting)
+		if !ok {
+			return nil, fmt.Errorf("target %#v is not a label setting", buildSettingLabelStr)
+		}
+		canonicalPlatformLabelStr := canonicalPlatformLabel.String()
+		if canonicalPlatformLabelStr != labelSetting.LabelSetting.BuildSettingDefault {
+			buildSettingOverrides = append(buildSettingOverrides, newLabelBuildSettingOverride(buildSettingLabelStr, canonicalPlatformLabelStr))
+		}
+	}
+	if missingDependencies {
+		return nil, evaluation.ErrMissingDependency
+	}
+
+	if len(buildSettingOverrides) == 0 {
+		return &topLevelConfiguration{}, nil
+	}
+	contents, metadata, err := model_core.MarshalAndEncodePatchedMessage(
+		model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.Configuration{
+			BuildSettingOverrides: buildSettingOverrides,
+		}),
+		c.buildSpecificationReference.GetReferenceFormat(),
+		c.getValueObjectEncoder(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+	return &topLevelConfiguration{
+		contents: contents,
+		metadata: metadata,
+	}, nil
+}
@@ -0,0 +1,290 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/evaluation"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_build_pb "github.com/buildbarn/bonanza/pkg/proto/model/build"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func newTestLabelSettingValue(buildSettingDefault string) *model_analysis_pb.Target_Value {
+	return &model_analysis_pb.Target_Value{
+		Definition: &model_starlark_pb.Target_Definition{
+			Kind: &model_starlark_pb.Target_Definition_LabelSetting{
+				LabelSetting: &model_starlark_pb.LabelSetting{
+					BuildSettingDefault: buildSettingDefault,
+					Flag:                true,
+				},
+			},
+		},
+	}
+}
+
+// newTestPlatformInfo creates a PlatformInfo provider instance, similar
+// to the one returned by platform().
+func newTestPlatformInfo(constraints map[string]string) *model_starlark_pb.Struct {
+	dict := &model_starlark_pb.Dict{}
+	for setting, value := range constraints {
+		dict.Entries = append(dict.Entries, &model_starlark_pb.Dict_Entry{
+			Level: &model_starlark_pb.Dict_Entry_Leaf_{
+				Leaf: &model_starlark_pb.Dict_Entry_Leaf{
+					Key:   newTestLabelValue(setting),
+					Value: newTestLabelValue(value),
+				},
+			},
+		})
+	}
+	return newTestProviderInstance(platformInfoProviderIdentifier.String(), map[string]*model_starlark_pb.Value{
+		"constraints": {
+			Kind: &model_starlark_pb.Value_Dict{Dict: dict},
+		},
+	})
+}
+
+// getTestPlatformCanonicalRepoNameValue can be used as an
+// implementation of GetCanonicalRepoNameValue() that only knows about
+// the "platforms" module.
+func getTestPlatformCanonicalRepoNameValue(key *model_analysis_pb.CanonicalRepoName_Key) model_core.Message[*model_analysis_pb.CanonicalRepoName_Value] {
+	return newTestMessage(&model_analysis_pb.CanonicalRepoName_Value{
+		ToCanonicalRepo: "platforms+",
+	}, key.ToApparentRepo == "platforms")
+}
+
+// getTestPlatformTargetValue can be used as an implementation of
+// GetTargetValue() that provides the label settings for
+// --host_platform and --platforms.
+func getTestPlatformTargetValue(key *model_analysis_pb.Target_Key) model_core.Message[*model_analysis_pb.Target_Value] {
+	switch key.Label {
+	case "@@bazel_tools+//command_line_option:host_platform", "@@bazel_tools+//command_line_option:platforms":
+		return model_core.NewSimpleMessage(newTestLabelSettingValue("@@platforms+//host"))
+	default:
+		return model_core.Message[*model_analysis_pb.Target_Value]{}
+	}
+}
+
+// getTestPlatformConfiguredTargetValue can be used as an implementation
+// of GetConfiguredTargetValue() that provides the PlatformInfo
+// providers of a couple of platforms.
+func getTestPlatformConfiguredTargetValue(key model_core.PatchedMessage[*model_analysis_pb.ConfiguredTarget_Key, dag.ObjectContentsWalker]) model_core.Message[*model_analysis_pb.ConfiguredTarget_Value] {
+	var constraints map[string]string
+	switch key.Message.Label {
+	case "@@example+//platforms:linux_aarch64":
+		constraints = map[string]string{
+			"@@platforms+//cpu:cpu": "@@platforms+//cpu:aarch64",
+			"@@platforms+//os:os":   "@@platforms+//os:linux",
+		}
+	case "@@platforms+//host":
+		constraints = map[string]string{
+			"@@platforms+//cpu:cpu": "@@platforms+//cpu:x86_64",
+			"@@platforms+//os:os":   "@@platforms+//os:linux",
+		}
+	default:
+		return model_core.Message[*model_analysis_pb.ConfiguredTarget_Value]{}
+	}
+	return model_core.NewSimpleMessage(&model_analysis_pb.ConfiguredTarget_Value{
+		ProviderInstances: []*model_starlark_pb.Struct{
+			newTestPlatformInfo(constraints),
+		},
+	})
+}
+
+// getTestPlatformVisibleTargetValue can be used as an implementation of
+// GetVisibleTargetValue() that expands an alias pointing to one of the
+// platforms.
+func getTestPlatformVisibleTargetValue(key model_core.PatchedMessage[*model_analysis_pb.VisibleTarget_Key, dag.ObjectContentsWalker]) model_core.Message[*model_analysis_pb.VisibleTarget_Value] {
+	switch key.Message.ToLabel {
+	case "@@example+//platforms:default":
+		return model_core.NewSimpleMessage(&model_analysis_pb.VisibleTarget_Value{
+			Label: "@@example+//platforms:linux_aarch64",
+		})
+	case "@@example+//platforms:linux_aarch64", "@@platforms+//host":
+		return model_core.NewSimpleMessage(&model_analysis_pb.VisibleTarget_Value{
+			Label: key.Message.ToLabel,
+		})
+	default:
+		return model_core.Message[*model_analysis_pb.VisibleTarget_Value]{}
+	}
+}
+
+func newTestTopLevelConfigurationEnvironment(ctrl *gomock.Controller) *MockBuildResultEnvironment {
+	e := NewMockBuildResultEnvironment(ctrl)
+	e.EXPECT().GetCanonicalRepoNameValue(gomock.Any()).DoAndReturn(getTestPlatformCanonicalRepoNameValue).AnyTimes()
+	e.EXPECT().GetTargetValue(gomock.Any()).DoAndReturn(getTestPlatformTargetValue).AnyTimes()
+	return e
+}
+
+func TestCreateTopLevelConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := newTestBaseComputer(testObjectDownloader{})
+	rootRepo := label.MustNewCanonicalRepo("example+")
+
+	t.Run("NoFlags", func(t *testing.T) {
+		// If no flags are provided, the empty configuration
+		// should be used.
+		configuration, err := c.createTopLevelConfiguration(newTestTopLevelConfigurationEnvironment(ctrl), rootRepo, &model_build_pb.BuildSpecification{})
+		require.NoError(t, err)
+		require.Nil(t, configuration.getPatchedReference().Message)
+	})
+
+	t.Run("DefaultValues", func(t *testing.T) {
+		// Explicitly setting flags to their default values
+		// should also yield the empty configuration, as the
+		// configuration needs to remain canonical.
+		configuration, err := c.createTopLevelConfiguration(newTestTopLevelConfigurationEnvironment(ctrl), rootRepo, &model_build_pb.BuildSpecification{
+			HostPlatform:   "@platforms//host",
+			TargetPlatform: "@@platforms+//host:host",
+		})
+		require.NoError(t, err)
+		require.Nil(t, configuration.getPatchedReference().Message)
+	})
+
+	t.Run("MissingRepo", func(t *testing.T) {
+		_, err := c.createTopLevelConfiguration(newTestTopLevelConfigurationEnvironment(ctrl), rootRepo, &model_build_pb.BuildSpecification{
+			TargetPlatform: "@unknown//:platform",
+		})
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+	})
+
+	t.Run("Overrides", func(t *testing.T) {
+		// Labels are relative to the root repo, and overrides
+		// should be sorted by label.
+		configuration, err := c.createTopLevelConfiguration(newTestTopLevelConfigurationEnvironment(ctrl), rootRepo, &model_build_pb.BuildSpecification{
+			HostPlatform:   "//platforms:linux_aarch64",
+			TargetPlatform: "//platforms:linux_aarch64",
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.Configuration{
+			BuildSettingOverrides: []*model_analysis_pb.Configuration_BuildSettingOverride{
+				newLabelBuildSettingOverride("@@bazel_tools+//command_line_option:host_platform", "@@example+//platforms:linux_aarch64"),
+				newLabelBuildSettingOverride("@@bazel_tools+//command_line_option:platforms", "@@example+//platforms:linux_aarch64"),
+			},
+		}, loadTestConfiguration(t, configuration.getPatchedReference()))
+	})
+}
+
+func TestComputeTargetPlatformConstraintsValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	objectDownloader := testObjectDownloader{}
+	c := newTestBaseComputer(objectDownloader)
+
+	e := NewMockTargetPlatformConstraintsEnvironment(ctrl)
+	e.EXPECT().GetTargetValue(gomock.Any()).DoAndReturn(getTestPlatformTargetValue).AnyTimes()
+	e.EXPECT().GetVisibleTargetValue(gomock.Any()).DoAndReturn(getTestPlatformVisibleTargetValue).AnyTimes()
+	e.EXPECT().GetConfiguredTargetValue(gomock.Any()).DoAndReturn(getTestPlatformConfiguredTargetValue).AnyTimes()
+
+	t.Run("DefaultPlatform", func(t *testing.T) {
+		// Without any configuration, the default value of
+		// --platforms should be used.
+		targetPlatformConstraints, err := c.ComputeTargetPlatformConstraintsValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.TargetPlatformConstraints_Key{}),
+			e,
+		)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.TargetPlatformConstraints_Value{
+			Constraints: []*model_analysis_pb.Constraint{
+				{Setting: "@@platforms+//cpu:cpu", Value: "@@platforms+//cpu:x86_64"},
+				{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:linux"},
+			},
+		}, targetPlatformConstraints.Message)
+	})
+
+	t.Run("PlatformsFlag", func(t *testing.T) {
+		// If --platforms is provided, the configuration
+		// should contain an override that is respected.
+		configuration, err := c.createTopLevelConfiguration(newTestTopLevelConfigurationEnvironment(ctrl), label.MustNewCanonicalRepo("example+"), &model_build_pb.BuildSpecification{
+			TargetPlatform: "//platforms:linux_aarch64",
+		})
+		require.NoError(t, err)
+		configurationReference := storeTestConfiguration(t, objectDownloader, loadTestConfiguration(t, configuration.getPatchedReference()))
+		key, _ := model_core.NewPatchedMessage(
+			&model_analysis_pb.TargetPlatformConstraints_Key{
+				ConfigurationReference: configurationReference.Message,
+			},
+			configurationReference.Patcher,
+		).SortAndSetReferences()
+
+		targetPlatformConstraints, err := c.ComputeTargetPlatformConstraintsValue(ctx, key, e)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.TargetPlatformConstraints_Value{
+			Constraints: []*model_analysis_pb.Constraint{
+				{Setting: "@@platforms+//cpu:cpu", Value: "@@platforms+//cpu:aarch64"},
+				{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:linux"},
+			},
+		}, targetPlatformConstraints.Message)
+	})
+
+	t.Run("AliasedPlatform", func(t *testing.T) {
+		// If --platforms refers to an alias, it should be
+		// expanded prior to obtaining the PlatformInfo
+		// provider. As the label is provided by the user, it
+		// should not be subject to visibility checks.
+		e := NewMockTargetPlatformConstraintsEnvironment(ctrl)
+		e.EXPECT().GetVisibleTargetValue(gomock.Any()).
+			DoAndReturn(func(key model_core.PatchedMessage[*model_analysis_pb.VisibleTarget_Key, dag.ObjectContentsWalker]) model_core.Message[*model_analysis_pb.VisibleTarget_Value] {
+				testutil.RequireEqualProto(t, &model_analysis_pb.VisibleTarget_Key{
+					FromPackage: "@@example+//platforms",
+					ToLabel:     "@@example+//platforms:default",
+				}, key.Message)
+				return getTestPlatformVisibleTargetValue(key)
+			})
+		e.EXPECT().GetConfiguredTargetValue(gomock.Any()).DoAndReturn(getTestPlatformConfiguredTargetValue)
+
+		configurationReference := storeTestConfiguration(t, objectDownloader, &model_analysis_pb.Configuration{
+			BuildSettingOverrides: []*model_analysis_pb.Configuration_BuildSettingOverride{
+				newLabelBuildSettingOverride("@@bazel_tools+//command_line_option:platforms", "@@example+//platforms:default"),
+			},
+		})
+		key, _ := model_core.NewPatchedMessage(
+			&model_analysis_pb.TargetPlatformConstraints_Key{
+				ConfigurationReference: configurationReference.Message,
+			},
+			configurationReference.Patcher,
+		).SortAndSetReferences()
+
+		targetPlatformConstraints, err := c.ComputeTargetPlatformConstraintsValue(ctx, key, e)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_analysis_pb.TargetPlatformConstraints_Value{
+			Constraints: []*model_analysis_pb.Constraint{
+				{Setting: "@@platforms+//cpu:cpu", Value: "@@platforms+//cpu:aarch64"},
+				{Setting: "@@platforms+//os:os", Value: "@@platforms+//os:linux"},
+			},
+		}, targetPlatformConstraints.Message)
+	})
+
+	t.Run("MissingVisibleTarget", func(t *testing.T) {
+		e := NewMockTargetPlatformConstraintsEnvironment(ctrl)
+		e.EXPECT().GetTargetValue(gomock.Any()).DoAndReturn(getTestPlatformTargetValue).AnyTimes()
+		e.EXPECT().GetVisibleTargetValue(gomock.Any()).Return(model_core.Message[*model_analysis_pb.VisibleTarget_Value]{})
+		_, err := c.ComputeTargetPlatformConstraintsValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.TargetPlatformConstraints_Key{}),
+			e,
+		)
+		require.Equal(t, evaluation.ErrMissingDependency, err)
+	})
+
+	t.Run("NotAPlatform", func(t *testing.T) {
+		e := NewMockTargetPlatformConstraintsEnvironment(ctrl)
+		e.EXPECT().GetTargetValue(gomock.Any()).DoAndReturn(getTestPlatformTargetValue).AnyTimes()
+		e.EXPECT().GetVisibleTargetValue(gomock.Any()).DoAndReturn(getTestPlatformVisibleTargetValue)
+		e.EXPECT().GetConfiguredTargetValue(gomock.Any()).Return(model_core.NewSimpleMessage(&model_analysis_pb.ConfiguredTarget_Value{}))
+		_, err := c.ComputeTargetPlatformConstraintsValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.TargetPlatformConstraints_Key{}),
+			e,
+		)
+		require.EqualError(t, err, "failed to obtain PlatformInfo provider for target \"@@platforms+//host\": target did not yield provider \"@@builtins_core+//:exports.bzl%PlatformInfo\"")
+	})
+}
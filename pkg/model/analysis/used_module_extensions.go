@@ -18,13 +18,17 @@ import (
 )
 
 type usedModuleExtension struct {
-	message model_analysis_pb.ModuleExtension
-	users   map[label.ModuleInstance]*moduleExtensionUser
+	message        model_analysis_pb.ModuleExtension
+	importsMessage model_analysis_pb.ModuleExtensionImports
+	users          map[label.ModuleInstance]*moduleExtensionUser
 }
 
 type moduleExtensionUser struct {
-	message    model_analysis_pb.ModuleExtension_User
-	tagClasses map[string]*model_analysis_pb.ModuleExtension_TagClass
+	message          model_analysis_pb.ModuleExtension_User
+	importsMessage   model_analysis_pb.ModuleExtensionImports_User
+	tagClasses       map[string]*model_analysis_pb.ModuleExtension_TagClass
+	importedRepos    map[string]struct{}
+	devImportedRepos map[string]struct{}
 }
 
 type usedModuleExtensionOptions struct {
@@ -66,7 +70,17 @@ func (p *usedModuleExtensionProxy) Tag(className string, attrs map[string]starla
 	return nil
 }
 
-func (usedModuleExtensionProxy) UseRepo(repos map[label.ApparentRepo]label.ApparentRepo) error {
+func (p *usedModuleExtensionProxy) UseRepo(repos map[label.ApparentRepo]label.ApparentRepo) error {
+	// Track which repos are imported, so that they can be compared
+	// against the direct dependencies reported by the module
+	// extension.
+	importedRepos := p.user.importedRepos
+	if p.devDependency {
+		importedRepos = p.user.devImportedRepos
+	}
+	for _, repo := range repos {
+		importedRepos[repo.String()] = struct{}{}
+	}
 	return nil
 }
 
@@ -130,6 +144,9 @@ func (h *usedModuleExtensionExtractingModuleDotBazelHandler) UseExtension(extens
 			message: model_analysis_pb.ModuleExtension{
 				Identifier: moduleExtensionIdentifierStr,
 			},
+			importsMessage: model_analysis_pb.ModuleExtensionImports{
+				Identifier: moduleExtensionIdentifierStr,
+			},
 			users: map[label.ModuleInstance]*moduleExtensionUser{},
 		}
 		h.usedModuleExtensions[moduleExtensionName] = ume
@@ -142,10 +159,17 @@ func (h *usedModuleExtensionExtractingModuleDotBazelHandler) UseExtension(extens
 				ModuleInstance: h.moduleInstance.String(),
 				IsRoot:         h.isRoot,
 			},
-			tagClasses: map[string]*model_analysis_pb.ModuleExtension_TagClass{},
+			importsMessage: model_analysis_pb.ModuleExtensionImports_User{
+				ModuleInstance: h.moduleInstance.String(),
+				IsRoot:         h.isRoot,
+			},
+			tagClasses:       map[string]*model_analysis_pb.ModuleExtension_TagClass{},
+			importedRepos:    map[string]struct{}{},
+			devImportedRepos: map[string]struct{}{},
 		}
 		ume.users[h.moduleInstance] = meu
 		ume.message.Users = append(ume.message.Users, &meu.message)
+		ume.importsMessage.Users = append(ume.importsMessage.Users, &meu.importsMessage)
 	}
 
 	return &usedModuleExtensionProxy{
@@ -187,26 +211,33 @@ func (c *baseComputer) ComputeUsedModuleExtensionsValue(ctx context.Context, key
 		return PatchedUsedModuleExtensionsValue{}, err
 	}
 
-	// Sort and populate tag classes of each module extension user.
+	// Sort and populate tag classes and imported repos of each
+	// module extension user.
 	for _, ume := range usedModuleExtensions {
 		for _, meu := range ume.users {
 			meu.message.TagClasses = make([]*model_analysis_pb.ModuleExtension_TagClass, 0, len(meu.tagClasses))
 			for _, name := range slices.Sorted(maps.Keys(meu.tagClasses)) {
 				meu.message.TagClasses = append(meu.message.TagClasses, meu.tagClasses[name])
 			}
+			meu.importsMessage.ImportedRepos = slices.Sorted(maps.Keys(meu.importedRepos))
+			meu.importsMessage.DevImportedRepos = slices.Sorted(maps.Keys(meu.devImportedRepos))
 		}
 	}
 
 	sortedModuleExtensions := make([]*model_analysis_pb.ModuleExtension, 0, len(usedModuleExtensions))
+	sortedModuleExtensionImports := make([]*model_analysis_pb.ModuleExtensionImports, 0, len(usedModuleExtensions))
 	for _, name := range slices.SortedFunc(
 		maps.Keys(usedModuleExtensions),
 		func(a, b label.ModuleExtension) int { return strings.Compare(a.String(), b.String()) },
 	) {
-		sortedModuleExtensions = append(sortedModuleExtensions, &usedModuleExtensions[name].message)
+		ume := usedModuleExtensions[name]
+		sortedModuleExtensions = append(sortedModuleExtensions, &ume.message)
+		sortedModuleExtensionImports = append(sortedModuleExtensionImports, &ume.importsMessage)
 	}
 	return model_core.NewPatchedMessage(
 		&model_analysis_pb.UsedModuleExtensions_Value{
-			ModuleExtensions: sortedModuleExtensions,
+			ModuleExtensions:       sortedModuleExtensions,
+			ModuleExtensionImports: sortedModuleExtensionImports,
 		},
 		options.patcher,
 	), nil
@@ -7,6 +7,7 @@ import (
 	"iter"
 	"maps"
 	"slices"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -27,10 +28,9 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
 )
 
-var commandLineOptionRepoRootPackage = label.MustNewCanonicalPackage("@@bazel_tools+")
-
 type expectedTransitionOutput struct {
 	label         string
 	key           string
@@ -204,6 +204,97 @@ func (c *baseComputer) applyTransition(
 	), nil
 }
 
+type getExpectedTransitionOutputEnvironment interface {
+	GetCompiledBzlFileGlobalValue(*model_analysis_pb.CompiledBzlFileGlobal_Key) model_core.Message[*model_analysis_pb.CompiledBzlFileGlobal_Value]
+	GetTargetValue(*model_analysis_pb.Target_Key) model_core.Message[*model_analysis_pb.Target_Value]
+	GetVisibleTargetValue(model_core.PatchedMessage[*model_analysis_pb.VisibleTarget_Key, dag.ObjectContentsWalker]) model_core.Message[*model_analysis_pb.VisibleTarget_Value]
+}
+
+// getExpectedTransitionOutput resolves the build setting to which a
+// transition writes, and determines how values associated with the
+// build setting need to be canonicalized.
+func getExpectedTransitionOutput(e getExpectedTransitionOutputEnvironment, transitionPackage label.CanonicalPackage, canonicalBuildSettingLabel label.CanonicalLabel, key string) (expectedTransitionOutput, error) {
+	visibleTargetValue := e.GetVisibleTargetValue(
+		model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](
+			&model_analysis_pb.VisibleTarget_Key{
+				FromPackage:        canonicalBuildSettingLabel.GetCanonicalPackage().String(),
+				ToLabel:            canonicalBuildSettingLabel.String(),
+				StopAtLabelSetting: true,
+			},
+		),
+	)
+	if !visibleTargetValue.IsSet() {
+		return expectedTransitionOutput{}, evaluation.ErrMissingDependency
+	}
+	visibleBuildSettingLabel := visibleTargetValue.Message.Label
+
+	// Determine how values associated with this build setting need
+	// to be canonicalized.
+	targetValue := e.GetTargetValue(&model_analysis_pb.Target_Key{
+		Label: visibleBuildSettingLabel,
+	})
+	if !targetValue.IsSet() {
+		return expectedTransitionOutput{}, evaluation.ErrMissingDependency
+	}
+	var canonicalizer unpack.Canonicalizer
+	var defaultValue model_core.Message[*model_starlark_pb.Value]
+	switch targetKind := targetValue.Message.Definition.GetKind().(type) {
+	case *model_starlark_pb.Target_Definition_LabelSetting:
+		// Build setting is a label_setting() or label_flag().
+		canonicalizer = model_starlark.NewLabelOrStringUnpackerInto(transitionPackage)
+		defaultValue = model_core.NewSimpleMessage(&model_starlark_pb.Value{
+			Kind: &model_starlark_pb.Value_Label{
+				Label: targetKind.LabelSetting.BuildSettingDefault,
+			},
+		})
+	case *model_starlark_pb.Target_Definition_RuleTarget:
+		// Build setting is written in Starlark.
+		if targetKind.RuleTarget.BuildSettingDefault == nil {
+			return expectedTransitionOutput{}, fmt.Errorf("rule %#v used by label setting %#v does not have \"build_setting\" set", targetKind.RuleTarget.RuleIdentifier, visibleBuildSettingLabel)
+		}
+		ruleValue := e.GetCompiledBzlFileGlobalValue(&model_analysis_pb.CompiledBzlFileGlobal_Key{
+			Identifier: targetKind.RuleTarget.RuleIdentifier,
+		})
+		if !ruleValue.IsSet() {
+			return expectedTransitionOutput{}, evaluation.ErrMissingDependency
+		}
+		rule, ok := ruleValue.Message.Global.Kind.(*model_starlark_pb.Value_Rule)
+		if !ok {
+			return expectedTransitionOutput{}, fmt.Errorf("identifier %#v used by build setting %#v is not a rule", targetKind.RuleTarget.RuleIdentifier, visibleBuildSettingLabel)
+		}
+		ruleDefinition, ok := rule.Rule.Kind.(*model_starlark_pb.Rule_Definition_)
+		if !ok {
+			return expectedTransitionOutput{}, fmt.Errorf("rule %#v used by build setting %#v does not have a definition", targetKind.RuleTarget.RuleIdentifier, visibleBuildSettingLabel)
+		}
+		if ruleDefinition.Definition.BuildSetting == nil {
+			return expectedTransitionOutput{}, fmt.Errorf("rule %#v used by build setting %#v does not have \"build_setting\" set", targetKind.RuleTarget.RuleIdentifier, visibleBuildSettingLabel)
+		}
+		buildSettingType, err := model_starlark.DecodeBuildSettingType(ruleDefinition.Definition.BuildSetting)
+		if err != nil {
+			return expectedTransitionOutput{}, fmt.Errorf("failed to decode build setting type for rule %#v used by build setting %#v: %w", targetKind.RuleTarget.RuleIdentifier, visibleBuildSettingLabel, err)
+		}
+		canonicalizer = buildSettingType.GetCanonicalizer()
+		defaultValue, _ = model_core.NewPatchedMessageFromExisting(
+			model_core.Message[*model_starlark_pb.Value]{
+				Message:            targetKind.RuleTarget.BuildSettingDefault,
+				OutgoingReferences: targetValue.OutgoingReferences,
+			},
+			func(index int) dag.ObjectContentsWalker {
+				return dag.ExistingObjectContentsWalker
+			},
+		).SortAndSetReferences()
+	default:
+		return expectedTransitionOutput{}, fmt.Errorf("target %#v is not a label setting or rule target", visibleBuildSettingLabel)
+	}
+
+	return expectedTransitionOutput{
+		label:         visibleBuildSettingLabel,
+		key:           key,
+		canonicalizer: canonicalizer,
+		defaultValue:  defaultValue,
+	}, nil
+}
+
 func (c *baseComputer) ComputeUserDefinedTransitionValue(ctx context.Context, key model_core.Message[*model_analysis_pb.UserDefinedTransition_Key], e UserDefinedTransitionEnvironment) (PatchedUserDefinedTransitionValue, error) {
 	transitionIdentifier, err := label.NewCanonicalStarlarkIdentifier(key.Message.TransitionIdentifier)
 	if err != nil {
@@ -259,7 +350,7 @@ func (c *baseComputer) ComputeUserDefinedTransitionValue(ctx context.Context, ke
 		// transition definition.
 		pkg := transitionPackage
 		if strings.HasPrefix(input, "//command_line_option:") {
-			pkg = commandLineOptionRepoRootPackage
+			pkg = model_starlark.CommandLineOptionRepoRootPackage
 		}
 		apparentBuildSettingLabel, err := pkg.AppendLabel(input)
 		if err != nil {
@@ -393,7 +484,7 @@ func (c *baseComputer) ComputeUserDefinedTransitionValue(ctx context.Context, ke
 		// transition definition.
 		pkg := transitionPackage
 		if strings.HasPrefix(output, "//command_line_option:") {
-			pkg = commandLineOptionRepoRootPackage
+			pkg = model_starlark.CommandLineOptionRepoRootPackage
 		}
 		apparentBuildSettingLabel, err := pkg.AppendLabel(output)
 		if err != nil {
@@ -407,92 +498,20 @@ func (c *baseComputer) ComputeUserDefinedTransitionValue(ctx context.Context, ke
 			}
 			return PatchedUserDefinedTransitionValue{}, err
 		}
-		visibleTargetValue := e.GetVisibleTargetValue(
-			model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](
-				&model_analysis_pb.VisibleTarget_Key{
-					FromPackage:        canonicalBuildSettingLabel.GetCanonicalPackage().String(),
-					ToLabel:            canonicalBuildSettingLabel.String(),
-					StopAtLabelSetting: true,
-				},
-			),
-		)
-		if !visibleTargetValue.IsSet() {
-			missingDependencies = true
-			continue
-		}
-		visibleBuildSettingLabel := visibleTargetValue.Message.Label
-
-		// Determine how values associated with this build
-		// setting need to be canonicalized.
-		targetValue := e.GetTargetValue(&model_analysis_pb.Target_Key{
-			Label: visibleBuildSettingLabel,
-		})
-		if !targetValue.IsSet() {
-			missingDependencies = true
-			continue
-		}
-		var canonicalizer unpack.Canonicalizer
-		var defaultValue model_core.Message[*model_starlark_pb.Value]
-		switch targetKind := targetValue.Message.Definition.GetKind().(type) {
-		case *model_starlark_pb.Target_Definition_LabelSetting:
-			// Build setting is a label_setting() or label_flag().
-			canonicalizer = model_starlark.NewLabelOrStringUnpackerInto(transitionPackage)
-			defaultValue = model_core.NewSimpleMessage(&model_starlark_pb.Value{
-				Kind: &model_starlark_pb.Value_Label{
-					Label: targetKind.LabelSetting.BuildSettingDefault,
-				},
-			})
-		case *model_starlark_pb.Target_Definition_RuleTarget:
-			// Build setting is written in Starlark.
-			if targetKind.RuleTarget.BuildSettingDefault == nil {
-				return PatchedUserDefinedTransitionValue{}, fmt.Errorf("rule %#v used by label setting %#v does not have \"build_setting\" set", targetKind.RuleTarget.RuleIdentifier, visibleBuildSettingLabel)
-			}
-			ruleValue := e.GetCompiledBzlFileGlobalValue(&model_analysis_pb.CompiledBzlFileGlobal_Key{
-				Identifier: targetKind.RuleTarget.RuleIdentifier,
-			})
-			if !ruleValue.IsSet() {
+		expectedOutput, err := getExpectedTransitionOutput(e, transitionPackage, canonicalBuildSettingLabel, output)
+		if err != nil {
+			if errors.Is(err, evaluation.ErrMissingDependency) {
 				missingDependencies = true
 				continue
 			}
-			rule, ok := ruleValue.Message.Global.Kind.(*model_starlark_pb.Value_Rule)
-			if !ok {
-				return PatchedUserDefinedTransitionValue{}, fmt.Errorf("identifier %#v used by build setting %#v is not a rule", targetKind.RuleTarget.RuleIdentifier, visibleBuildSettingLabel)
-			}
-			ruleDefinition, ok := rule.Rule.Kind.(*model_starlark_pb.Rule_Definition_)
-			if !ok {
-				return PatchedUserDefinedTransitionValue{}, fmt.Errorf("rule %#v used by build setting %#v does not have a definition", targetKind.RuleTarget.RuleIdentifier, visibleBuildSettingLabel)
-			}
-			if ruleDefinition.Definition.BuildSetting == nil {
-				return PatchedUserDefinedTransitionValue{}, fmt.Errorf("rule %#v used by build setting %#v does not have \"build_setting\" set", targetKind.RuleTarget.RuleIdentifier, visibleBuildSettingLabel)
-			}
-			buildSettingType, err := model_starlark.DecodeBuildSettingType(ruleDefinition.Definition.BuildSetting)
-			if err != nil {
-				return PatchedUserDefinedTransitionValue{}, fmt.Errorf("failed to decode build setting type for rule %#v used by build setting %#v: %w", targetKind.RuleTarget.RuleIdentifier, visibleBuildSettingLabel, err)
-			}
-			canonicalizer = buildSettingType.GetCanonicalizer()
-			defaultValue, _ = model_core.NewPatchedMessageFromExisting(
-				model_core.Message[*model_starlark_pb.Value]{
-					Message:            targetKind.RuleTarget.BuildSettingDefault,
-					OutgoingReferences: targetValue.OutgoingReferences,
-				},
-				func(index int) dag.ObjectContentsWalker {
-					return dag.ExistingObjectContentsWalker
-				},
-			).SortAndSetReferences()
-		default:
-			return PatchedUserDefinedTransitionValue{}, fmt.Errorf("target %#v is not a label setting or rule target", visibleBuildSettingLabel)
+			return PatchedUserDefinedTransitionValue{}, err
 		}
 
-		if existing, ok := expectedOutputLabels[visibleBuildSettingLabel]; ok {
-			return PatchedUserDefinedTransitionValue{}, fmt.Errorf("outputs %#v and %#v both refer to build setting %#v", existing, output, visibleBuildSettingLabel)
+		if existing, ok := expectedOutputLabels[expectedOutput.label]; ok {
+			return PatchedUserDefinedTransitionValue{}, fmt.Errorf("outputs %#v and %#v both refer to build setting %#v", existing, output, expectedOutput.label)
 		}
-		expectedOutputLabels[visibleBuildSettingLabel] = output
-		expectedOutputs = append(expectedOutputs, expectedTransitionOutput{
-			label:         visibleBuildSettingLabel,
-			key:           output,
-			canonicalizer: canonicalizer,
-			defaultValue:  defaultValue,
-		})
+		expectedOutputLabels[expectedOutput.label] = output
+		expectedOutputs = append(expectedOutputs, expectedOutput)
 	}
 	slices.SortFunc(expectedOutputs, func(a, b expectedTransitionOutput) int {
 		return strings.Compare(a.label, b.label)
@@ -516,11 +535,30 @@ func (c *baseComputer) ComputeUserDefinedTransitionValue(ctx context.Context, ke
 		),
 		/* args = */ starlark.Tuple{
 			inputs,
-			stubbedTransitionAttr{},
+			&transitionAttr{
+				computer: c,
+				context:  ctx,
+				attrValues: model_core.Message[[]*model_analysis_pb.UserDefinedTransition_Key_AttrValue]{
+					Message:            key.Message.AttrValues,
+					OutgoingReferences: key.OutgoingReferences,
+				},
+				decodedValues: map[string]starlark.Value{},
+			},
 		},
 		/* kwargs = */ nil,
 	)
 	if err != nil {
+		// If the implementation function accessed an attr
+		// whose value is not part of the key, let the caller
+		// provide it.
+		var missingAttrErr missingTransitionAttrError
+		if errors.As(err, &missingAttrErr) {
+			return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&model_analysis_pb.UserDefinedTransition_Value{
+				Result: &model_analysis_pb.UserDefinedTransition_Value_MissingAttr{
+					MissingAttr: string(missingAttrErr),
+				},
+			}), nil
+		}
 		if !errors.Is(err, evaluation.ErrMissingDependency) {
 			var evalErr *starlark.EvalError
 			if errors.As(err, &evalErr) {
@@ -602,31 +640,190 @@ func (c *baseComputer) ComputeUserDefinedTransitionValue(ctx context.Context, ke
 	}, nil
 }
 
-type stubbedTransitionAttr struct{}
+// missingTransitionAttrError is returned by transitionAttr if the
+// implementation function of a transition accesses an attr whose value
+// is not provided.
+type missingTransitionAttrError string
+
+func (e missingTransitionAttrError) Error() string {
+	return fmt.Sprintf("value of attr %#v is not available", string(e))
+}
+
+// transitionAttr is the attr object that is provided to the
+// implementation function of a transition. It provides access to the
+// values of the attrs that are part of the UserDefinedTransition key.
+type transitionAttr struct {
+	computer      *baseComputer
+	context       context.Context
+	attrValues    model_core.Message[[]*model_analysis_pb.UserDefinedTransition_Key_AttrValue]
+	decodedValues map[string]starlark.Value
+}
 
-var _ starlark.Mapping = stubbedTransitionAttr{}
+var _ starlark.HasAttrs = (*transitionAttr)(nil)
 
-func (stubbedTransitionAttr) String() string {
+func (transitionAttr) String() string {
 	return "<transition_attr>"
 }
 
-func (stubbedTransitionAttr) Type() string {
+func (transitionAttr) Type() string {
 	return "transition_attr"
 }
 
-func (stubbedTransitionAttr) Freeze() {}
+func (transitionAttr) Freeze() {}
 
-func (stubbedTransitionAttr) Truth() starlark.Bool {
+func (transitionAttr) Truth() starlark.Bool {
 	return starlark.True
 }
 
-func (stubbedTransitionAttr) Hash(thread *starlark.Thread) (uint32, error) {
+func (transitionAttr) Hash(thread *starlark.Thread) (uint32, error) {
 	return 0, errors.New("transition_attr cannot be hashed")
 }
 
-func (stubbedTransitionAttr) Get(*starlark.Thread, starlark.Value) (starlark.Value, bool, error) {
-	// TODO: This should return an error that
-	// ComputeUserDefinedTransitionValue() catches and turns into a
-	// special response.
-	return nil, false, errors.New("transition depends on rule attrs, which are not available in this context")
+func (ta *transitionAttr) Attr(thread *starlark.Thread, name string) (starlark.Value, error) {
+	if v, ok := ta.decodedValues[name]; ok {
+		return v, nil
+	}
+
+	attrValues := ta.attrValues.Message
+	attrValueIndex, ok := sort.Find(
+		len(attrValues),
+		func(i int) int { return strings.Compare(name, attrValues[i].Name) },
+	)
+	if !ok {
+		return nil, missingTransitionAttrError(name)
+	}
+	valueParts := attrValues[attrValueIndex].ValueParts
+	if len(valueParts) == 0 {
+		// Target does not have an attr with this name.
+		return nil, nil
+	}
+
+	// Decode the value, concatenating the parts if select() was used.
+	var v starlark.Value
+	for _, valuePart := range valueParts {
+		decodedPart, err := model_starlark.DecodeValue(
+			model_core.Message[*model_starlark_pb.Value]{
+				Message:            valuePart,
+				OutgoingReferences: ta.attrValues.OutgoingReferences,
+			},
+			/* currentIdentifier = */ nil,
+			ta.computer.getValueDecodingOptions(ta.context, func(resolvedLabel label.ResolvedLabel) (starlark.Value, error) {
+				return model_starlark.NewLabel(resolvedLabel), nil
+			}),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode value of attr %#v: %w", name, err)
+		}
+		if v == nil {
+			v = decodedPart
+		} else {
+			concatenationOperator := syntax.PLUS
+			if _, ok := v.(*starlark.Dict); ok {
+				concatenationOperator = syntax.PIPE
+			}
+			v, err = starlark.Binary(thread, concatenationOperator, v, decodedPart)
+			if err != nil {
+				return nil, fmt.Errorf("failed to concatenate value parts of attr %#v: %w", name, err)
+			}
+		}
+	}
+	v.Freeze()
+	ta.decodedValues[name] = v
+	return v, nil
+}
+
+func (ta *transitionAttr) AttrNames() []string {
+	var attrNames []string
+	for _, attrValue := range ta.attrValues.Message {
+		if len(attrValue.ValueParts) > 0 {
+			attrNames = append(attrNames, attrValue.Name)
+		}
+	}
+	return attrNames
+}
+
+type getUserDefinedTransitionEnvironment interface {
+	GetUserDefinedTransitionValue(key model_core.PatchedMessage[*model_analysis_pb.UserDefinedTransition_Key, dag.ObjectContentsWalker]) model_core.Message[*model_analysis_pb.UserDefinedTransition_Value]
+}
+
+// getUserDefinedTransition computes the output configurations of a
+// user defined transition. If the implementation function of the
+// transition accesses attrs of the target to which the transition is
+// applied, their values are obtained through getAttrValueParts, and
+// the transition is requested once more with these values added to
+// the key.
+func getUserDefinedTransition(
+	e getUserDefinedTransitionEnvironment,
+	transitionIdentifier string,
+	inputConfigurationReference model_core.Message[*model_core_pb.Reference],
+	getAttrValueParts func(name string) (model_core.Message[[]*model_starlark_pb.Value], error),
+) (model_core.Message[*model_analysis_pb.UserDefinedTransition_Value_Success], error) {
+	var attrValues []model_core.Message[*model_analysis_pb.UserDefinedTransition_Key_AttrValue]
+	for {
+		patcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
+		patchedInputConfigurationReference := model_core.NewPatchedMessageFromExisting(
+			inputConfigurationReference,
+			func(index int) dag.ObjectContentsWalker {
+				return dag.ExistingObjectContentsWalker
+			},
+		)
+		patcher.Merge(patchedInputConfigurationReference.Patcher)
+		keyAttrValues := make([]*model_analysis_pb.UserDefinedTransition_Key_AttrValue, 0, len(attrValues))
+		for _, attrValue := range attrValues {
+			patchedAttrValue := model_core.NewPatchedMessageFromExisting(
+				attrValue,
+				func(index int) dag.ObjectContentsWalker {
+					return dag.ExistingObjectContentsWalker
+				},
+			)
+			keyAttrValues = append(keyAttrValues, patchedAttrValue.Message)
+			patcher.Merge(patchedAttrValue.Patcher)
+		}
+
+		transitionValue := e.GetUserDefinedTransitionValue(
+			model_core.NewPatchedMessage(
+				&model_analysis_pb.UserDefinedTransition_Key{
+					TransitionIdentifier:        transitionIdentifier,
+					InputConfigurationReference: patchedInputConfigurationReference.Message,
+					AttrValues:                  keyAttrValues,
+				},
+				patcher,
+			),
+		)
+		if !transitionValue.IsSet() {
+			return model_core.Message[*model_analysis_pb.UserDefinedTransition_Value_Success]{}, evaluation.ErrMissingDependency
+		}
+
+		switch result := transitionValue.Message.Result.(type) {
+		case *model_analysis_pb.UserDefinedTransition_Value_MissingAttr:
+			// Transition accessed an attr. Obtain its value
+			// and retry. Keep attr values sorted by name.
+			name := result.MissingAttr
+			index, found := sort.Find(
+				len(attrValues),
+				func(i int) int { return strings.Compare(name, attrValues[i].Message.Name) },
+			)
+			if found {
+				return model_core.Message[*model_analysis_pb.UserDefinedTransition_Value_Success]{}, fmt.Errorf("transition %#v requested the value of attr %#v, even though it was provided", transitionIdentifier, name)
+			}
+			valueParts, err := getAttrValueParts(name)
+			if err != nil {
+				return model_core.Message[*model_analysis_pb.UserDefinedTransition_Value_Success]{}, fmt.Errorf("failed to obtain value of attr %#v for transition %#v: %w", name, transitionIdentifier, err)
+			}
+			attrValues = slices.Insert(attrValues, index, model_core.Message[*model_analysis_pb.UserDefinedTransition_Key_AttrValue]{
+				Message: &model_analysis_pb.UserDefinedTransition_Key_AttrValue{
+					Name:       name,
+					ValueParts: valueParts.Message,
+				},
+				OutgoingReferences: valueParts.OutgoingReferences,
+			})
+		case *model_analysis_pb.UserDefinedTransition_Value_Success_:
+			return model_core.Message[*model_analysis_pb.UserDefinedTransition_Value_Success]{
+				Message:            result.Success,
+				OutgoingReferences: transitionValue.OutgoingReferences,
+			}, nil
+		default:
+			return model_core.Message[*model_analysis_pb.UserDefinedTransition_Value_Success]{}, fmt.Errorf("transition %#v uses an unknown result type", transitionIdentifier)
+		}
+	}
 }
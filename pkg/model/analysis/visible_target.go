@@ -58,18 +58,19 @@ func getValueFromSelectGroup(e getValueFromSelectGroupEnvironment, selectGroup *
 	}
 }
 
-func checkVisibility(fromPackage label.CanonicalPackage, toLabel label.CanonicalLabel, toLabelVisibility model_core.Message[*model_starlark_pb.PackageGroup]) error {
-	// Always permit access from within the same package.
-	if fromPackage == toLabel.GetCanonicalPackage() {
-		return nil
-	}
+// packageGroupTreeContainsPackage returns whether a package is
+// contained in the tree of packages stored in a PackageGroup message.
+// Included package groups are not considered.
+func (c *baseComputer) packageGroupTreeContainsPackage(ctx context.Context, canonicalPackage label.CanonicalPackage, tree model_core.Message[*model_starlark_pb.PackageGroup_Subpackages]) (bool, error) {
+	overridesReader := model_parser.NewStorageBackedParsedObjectReader(
+		c.objectDownloader,
+		c.getValueObjectEncoder(),
+		model_parser.NewMessageObjectParser[object.LocalReference, model_starlark_pb.PackageGroup_Subpackages_Overrides](),
+	)
 
-	subpackages := model_core.Message[*model_starlark_pb.PackageGroup_Subpackages]{
-		Message:            toLabelVisibility.Message.Tree,
-		OutgoingReferences: toLabelVisibility.OutgoingReferences,
-	}
-	component := fromPackage.GetCanonicalRepo().String()
-	fromPackagePath := fromPackage.GetPackagePath()
+	subpackages := tree
+	component := canonicalPackage.GetCanonicalRepo().String()
+	packagePath := canonicalPackage.GetPackagePath()
 	for {
 		// Determine whether there are any overrides present at
 		// this level in the tree.
@@ -81,11 +82,18 @@ func checkVisibility(fromPackage label.CanonicalPackage, toLabel label.Canonical
 				OutgoingReferences: subpackages.OutgoingReferences,
 			}
 		case *model_starlark_pb.PackageGroup_Subpackages_OverridesExternal:
-			return errors.New("TODO: Download external overrides!")
+			index, err := model_core.GetIndexFromReferenceMessage(o.OverridesExternal, subpackages.OutgoingReferences.GetDegree())
+			if err != nil {
+				return false, fmt.Errorf("invalid overrides reference: %w", err)
+			}
+			overrides, _, err = overridesReader.ReadParsedObject(ctx, subpackages.OutgoingReferences.GetOutgoingReference(index))
+			if err != nil {
+				return false, fmt.Errorf("failed to read overrides: %w", err)
+			}
 		case nil:
 			// No overrides present.
 		default:
-			return errors.New("invalid overrides type")
+			return false, errors.New("invalid overrides type")
 		}
 
 		packages := overrides.Message.GetPackages()
@@ -96,50 +104,116 @@ func checkVisibility(fromPackage label.CanonicalPackage, toLabel label.Canonical
 		if !ok {
 			// No override is in place for this specific
 			// component. Consider include_subpackages.
-			//
-			// TODO: We should consider included package groups!
-			if !subpackages.Message.GetIncludeSubpackages() {
-				return fmt.Errorf("target %#v is not visible from package %#v", toLabel.String(), fromPackage.String())
-			}
-			return nil
+			return subpackages.Message.GetIncludeSubpackages(), nil
 		}
 
 		// An override is in place for this specific component.
 		// Continue traversal.
 		p := packages[packageIndex]
+		if packagePath == "" {
+			// Fully resolved the package name. Consider
+			// include_package.
+			return p.IncludePackage, nil
+		}
 		subpackages = model_core.Message[*model_starlark_pb.PackageGroup_Subpackages]{
 			Message:            p.Subpackages,
 			OutgoingReferences: overrides.OutgoingReferences,
 		}
 
-		if fromPackagePath == "" {
-			// Fully resolved the package name. Consider
-			// include_package.
-			//
-			// TODO: We should consider included package groups!
-			if !p.IncludePackage {
-				return fmt.Errorf("target %#v is not visible from package %#v", toLabel.String(), fromPackage.String())
-			}
-			return nil
-		}
-
 		// Extract the next component.
-		if split := strings.IndexByte(fromPackagePath, '/'); split < 0 {
-			component = fromPackagePath
-			fromPackagePath = ""
+		if split := strings.IndexByte(packagePath, '/'); split < 0 {
+			component = packagePath
+			packagePath = ""
 		} else {
-			component = fromPackagePath[:split]
-			fromPackagePath = fromPackagePath[split+1:]
+			component = packagePath[:split]
+			packagePath = packagePath[split+1:]
+		}
+	}
+}
+
+type packageGroupContainsPackageEnvironment interface {
+	GetTargetValue(*model_analysis_pb.Target_Key) model_core.Message[*model_analysis_pb.Target_Value]
+}
+
+// packageGroupContainsPackage returns whether a package is contained in
+// a package group. In addition to the tree of packages stored in the
+// package group, any package groups that are included are considered.
+func (c *baseComputer) packageGroupContainsPackage(ctx context.Context, e packageGroupContainsPackageEnvironment, canonicalPackage label.CanonicalPackage, packageGroup model_core.Message[*model_starlark_pb.PackageGroup]) (bool, error) {
+	// Package groups may include each other cyclically. Traverse
+	// them breadth first, only visiting each package group once.
+	packageGroups := []model_core.Message[*model_starlark_pb.PackageGroup]{packageGroup}
+	seenPackageGroups := map[string]struct{}{}
+	missingDependencies := false
+	for len(packageGroups) > 0 {
+		packageGroup := packageGroups[0]
+		packageGroups = packageGroups[1:]
+
+		if contained, err := c.packageGroupTreeContainsPackage(
+			ctx,
+			canonicalPackage,
+			model_core.Message[*model_starlark_pb.PackageGroup_Subpackages]{
+				Message:            packageGroup.Message.Tree,
+				OutgoingReferences: packageGroup.OutgoingReferences,
+			},
+		); err != nil || contained {
+			return contained, err
+		}
+
+		for _, includedLabel := range packageGroup.Message.IncludePackageGroups {
+			if _, ok := seenPackageGroups[includedLabel]; ok {
+				continue
+			}
+			seenPackageGroups[includedLabel] = struct{}{}
+
+			includedTarget := e.GetTargetValue(&model_analysis_pb.Target_Key{
+				Label: includedLabel,
+			})
+			if !includedTarget.IsSet() {
+				missingDependencies = true
+				continue
+			}
+			includedPackageGroup, ok := includedTarget.Message.Definition.GetKind().(*model_starlark_pb.Target_Definition_PackageGroup)
+			if !ok {
+				return false, fmt.Errorf("included target %#v is not a package group", includedLabel)
+			}
+			packageGroups = append(packageGroups, model_core.Message[*model_starlark_pb.PackageGroup]{
+				Message:            includedPackageGroup.PackageGroup,
+				OutgoingReferences: includedTarget.OutgoingReferences,
+			})
 		}
 	}
+	if missingDependencies {
+		return false, evaluation.ErrMissingDependency
+	}
+	return false, nil
 }
 
-func checkRuleTargetVisibility(fromPackage label.CanonicalPackage, ruleTargetLabel label.CanonicalLabel, ruleTarget model_core.Message[*model_starlark_pb.RuleTarget]) error {
+// checkVisibility returns an error if a target is not visible from a
+// given package.
+func (c *baseComputer) checkVisibility(ctx context.Context, e packageGroupContainsPackageEnvironment, fromPackage label.CanonicalPackage, toLabel label.CanonicalLabel, toLabelVisibility model_core.Message[*model_starlark_pb.PackageGroup]) error {
+	// Always permit access from within the same package.
+	if fromPackage == toLabel.GetCanonicalPackage() {
+		return nil
+	}
+
+	visible, err := c.packageGroupContainsPackage(ctx, e, fromPackage, toLabelVisibility)
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return fmt.Errorf("target %#v is not visible from package %#v, as the package is not included in the target's visibility", toLabel.String(), fromPackage.String())
+	}
+	return nil
+}
+
+func (c *baseComputer) checkRuleTargetVisibility(ctx context.Context, e packageGroupContainsPackageEnvironment, fromPackage label.CanonicalPackage, ruleTargetLabel label.CanonicalLabel, ruleTarget model_core.Message[*model_starlark_pb.RuleTarget]) error {
 	inheritableAttrs := ruleTarget.Message.InheritableAttrs
 	if inheritableAttrs == nil {
 		return fmt.Errorf("rule target %#v has no inheritable attrs", ruleTargetLabel)
 	}
-	return checkVisibility(
+	return c.checkVisibility(
+		ctx,
+		e,
 		fromPackage,
 		ruleTargetLabel,
 		model_core.Message[*model_starlark_pb.PackageGroup]{
@@ -173,7 +247,9 @@ func (c *baseComputer) ComputeVisibleTargetValue(ctx context.Context, key model_
 
 	switch definition := targetValue.Message.Definition.GetKind().(type) {
 	case *model_starlark_pb.Target_Definition_Alias:
-		if err := checkVisibility(
+		if err := c.checkVisibility(
+			ctx,
+			e,
 			fromPackage,
 			toLabel,
 			model_core.Message[*model_starlark_pb.PackageGroup]{
@@ -369,7 +445,9 @@ func (c *baseComputer) ComputeVisibleTargetValue(ctx context.Context, key model_
 		if !ok {
 			return PatchedVisibleTargetValue{}, fmt.Errorf("owner %#v is not a rule target", ownerLabelStr)
 		}
-		if err := checkRuleTargetVisibility(
+		if err := c.checkRuleTargetVisibility(
+			ctx,
+			e,
 			fromPackage,
 			ownerLabel,
 			model_core.Message[*model_starlark_pb.RuleTarget]{
@@ -387,7 +465,9 @@ func (c *baseComputer) ComputeVisibleTargetValue(ctx context.Context, key model_
 			},
 		), nil
 	case *model_starlark_pb.Target_Definition_RuleTarget:
-		if err := checkRuleTargetVisibility(
+		if err := c.checkRuleTargetVisibility(
+			ctx,
+			e,
 			fromPackage,
 			toLabel,
 			model_core.Message[*model_starlark_pb.RuleTarget]{
@@ -405,7 +485,9 @@ func (c *baseComputer) ComputeVisibleTargetValue(ctx context.Context, key model_
 			},
 		), nil
 	case *model_starlark_pb.Target_Definition_SourceFileTarget:
-		if err := checkVisibility(
+		if err := c.checkVisibility(
+			ctx,
+			e,
 			fromPackage,
 			toLabel,
 			model_core.Message[*model_starlark_pb.PackageGroup]{
@@ -0,0 +1,100 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/label"
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	"github.com/buildbarn/bonanza/pkg/model/core/inlinedtree"
+	model_encoding "github.com/buildbarn/bonanza/pkg/model/encoding"
+	model_starlark "github.com/buildbarn/bonanza/pkg/model/starlark"
+	model_analysis_pb "github.com/buildbarn/bonanza/pkg/proto/model/analysis"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	object_pb "github.com/buildbarn/bonanza/pkg/proto/storage/object"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+// newTestPackageGroup creates a PackageGroup message that matches the
+// provided package specifications. The resulting message is small
+// enough to be inlined entirely.
+func newTestPackageGroup(t *testing.T, specifications ...model_starlark.PackageSpecification) *model_starlark_pb.PackageGroup {
+	packageGroup, err := model_starlark.NewPackageGroupFromPackageSpecifications(
+		specifications,
+		/* includePackageGroups = */ nil,
+		&inlinedtree.Options{
+			ReferenceFormat:  object.MustNewReferenceFormat(object_pb.ReferenceFormat_SHA256_V1),
+			Encoder:          model_encoding.NewChainedBinaryEncoder(nil),
+			MaximumSizeBytes: 16 * 1024,
+		},
+	)
+	require.NoError(t, err)
+	return packageGroup.Message
+}
+
+func TestComputeVisibleTargetValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	c := newTestBaseComputer(testObjectDownloader{})
+
+	e := NewMockVisibleTargetEnvironment(ctrl)
+	e.EXPECT().GetTargetValue(testutil.EqProto(t, &model_analysis_pb.Target_Key{
+		Label: "@@example+//lib:internal",
+	})).Return(model_core.NewSimpleMessage(&model_analysis_pb.Target_Value{
+		Definition: &model_starlark_pb.Target_Definition{
+			Kind: &model_starlark_pb.Target_Definition_RuleTarget{
+				RuleTarget: &model_starlark_pb.RuleTarget{
+					RuleIdentifier: "@@example+//:rules.bzl%my_rule",
+					InheritableAttrs: &model_starlark_pb.InheritableAttrs{
+						Visibility: newTestPackageGroup(t, model_starlark.PackageSpecification{
+							Package:            label.MustNewCanonicalPackage("@@example+//lib"),
+							IncludeSubpackages: true,
+						}),
+					},
+				},
+			},
+		},
+	})).AnyTimes()
+
+	t.Run("SamePackage", func(t *testing.T) {
+		visibleTarget, err := c.ComputeVisibleTargetValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.VisibleTarget_Key{
+				FromPackage: "@@example+//lib",
+				ToLabel:     "@@example+//lib:internal",
+			}),
+			e,
+		)
+		require.NoError(t, err)
+		require.Equal(t, "@@example+//lib:internal", visibleTarget.Message.Label)
+	})
+
+	t.Run("Subpackage", func(t *testing.T) {
+		visibleTarget, err := c.ComputeVisibleTargetValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.VisibleTarget_Key{
+				FromPackage: "@@example+//lib/sub",
+				ToLabel:     "@@example+//lib:internal",
+			}),
+			e,
+		)
+		require.NoError(t, err)
+		require.Equal(t, "@@example+//lib:internal", visibleTarget.Message.Label)
+	})
+
+	t.Run("NotVisibleFromPackage", func(t *testing.T) {
+		_, err := c.ComputeVisibleTargetValue(
+			ctx,
+			model_core.NewSimpleMessage(&model_analysis_pb.VisibleTarget_Key{
+				FromPackage: "@@example+//app",
+				ToLabel:     "@@example+//lib:internal",
+			}),
+			e,
+		)
+		require.EqualError(t, err, "target \"@@example+//lib:internal\" is not visible from package \"@@example+//app\", as the package is not included in the target's visibility")
+	})
+}
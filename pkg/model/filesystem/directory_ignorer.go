@@ -0,0 +1,83 @@
+package filesystem
+
+import (
+	"path"
+	"strings"
+)
+
+// DirectoryIgnorer determines whether directories in a repo should be
+// skipped. Directories can be ignored by listing them in the root
+// module's .bazelignore file, or by calling ignore_directories() in the
+// repo's REPO.bazel file.
+//
+// The zero value of DirectoryIgnorer does not ignore any directories.
+type DirectoryIgnorer struct {
+	bazelIgnorePaths map[string]struct{}
+	ignorePatterns   [][]string
+}
+
+// AddBazelIgnore adds paths contained in a .bazelignore file to the set
+// of ignored directories. Every line contains the path of a directory
+// relative to the root of the repo. Empty lines and lines starting with
+// "#" are ignored.
+func (di *DirectoryIgnorer) AddBazelIgnore(contents string) {
+	if di.bazelIgnorePaths == nil {
+		di.bazelIgnorePaths = map[string]struct{}{}
+	}
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		di.bazelIgnorePaths[path.Clean(line)] = struct{}{}
+	}
+}
+
+// AddIgnoreDirectoriesPatterns adds glob patterns that were provided
+// to ignore_directories() in REPO.bazel to the set of ignored
+// directories.
+func (di *DirectoryIgnorer) AddIgnoreDirectoriesPatterns(patterns []string) {
+	for _, pattern := range patterns {
+		di.ignorePatterns = append(di.ignorePatterns, strings.Split(pattern, "/"))
+	}
+}
+
+// IsIgnored returns true if a directory at a given path relative to the
+// root of the repo should not be considered part of any package.
+func (di *DirectoryIgnorer) IsIgnored(directoryPath string) bool {
+	if _, ok := di.bazelIgnorePaths[directoryPath]; ok {
+		return true
+	}
+	components := strings.Split(directoryPath, "/")
+	for _, pattern := range di.ignorePatterns {
+		if matchGlobComponents(pattern, components) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlobComponents returns true if a sequence of pathname
+// components matches a glob pattern. In addition to the wildcards
+// supported by path.Match(), the pattern may contain "**" components
+// that match zero or more pathname components.
+func matchGlobComponents(pattern, components []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(components); i++ {
+				if matchGlobComponents(pattern[1:], components[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(components) == 0 {
+			return false
+		}
+		if matched, err := path.Match(pattern[0], components[0]); err != nil || !matched {
+			return false
+		}
+		pattern, components = pattern[1:], components[1:]
+	}
+	return len(components) == 0
+}
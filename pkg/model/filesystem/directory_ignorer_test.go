@@ -0,0 +1,42 @@
+package filesystem_test
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bonanza/pkg/model/filesystem"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectoryIgnorer(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		var di filesystem.DirectoryIgnorer
+		require.False(t, di.IsIgnored("foo"))
+		require.False(t, di.IsIgnored("foo/bar"))
+	})
+
+	t.Run("BazelIgnore", func(t *testing.T) {
+		var di filesystem.DirectoryIgnorer
+		di.AddBazelIgnore("# Comment\n\nnode_modules\n  third_party/foo/  \n")
+		require.True(t, di.IsIgnored("node_modules"))
+		require.True(t, di.IsIgnored("third_party/foo"))
+		require.False(t, di.IsIgnored("third_party"))
+		require.False(t, di.IsIgnored("# Comment"))
+
+		// Entries in .bazelignore are relative to the root of
+		// the repo. They should not match directories having
+		// the same name elsewhere.
+		require.False(t, di.IsIgnored("src/node_modules"))
+	})
+
+	t.Run("IgnoreDirectoriesPatterns", func(t *testing.T) {
+		var di filesystem.DirectoryIgnorer
+		di.AddIgnoreDirectoriesPatterns([]string{"**/node_modules", "bazel-*", "foo/*/bar"})
+		require.True(t, di.IsIgnored("node_modules"))
+		require.True(t, di.IsIgnored("src/app/node_modules"))
+		require.True(t, di.IsIgnored("bazel-out"))
+		require.True(t, di.IsIgnored("foo/baz/bar"))
+		require.False(t, di.IsIgnored("src/bazel-out"))
+		require.False(t, di.IsIgnored("foo/bar"))
+		require.False(t, di.IsIgnored("node_modules/foo"))
+	})
+}
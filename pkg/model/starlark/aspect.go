@@ -2,6 +2,10 @@ package starlark
 
 import (
 	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
 
 	pg_label "github.com/buildbarn/bonanza/pkg/label"
 	model_core "github.com/buildbarn/bonanza/pkg/model/core"
@@ -13,7 +17,7 @@ import (
 
 type Aspect struct {
 	LateNamedValue
-	definition *model_starlark_pb.Aspect_Definition
+	definition AspectDefinition
 }
 
 var (
@@ -21,7 +25,7 @@ var (
 	_ NamedGlobal    = &Aspect{}
 )
 
-func NewAspect(identifier *pg_label.CanonicalStarlarkIdentifier, definition *model_starlark_pb.Aspect_Definition) starlark.Value {
+func NewAspect(identifier *pg_label.CanonicalStarlarkIdentifier, definition AspectDefinition) *Aspect {
 	return &Aspect{
 		LateNamedValue: LateNamedValue{
 			Identifier: identifier,
@@ -68,16 +72,185 @@ func (a *Aspect) EncodeValue(path map[starlark.Value]struct{}, currentIdentifier
 		), false, nil
 	}
 
-	needsCode := false
-	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](
+	definition, needsCode, err := a.definition.Encode(path, options)
+	if err != nil {
+		return model_core.PatchedMessage[*model_starlark_pb.Value, dag.ObjectContentsWalker]{}, false, err
+	}
+	return model_core.NewPatchedMessage(
 		&model_starlark_pb.Value{
 			Kind: &model_starlark_pb.Value_Aspect{
 				Aspect: &model_starlark_pb.Aspect{
 					Kind: &model_starlark_pb.Aspect_Definition_{
-						Definition: &model_starlark_pb.Aspect_Definition{},
+						Definition: definition.Message,
 					},
 				},
 			},
 		},
+		definition.Patcher,
+	), needsCode, nil
+}
+
+type AspectDefinition interface {
+	Encode(path map[starlark.Value]struct{}, options *ValueEncodingOptions) (model_core.PatchedMessage[*model_starlark_pb.Aspect_Definition, dag.ObjectContentsWalker], bool, error)
+}
+
+type starlarkAspectDefinition struct {
+	attrAspects             []string
+	attrs                   map[pg_label.StarlarkIdentifier]*Attr
+	execGroups              map[string]*ExecGroup
+	implementation          NamedFunction
+	provides                []*Provider
+	requiredAspectProviders [][]*Provider
+	requiredProviders       [][]*Provider
+	requires                []*Aspect
+}
+
+func NewStarlarkAspectDefinition(
+	attrAspects []string,
+	attrs map[pg_label.StarlarkIdentifier]*Attr,
+	execGroups map[string]*ExecGroup,
+	implementation NamedFunction,
+	provides []*Provider,
+	requiredAspectProviders [][]*Provider,
+	requiredProviders [][]*Provider,
+	requires []*Aspect,
+) AspectDefinition {
+	return &starlarkAspectDefinition{
+		attrAspects:             attrAspects,
+		attrs:                   attrs,
+		execGroups:              execGroups,
+		implementation:          implementation,
+		provides:                provides,
+		requiredAspectProviders: requiredAspectProviders,
+		requiredProviders:       requiredProviders,
+		requires:                requires,
+	}
+}
+
+func (ad *starlarkAspectDefinition) Encode(path map[starlark.Value]struct{}, options *ValueEncodingOptions) (model_core.PatchedMessage[*model_starlark_pb.Aspect_Definition, dag.ObjectContentsWalker], bool, error) {
+	patcher := model_core.NewReferenceMessagePatcher[dag.ObjectContentsWalker]()
+
+	execGroups := make([]*model_starlark_pb.NamedExecGroup, 0, len(ad.execGroups))
+	for _, name := range slices.Sorted(maps.Keys(ad.execGroups)) {
+		execGroups = append(execGroups, &model_starlark_pb.NamedExecGroup{
+			Name:      name,
+			ExecGroup: ad.execGroups[name].Encode(),
+		})
+	}
+
+	implementation, needsCode, err := ad.implementation.Encode(path, options)
+	if err != nil {
+		return model_core.PatchedMessage[*model_starlark_pb.Aspect_Definition, dag.ObjectContentsWalker]{}, false, err
+	}
+	patcher.Merge(implementation.Patcher)
+
+	namedAttrs, namedAttrsNeedCode, err := encodeNamedAttrs(ad.attrs, path, options)
+	if err != nil {
+		return model_core.PatchedMessage[*model_starlark_pb.Aspect_Definition, dag.ObjectContentsWalker]{}, false, err
+	}
+	needsCode = needsCode || namedAttrsNeedCode
+	patcher.Merge(namedAttrs.Patcher)
+
+	provides, err := encodeProviderIdentifiers(ad.provides)
+	if err != nil {
+		return model_core.PatchedMessage[*model_starlark_pb.Aspect_Definition, dag.ObjectContentsWalker]{}, false, fmt.Errorf("provides: %w", err)
+	}
+	requiredAspectProviders, err := encodeProviderSets(ad.requiredAspectProviders)
+	if err != nil {
+		return model_core.PatchedMessage[*model_starlark_pb.Aspect_Definition, dag.ObjectContentsWalker]{}, false, fmt.Errorf("required_aspect_providers: %w", err)
+	}
+	requiredProviders, err := encodeProviderSets(ad.requiredProviders)
+	if err != nil {
+		return model_core.PatchedMessage[*model_starlark_pb.Aspect_Definition, dag.ObjectContentsWalker]{}, false, fmt.Errorf("required_providers: %w", err)
+	}
+	requires, err := EncodeAspectIdentifiers(ad.requires)
+	if err != nil {
+		return model_core.PatchedMessage[*model_starlark_pb.Aspect_Definition, dag.ObjectContentsWalker]{}, false, fmt.Errorf("requires: %w", err)
+	}
+
+	attrAspects := slices.Clone(ad.attrAspects)
+	sort.Strings(attrAspects)
+
+	return model_core.NewPatchedMessage(
+		&model_starlark_pb.Aspect_Definition{
+			AttrAspects:             slices.Compact(attrAspects),
+			Attrs:                   namedAttrs.Message,
+			ExecGroups:              execGroups,
+			Implementation:          implementation.Message,
+			Provides:                provides,
+			RequiredProviders:       requiredProviders,
+			RequiredAspectProviders: requiredAspectProviders,
+			Requires:                requires,
+		},
+		patcher,
 	), needsCode, nil
 }
+
+type protoAspectDefinition struct {
+	message model_core.Message[*model_starlark_pb.Aspect_Definition]
+}
+
+func NewProtoAspectDefinition(message model_core.Message[*model_starlark_pb.Aspect_Definition]) AspectDefinition {
+	return &protoAspectDefinition{
+		message: message,
+	}
+}
+
+func (ad *protoAspectDefinition) Encode(path map[starlark.Value]struct{}, options *ValueEncodingOptions) (model_core.PatchedMessage[*model_starlark_pb.Aspect_Definition, dag.ObjectContentsWalker], bool, error) {
+	panic("aspect definition was already encoded previously")
+}
+
+// EncodeAspectIdentifiers converts a list of aspects to a list of
+// identifiers, so that they can be referenced from within attrs and
+// other aspects. The order of the aspects is preserved, as it
+// determines the order in which they are applied.
+func EncodeAspectIdentifiers(aspects []*Aspect) ([]string, error) {
+	identifiers := make([]string, 0, len(aspects))
+	for i, aspect := range aspects {
+		if aspect.Identifier == nil {
+			return nil, fmt.Errorf("aspect at index %d does not have a name", i)
+		}
+		identifiers = append(identifiers, aspect.Identifier.String())
+	}
+	return identifiers, nil
+}
+
+// decodeAspectIdentifiers performs the inverse of
+// EncodeAspectIdentifiers, returning references to aspects.
+func decodeAspectIdentifiers(identifiers []string) ([]*Aspect, error) {
+	aspects := make([]*Aspect, 0, len(identifiers))
+	for _, identifierStr := range identifiers {
+		identifier, err := pg_label.NewCanonicalStarlarkIdentifier(identifierStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid aspect identifier %#v: %w", identifierStr, err)
+		}
+		aspects = append(aspects, NewAspect(&identifier, nil))
+	}
+	return aspects, nil
+}
+
+func encodeProviderIdentifiers(providers []*Provider) ([]string, error) {
+	identifiers := make([]string, 0, len(providers))
+	for i, provider := range providers {
+		if provider.Identifier == nil {
+			return nil, fmt.Errorf("provider at index %d does not have a name", i)
+		}
+		identifiers = append(identifiers, provider.Identifier.String())
+	}
+	sort.Strings(identifiers)
+	return slices.Compact(identifiers), nil
+}
+
+func encodeProviderSets(providerSets [][]*Provider) ([]*model_starlark_pb.Aspect_ProviderSet, error) {
+	encodedProviderSets := make([]*model_starlark_pb.Aspect_ProviderSet, 0, len(providerSets))
+	for i, providerSet := range providerSets {
+		providerIdentifiers, err := encodeProviderIdentifiers(providerSet)
+		if err != nil {
+			return nil, fmt.Errorf("provider set at index %d: %w", i, err)
+		}
+		encodedProviderSets = append(encodedProviderSets, &model_starlark_pb.Aspect_ProviderSet{
+			ProviderIdentifiers: providerIdentifiers,
+		})
+	}
+	return encodedProviderSets, nil
+}
@@ -245,15 +245,17 @@ type labelAttrType struct {
 	executable      bool
 	valueAllowFiles []string
 	valueCfg        TransitionDefinition
+	valueAspects    []*Aspect
 }
 
-func NewLabelAttrType(allowNone, allowSingleFile, executable bool, valueAllowFiles []string, valueCfg TransitionDefinition) AttrType {
+func NewLabelAttrType(allowNone, allowSingleFile, executable bool, valueAllowFiles []string, valueCfg TransitionDefinition, valueAspects []*Aspect) AttrType {
 	return &labelAttrType{
 		allowNone:       allowNone,
 		allowSingleFile: allowSingleFile,
 		executable:      executable,
 		valueAllowFiles: valueAllowFiles,
 		valueCfg:        valueCfg,
+		valueAspects:    valueAspects,
 	}
 }
 
@@ -266,12 +268,17 @@ func (at *labelAttrType) Encode(out *model_starlark_pb.Attr) error {
 	if err != nil {
 		return err
 	}
+	valueAspects, err := EncodeAspectIdentifiers(at.valueAspects)
+	if err != nil {
+		return err
+	}
 	out.Type = &model_starlark_pb.Attr_Label{
 		Label: &model_starlark_pb.Attr_LabelType{
 			AllowNone:       at.allowNone,
 			AllowSingleFile: at.allowSingleFile,
 			Executable:      at.executable,
 			ValueOptions: &model_starlark_pb.Attr_LabelOptions{
+				Aspects:    valueAspects,
 				AllowFiles: at.valueAllowFiles,
 				Cfg:        valueCfg,
 			},
@@ -295,12 +302,14 @@ func (labelAttrType) IsOutput() (string, bool) {
 type labelKeyedStringDictAttrType struct {
 	dictKeyAllowFiles []string
 	dictKeyCfg        TransitionDefinition
+	dictKeyAspects    []*Aspect
 }
 
-func NewLabelKeyedStringDictAttrType(dictKeyAllowFiles []string, dictKeyCfg TransitionDefinition) AttrType {
+func NewLabelKeyedStringDictAttrType(dictKeyAllowFiles []string, dictKeyCfg TransitionDefinition, dictKeyAspects []*Aspect) AttrType {
 	return &labelKeyedStringDictAttrType{
 		dictKeyAllowFiles: dictKeyAllowFiles,
 		dictKeyCfg:        dictKeyCfg,
+		dictKeyAspects:    dictKeyAspects,
 	}
 }
 
@@ -313,9 +322,14 @@ func (at *labelKeyedStringDictAttrType) Encode(out *model_starlark_pb.Attr) erro
 	if err != nil {
 		return err
 	}
+	dictKeyAspects, err := EncodeAspectIdentifiers(at.dictKeyAspects)
+	if err != nil {
+		return err
+	}
 	out.Type = &model_starlark_pb.Attr_LabelKeyedStringDict{
 		LabelKeyedStringDict: &model_starlark_pb.Attr_LabelKeyedStringDictType{
 			DictKeyOptions: &model_starlark_pb.Attr_LabelOptions{
+				Aspects:    dictKeyAspects,
 				AllowFiles: at.dictKeyAllowFiles,
 				Cfg:        dictKeyCfg,
 			},
@@ -335,12 +349,14 @@ func (labelKeyedStringDictAttrType) IsOutput() (string, bool) {
 type labelListAttrType struct {
 	listValueAllowFiles []string
 	listValueCfg        TransitionDefinition
+	listValueAspects    []*Aspect
 }
 
-func NewLabelListAttrType(listValueAllowFiles []string, listValueCfg TransitionDefinition) AttrType {
+func NewLabelListAttrType(listValueAllowFiles []string, listValueCfg TransitionDefinition, listValueAspects []*Aspect) AttrType {
 	return &labelListAttrType{
 		listValueAllowFiles: listValueAllowFiles,
 		listValueCfg:        listValueCfg,
+		listValueAspects:    listValueAspects,
 	}
 }
 
@@ -353,9 +369,14 @@ func (at *labelListAttrType) Encode(out *model_starlark_pb.Attr) error {
 	if err != nil {
 		return err
 	}
+	listValueAspects, err := EncodeAspectIdentifiers(at.listValueAspects)
+	if err != nil {
+		return err
+	}
 	out.Type = &model_starlark_pb.Attr_LabelList{
 		LabelList: &model_starlark_pb.Attr_LabelListType{
 			ListValueOptions: &model_starlark_pb.Attr_LabelOptions{
+				Aspects:    listValueAspects,
 				AllowFiles: at.listValueAllowFiles,
 				Cfg:        listValueCfg,
 			},
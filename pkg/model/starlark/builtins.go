@@ -6,6 +6,7 @@ import (
 	"maps"
 	"slices"
 	"sort"
+	"strings"
 	"unicode/utf8"
 
 	pg_label "github.com/buildbarn/bonanza/pkg/label"
@@ -68,6 +69,65 @@ const (
 	GlobExpanderKey     = "glob_expander"
 )
 
+// CommandLineOptionRepoRootPackage is the package relative to which
+// labels of build settings starting with "//command_line_option:" are
+// resolved.
+var CommandLineOptionRepoRootPackage = pg_label.MustNewCanonicalPackage("@@bazel_tools+")
+
+// unpackPackageSpecifications parses package specifications, as
+// accepted by the "packages" attribute of package_group() and by
+// visibility(). Package specifications are resolved relative to the
+// repo containing the provided package.
+func unpackPackageSpecifications(thread *starlark.Thread, currentPackage pg_label.CanonicalPackage, specifications []string) ([]PackageSpecification, error) {
+	labelUnpacker := NewLabelOrStringUnpackerInto(currentPackage)
+	parsedSpecifications := make([]PackageSpecification, 0, len(specifications))
+	for _, specification := range specifications {
+		switch specification {
+		case "public":
+			parsedSpecifications = append(parsedSpecifications, PackageSpecification{
+				AllPackages: true,
+			})
+			continue
+		case "private":
+			continue
+		}
+
+		packageName, exclude := strings.CutPrefix(specification, "-")
+		if packageName == "public" || packageName == "private" {
+			return nil, fmt.Errorf("package specification %#v cannot be negated", packageName)
+		}
+		if !strings.Contains(packageName, "//") || strings.Contains(packageName, ":") {
+			return nil, fmt.Errorf("invalid package specification %#v: expected \"public\", \"private\", or a package name starting with \"//\" or \"@\", optionally followed by \"/...\"", specification)
+		}
+		includeSubpackages := false
+		if p, ok := strings.CutSuffix(packageName, "//..."); ok {
+			packageName = p + "//"
+			includeSubpackages = true
+		} else if p, ok := strings.CutSuffix(packageName, "/..."); ok {
+			packageName = p
+			includeSubpackages = true
+		}
+
+		var resolvedLabel pg_label.ResolvedLabel
+		if err := labelUnpacker.UnpackInto(thread, starlark.String(packageName+":__pkg__"), &resolvedLabel); err != nil {
+			return nil, fmt.Errorf("invalid package specification %#v: %w", specification, err)
+		}
+		canonicalLabel, err := resolvedLabel.AsCanonical()
+		if err != nil {
+			// Package specification refers to an invalid
+			// repo. For consistency with labels in
+			// "visibility", discard the entry.
+			continue
+		}
+		parsedSpecifications = append(parsedSpecifications, PackageSpecification{
+			Package:            canonicalLabel.GetCanonicalPackage(),
+			IncludeSubpackages: includeSubpackages,
+			Exclude:            exclude,
+		})
+	}
+	return parsedSpecifications, nil
+}
+
 type GlobExpander = func(include, exclude []string, includeDirectories bool) ([]pg_label.TargetName, error)
 
 // sortAndDeduplicateSuffixes sorts the strings in a given list,
@@ -251,6 +311,76 @@ func labelSetting(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tu
 	)
 }
 
+// testingAnalysisTest implements testing.analysis_test(). It declares
+// a rule that is marked as being an analysis test and immediately
+// instantiates it, allowing analysis tests to be declared from within
+// macros.
+func testingAnalysisTest(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if len(args) > 2 {
+		return nil, fmt.Errorf("%s: got %d positional arguments, want at most 2", b.Name(), len(args))
+	}
+	var name string
+	var implementation NamedFunction
+	attrs := map[pg_label.StarlarkIdentifier]*Attr{}
+	var fragments []string
+	var toolchains []*ToolchainType
+	var attrValues map[string]starlark.Value
+	if err := starlark.UnpackArgs(
+		b.Name(), args, kwargs,
+		// Positional arguments.
+		"name", unpack.Bind(thread, &name, unpack.Stringer(unpack.TargetName)),
+		"implementation", unpack.Bind(thread, &implementation, NamedFunctionUnpackerInto),
+		// Keyword arguments.
+		"attrs?", unpack.Bind(thread, &attrs, unpack.Dict(unpack.StarlarkIdentifier, unpack.Type[*Attr]("attr.*"))),
+		"fragments?", unpack.Bind(thread, &fragments, unpack.List(unpack.String)),
+		"toolchains?", unpack.Bind(thread, &toolchains, unpack.List(ToolchainTypeUnpackerInto)),
+		"attr_values?", unpack.Bind(thread, &attrValues, unpack.Dict(unpack.String, unpack.Any)),
+	); err != nil {
+		return nil, err
+	}
+
+	// The rule is not bound to a global identifier. Name it after
+	// its implementation function, so that error messages remain
+	// meaningful.
+	implementationPosition := implementation.Position()
+	implementationFilename, err := pg_label.NewCanonicalLabel(implementationPosition.Filename())
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid filename of implementation function: %w", b.Name(), err)
+	}
+	implementationName, err := pg_label.NewStarlarkIdentifier(implementation.Name())
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid name of implementation function: %w", b.Name(), err)
+	}
+
+	r := newInlineRule(
+		implementationFilename.AppendStarlarkIdentifier(implementationName),
+		NewStarlarkRuleDefinition(
+			attrs,
+			/* buildSetting = */ nil,
+			/* cfg = */ nil,
+			map[string]*ExecGroup{
+				"": NewExecGroup(nil, toolchains),
+			},
+			fragments,
+			implementation,
+			/* initializer = */ nil,
+			/* provides = */ nil,
+			/* test = */ true,
+			/* subrules = */ nil,
+		),
+	)
+
+	ruleKwargs := make([]starlark.Tuple, 0, 1+len(attrValues))
+	ruleKwargs = append(ruleKwargs, starlark.Tuple{starlark.String("name"), starlark.String(name)})
+	for _, attrName := range slices.Sorted(maps.Keys(attrValues)) {
+		if attrName == "name" {
+			return nil, fmt.Errorf("%s: attr_values cannot contain \"name\"", b.Name())
+		}
+		ruleKwargs = append(ruleKwargs, starlark.Tuple{starlark.String(attrName), attrValues[attrName]})
+	}
+	return r.CallInternal(thread, nil, ruleKwargs)
+}
+
 func stringDictToStructFields(in starlark.StringDict) map[string]any {
 	out := make(map[string]any, len(in))
 	for k, v := range in {
@@ -266,14 +396,42 @@ func init() {
 		"analysis_test_transition": starlark.NewBuiltin(
 			"analysis_test_transition",
 			func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
-				var settings map[string]string
+				var settings map[string]starlark.Value
 				if err := starlark.UnpackArgs(
 					b.Name(), args, kwargs,
-					"settings", unpack.Bind(thread, &settings, unpack.Dict(unpack.String, unpack.String)),
+					"settings", unpack.Bind(thread, &settings, unpack.Dict(unpack.String, unpack.Any)),
 				); err != nil {
 					return nil, err
 				}
-				return nil, errors.New("not implemented")
+
+				// Unlike user-defined transitions, analysis
+				// test transitions don't invoke an
+				// implementation function that receives the
+				// labels of the build settings as provided.
+				// This means they can be canonicalized
+				// immediately.
+				currentPackage := CurrentFilePackage(thread, 1)
+				canonicalSettings := make(map[string]starlark.Value, len(settings))
+				for key, value := range settings {
+					basePackage := currentPackage
+					if strings.HasPrefix(key, "//command_line_option:") {
+						basePackage = CommandLineOptionRepoRootPackage
+					}
+					var resolvedLabel pg_label.ResolvedLabel
+					if err := NewLabelOrStringUnpackerInto(basePackage).UnpackInto(thread, starlark.String(key), &resolvedLabel); err != nil {
+						return nil, fmt.Errorf("%s: invalid build setting label %#v: %w", b.Name(), key, err)
+					}
+					canonicalLabel, err := resolvedLabel.AsCanonical()
+					if err != nil {
+						return nil, fmt.Errorf("%s: invalid build setting label %#v: %w", b.Name(), key, err)
+					}
+					canonicalSettings[canonicalLabel.String()] = value
+				}
+				transitionDefinition, err := NewAnalysisTestTransitionDefinition(canonicalSettings)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", b.Name(), err)
+				}
+				return NewTransition(transitionDefinition), nil
 			},
 		),
 		"aspect": starlark.NewBuiltin(
@@ -311,7 +469,22 @@ func init() {
 				); err != nil {
 					return nil, err
 				}
-				return NewAspect(nil, &model_starlark_pb.Aspect_Definition{}), nil
+
+				if _, ok := execGroups[""]; ok {
+					return nil, errors.New("cannot explicitly declare exec_group with name \"\"")
+				}
+				execGroups[""] = NewExecGroup(nil, toolchains)
+
+				return NewAspect(nil, NewStarlarkAspectDefinition(
+					attrAspects,
+					attrs,
+					execGroups,
+					implementation,
+					provides,
+					requiredAspectProviders,
+					requiredProviders,
+					requires,
+				)), nil
 			},
 		),
 		"attr": NewStructFromDict(nil, map[string]any{
@@ -430,7 +603,7 @@ func init() {
 						cfg = TargetTransitionDefinition
 					}
 
-					attrType := NewLabelAttrType(!mandatory, len(allowSingleFile) > 0, executable, sortAndDeduplicateSuffixes(allowFiles), cfg)
+					attrType := NewLabelAttrType(!mandatory, len(allowSingleFile) > 0, executable, sortAndDeduplicateSuffixes(allowFiles), cfg, aspects)
 					if mandatory {
 						defaultValue = nil
 					} else {
@@ -475,7 +648,7 @@ func init() {
 						return nil, err
 					}
 
-					attrType := NewLabelKeyedStringDictAttrType(sortAndDeduplicateSuffixes(allowFiles), cfg)
+					attrType := NewLabelKeyedStringDictAttrType(sortAndDeduplicateSuffixes(allowFiles), cfg, aspects)
 					if mandatory {
 						defaultValue = nil
 					} else {
@@ -524,7 +697,7 @@ func init() {
 						return nil, err
 					}
 
-					attrType := NewLabelListAttrType(sortAndDeduplicateSuffixes(allowFiles), cfg)
+					attrType := NewLabelListAttrType(sortAndDeduplicateSuffixes(allowFiles), cfg, aspects)
 					if mandatory {
 						defaultValue = nil
 					} else {
@@ -1240,18 +1413,23 @@ func init() {
 						return nil, err
 					}
 
-					slices.Sort(includes)
+					specifications, err := unpackPackageSpecifications(thread, currentPackage, packages)
+					if err != nil {
+						return nil, err
+					}
+					packageGroup, err := NewPackageGroupFromPackageSpecifications(specifications, includes, targetRegistrar.inlinedTreeOptions)
+					if err != nil {
+						return nil, err
+					}
 					return starlark.None, targetRegistrar.registerExplicitTarget(
 						name,
-						model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](
+						model_core.NewPatchedMessage(
 							&model_starlark_pb.Target_Definition{
 								Kind: &model_starlark_pb.Target_Definition_PackageGroup{
-									PackageGroup: &model_starlark_pb.PackageGroup{
-										// TODO: Set tree!
-										IncludePackageGroups: slices.Compact(includes),
-									},
+									PackageGroup: packageGroup.Message,
 								},
 							},
+							packageGroup.Patcher,
 						),
 					)
 				},
@@ -1381,7 +1559,7 @@ func init() {
 				); err != nil {
 					return nil, err
 				}
-				return NewRepositoryRule(nil, NewStarlarkRepositoryRuleDefinition(implementation, attrs)), nil
+				return NewRepositoryRule(nil, NewStarlarkRepositoryRuleDefinition(implementation, attrs, environ)), nil
 			},
 		),
 		"rule": starlark.NewBuiltin(
@@ -1391,6 +1569,7 @@ func init() {
 					return nil, fmt.Errorf("%s: got %d positional arguments, want at most 1", b.Name(), len(args))
 				}
 				var implementation NamedFunction
+				analysisTest := false
 				attrs := map[pg_label.StarlarkIdentifier]*Attr{}
 				var buildSetting *BuildSetting
 				var cfg *Transition
@@ -1411,6 +1590,7 @@ func init() {
 					// Positional arguments.
 					"implementation", unpack.Bind(thread, &implementation, NamedFunctionUnpackerInto),
 					// Keyword arguments.
+					"analysis_test?", unpack.Bind(thread, &analysisTest, unpack.Bool),
 					"attrs?", unpack.Bind(thread, &attrs, unpack.Dict(unpack.StarlarkIdentifier, unpack.Type[*Attr]("attr.*"))),
 					"build_setting?", unpack.Bind(thread, &buildSetting, unpack.IfNotNone(unpack.Type[*BuildSetting]("config.*"))),
 					"cfg?", unpack.Bind(thread, &cfg, unpack.IfNotNone(unpack.Type[*Transition]("transition"))),
@@ -1435,6 +1615,12 @@ func init() {
 				}
 				execGroups[""] = NewExecGroup(execCompatibleWith, toolchains)
 
+				// Analysis tests are test rules whose
+				// outcome is determined during analysis.
+				if analysisTest {
+					test = true
+				}
+
 				// Convert predeclared outputs to
 				// attr.output(), with the filename
 				// template as the attr's default value.
@@ -1450,6 +1636,7 @@ func init() {
 					buildSetting,
 					cfg,
 					execGroups,
+					fragments,
 					implementation,
 					initializer,
 					provides,
@@ -1522,6 +1709,9 @@ func init() {
 				return NewTagClass(NewStarlarkTagClassDefinition(attrs)), nil
 			},
 		),
+		"testing": NewStructFromDict(nil, map[string]any{
+			"analysis_test": starlark.NewBuiltin("testing.analysis_test", testingAnalysisTest),
+		}),
 		"transition": starlark.NewBuiltin(
 			"transition",
 			func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
@@ -1559,8 +1749,32 @@ func init() {
 		"visibility": starlark.NewBuiltin(
 			"visibility",
 			func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
-				// TODO: Implement!
-				return starlark.None, nil
+				loadVisibilityRegistrar, ok := thread.Local(LoadVisibilityRegistrarKey).(*LoadVisibilityRegistrar)
+				if !ok {
+					return nil, errors.New("load visibility cannot be set from within this context")
+				}
+				// Only permit calls from the top level
+				// of the .bzl file, as opposed to from
+				// within functions.
+				if thread.CallStackDepth() != 2 {
+					return nil, errors.New("visibility() can only be called at the top level of a .bzl file")
+				}
+
+				var specifications []string
+				if err := starlark.UnpackArgs(
+					b.Name(), args, kwargs,
+					"value", unpack.Bind(thread, &specifications, unpack.Or([]unpack.UnpackerInto[[]string]{
+						unpack.Singleton(unpack.String),
+						unpack.List(unpack.String),
+					})),
+				); err != nil {
+					return nil, err
+				}
+				parsedSpecifications, err := unpackPackageSpecifications(thread, CurrentFilePackage(thread, 1), specifications)
+				if err != nil {
+					return nil, err
+				}
+				return starlark.None, loadVisibilityRegistrar.setVisibility(parsedSpecifications)
 			},
 		),
 	}
@@ -0,0 +1,74 @@
+package starlark
+
+import (
+	"testing"
+
+	pg_label "github.com/buildbarn/bonanza/pkg/label"
+	"github.com/stretchr/testify/require"
+
+	"go.starlark.net/starlark"
+)
+
+func TestUnpackPackageSpecifications(t *testing.T) {
+	thread := &starlark.Thread{}
+	thread.SetLocal(CanonicalRepoResolverKey, CanonicalRepoResolver(func(fromCanonicalRepo pg_label.CanonicalRepo, toApparentRepo pg_label.ApparentRepo) (*pg_label.CanonicalRepo, error) {
+		if toApparentRepo.String() == "rules_cc" {
+			canonicalRepo := pg_label.MustNewCanonicalRepo("rules_cc+")
+			return &canonicalRepo, nil
+		}
+		return nil, nil
+	}))
+	currentPackage := pg_label.MustNewCanonicalPackage("@@example+//foo")
+
+	t.Run("Success", func(t *testing.T) {
+		specifications, err := unpackPackageSpecifications(thread, currentPackage, []string{
+			// "private" does not match any packages.
+			"private",
+			"public",
+			// Package names are relative to the current
+			// repo, and may include subpackages.
+			"//bar",
+			"//baz/...",
+			"-//baz/internal/...",
+			// Recursively matching all packages in a
+			// repo.
+			"@rules_cc//...",
+			"@@other+//qux",
+		})
+		require.NoError(t, err)
+		require.Equal(t, []PackageSpecification{
+			{AllPackages: true},
+			{Package: pg_label.MustNewCanonicalPackage("@@example+//bar")},
+			{Package: pg_label.MustNewCanonicalPackage("@@example+//baz"), IncludeSubpackages: true},
+			{Package: pg_label.MustNewCanonicalPackage("@@example+//baz/internal"), IncludeSubpackages: true, Exclude: true},
+			{Package: pg_label.MustNewCanonicalPackage("@@rules_cc+"), IncludeSubpackages: true},
+			{Package: pg_label.MustNewCanonicalPackage("@@other+//qux")},
+		}, specifications)
+	})
+
+	t.Run("UnknownRepo", func(t *testing.T) {
+		// Package specifications referring to repos that do
+		// not exist are discarded, for consistency with labels
+		// in "visibility".
+		specifications, err := unpackPackageSpecifications(thread, currentPackage, []string{
+			"@unknown//bar",
+		})
+		require.NoError(t, err)
+		require.Empty(t, specifications)
+	})
+
+	t.Run("NegatedPublic", func(t *testing.T) {
+		_, err := unpackPackageSpecifications(thread, currentPackage, []string{"-public"})
+		require.EqualError(t, err, "package specification \"public\" cannot be negated")
+	})
+
+	t.Run("TargetName", func(t *testing.T) {
+		_, err := unpackPackageSpecifications(thread, currentPackage, []string{"//bar:baz"})
+		require.EqualError(t, err, "invalid package specification \"//bar:baz\": expected \"public\", \"private\", or a package name starting with \"//\" or \"@\", optionally followed by \"/...\"")
+	})
+
+	t.Run("RelativePackage", func(t *testing.T) {
+		_, err := unpackPackageSpecifications(thread, currentPackage, []string{"bar"})
+		require.EqualError(t, err, "invalid package specification \"bar\": expected \"public\", \"private\", or a package name starting with \"//\" or \"@\", optionally followed by \"/...\"")
+	})
+}
@@ -373,7 +373,12 @@ func DecodeValue(encodedValue model_core.Message[*model_starlark_pb.Value], curr
 			if currentIdentifier == nil {
 				return nil, errors.New("encoded aspect does not have a name")
 			}
-			return NewAspect(currentIdentifier, aspectKind.Definition), nil
+			return NewAspect(currentIdentifier, NewProtoAspectDefinition(
+				model_core.Message[*model_starlark_pb.Aspect_Definition]{
+					Message:            aspectKind.Definition,
+					OutgoingReferences: encodedValue.OutgoingReferences,
+				},
+			)), nil
 		default:
 			return nil, errors.New("encoded aspect does not have a reference or definition")
 		}
@@ -760,28 +765,43 @@ func DecodeAttrType(attr *model_starlark_pb.Attr) (AttrType, error) {
 		if attrTypeInfo.Label.ValueOptions == nil || attrTypeInfo.Label.ValueOptions.Cfg == nil {
 			return nil, errors.New("missing value options")
 		}
+		valueAspects, err := decodeAspectIdentifiers(attrTypeInfo.Label.ValueOptions.Aspects)
+		if err != nil {
+			return nil, err
+		}
 		return NewLabelAttrType(
 			attrTypeInfo.Label.AllowNone,
 			attrTypeInfo.Label.AllowSingleFile,
 			attrTypeInfo.Label.Executable,
 			attrTypeInfo.Label.ValueOptions.AllowFiles,
 			NewReferenceTransitionDefinition(attrTypeInfo.Label.ValueOptions.Cfg),
+			valueAspects,
 		), nil
 	case *model_starlark_pb.Attr_LabelKeyedStringDict:
 		if attrTypeInfo.LabelKeyedStringDict.DictKeyOptions == nil || attrTypeInfo.LabelKeyedStringDict.DictKeyOptions.Cfg == nil {
 			return nil, errors.New("missing dict key options")
 		}
+		dictKeyAspects, err := decodeAspectIdentifiers(attrTypeInfo.LabelKeyedStringDict.DictKeyOptions.Aspects)
+		if err != nil {
+			return nil, err
+		}
 		return NewLabelKeyedStringDictAttrType(
 			attrTypeInfo.LabelKeyedStringDict.DictKeyOptions.AllowFiles,
 			NewReferenceTransitionDefinition(attrTypeInfo.LabelKeyedStringDict.DictKeyOptions.Cfg),
+			dictKeyAspects,
 		), nil
 	case *model_starlark_pb.Attr_LabelList:
 		if attrTypeInfo.LabelList.ListValueOptions == nil || attrTypeInfo.LabelList.ListValueOptions.Cfg == nil {
 			return nil, errors.New("missing list value options")
 		}
+		listValueAspects, err := decodeAspectIdentifiers(attrTypeInfo.LabelList.ListValueOptions.Aspects)
+		if err != nil {
+			return nil, err
+		}
 		return NewLabelListAttrType(
 			attrTypeInfo.LabelList.ListValueOptions.AllowFiles,
 			NewReferenceTransitionDefinition(attrTypeInfo.LabelList.ListValueOptions.Cfg),
+			listValueAspects,
 		), nil
 	case *model_starlark_pb.Attr_Output:
 		return NewOutputAttrType(attrTypeInfo.Output.FilenameTemplate), nil
@@ -18,7 +18,29 @@ import (
 	"go.starlark.net/syntax"
 )
 
-const externalDirectoryName = "external"
+const (
+	externalDirectoryName = "external"
+
+	// mappedOutputDirectoryName is the name of the output directory
+	// that is used in place of the configuration specific one when
+	// path mapping is applied. This causes actions that are identical
+	// except for the configuration in which they are built to have the
+	// same command line, allowing them to be deduplicated.
+	mappedOutputDirectoryName = "cfg"
+)
+
+// GetOutputDirectoryName returns the name of the directory inside
+// bazel-out in which files built in a given configuration are stored.
+func GetOutputDirectoryName(mnemonic string, configurationChecksum []byte) string {
+	return mnemonic + "-" + hex.EncodeToString(configurationChecksum)
+}
+
+// GetBinDirectoryPath returns the path of the directory in which files
+// built in a given configuration are stored, relative to the execution
+// root. This corresponds to the value of ctx.bin_dir.path.
+func GetBinDirectoryPath(mnemonic string, configurationChecksum []byte) string {
+	return go_path.Join("bazel-out", GetOutputDirectoryName(mnemonic, configurationChecksum), "bin")
+}
 
 type File struct {
 	definition *model_starlark_pb.File
@@ -72,24 +94,23 @@ func (f File) CompareSameType(thread *starlark.Thread, op syntax.Token, other st
 	}
 }
 
-func (f File) appendOwner(parts []string) []string {
+func (f File) appendOwner(parts []string, mapPaths bool) []string {
 	if o := f.definition.Owner; o != nil {
-		parts = append(
-			parts,
-			"bazel-out",
-			hex.EncodeToString(o.Cfg),
-			"bin",
-		)
+		outputDirectoryName := mappedOutputDirectoryName
+		if !mapPaths {
+			outputDirectoryName = GetOutputDirectoryName(o.Mnemonic, o.Cfg)
+		}
+		parts = append(parts, "bazel-out", outputDirectoryName, "bin")
 	}
 	return parts
 }
 
-func (f File) getPath() (string, error) {
+func (f File) getPath(mapPaths bool) (string, error) {
 	canonicalPackage, err := pg_label.NewCanonicalPackage(f.definition.Package)
 	if err != nil {
 		return "", fmt.Errorf("invalid canonical package %#v: %w", f.definition.Package, err)
 	}
-	parts := f.appendOwner(make([]string, 0, 7))
+	parts := f.appendOwner(make([]string, 0, 7), mapPaths)
 	return go_path.Join(
 		append(
 			parts,
@@ -101,12 +122,27 @@ func (f File) getPath() (string, error) {
 	), nil
 }
 
+// GetPath returns the path of the file relative to the execution root.
+// This corresponds to the value of File.path.
+func (f File) GetPath() (string, error) {
+	return f.getPath(false)
+}
+
+// GetMappedPath returns the path of the file relative to the execution
+// root, where the name of the output directory is replaced with a
+// placeholder that does not depend on the configuration. This path
+// should be used in command lines of actions that support path
+// mapping.
+func (f File) GetMappedPath() (string, error) {
+	return f.getPath(true)
+}
+
 func (f File) Attr(thread *starlark.Thread, name string) (starlark.Value, error) {
 	switch name {
 	case "basename":
 		return starlark.String(go_path.Base(f.definition.PackageRelativePath)), nil
 	case "dirname":
-		p, err := f.getPath()
+		p, err := f.getPath(false)
 		if err != nil {
 			return nil, err
 		}
@@ -145,7 +181,7 @@ func (f File) Attr(thread *starlark.Thread, name string) (starlark.Value, error)
 
 		return NewLabel(canonicalPackage.AppendTargetName(targetName).AsResolved()), nil
 	case "path":
-		p, err := f.getPath()
+		p, err := f.getPath(false)
 		if err != nil {
 			return nil, err
 		}
@@ -155,7 +191,7 @@ func (f File) Attr(thread *starlark.Thread, name string) (starlark.Value, error)
 		if err != nil {
 			return nil, fmt.Errorf("invalid canonical package %#v: %w", f.definition.Package, err)
 		}
-		parts := f.appendOwner(make([]string, 0, 6))
+		parts := f.appendOwner(make([]string, 0, 6), false)
 		// TODO: Should we have a dedicated root type?
 		return newStructFromLists(
 			nil,
@@ -0,0 +1,49 @@
+package starlark
+
+import (
+	"errors"
+
+	model_core "github.com/buildbarn/bonanza/pkg/model/core"
+	"github.com/buildbarn/bonanza/pkg/model/core/inlinedtree"
+	model_starlark_pb "github.com/buildbarn/bonanza/pkg/proto/model/starlark"
+	"github.com/buildbarn/bonanza/pkg/storage/dag"
+)
+
+const LoadVisibilityRegistrarKey = "load_visibility_registrar"
+
+// LoadVisibilityRegistrar keeps track of the load visibility of a .bzl
+// file, as declared by calling visibility() while the file is being
+// loaded.
+type LoadVisibilityRegistrar struct {
+	// Immutable fields.
+	inlinedTreeOptions *inlinedtree.Options
+
+	// Mutable fields.
+	visibility model_core.PatchedMessage[*model_starlark_pb.PackageGroup, dag.ObjectContentsWalker]
+}
+
+func NewLoadVisibilityRegistrar(inlinedTreeOptions *inlinedtree.Options) *LoadVisibilityRegistrar {
+	return &LoadVisibilityRegistrar{
+		inlinedTreeOptions: inlinedTreeOptions,
+	}
+}
+
+// GetVisibility returns the load visibility that was declared by
+// calling visibility(). If visibility() was not called, an unset
+// message is returned, meaning that the .bzl file can be loaded from
+// any package.
+func (r *LoadVisibilityRegistrar) GetVisibility() model_core.PatchedMessage[*model_starlark_pb.PackageGroup, dag.ObjectContentsWalker] {
+	return r.visibility
+}
+
+func (r *LoadVisibilityRegistrar) setVisibility(specifications []PackageSpecification) error {
+	if r.visibility.IsSet() {
+		return errors.New("load visibility may only be set once")
+	}
+	visibility, err := NewPackageGroupFromPackageSpecifications(specifications, nil, r.inlinedTreeOptions)
+	if err != nil {
+		return err
+	}
+	r.visibility = visibility
+	return nil
+}
@@ -40,9 +40,54 @@ func (n *packageGroupNode) getOrCreate(name string) *packageGroupNode {
 	return nSub
 }
 
+// getOrCreateForExclusion is identical to getOrCreate, except that it
+// may also be called on nodes that include all of their children. Any
+// child node that is created inherits inclusion from its parent, so
+// that inclusion can subsequently be revoked.
+func (n *packageGroupNode) getOrCreateForExclusion(name string) *packageGroupNode {
+	if n.subpackages == nil {
+		n.subpackages = map[string]*packageGroupNode{}
+	}
+	nSub, ok := n.subpackages[name]
+	if !ok {
+		nSub = &packageGroupNode{
+			includePackage:     n.includeSubpackages,
+			includeSubpackages: n.includeSubpackages,
+			subpackages:        map[string]*packageGroupNode{},
+		}
+		n.subpackages[name] = nSub
+	}
+	return nSub
+}
+
+// excludePackage removes a given canonical package from the tree. If
+// excludeSubpackages is set, all of the package's subpackages are
+// removed as well.
+func (n *packageGroupNode) excludePackage(canonicalPackage pg_label.CanonicalPackage, excludeSubpackages bool) {
+	nWalk := n.getOrCreateForExclusion(canonicalPackage.GetCanonicalRepo().String())
+	for packagePath := canonicalPackage.GetPackagePath(); packagePath != ""; {
+		if split := strings.IndexByte(packagePath, '/'); split < 0 {
+			nWalk = nWalk.getOrCreateForExclusion(packagePath)
+			packagePath = ""
+		} else {
+			nWalk = nWalk.getOrCreateForExclusion(packagePath[:split])
+			packagePath = packagePath[split+1:]
+		}
+	}
+
+	nWalk.includePackage = false
+	if excludeSubpackages {
+		nWalk.includeSubpackages = false
+		nWalk.subpackages = map[string]*packageGroupNode{}
+	}
+}
+
 // lookupPackage looks up the node that corresponds to a given canonical
 // package name.
 func (n *packageGroupNode) lookupPackage(canonicalPackage pg_label.CanonicalPackage) *packageGroupNode {
+	if n.includeSubpackages {
+		return nil
+	}
 	nWalk := n.getOrCreate(canonicalPackage.GetCanonicalRepo().String())
 	packagePath := canonicalPackage.GetPackagePath()
 	for {
@@ -209,3 +254,79 @@ func NewPackageGroupFromVisibility(visibility []pg_label.ResolvedLabel, inlinedT
 		treeProto.Patcher,
 	), nil
 }
+
+// PackageSpecification corresponds to a single entry in the "packages"
+// attribute of package_group(), or in the list of package
+// specifications provided to visibility().
+type PackageSpecification struct {
+	// If set, the specification matches all packages in all
+	// repos. This corresponds to "public".
+	AllPackages bool
+
+	// The package matched by the specification.
+	Package pg_label.CanonicalPackage
+
+	// If set, the specification also matches all subpackages. This
+	// corresponds to specifications ending with "/...".
+	IncludeSubpackages bool
+
+	// If set, the specification was prefixed with "-", meaning
+	// that the matching packages are excluded from the group, even
+	// if they are matched by other specifications.
+	Exclude bool
+}
+
+// NewPackageGroupFromPackageSpecifications generates a PackageGroup
+// message based on the "packages" and "includes" attributes provided to
+// package_group(), or the package specifications provided to
+// visibility().
+func NewPackageGroupFromPackageSpecifications(specifications []PackageSpecification, includePackageGroups []string, inlinedTreeOptions *inlinedtree.Options) (model_core.PatchedMessage[*model_starlark_pb.PackageGroup, dag.ObjectContentsWalker], error) {
+	tree := packageGroupNode{
+		subpackages: map[string]*packageGroupNode{},
+	}
+
+	// Exclusions take precedence over inclusions, regardless of
+	// the order in which they are specified. Process all
+	// inclusions first.
+	for _, specification := range specifications {
+		if !specification.Exclude {
+			if specification.AllPackages {
+				tree = packageGroupNode{
+					includeSubpackages: true,
+				}
+			} else if n := tree.lookupPackage(specification.Package); n != nil {
+				if specification.IncludeSubpackages {
+					*n = packageGroupNode{
+						includePackage:     true,
+						includeSubpackages: true,
+					}
+				} else {
+					n.includePackage = true
+				}
+			}
+		}
+	}
+	for _, specification := range specifications {
+		if specification.Exclude {
+			if specification.AllPackages {
+				return model_core.PatchedMessage[*model_starlark_pb.PackageGroup, dag.ObjectContentsWalker]{}, errors.New("all packages cannot be excluded")
+			}
+			tree.excludePackage(specification.Package, specification.IncludeSubpackages)
+		}
+	}
+
+	treeProto, err := tree.toProto(inlinedTreeOptions)
+	if err != nil {
+		return model_core.PatchedMessage[*model_starlark_pb.PackageGroup, dag.ObjectContentsWalker]{}, err
+	}
+
+	includePackageGroups = slices.Clone(includePackageGroups)
+	sort.Strings(includePackageGroups)
+	return model_core.NewPatchedMessage(
+		&model_starlark_pb.PackageGroup{
+			Tree:                 treeProto.Message,
+			IncludePackageGroups: slices.Compact(includePackageGroups),
+		},
+		treeProto.Patcher,
+	), nil
+}
@@ -248,3 +248,107 @@ func TestNewPackageGroupFromVisibility(t *testing.T) {
 		}, packageGroup.Message)
 	})
 }
+
+func TestNewPackageGroupFromPackageSpecifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	t.Run("Empty", func(t *testing.T) {
+		packageGroup, err := model_starlark.NewPackageGroupFromPackageSpecifications(
+			nil,
+			nil,
+			&inlinedtree.Options{
+				ReferenceFormat:  object.MustNewReferenceFormat(object_pb.ReferenceFormat_SHA256_V1),
+				Encoder:          NewMockBinaryEncoder(ctrl),
+				MaximumSizeBytes: 0,
+			},
+		)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_starlark_pb.PackageGroup{
+			Tree: &model_starlark_pb.PackageGroup_Subpackages{},
+		}, packageGroup.Message)
+	})
+
+	t.Run("Exclusions", func(t *testing.T) {
+		packageGroup, err := model_starlark.NewPackageGroupFromPackageSpecifications(
+			[]model_starlark.PackageSpecification{
+				// Exclusions should take precedence,
+				// regardless of the order in which they
+				// are specified.
+				{
+					Package:            label.MustNewCanonicalPackage("@@foo+//bar/baz"),
+					IncludeSubpackages: true,
+					Exclude:            true,
+				},
+				{
+					Package:            label.MustNewCanonicalPackage("@@foo+//bar"),
+					IncludeSubpackages: true,
+				},
+				// Excluding a single package should
+				// leave its subpackages intact.
+				{
+					Package: label.MustNewCanonicalPackage("@@foo+//bar/qux"),
+					Exclude: true,
+				},
+			},
+			[]string{
+				"@@foo+//:group2",
+				"@@foo+//:group1",
+				"@@foo+//:group2",
+			},
+			&inlinedtree.Options{
+				ReferenceFormat:  object.MustNewReferenceFormat(object_pb.ReferenceFormat_SHA256_V1),
+				Encoder:          NewMockBinaryEncoder(ctrl),
+				MaximumSizeBytes: 1 << 20,
+			},
+		)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_starlark_pb.PackageGroup{
+			Tree: &model_starlark_pb.PackageGroup_Subpackages{
+				Overrides: &model_starlark_pb.PackageGroup_Subpackages_OverridesInline{
+					OverridesInline: &model_starlark_pb.PackageGroup_Subpackages_Overrides{
+						Packages: []*model_starlark_pb.PackageGroup_Package{
+							{
+								Component: "foo+",
+								Subpackages: &model_starlark_pb.PackageGroup_Subpackages{
+									Overrides: &model_starlark_pb.PackageGroup_Subpackages_OverridesInline{
+										OverridesInline: &model_starlark_pb.PackageGroup_Subpackages_Overrides{
+											Packages: []*model_starlark_pb.PackageGroup_Package{
+												{
+													Component:      "bar",
+													IncludePackage: true,
+													Subpackages: &model_starlark_pb.PackageGroup_Subpackages{
+														IncludeSubpackages: true,
+														Overrides: &model_starlark_pb.PackageGroup_Subpackages_OverridesInline{
+															OverridesInline: &model_starlark_pb.PackageGroup_Subpackages_Overrides{
+																Packages: []*model_starlark_pb.PackageGroup_Package{
+																	{
+																		Component:   "baz",
+																		Subpackages: &model_starlark_pb.PackageGroup_Subpackages{},
+																	},
+																	{
+																		Component: "qux",
+																		Subpackages: &model_starlark_pb.PackageGroup_Subpackages{
+																			IncludeSubpackages: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			IncludePackageGroups: []string{
+				"@@foo+//:group1",
+				"@@foo+//:group2",
+			},
+		}, packageGroup.Message)
+	})
+}
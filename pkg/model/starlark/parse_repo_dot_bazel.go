@@ -21,20 +21,38 @@ var DefaultInheritableAttrs = model_starlark_pb.InheritableAttrs{
 }
 
 // ParseRepoDotBazel parses a REPO.bazel file that may be stored at the
-// root of a repository.
-func ParseRepoDotBazel(contents string, filename pg_label.CanonicalLabel, inlinedTreeOptions *inlinedtree.Options) (model_core.PatchedMessage[*model_starlark_pb.InheritableAttrs, dag.ObjectContentsWalker], error) {
+// root of a repository. In addition to the default attributes of
+// packages in the repository, it returns the list of glob patterns
+// provided to ignore_directories(), matching directories that should
+// not be considered part of any package. Calls to print() are
+// forwarded to printFunc.
+func ParseRepoDotBazel(contents string, filename pg_label.CanonicalLabel, inlinedTreeOptions *inlinedtree.Options, printFunc func(thread *starlark.Thread, msg string)) (model_core.PatchedMessage[*model_starlark_pb.InheritableAttrs, dag.ObjectContentsWalker], []string, error) {
 	var defaultAttrs model_core.PatchedMessage[*model_starlark_pb.InheritableAttrs, dag.ObjectContentsWalker]
+	var ignoredDirectories []string
+	ignoreDirectoriesInvoked := false
 	_, err := starlark.ExecFile(
 		&starlark.Thread{
-			Name: "main",
-			Print: func(_ *starlark.Thread, msg string) {
-				// TODO: Provide logging sink.
-				fmt.Println(msg)
-			},
+			Name:  "main",
+			Print: printFunc,
 		},
 		filename.String(),
 		contents,
 		starlark.StringDict{
+			"ignore_directories": starlark.NewBuiltin("ignore_directories", func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				if ignoreDirectoriesInvoked {
+					return nil, fmt.Errorf("%s: function can only be invoked once", b.Name())
+				}
+				var patterns []string
+				if err := starlark.UnpackArgs(
+					b.Name(), args, kwargs,
+					"patterns", unpack.Bind(thread, &patterns, unpack.List(unpack.String)),
+				); err != nil {
+					return nil, err
+				}
+				ignoreDirectoriesInvoked = true
+				ignoredDirectories = patterns
+				return starlark.None, nil
+			}),
 			"repo": starlark.NewBuiltin("repo", func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
 				if defaultAttrs.IsSet() {
 					return nil, fmt.Errorf("%s: function can only be invoked once", b.Name())
@@ -61,7 +79,7 @@ func ParseRepoDotBazel(contents string, filename pg_label.CanonicalLabel, inline
 	if !defaultAttrs.IsSet() {
 		defaultAttrs = model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](&DefaultInheritableAttrs)
 	}
-	return defaultAttrs, err
+	return defaultAttrs, ignoredDirectories, err
 }
 
 // getDefaultInheritableAttrs parses the arguments provided to
@@ -22,7 +22,7 @@ func TestParseModuleDotBazel(t *testing.T) {
 		// If no calls to repo() are made, the resulting
 		// attributes should be identical to the constant
 		// message value we provide.
-		defaultAttrs, err := model_starlark.ParseRepoDotBazel(
+		defaultAttrs, _, err := model_starlark.ParseRepoDotBazel(
 			"",
 			label.MustNewCanonicalLabel("@@foo+//:REPO.bazel"),
 			&inlinedtree.Options{
@@ -30,6 +30,7 @@ func TestParseModuleDotBazel(t *testing.T) {
 				Encoder:          NewMockBinaryEncoder(ctrl),
 				MaximumSizeBytes: 0,
 			},
+			/* printFunc = */ nil,
 		)
 		require.NoError(t, err)
 		testutil.RequireEqualProto(t, &model_starlark.DefaultInheritableAttrs, defaultAttrs.Message)
@@ -39,7 +40,7 @@ func TestParseModuleDotBazel(t *testing.T) {
 		// It should be valid to call repo() without any
 		// arguments. In that case the returned attributes
 		// should also be equal to the default.
-		defaultAttrs, err := model_starlark.ParseRepoDotBazel(
+		defaultAttrs, _, err := model_starlark.ParseRepoDotBazel(
 			"repo()",
 			label.MustNewCanonicalLabel("@@foo+//:REPO.bazel"),
 			&inlinedtree.Options{
@@ -47,6 +48,7 @@ func TestParseModuleDotBazel(t *testing.T) {
 				Encoder:          NewMockBinaryEncoder(ctrl),
 				MaximumSizeBytes: 0,
 			},
+			/* printFunc = */ nil,
 		)
 		require.NoError(t, err)
 		testutil.RequireEqualProto(t, &model_starlark.DefaultInheritableAttrs, defaultAttrs.Message)
@@ -54,7 +56,7 @@ func TestParseModuleDotBazel(t *testing.T) {
 
 	t.Run("RedundantCalls", func(t *testing.T) {
 		// Calling repo() times is not permitted.
-		_, err := model_starlark.ParseRepoDotBazel(
+		_, _, err := model_starlark.ParseRepoDotBazel(
 			"repo()\nrepo()",
 			label.MustNewCanonicalLabel("@@foo+//:REPO.bazel"),
 			&inlinedtree.Options{
@@ -62,6 +64,7 @@ func TestParseModuleDotBazel(t *testing.T) {
 				Encoder:          NewMockBinaryEncoder(ctrl),
 				MaximumSizeBytes: 0,
 			},
+			/* printFunc = */ nil,
 		)
 		require.EqualError(t, err, "repo: function can only be invoked once")
 	})
@@ -70,7 +73,7 @@ func TestParseModuleDotBazel(t *testing.T) {
 		// default_applicable_licenses is an alias of
 		// default_package_metadata. It's not possible to
 		// provide both arguments at once.
-		_, err := model_starlark.ParseRepoDotBazel(
+		_, _, err := model_starlark.ParseRepoDotBazel(
 			`repo(
 				default_applicable_licenses = ["//:license"],
 				default_package_metadata = ["//:metadata"],
@@ -81,14 +84,33 @@ func TestParseModuleDotBazel(t *testing.T) {
 				Encoder:          NewMockBinaryEncoder(ctrl),
 				MaximumSizeBytes: 0,
 			},
+			/* printFunc = */ nil,
 		)
 		require.EqualError(t, err, "repo: default_applicable_licenses and default_package_metadata are mutually exclusive")
 	})
 
+	t.Run("IgnoreDirectories", func(t *testing.T) {
+		// Patterns provided to ignore_directories() should be
+		// returned separately from the default attributes.
+		defaultAttrs, ignoredDirectories, err := model_starlark.ParseRepoDotBazel(
+			`ignore_directories(["node_modules", "**/.git"])`,
+			label.MustNewCanonicalLabel("@@foo+//:REPO.bazel"),
+			&inlinedtree.Options{
+				ReferenceFormat:  object.MustNewReferenceFormat(object_pb.ReferenceFormat_SHA256_V1),
+				Encoder:          NewMockBinaryEncoder(ctrl),
+				MaximumSizeBytes: 0,
+			},
+			/* printFunc = */ nil,
+		)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &model_starlark.DefaultInheritableAttrs, defaultAttrs.Message)
+		require.Equal(t, []string{"node_modules", "**/.git"}, ignoredDirectories)
+	})
+
 	t.Run("AllArguments", func(t *testing.T) {
 		// Example invocation where all supported arguments are
 		// provided.
-		defaultAttrs, err := model_starlark.ParseRepoDotBazel(
+		defaultAttrs, _, err := model_starlark.ParseRepoDotBazel(
 			`repo(
 				default_deprecation = "All code in this repository is deprecated.",
 				default_package_metadata = ["//:metadata"],
@@ -103,6 +125,7 @@ func TestParseModuleDotBazel(t *testing.T) {
 				Encoder:          NewMockBinaryEncoder(ctrl),
 				MaximumSizeBytes: 0,
 			},
+			/* printFunc = */ nil,
 		)
 		require.NoError(t, err)
 		testutil.RequireEqualProto(t, &model_starlark_pb.InheritableAttrs{
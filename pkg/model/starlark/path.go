@@ -113,7 +113,10 @@ func (bp *BarePath) GetUNIXString() string {
 type Filesystem interface {
 	Exists(*BarePath) (bool, error)
 	IsDir(*BarePath) (bool, error)
-	Readdir(*BarePath) ([]bb_path.Component, error)
+	// Readdir returns the names of the entries in a directory. If
+	// mustWatch is set, an error is returned if changes to the
+	// directory's contents cannot be observed.
+	Readdir(p *BarePath, mustWatch bool) ([]bb_path.Component, error)
 	Realpath(*BarePath) (*BarePath, error)
 }
 
@@ -205,15 +208,36 @@ func (p *path) Attr(thread *starlark.Thread, name string) (starlark.Value, error
 		}
 		return starlark.Bool(isDir), nil
 	case "readdir":
-		names, err := p.filesystem.Readdir(p.bare)
-		if err != nil {
-			return nil, err
-		}
-		paths := make([]starlark.Value, 0, len(names))
-		for _, name := range names {
-			paths = append(paths, NewPath(bp.Append(name), p.filesystem))
-		}
-		return starlark.NewList(paths), nil
+		return starlark.NewBuiltin(
+			"path.readdir",
+			func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				watch := "auto"
+				if err := starlark.UnpackArgs(
+					b.Name(), args, kwargs,
+					"watch?", unpack.Bind(thread, &watch, unpack.String),
+				); err != nil {
+					return nil, err
+				}
+				var mustWatch bool
+				switch watch {
+				case "yes":
+					mustWatch = true
+				case "no", "auto":
+				default:
+					return nil, fmt.Errorf("%s: invalid value for watch: %#v", b.Name(), watch)
+				}
+
+				names, err := p.filesystem.Readdir(p.bare, mustWatch)
+				if err != nil {
+					return nil, err
+				}
+				paths := make([]starlark.Value, 0, len(names))
+				for _, name := range names {
+					paths = append(paths, NewPath(bp.Append(name), p.filesystem))
+				}
+				return starlark.NewList(paths), nil
+			},
+		), nil
 	case "realpath":
 		realpath, err := p.filesystem.Realpath(p.bare)
 		if err != nil {
@@ -263,7 +287,9 @@ func (pcp pathComponentParser) ParseFirstComponent(componentWalker bb_path.Compo
 	if err != nil || r == nil {
 		return nil, nil, err
 	}
-	return r, nil, nil
+	// Path resolution ended at a symbolic link, which needs to be
+	// expanded.
+	return *r, nil, nil
 }
 
 type PathResolver struct {
@@ -221,12 +221,14 @@ type RepositoryRuleDefinition interface {
 type starlarkRepositoryRuleDefinition struct {
 	implementation NamedFunction
 	attrs          map[pg_label.StarlarkIdentifier]*Attr
+	environ        []string
 }
 
-func NewStarlarkRepositoryRuleDefinition(implementation NamedFunction, attrs map[pg_label.StarlarkIdentifier]*Attr) RepositoryRuleDefinition {
+func NewStarlarkRepositoryRuleDefinition(implementation NamedFunction, attrs map[pg_label.StarlarkIdentifier]*Attr, environ []string) RepositoryRuleDefinition {
 	return &starlarkRepositoryRuleDefinition{
 		implementation: implementation,
 		attrs:          attrs,
+		environ:        environ,
 	}
 }
 
@@ -245,6 +247,7 @@ func (rrd *starlarkRepositoryRuleDefinition) Encode(path map[starlark.Value]stru
 		&model_starlark_pb.RepositoryRule_Definition{
 			Implementation: implementation.Message,
 			Attrs:          namedAttrs.Message,
+			Environ:        slices.Compact(slices.Sorted(slices.Values(rrd.environ))),
 		},
 		namedAttrs.Patcher,
 	), implementationNeedsCode || namedAttrsNeedCode, nil
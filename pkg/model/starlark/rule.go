@@ -21,6 +21,7 @@ import (
 type rule struct {
 	LateNamedValue
 	definition RuleDefinition
+	inline     bool
 }
 
 var (
@@ -38,6 +39,20 @@ func NewRule(identifier *pg_label.CanonicalStarlarkIdentifier, definition RuleDe
 	}
 }
 
+// newInlineRule creates a rule that is not bound to a global
+// identifier, such as the ones created by testing.analysis_test().
+// As the definition of the rule cannot be looked up by identifier,
+// targets created by invoking the rule embed its definition.
+func newInlineRule(identifier pg_label.CanonicalStarlarkIdentifier, definition RuleDefinition) *rule {
+	return &rule{
+		LateNamedValue: LateNamedValue{
+			Identifier: &identifier,
+		},
+		definition: definition,
+		inline:     true,
+	}
+}
+
 func (r *rule) String() string {
 	return "<rule>"
 }
@@ -396,6 +411,19 @@ func (r *rule) CallInternal(thread *starlark.Thread, args starlark.Tuple, kwargs
 	}
 	patcher.Merge(visibilityPackageGroup.Patcher)
 
+	var inlineRuleDefinition *model_starlark_pb.Rule_Definition
+	if r.inline {
+		encodedDefinition, _, err := r.definition.Encode(
+			/* path = */ map[starlark.Value]struct{}{},
+			valueEncodingOptions,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode rule definition: %w", err)
+		}
+		inlineRuleDefinition = encodedDefinition.Message
+		patcher.Merge(encodedDefinition.Patcher)
+	}
+
 	return starlark.None, targetRegistrar.registerExplicitTarget(
 		name,
 		model_core.NewPatchedMessage(
@@ -413,7 +441,8 @@ func (r *rule) CallInternal(thread *starlark.Thread, args starlark.Tuple, kwargs
 							Testonly:        testOnly,
 							Visibility:      visibilityPackageGroup.Message,
 						},
-						BuildSettingDefault: encodedBuildSettingDefault.Message,
+						BuildSettingDefault:  encodedBuildSettingDefault.Message,
+						InlineRuleDefinition: inlineRuleDefinition,
 					},
 				},
 			},
@@ -471,6 +500,7 @@ type starlarkRuleDefinition struct {
 	buildSetting   *BuildSetting
 	cfg            *Transition
 	execGroups     map[string]*ExecGroup
+	fragments      []string
 	implementation NamedFunction
 	initializer    *NamedFunction
 	provides       []*Provider
@@ -483,6 +513,7 @@ func NewStarlarkRuleDefinition(
 	buildSetting *BuildSetting,
 	cfg *Transition,
 	execGroups map[string]*ExecGroup,
+	fragments []string,
 	implementation NamedFunction,
 	initializer *NamedFunction,
 	provides []*Provider,
@@ -494,6 +525,7 @@ func NewStarlarkRuleDefinition(
 		buildSetting:   buildSetting,
 		cfg:            cfg,
 		execGroups:     execGroups,
+		fragments:      fragments,
 		implementation: implementation,
 		initializer:    initializer,
 		provides:       provides,
@@ -569,6 +601,7 @@ func (rd *starlarkRuleDefinition) Encode(path map[starlark.Value]struct{}, optio
 			Initializer:             initializerMessage,
 			Test:                    rd.test,
 			SubruleIdentifiers:      slices.Compact(subruleIdentifiers),
+			Fragments:               slices.Compact(slices.Sorted(slices.Values(rd.fragments))),
 		},
 		patcher,
 	), needsCode, nil
@@ -3,6 +3,8 @@ package starlark
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	pg_label "github.com/buildbarn/bonanza/pkg/label"
 	model_core "github.com/buildbarn/bonanza/pkg/model/core"
@@ -126,6 +128,121 @@ var (
 	)
 )
 
+type analysisTestTransitionDefinition struct {
+	settings []*model_starlark_pb.Transition_Reference_AnalysisTest_Setting
+}
+
+// NewAnalysisTestTransitionDefinition creates a transition that sets
+// build settings to fixed values, as returned by
+// analysis_test_transition(). The keys of the provided map must be
+// canonical labels of build settings.
+func NewAnalysisTestTransitionDefinition(settings map[string]starlark.Value) (TransitionDefinition, error) {
+	encodedSettings := make([]*model_starlark_pb.Transition_Reference_AnalysisTest_Setting, 0, len(settings))
+	for _, label := range slices.Sorted(maps.Keys(settings)) {
+		encodedValue, err := encodeAnalysisTestSettingValue(settings[label])
+		if err != nil {
+			return nil, fmt.Errorf("setting %#v: %w", label, err)
+		}
+		encodedSettings = append(encodedSettings, &model_starlark_pb.Transition_Reference_AnalysisTest_Setting{
+			Label: label,
+			Value: encodedValue,
+		})
+	}
+	return &analysisTestTransitionDefinition{
+		settings: encodedSettings,
+	}, nil
+}
+
+// encodeAnalysisTestSettingValue encodes the value of a build setting
+// that is set by an analysis test transition. As transitions are
+// embedded in attrs, values are limited to those that can be stored
+// without creating any separate objects.
+func encodeAnalysisTestSettingValue(v starlark.Value) (*model_starlark_pb.Value, error) {
+	switch typedV := v.(type) {
+	case starlark.Bool:
+		return &model_starlark_pb.Value{
+			Kind: &model_starlark_pb.Value_Bool{
+				Bool: bool(typedV),
+			},
+		}, nil
+	case starlark.Int:
+		return &model_starlark_pb.Value{
+			Kind: &model_starlark_pb.Value_Int{
+				Int: typedV.BigInt().Bytes(),
+			},
+		}, nil
+	case label:
+		return &model_starlark_pb.Value{
+			Kind: &model_starlark_pb.Value_Label{
+				Label: typedV.value.String(),
+			},
+		}, nil
+	case starlark.String:
+		return &model_starlark_pb.Value{
+			Kind: &model_starlark_pb.Value_Str{
+				Str: string(typedV),
+			},
+		}, nil
+	case *starlark.List, starlark.Tuple:
+		var elements []*model_starlark_pb.List_Element
+		for element := range starlark.Elements(typedV.(starlark.Iterable)) {
+			encodedElement, err := encodeAnalysisTestSettingValue(element)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, &model_starlark_pb.List_Element{
+				Level: &model_starlark_pb.List_Element_Leaf{
+					Leaf: encodedElement,
+				},
+			})
+		}
+		return &model_starlark_pb.Value{
+			Kind: &model_starlark_pb.Value_List{
+				List: &model_starlark_pb.List{
+					Elements: elements,
+				},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("values of type %s cannot be used by analysis test transitions", v.Type())
+	}
+}
+
+func (analysisTestTransitionDefinition) AssignIdentifier(identifier pg_label.CanonicalStarlarkIdentifier) {
+}
+
+func (td *analysisTestTransitionDefinition) EncodeReference() (*model_starlark_pb.Transition_Reference, error) {
+	return &model_starlark_pb.Transition_Reference{
+		Kind: &model_starlark_pb.Transition_Reference_AnalysisTest_{
+			AnalysisTest: &model_starlark_pb.Transition_Reference_AnalysisTest{
+				Settings: td.settings,
+			},
+		},
+	}, nil
+}
+
+func (analysisTestTransitionDefinition) GetUserDefinedTransitionIdentifier() (string, error) {
+	return "", errors.New("analysis test transitions cannot be used as incoming edge transitions")
+}
+
+func (td *analysisTestTransitionDefinition) EncodeValue(path map[starlark.Value]struct{}, currentIdentifier *pg_label.CanonicalStarlarkIdentifier, options *ValueEncodingOptions) (model_core.PatchedMessage[*model_starlark_pb.Value, dag.ObjectContentsWalker], bool, error) {
+	reference, err := td.EncodeReference()
+	if err != nil {
+		return model_core.PatchedMessage[*model_starlark_pb.Value, dag.ObjectContentsWalker]{}, false, err
+	}
+	return model_core.NewSimplePatchedMessage[dag.ObjectContentsWalker](
+		&model_starlark_pb.Value{
+			Kind: &model_starlark_pb.Value_Transition{
+				Transition: &model_starlark_pb.Transition{
+					Kind: &model_starlark_pb.Transition_Reference_{
+						Reference: reference,
+					},
+				},
+			},
+		},
+	), false, nil
+}
+
 type userDefinedTransitionDefinition struct {
 	LateNamedValue
 
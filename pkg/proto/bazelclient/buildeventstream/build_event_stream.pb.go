@@ -0,0 +1,1700 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: pkg/proto/bazelclient/buildeventstream/build_event_stream.proto
+
+package buildeventstream
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TestSize int32
+
+const (
+	TestSize_UNKNOWN  TestSize = 0
+	TestSize_SMALL    TestSize = 1
+	TestSize_MEDIUM   TestSize = 2
+	TestSize_LARGE    TestSize = 3
+	TestSize_ENORMOUS TestSize = 4
+)
+
+// Enum value maps for TestSize.
+var (
+	TestSize_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "SMALL",
+		2: "MEDIUM",
+		3: "LARGE",
+		4: "ENORMOUS",
+	}
+	TestSize_value = map[string]int32{
+		"UNKNOWN":  0,
+		"SMALL":    1,
+		"MEDIUM":   2,
+		"LARGE":    3,
+		"ENORMOUS": 4,
+	}
+)
+
+func (x TestSize) Enum() *TestSize {
+	p := new(TestSize)
+	*p = x
+	return p
+}
+
+func (x TestSize) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestSize) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_enumTypes[0].Descriptor()
+}
+
+func (TestSize) Type() protoreflect.EnumType {
+	return &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_enumTypes[0]
+}
+
+func (x TestSize) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TestSize.Descriptor instead.
+func (TestSize) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{0}
+}
+
+type TestStatus int32
+
+const (
+	TestStatus_NO_STATUS                  TestStatus = 0
+	TestStatus_PASSED                     TestStatus = 1
+	TestStatus_FLAKY                      TestStatus = 2
+	TestStatus_TIMEOUT                    TestStatus = 3
+	TestStatus_FAILED                     TestStatus = 4
+	TestStatus_INCOMPLETE                 TestStatus = 5
+	TestStatus_REMOTE_FAILURE             TestStatus = 6
+	TestStatus_FAILED_TO_BUILD            TestStatus = 7
+	TestStatus_TOOL_HALTED_BEFORE_TESTING TestStatus = 8
+)
+
+// Enum value maps for TestStatus.
+var (
+	TestStatus_name = map[int32]string{
+		0: "NO_STATUS",
+		1: "PASSED",
+		2: "FLAKY",
+		3: "TIMEOUT",
+		4: "FAILED",
+		5: "INCOMPLETE",
+		6: "REMOTE_FAILURE",
+		7: "FAILED_TO_BUILD",
+		8: "TOOL_HALTED_BEFORE_TESTING",
+	}
+	TestStatus_value = map[string]int32{
+		"NO_STATUS":                  0,
+		"PASSED":                     1,
+		"FLAKY":                      2,
+		"TIMEOUT":                    3,
+		"FAILED":                     4,
+		"INCOMPLETE":                 5,
+		"REMOTE_FAILURE":             6,
+		"FAILED_TO_BUILD":            7,
+		"TOOL_HALTED_BEFORE_TESTING": 8,
+	}
+)
+
+func (x TestStatus) Enum() *TestStatus {
+	p := new(TestStatus)
+	*p = x
+	return p
+}
+
+func (x TestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_enumTypes[1].Descriptor()
+}
+
+func (TestStatus) Type() protoreflect.EnumType {
+	return &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_enumTypes[1]
+}
+
+func (x TestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TestStatus.Descriptor instead.
+func (TestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{1}
+}
+
+type BuildEventId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Id:
+	//
+	//	*BuildEventId_Progress
+	//	*BuildEventId_Started
+	//	*BuildEventId_Pattern
+	//	*BuildEventId_TargetConfigured
+	//	*BuildEventId_TargetCompleted
+	//	*BuildEventId_TestResult
+	//	*BuildEventId_BuildFinished
+	Id            isBuildEventId_Id `protobuf_oneof:"id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildEventId) Reset() {
+	*x = BuildEventId{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildEventId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId) ProtoMessage() {}
+
+func (x *BuildEventId) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId.ProtoReflect.Descriptor instead.
+func (*BuildEventId) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BuildEventId) GetId() isBuildEventId_Id {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetProgress() *BuildEventId_ProgressId {
+	if x != nil {
+		if x, ok := x.Id.(*BuildEventId_Progress); ok {
+			return x.Progress
+		}
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetStarted() *BuildEventId_BuildStartedId {
+	if x != nil {
+		if x, ok := x.Id.(*BuildEventId_Started); ok {
+			return x.Started
+		}
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetPattern() *BuildEventId_PatternExpandedId {
+	if x != nil {
+		if x, ok := x.Id.(*BuildEventId_Pattern); ok {
+			return x.Pattern
+		}
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetTargetConfigured() *BuildEventId_TargetConfiguredId {
+	if x != nil {
+		if x, ok := x.Id.(*BuildEventId_TargetConfigured); ok {
+			return x.TargetConfigured
+		}
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetTargetCompleted() *BuildEventId_TargetCompletedId {
+	if x != nil {
+		if x, ok := x.Id.(*BuildEventId_TargetCompleted); ok {
+			return x.TargetCompleted
+		}
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetTestResult() *BuildEventId_TestResultId {
+	if x != nil {
+		if x, ok := x.Id.(*BuildEventId_TestResult); ok {
+			return x.TestResult
+		}
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetBuildFinished() *BuildEventId_BuildFinishedId {
+	if x != nil {
+		if x, ok := x.Id.(*BuildEventId_BuildFinished); ok {
+			return x.BuildFinished
+		}
+	}
+	return nil
+}
+
+type isBuildEventId_Id interface {
+	isBuildEventId_Id()
+}
+
+type BuildEventId_Progress struct {
+	Progress *BuildEventId_ProgressId `protobuf:"bytes,2,opt,name=progress,proto3,oneof"`
+}
+
+type BuildEventId_Started struct {
+	Started *BuildEventId_BuildStartedId `protobuf:"bytes,3,opt,name=started,proto3,oneof"`
+}
+
+type BuildEventId_Pattern struct {
+	Pattern *BuildEventId_PatternExpandedId `protobuf:"bytes,4,opt,name=pattern,proto3,oneof"`
+}
+
+type BuildEventId_TargetConfigured struct {
+	TargetConfigured *BuildEventId_TargetConfiguredId `protobuf:"bytes,16,opt,name=target_configured,json=targetConfigured,proto3,oneof"`
+}
+
+type BuildEventId_TargetCompleted struct {
+	TargetCompleted *BuildEventId_TargetCompletedId `protobuf:"bytes,5,opt,name=target_completed,json=targetCompleted,proto3,oneof"`
+}
+
+type BuildEventId_TestResult struct {
+	TestResult *BuildEventId_TestResultId `protobuf:"bytes,8,opt,name=test_result,json=testResult,proto3,oneof"`
+}
+
+type BuildEventId_BuildFinished struct {
+	BuildFinished *BuildEventId_BuildFinishedId `protobuf:"bytes,9,opt,name=build_finished,json=buildFinished,proto3,oneof"`
+}
+
+func (*BuildEventId_Progress) isBuildEventId_Id() {}
+
+func (*BuildEventId_Started) isBuildEventId_Id() {}
+
+func (*BuildEventId_Pattern) isBuildEventId_Id() {}
+
+func (*BuildEventId_TargetConfigured) isBuildEventId_Id() {}
+
+func (*BuildEventId_TargetCompleted) isBuildEventId_Id() {}
+
+func (*BuildEventId_TestResult) isBuildEventId_Id() {}
+
+func (*BuildEventId_BuildFinished) isBuildEventId_Id() {}
+
+type Progress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stdout        string                 `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr        string                 `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Progress) Reset() {
+	*x = Progress{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Progress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Progress) ProtoMessage() {}
+
+func (x *Progress) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Progress.ProtoReflect.Descriptor instead.
+func (*Progress) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Progress) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *Progress) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+type BuildStarted struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Uuid               string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	StartTime          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	BuildToolVersion   string                 `protobuf:"bytes,3,opt,name=build_tool_version,json=buildToolVersion,proto3" json:"build_tool_version,omitempty"`
+	OptionsDescription string                 `protobuf:"bytes,4,opt,name=options_description,json=optionsDescription,proto3" json:"options_description,omitempty"`
+	Command            string                 `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	WorkingDirectory   string                 `protobuf:"bytes,6,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
+	WorkspaceDirectory string                 `protobuf:"bytes,7,opt,name=workspace_directory,json=workspaceDirectory,proto3" json:"workspace_directory,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BuildStarted) Reset() {
+	*x = BuildStarted{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildStarted) ProtoMessage() {}
+
+func (x *BuildStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildStarted.ProtoReflect.Descriptor instead.
+func (*BuildStarted) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BuildStarted) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *BuildStarted) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *BuildStarted) GetBuildToolVersion() string {
+	if x != nil {
+		return x.BuildToolVersion
+	}
+	return ""
+}
+
+func (x *BuildStarted) GetOptionsDescription() string {
+	if x != nil {
+		return x.OptionsDescription
+	}
+	return ""
+}
+
+func (x *BuildStarted) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *BuildStarted) GetWorkingDirectory() string {
+	if x != nil {
+		return x.WorkingDirectory
+	}
+	return ""
+}
+
+func (x *BuildStarted) GetWorkspaceDirectory() string {
+	if x != nil {
+		return x.WorkspaceDirectory
+	}
+	return ""
+}
+
+type PatternExpanded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatternExpanded) Reset() {
+	*x = PatternExpanded{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatternExpanded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatternExpanded) ProtoMessage() {}
+
+func (x *PatternExpanded) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatternExpanded.ProtoReflect.Descriptor instead.
+func (*PatternExpanded) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{3}
+}
+
+type TargetConfigured struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetKind    string                 `protobuf:"bytes,1,opt,name=target_kind,json=targetKind,proto3" json:"target_kind,omitempty"`
+	TestSize      TestSize               `protobuf:"varint,2,opt,name=test_size,json=testSize,proto3,enum=build_event_stream.TestSize" json:"test_size,omitempty"`
+	Tag           []string               `protobuf:"bytes,3,rep,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetConfigured) Reset() {
+	*x = TargetConfigured{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetConfigured) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetConfigured) ProtoMessage() {}
+
+func (x *TargetConfigured) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetConfigured.ProtoReflect.Descriptor instead.
+func (*TargetConfigured) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TargetConfigured) GetTargetKind() string {
+	if x != nil {
+		return x.TargetKind
+	}
+	return ""
+}
+
+func (x *TargetConfigured) GetTestSize() TestSize {
+	if x != nil {
+		return x.TestSize
+	}
+	return TestSize_UNKNOWN
+}
+
+func (x *TargetConfigured) GetTag() []string {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type TargetComplete struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Tag           []string               `protobuf:"bytes,3,rep,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetComplete) Reset() {
+	*x = TargetComplete{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetComplete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetComplete) ProtoMessage() {}
+
+func (x *TargetComplete) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetComplete.ProtoReflect.Descriptor instead.
+func (*TargetComplete) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TargetComplete) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TargetComplete) GetTag() []string {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type TestResult struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Status              TestStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=build_event_stream.TestStatus" json:"status,omitempty"`
+	StatusDetails       string                 `protobuf:"bytes,9,opt,name=status_details,json=statusDetails,proto3" json:"status_details,omitempty"`
+	CachedLocally       bool                   `protobuf:"varint,4,opt,name=cached_locally,json=cachedLocally,proto3" json:"cached_locally,omitempty"`
+	TestAttemptStart    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=test_attempt_start,json=testAttemptStart,proto3" json:"test_attempt_start,omitempty"`
+	TestAttemptDuration *durationpb.Duration   `protobuf:"bytes,11,opt,name=test_attempt_duration,json=testAttemptDuration,proto3" json:"test_attempt_duration,omitempty"`
+	Warning             []string               `protobuf:"bytes,7,rep,name=warning,proto3" json:"warning,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TestResult) Reset() {
+	*x = TestResult{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResult) ProtoMessage() {}
+
+func (x *TestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResult.ProtoReflect.Descriptor instead.
+func (*TestResult) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TestResult) GetStatus() TestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TestStatus_NO_STATUS
+}
+
+func (x *TestResult) GetStatusDetails() string {
+	if x != nil {
+		return x.StatusDetails
+	}
+	return ""
+}
+
+func (x *TestResult) GetCachedLocally() bool {
+	if x != nil {
+		return x.CachedLocally
+	}
+	return false
+}
+
+func (x *TestResult) GetTestAttemptStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TestAttemptStart
+	}
+	return nil
+}
+
+func (x *TestResult) GetTestAttemptDuration() *durationpb.Duration {
+	if x != nil {
+		return x.TestAttemptDuration
+	}
+	return nil
+}
+
+func (x *TestResult) GetWarning() []string {
+	if x != nil {
+		return x.Warning
+	}
+	return nil
+}
+
+type BuildFinished struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ExitCode      *BuildFinished_ExitCode `protobuf:"bytes,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	FinishTime    *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildFinished) Reset() {
+	*x = BuildFinished{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildFinished) ProtoMessage() {}
+
+func (x *BuildFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildFinished.ProtoReflect.Descriptor instead.
+func (*BuildFinished) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BuildFinished) GetExitCode() *BuildFinished_ExitCode {
+	if x != nil {
+		return x.ExitCode
+	}
+	return nil
+}
+
+func (x *BuildFinished) GetFinishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishTime
+	}
+	return nil
+}
+
+type BuildEvent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          *BuildEventId          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Children    []*BuildEventId        `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	LastMessage bool                   `protobuf:"varint,20,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*BuildEvent_Progress
+	//	*BuildEvent_Started
+	//	*BuildEvent_Expanded
+	//	*BuildEvent_Configured
+	//	*BuildEvent_Completed
+	//	*BuildEvent_TestResult
+	//	*BuildEvent_Finished
+	Payload       isBuildEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildEvent) Reset() {
+	*x = BuildEvent{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEvent) ProtoMessage() {}
+
+func (x *BuildEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEvent.ProtoReflect.Descriptor instead.
+func (*BuildEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BuildEvent) GetId() *BuildEventId {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetChildren() []*BuildEventId {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetLastMessage() bool {
+	if x != nil {
+		return x.LastMessage
+	}
+	return false
+}
+
+func (x *BuildEvent) GetPayload() isBuildEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetProgress() *Progress {
+	if x != nil {
+		if x, ok := x.Payload.(*BuildEvent_Progress); ok {
+			return x.Progress
+		}
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetStarted() *BuildStarted {
+	if x != nil {
+		if x, ok := x.Payload.(*BuildEvent_Started); ok {
+			return x.Started
+		}
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetExpanded() *PatternExpanded {
+	if x != nil {
+		if x, ok := x.Payload.(*BuildEvent_Expanded); ok {
+			return x.Expanded
+		}
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetConfigured() *TargetConfigured {
+	if x != nil {
+		if x, ok := x.Payload.(*BuildEvent_Configured); ok {
+			return x.Configured
+		}
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetCompleted() *TargetComplete {
+	if x != nil {
+		if x, ok := x.Payload.(*BuildEvent_Completed); ok {
+			return x.Completed
+		}
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetTestResult() *TestResult {
+	if x != nil {
+		if x, ok := x.Payload.(*BuildEvent_TestResult); ok {
+			return x.TestResult
+		}
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetFinished() *BuildFinished {
+	if x != nil {
+		if x, ok := x.Payload.(*BuildEvent_Finished); ok {
+			return x.Finished
+		}
+	}
+	return nil
+}
+
+type isBuildEvent_Payload interface {
+	isBuildEvent_Payload()
+}
+
+type BuildEvent_Progress struct {
+	Progress *Progress `protobuf:"bytes,3,opt,name=progress,proto3,oneof"`
+}
+
+type BuildEvent_Started struct {
+	Started *BuildStarted `protobuf:"bytes,5,opt,name=started,proto3,oneof"`
+}
+
+type BuildEvent_Expanded struct {
+	Expanded *PatternExpanded `protobuf:"bytes,6,opt,name=expanded,proto3,oneof"`
+}
+
+type BuildEvent_Configured struct {
+	Configured *TargetConfigured `protobuf:"bytes,18,opt,name=configured,proto3,oneof"`
+}
+
+type BuildEvent_Completed struct {
+	Completed *TargetComplete `protobuf:"bytes,8,opt,name=completed,proto3,oneof"`
+}
+
+type BuildEvent_TestResult struct {
+	TestResult *TestResult `protobuf:"bytes,10,opt,name=test_result,json=testResult,proto3,oneof"`
+}
+
+type BuildEvent_Finished struct {
+	Finished *BuildFinished `protobuf:"bytes,14,opt,name=finished,proto3,oneof"`
+}
+
+func (*BuildEvent_Progress) isBuildEvent_Payload() {}
+
+func (*BuildEvent_Started) isBuildEvent_Payload() {}
+
+func (*BuildEvent_Expanded) isBuildEvent_Payload() {}
+
+func (*BuildEvent_Configured) isBuildEvent_Payload() {}
+
+func (*BuildEvent_Completed) isBuildEvent_Payload() {}
+
+func (*BuildEvent_TestResult) isBuildEvent_Payload() {}
+
+func (*BuildEvent_Finished) isBuildEvent_Payload() {}
+
+type BuildEventId_ProgressId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpaqueCount   int32                  `protobuf:"varint,1,opt,name=opaque_count,json=opaqueCount,proto3" json:"opaque_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildEventId_ProgressId) Reset() {
+	*x = BuildEventId_ProgressId{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildEventId_ProgressId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_ProgressId) ProtoMessage() {}
+
+func (x *BuildEventId_ProgressId) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_ProgressId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_ProgressId) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *BuildEventId_ProgressId) GetOpaqueCount() int32 {
+	if x != nil {
+		return x.OpaqueCount
+	}
+	return 0
+}
+
+type BuildEventId_BuildStartedId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildEventId_BuildStartedId) Reset() {
+	*x = BuildEventId_BuildStartedId{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildEventId_BuildStartedId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_BuildStartedId) ProtoMessage() {}
+
+func (x *BuildEventId_BuildStartedId) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_BuildStartedId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_BuildStartedId) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type BuildEventId_PatternExpandedId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       []string               `protobuf:"bytes,1,rep,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildEventId_PatternExpandedId) Reset() {
+	*x = BuildEventId_PatternExpandedId{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildEventId_PatternExpandedId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_PatternExpandedId) ProtoMessage() {}
+
+func (x *BuildEventId_PatternExpandedId) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_PatternExpandedId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_PatternExpandedId) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *BuildEventId_PatternExpandedId) GetPattern() []string {
+	if x != nil {
+		return x.Pattern
+	}
+	return nil
+}
+
+type BuildEventId_TargetConfiguredId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Aspect        string                 `protobuf:"bytes,2,opt,name=aspect,proto3" json:"aspect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildEventId_TargetConfiguredId) Reset() {
+	*x = BuildEventId_TargetConfiguredId{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildEventId_TargetConfiguredId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_TargetConfiguredId) ProtoMessage() {}
+
+func (x *BuildEventId_TargetConfiguredId) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_TargetConfiguredId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_TargetConfiguredId) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *BuildEventId_TargetConfiguredId) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BuildEventId_TargetConfiguredId) GetAspect() string {
+	if x != nil {
+		return x.Aspect
+	}
+	return ""
+}
+
+type BuildEventId_ConfigurationId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildEventId_ConfigurationId) Reset() {
+	*x = BuildEventId_ConfigurationId{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildEventId_ConfigurationId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_ConfigurationId) ProtoMessage() {}
+
+func (x *BuildEventId_ConfigurationId) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_ConfigurationId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_ConfigurationId) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *BuildEventId_ConfigurationId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type BuildEventId_TargetCompletedId struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Label         string                        `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Configuration *BuildEventId_ConfigurationId `protobuf:"bytes,3,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Aspect        string                        `protobuf:"bytes,2,opt,name=aspect,proto3" json:"aspect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildEventId_TargetCompletedId) Reset() {
+	*x = BuildEventId_TargetCompletedId{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildEventId_TargetCompletedId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_TargetCompletedId) ProtoMessage() {}
+
+func (x *BuildEventId_TargetCompletedId) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_TargetCompletedId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_TargetCompletedId) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *BuildEventId_TargetCompletedId) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BuildEventId_TargetCompletedId) GetConfiguration() *BuildEventId_ConfigurationId {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *BuildEventId_TargetCompletedId) GetAspect() string {
+	if x != nil {
+		return x.Aspect
+	}
+	return ""
+}
+
+type BuildEventId_TestResultId struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Label         string                        `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Configuration *BuildEventId_ConfigurationId `protobuf:"bytes,5,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Run           int32                         `protobuf:"varint,2,opt,name=run,proto3" json:"run,omitempty"`
+	Shard         int32                         `protobuf:"varint,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	Attempt       int32                         `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildEventId_TestResultId) Reset() {
+	*x = BuildEventId_TestResultId{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildEventId_TestResultId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_TestResultId) ProtoMessage() {}
+
+func (x *BuildEventId_TestResultId) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_TestResultId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_TestResultId) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *BuildEventId_TestResultId) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BuildEventId_TestResultId) GetConfiguration() *BuildEventId_ConfigurationId {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *BuildEventId_TestResultId) GetRun() int32 {
+	if x != nil {
+		return x.Run
+	}
+	return 0
+}
+
+func (x *BuildEventId_TestResultId) GetShard() int32 {
+	if x != nil {
+		return x.Shard
+	}
+	return 0
+}
+
+func (x *BuildEventId_TestResultId) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+type BuildEventId_BuildFinishedId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildEventId_BuildFinishedId) Reset() {
+	*x = BuildEventId_BuildFinishedId{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildEventId_BuildFinishedId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_BuildFinishedId) ProtoMessage() {}
+
+func (x *BuildEventId_BuildFinishedId) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_BuildFinishedId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_BuildFinishedId) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{0, 7}
+}
+
+type BuildFinished_ExitCode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildFinished_ExitCode) Reset() {
+	*x = BuildFinished_ExitCode{}
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildFinished_ExitCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildFinished_ExitCode) ProtoMessage() {}
+
+func (x *BuildFinished_ExitCode) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildFinished_ExitCode.ProtoReflect.Descriptor instead.
+func (*BuildFinished_ExitCode) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *BuildFinished_ExitCode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuildFinished_ExitCode) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+var File_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDesc = string([]byte{
+	0x0a, 0x3f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x61, 0x7a, 0x65,
+	0x6c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7, 0x09, 0x0a, 0x0c, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x49, 0x64, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x4b, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x49, 0x64, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x4e, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x2e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64,
+	0x65, 0x64, 0x49, 0x64, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x62, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x49, 0x64, 0x48,
+	0x00, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x64, 0x12, 0x5f, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x49,
+	0x64, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x49, 0x64,
+	0x48, 0x00, 0x52, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x1a, 0x2f, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x10, 0x0a, 0x0e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x49, 0x64, 0x1a, 0x2d, 0x0a, 0x11, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x45,
+	0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x1a, 0x42, 0x0a, 0x12, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x1a, 0x21, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x1a, 0x99, 0x01, 0x0a, 0x11, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x56, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x1a, 0xbe, 0x01, 0x0a, 0x0c, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x56, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x1a, 0x11, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x49, 0x64, 0x42, 0x04, 0x0a, 0x02, 0x69, 0x64,
+	0x22, 0x3a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x22, 0xb4, 0x02, 0x0a,
+	0x0c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x54,
+	0x6f, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x45, 0x78,
+	0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x10, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x09,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x08, 0x74,
+	0x65, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x3c, 0x0a, 0x0e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0xc5, 0x02, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x6c, 0x79, 0x12, 0x48, 0x0a, 0x12,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x4d, 0x0a, 0x15, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x13, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x22,
+	0xc9, 0x01, 0x0a, 0x0d, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x12, 0x47, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x32, 0x0a, 0x08, 0x45, 0x78, 0x69, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xf7, 0x04, 0x0a, 0x0a,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x08,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x61, 0x6e,
+	0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x08, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x64, 0x12, 0x42, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x74,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x47, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09,
+	0x0a, 0x05, 0x53, 0x4d, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x44,
+	0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x41, 0x52, 0x47, 0x45, 0x10, 0x03,
+	0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4e, 0x4f, 0x52, 0x4d, 0x4f, 0x55, 0x53, 0x10, 0x04, 0x2a, 0xa4,
+	0x01, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a,
+	0x09, 0x4e, 0x4f, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4c, 0x41, 0x4b,
+	0x59, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x03,
+	0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a,
+	0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e,
+	0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x06,
+	0x12, 0x13, 0x0a, 0x0f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x5f, 0x42, 0x55,
+	0x49, 0x4c, 0x44, 0x10, 0x07, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x4f, 0x4c, 0x5f, 0x48, 0x41,
+	0x4c, 0x54, 0x45, 0x44, 0x5f, 0x42, 0x45, 0x46, 0x4f, 0x52, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x08, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x6f,
+	0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x62, 0x61, 0x7a, 0x65, 0x6c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescOnce sync.Once
+	file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescData []byte
+)
+
+func file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescGZIP() []byte {
+	file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDesc), len(file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDesc)))
+	})
+	return file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDescData
+}
+
+var file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_goTypes = []any{
+	(TestSize)(0),                           // 0: build_event_stream.TestSize
+	(TestStatus)(0),                         // 1: build_event_stream.TestStatus
+	(*BuildEventId)(nil),                    // 2: build_event_stream.BuildEventId
+	(*Progress)(nil),                        // 3: build_event_stream.Progress
+	(*BuildStarted)(nil),                    // 4: build_event_stream.BuildStarted
+	(*PatternExpanded)(nil),                 // 5: build_event_stream.PatternExpanded
+	(*TargetConfigured)(nil),                // 6: build_event_stream.TargetConfigured
+	(*TargetComplete)(nil),                  // 7: build_event_stream.TargetComplete
+	(*TestResult)(nil),                      // 8: build_event_stream.TestResult
+	(*BuildFinished)(nil),                   // 9: build_event_stream.BuildFinished
+	(*BuildEvent)(nil),                      // 10: build_event_stream.BuildEvent
+	(*BuildEventId_ProgressId)(nil),         // 11: build_event_stream.BuildEventId.ProgressId
+	(*BuildEventId_BuildStartedId)(nil),     // 12: build_event_stream.BuildEventId.BuildStartedId
+	(*BuildEventId_PatternExpandedId)(nil),  // 13: build_event_stream.BuildEventId.PatternExpandedId
+	(*BuildEventId_TargetConfiguredId)(nil), // 14: build_event_stream.BuildEventId.TargetConfiguredId
+	(*BuildEventId_ConfigurationId)(nil),    // 15: build_event_stream.BuildEventId.ConfigurationId
+	(*BuildEventId_TargetCompletedId)(nil),  // 16: build_event_stream.BuildEventId.TargetCompletedId
+	(*BuildEventId_TestResultId)(nil),       // 17: build_event_stream.BuildEventId.TestResultId
+	(*BuildEventId_BuildFinishedId)(nil),    // 18: build_event_stream.BuildEventId.BuildFinishedId
+	(*BuildFinished_ExitCode)(nil),          // 19: build_event_stream.BuildFinished.ExitCode
+	(*timestamppb.Timestamp)(nil),           // 20: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 21: google.protobuf.Duration
+}
+var file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_depIdxs = []int32{
+	11, // 0: build_event_stream.BuildEventId.progress:type_name -> build_event_stream.BuildEventId.ProgressId
+	12, // 1: build_event_stream.BuildEventId.started:type_name -> build_event_stream.BuildEventId.BuildStartedId
+	13, // 2: build_event_stream.BuildEventId.pattern:type_name -> build_event_stream.BuildEventId.PatternExpandedId
+	14, // 3: build_event_stream.BuildEventId.target_configured:type_name -> build_event_stream.BuildEventId.TargetConfiguredId
+	16, // 4: build_event_stream.BuildEventId.target_completed:type_name -> build_event_stream.BuildEventId.TargetCompletedId
+	17, // 5: build_event_stream.BuildEventId.test_result:type_name -> build_event_stream.BuildEventId.TestResultId
+	18, // 6: build_event_stream.BuildEventId.build_finished:type_name -> build_event_stream.BuildEventId.BuildFinishedId
+	20, // 7: build_event_stream.BuildStarted.start_time:type_name -> google.protobuf.Timestamp
+	0,  // 8: build_event_stream.TargetConfigured.test_size:type_name -> build_event_stream.TestSize
+	1,  // 9: build_event_stream.TestResult.status:type_name -> build_event_stream.TestStatus
+	20, // 10: build_event_stream.TestResult.test_attempt_start:type_name -> google.protobuf.Timestamp
+	21, // 11: build_event_stream.TestResult.test_attempt_duration:type_name -> google.protobuf.Duration
+	19, // 12: build_event_stream.BuildFinished.exit_code:type_name -> build_event_stream.BuildFinished.ExitCode
+	20, // 13: build_event_stream.BuildFinished.finish_time:type_name -> google.protobuf.Timestamp
+	2,  // 14: build_event_stream.BuildEvent.id:type_name -> build_event_stream.BuildEventId
+	2,  // 15: build_event_stream.BuildEvent.children:type_name -> build_event_stream.BuildEventId
+	3,  // 16: build_event_stream.BuildEvent.progress:type_name -> build_event_stream.Progress
+	4,  // 17: build_event_stream.BuildEvent.started:type_name -> build_event_stream.BuildStarted
+	5,  // 18: build_event_stream.BuildEvent.expanded:type_name -> build_event_stream.PatternExpanded
+	6,  // 19: build_event_stream.BuildEvent.configured:type_name -> build_event_stream.TargetConfigured
+	7,  // 20: build_event_stream.BuildEvent.completed:type_name -> build_event_stream.TargetComplete
+	8,  // 21: build_event_stream.BuildEvent.test_result:type_name -> build_event_stream.TestResult
+	9,  // 22: build_event_stream.BuildEvent.finished:type_name -> build_event_stream.BuildFinished
+	15, // 23: build_event_stream.BuildEventId.TargetCompletedId.configuration:type_name -> build_event_stream.BuildEventId.ConfigurationId
+	15, // 24: build_event_stream.BuildEventId.TestResultId.configuration:type_name -> build_event_stream.BuildEventId.ConfigurationId
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_init() }
+func file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_init() {
+	if File_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto != nil {
+		return
+	}
+	file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[0].OneofWrappers = []any{
+		(*BuildEventId_Progress)(nil),
+		(*BuildEventId_Started)(nil),
+		(*BuildEventId_Pattern)(nil),
+		(*BuildEventId_TargetConfigured)(nil),
+		(*BuildEventId_TargetCompleted)(nil),
+		(*BuildEventId_TestResult)(nil),
+		(*BuildEventId_BuildFinished)(nil),
+	}
+	file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes[8].OneofWrappers = []any{
+		(*BuildEvent_Progress)(nil),
+		(*BuildEvent_Started)(nil),
+		(*BuildEvent_Expanded)(nil),
+		(*BuildEvent_Configured)(nil),
+		(*BuildEvent_Completed)(nil),
+		(*BuildEvent_TestResult)(nil),
+		(*BuildEvent_Finished)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDesc), len(file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_depIdxs,
+		EnumInfos:         file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_enumTypes,
+		MessageInfos:      file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto = out.File
+	file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_goTypes = nil
+	file_pkg_proto_bazelclient_buildeventstream_build_event_stream_proto_depIdxs = nil
+}
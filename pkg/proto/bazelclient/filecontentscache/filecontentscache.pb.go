@@ -0,0 +1,422 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: pkg/proto/bazelclient/filecontentscache/filecontentscache.proto
+
+package filecontentscache
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FileContentsCache struct {
+	state                        protoimpl.MessageState         `protogen:"open.v1"`
+	FileCreationParametersDigest []byte                         `protobuf:"bytes,1,opt,name=file_creation_parameters_digest,json=fileCreationParametersDigest,proto3" json:"file_creation_parameters_digest,omitempty"`
+	Files                        []*FileContentsCache_File      `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	Directories                  []*FileContentsCache_Directory `protobuf:"bytes,3,rep,name=directories,proto3" json:"directories,omitempty"`
+	Objects                      []*FileContentsCache_Object    `protobuf:"bytes,4,rep,name=objects,proto3" json:"objects,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *FileContentsCache) Reset() {
+	*x = FileContentsCache{}
+	mi := &file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileContentsCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileContentsCache) ProtoMessage() {}
+
+func (x *FileContentsCache) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileContentsCache.ProtoReflect.Descriptor instead.
+func (*FileContentsCache) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FileContentsCache) GetFileCreationParametersDigest() []byte {
+	if x != nil {
+		return x.FileCreationParametersDigest
+	}
+	return nil
+}
+
+func (x *FileContentsCache) GetFiles() []*FileContentsCache_File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *FileContentsCache) GetDirectories() []*FileContentsCache_Directory {
+	if x != nil {
+		return x.Directories
+	}
+	return nil
+}
+
+func (x *FileContentsCache) GetObjects() []*FileContentsCache_Object {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+type FileContentsCache_File struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Path             string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	InodeNumber      uint64                 `protobuf:"varint,2,opt,name=inode_number,json=inodeNumber,proto3" json:"inode_number,omitempty"`
+	SizeBytes        uint64                 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ModificationTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=modification_time,json=modificationTime,proto3" json:"modification_time,omitempty"`
+	ChangeTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=change_time,json=changeTime,proto3" json:"change_time,omitempty"`
+	Reference        []byte                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FileContentsCache_File) Reset() {
+	*x = FileContentsCache_File{}
+	mi := &file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileContentsCache_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileContentsCache_File) ProtoMessage() {}
+
+func (x *FileContentsCache_File) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileContentsCache_File.ProtoReflect.Descriptor instead.
+func (*FileContentsCache_File) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *FileContentsCache_File) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileContentsCache_File) GetInodeNumber() uint64 {
+	if x != nil {
+		return x.InodeNumber
+	}
+	return 0
+}
+
+func (x *FileContentsCache_File) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *FileContentsCache_File) GetModificationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModificationTime
+	}
+	return nil
+}
+
+func (x *FileContentsCache_File) GetChangeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangeTime
+	}
+	return nil
+}
+
+func (x *FileContentsCache_File) GetReference() []byte {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+type FileContentsCache_Directory struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Path               string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Key                []byte                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Directory          []byte                 `protobuf:"bytes,3,opt,name=directory,proto3" json:"directory,omitempty"`
+	OutgoingReferences [][]byte               `protobuf:"bytes,4,rep,name=outgoing_references,json=outgoingReferences,proto3" json:"outgoing_references,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FileContentsCache_Directory) Reset() {
+	*x = FileContentsCache_Directory{}
+	mi := &file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileContentsCache_Directory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileContentsCache_Directory) ProtoMessage() {}
+
+func (x *FileContentsCache_Directory) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileContentsCache_Directory.ProtoReflect.Descriptor instead.
+func (*FileContentsCache_Directory) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *FileContentsCache_Directory) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileContentsCache_Directory) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *FileContentsCache_Directory) GetDirectory() []byte {
+	if x != nil {
+		return x.Directory
+	}
+	return nil
+}
+
+func (x *FileContentsCache_Directory) GetOutgoingReferences() [][]byte {
+	if x != nil {
+		return x.OutgoingReferences
+	}
+	return nil
+}
+
+type FileContentsCache_Object struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reference     []byte                 `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileContentsCache_Object) Reset() {
+	*x = FileContentsCache_Object{}
+	mi := &file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileContentsCache_Object) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileContentsCache_Object) ProtoMessage() {}
+
+func (x *FileContentsCache_Object) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileContentsCache_Object.ProtoReflect.Descriptor instead.
+func (*FileContentsCache_Object) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *FileContentsCache_Object) GetReference() []byte {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *FileContentsCache_Object) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDesc = string([]byte{
+	0x0a, 0x3f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x61, 0x7a, 0x65,
+	0x6c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x73, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x25, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x63, 0x61, 0x63, 0x68, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x06, 0x0a, 0x11, 0x46, 0x69,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12,
+	0x45, 0x0a, 0x1f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x64, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1c, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e,
+	0x62, 0x61, 0x7a, 0x65, 0x6c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x69, 0x6c, 0x65,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x0b, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x42, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x59, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x62, 0x61, 0x7a,
+	0x65, 0x6c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x73, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x80, 0x02, 0x0a,
+	0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a,
+	0x80, 0x01, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x12,
+	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x46,
+	0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x73, 0x63, 0x61, 0x63, 0x68, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDescOnce sync.Once
+	file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDescData []byte
+)
+
+func file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDescGZIP() []byte {
+	file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDesc), len(file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDesc)))
+	})
+	return file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDescData
+}
+
+var file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_goTypes = []any{
+	(*FileContentsCache)(nil),           // 0: bonanza.bazelclient.filecontentscache.FileContentsCache
+	(*FileContentsCache_File)(nil),      // 1: bonanza.bazelclient.filecontentscache.FileContentsCache.File
+	(*FileContentsCache_Directory)(nil), // 2: bonanza.bazelclient.filecontentscache.FileContentsCache.Directory
+	(*FileContentsCache_Object)(nil),    // 3: bonanza.bazelclient.filecontentscache.FileContentsCache.Object
+	(*timestamppb.Timestamp)(nil),       // 4: google.protobuf.Timestamp
+}
+var file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_depIdxs = []int32{
+	1, // 0: bonanza.bazelclient.filecontentscache.FileContentsCache.files:type_name -> bonanza.bazelclient.filecontentscache.FileContentsCache.File
+	2, // 1: bonanza.bazelclient.filecontentscache.FileContentsCache.directories:type_name -> bonanza.bazelclient.filecontentscache.FileContentsCache.Directory
+	3, // 2: bonanza.bazelclient.filecontentscache.FileContentsCache.objects:type_name -> bonanza.bazelclient.filecontentscache.FileContentsCache.Object
+	4, // 3: bonanza.bazelclient.filecontentscache.FileContentsCache.File.modification_time:type_name -> google.protobuf.Timestamp
+	4, // 4: bonanza.bazelclient.filecontentscache.FileContentsCache.File.change_time:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_init() }
+func file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_init() {
+	if File_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDesc), len(file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto = out.File
+	file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_goTypes = nil
+	file_pkg_proto_bazelclient_filecontentscache_filecontentscache_proto_depIdxs = nil
+}
@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: pkg/proto/bazelclient/pendingbuild/pendingbuild.proto
+
+package pendingbuild
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PendingBuild struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	OperationName          string                 `protobuf:"bytes,1,opt,name=operation_name,json=operationName,proto3" json:"operation_name,omitempty"`
+	BuilderPkixPublicKey   []byte                 `protobuf:"bytes,2,opt,name=builder_pkix_public_key,json=builderPkixPublicKey,proto3" json:"builder_pkix_public_key,omitempty"`
+	ActionCiphertextSha256 []byte                 `protobuf:"bytes,3,opt,name=action_ciphertext_sha256,json=actionCiphertextSha256,proto3" json:"action_ciphertext_sha256,omitempty"`
+	TargetPatterns         []string               `protobuf:"bytes,4,rep,name=target_patterns,json=targetPatterns,proto3" json:"target_patterns,omitempty"`
+	InvocationId           string                 `protobuf:"bytes,5,opt,name=invocation_id,json=invocationId,proto3" json:"invocation_id,omitempty"`
+	BuildRequestId         string                 `protobuf:"bytes,6,opt,name=build_request_id,json=buildRequestId,proto3" json:"build_request_id,omitempty"`
+	StartTime              *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PendingBuild) Reset() {
+	*x = PendingBuild{}
+	mi := &file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingBuild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingBuild) ProtoMessage() {}
+
+func (x *PendingBuild) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingBuild.ProtoReflect.Descriptor instead.
+func (*PendingBuild) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PendingBuild) GetOperationName() string {
+	if x != nil {
+		return x.OperationName
+	}
+	return ""
+}
+
+func (x *PendingBuild) GetBuilderPkixPublicKey() []byte {
+	if x != nil {
+		return x.BuilderPkixPublicKey
+	}
+	return nil
+}
+
+func (x *PendingBuild) GetActionCiphertextSha256() []byte {
+	if x != nil {
+		return x.ActionCiphertextSha256
+	}
+	return nil
+}
+
+func (x *PendingBuild) GetTargetPatterns() []string {
+	if x != nil {
+		return x.TargetPatterns
+	}
+	return nil
+}
+
+func (x *PendingBuild) GetInvocationId() string {
+	if x != nil {
+		return x.InvocationId
+	}
+	return ""
+}
+
+func (x *PendingBuild) GetBuildRequestId() string {
+	if x != nil {
+		return x.BuildRequestId
+	}
+	return ""
+}
+
+func (x *PendingBuild) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+var File_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_rawDesc = string([]byte{
+	0x0a, 0x35, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x61, 0x7a, 0x65,
+	0x6c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
+	0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x02, 0x0a, 0x0c, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x6b,
+	0x69, 0x78, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x14, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x50, 0x6b, 0x69, 0x78,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x53, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62,
+	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+})
+
+var (
+	file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_rawDescOnce sync.Once
+	file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_rawDescData []byte
+)
+
+func file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_rawDescGZIP() []byte {
+	file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_rawDesc), len(file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_rawDesc)))
+	})
+	return file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_rawDescData
+}
+
+var file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_goTypes = []any{
+	(*PendingBuild)(nil),          // 0: bonanza.bazelclient.pendingbuild.PendingBuild
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_depIdxs = []int32{
+	1, // 0: bonanza.bazelclient.pendingbuild.PendingBuild.start_time:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_init() }
+func file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_init() {
+	if File_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_rawDesc), len(file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto = out.File
+	file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_goTypes = nil
+	file_pkg_proto_bazelclient_pendingbuild_pendingbuild_proto_depIdxs = nil
+}
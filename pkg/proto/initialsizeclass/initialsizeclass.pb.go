@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: pkg/proto/initialsizeclass/initialsizeclass.proto
+
+package initialsizeclass
+
+import (
+	iscc "github.com/buildbarn/bb-storage/pkg/proto/iscc"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PreviousExecutionStatsEntry struct {
+	state             protoimpl.MessageState       `protogen:"open.v1"`
+	StableFingerprint []byte                       `protobuf:"bytes,1,opt,name=stable_fingerprint,json=stableFingerprint,proto3" json:"stable_fingerprint,omitempty"`
+	Stats             *iscc.PreviousExecutionStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PreviousExecutionStatsEntry) Reset() {
+	*x = PreviousExecutionStatsEntry{}
+	mi := &file_pkg_proto_initialsizeclass_initialsizeclass_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviousExecutionStatsEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviousExecutionStatsEntry) ProtoMessage() {}
+
+func (x *PreviousExecutionStatsEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_initialsizeclass_initialsizeclass_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviousExecutionStatsEntry.ProtoReflect.Descriptor instead.
+func (*PreviousExecutionStatsEntry) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_initialsizeclass_initialsizeclass_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PreviousExecutionStatsEntry) GetStableFingerprint() []byte {
+	if x != nil {
+		return x.StableFingerprint
+	}
+	return nil
+}
+
+func (x *PreviousExecutionStatsEntry) GetStats() *iscc.PreviousExecutionStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+var File_pkg_proto_initialsizeclass_initialsizeclass_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_initialsizeclass_initialsizeclass_proto_rawDesc = string([]byte{
+	0x0a, 0x31, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x69, 0x7a, 0x65, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2f, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x69, 0x7a, 0x65, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x18, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x69, 0x7a, 0x65, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x1a, 0x19, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x73, 0x63, 0x63, 0x2f, 0x69, 0x73,
+	0x63, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x01, 0x0a, 0x1b, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61,
+	0x72, 0x6e, 0x2e, 0x69, 0x73, 0x63, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x6f,
+	0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x69, 0x7a, 0x65, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_pkg_proto_initialsizeclass_initialsizeclass_proto_rawDescOnce sync.Once
+	file_pkg_proto_initialsizeclass_initialsizeclass_proto_rawDescData []byte
+)
+
+func file_pkg_proto_initialsizeclass_initialsizeclass_proto_rawDescGZIP() []byte {
+	file_pkg_proto_initialsizeclass_initialsizeclass_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_initialsizeclass_initialsizeclass_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_proto_initialsizeclass_initialsizeclass_proto_rawDesc), len(file_pkg_proto_initialsizeclass_initialsizeclass_proto_rawDesc)))
+	})
+	return file_pkg_proto_initialsizeclass_initialsizeclass_proto_rawDescData
+}
+
+var file_pkg_proto_initialsizeclass_initialsizeclass_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_proto_initialsizeclass_initialsizeclass_proto_goTypes = []any{
+	(*PreviousExecutionStatsEntry)(nil), // 0: bonanza.initialsizeclass.PreviousExecutionStatsEntry
+	(*iscc.PreviousExecutionStats)(nil), // 1: buildbarn.iscc.PreviousExecutionStats
+}
+var file_pkg_proto_initialsizeclass_initialsizeclass_proto_depIdxs = []int32{
+	1, // 0: bonanza.initialsizeclass.PreviousExecutionStatsEntry.stats:type_name -> buildbarn.iscc.PreviousExecutionStats
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_initialsizeclass_initialsizeclass_proto_init() }
+func file_pkg_proto_initialsizeclass_initialsizeclass_proto_init() {
+	if File_pkg_proto_initialsizeclass_initialsizeclass_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_initialsizeclass_initialsizeclass_proto_rawDesc), len(file_pkg_proto_initialsizeclass_initialsizeclass_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_proto_initialsizeclass_initialsizeclass_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_initialsizeclass_initialsizeclass_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_initialsizeclass_initialsizeclass_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_initialsizeclass_initialsizeclass_proto = out.File
+	file_pkg_proto_initialsizeclass_initialsizeclass_proto_goTypes = nil
+	file_pkg_proto_initialsizeclass_initialsizeclass_proto_depIdxs = nil
+}
@@ -15,7 +15,6 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -77,7 +76,7 @@ func (x HttpArchiveContents_Key_Format) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HttpArchiveContents_Key_Format.Descriptor instead.
 func (HttpArchiveContents_Key_Format) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{23, 0, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{26, 0, 0}
 }
 
 type ActionResult struct {
@@ -116,6 +115,42 @@ func (*ActionResult) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{0}
 }
 
+type AnalysisTestTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalysisTestTransition) Reset() {
+	*x = AnalysisTestTransition{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalysisTestTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisTestTransition) ProtoMessage() {}
+
+func (x *AnalysisTestTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisTestTransition.ProtoReflect.Descriptor instead.
+func (*AnalysisTestTransition) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{1}
+}
+
 type BuildSpecification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -124,7 +159,7 @@ type BuildSpecification struct {
 
 func (x *BuildSpecification) Reset() {
 	*x = BuildSpecification{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[1]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -136,7 +171,7 @@ func (x *BuildSpecification) String() string {
 func (*BuildSpecification) ProtoMessage() {}
 
 func (x *BuildSpecification) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[1]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,7 +184,7 @@ func (x *BuildSpecification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildSpecification.ProtoReflect.Descriptor instead.
 func (*BuildSpecification) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{2}
 }
 
 type BuiltinsModuleNames struct {
@@ -160,7 +195,7 @@ type BuiltinsModuleNames struct {
 
 func (x *BuiltinsModuleNames) Reset() {
 	*x = BuiltinsModuleNames{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[2]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -172,7 +207,7 @@ func (x *BuiltinsModuleNames) String() string {
 func (*BuiltinsModuleNames) ProtoMessage() {}
 
 func (x *BuiltinsModuleNames) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[2]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -185,7 +220,7 @@ func (x *BuiltinsModuleNames) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuiltinsModuleNames.ProtoReflect.Descriptor instead.
 func (*BuiltinsModuleNames) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{2}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{3}
 }
 
 type BuildResult struct {
@@ -196,7 +231,7 @@ type BuildResult struct {
 
 func (x *BuildResult) Reset() {
 	*x = BuildResult{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[3]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -208,7 +243,7 @@ func (x *BuildResult) String() string {
 func (*BuildResult) ProtoMessage() {}
 
 func (x *BuildResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[3]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -221,7 +256,7 @@ func (x *BuildResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildResult.ProtoReflect.Descriptor instead.
 func (*BuildResult) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{3}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{4}
 }
 
 type CanonicalRepoName struct {
@@ -232,7 +267,7 @@ type CanonicalRepoName struct {
 
 func (x *CanonicalRepoName) Reset() {
 	*x = CanonicalRepoName{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[4]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -244,7 +279,7 @@ func (x *CanonicalRepoName) String() string {
 func (*CanonicalRepoName) ProtoMessage() {}
 
 func (x *CanonicalRepoName) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[4]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,7 +292,7 @@ func (x *CanonicalRepoName) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanonicalRepoName.ProtoReflect.Descriptor instead.
 func (*CanonicalRepoName) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{4}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5}
 }
 
 type CommandEncoderObject struct {
@@ -268,7 +303,7 @@ type CommandEncoderObject struct {
 
 func (x *CommandEncoderObject) Reset() {
 	*x = CommandEncoderObject{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[5]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -280,7 +315,7 @@ func (x *CommandEncoderObject) String() string {
 func (*CommandEncoderObject) ProtoMessage() {}
 
 func (x *CommandEncoderObject) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[5]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,7 +328,7 @@ func (x *CommandEncoderObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommandEncoderObject.ProtoReflect.Descriptor instead.
 func (*CommandEncoderObject) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6}
 }
 
 type CommandEncoders struct {
@@ -304,7 +339,7 @@ type CommandEncoders struct {
 
 func (x *CommandEncoders) Reset() {
 	*x = CommandEncoders{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[6]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +351,7 @@ func (x *CommandEncoders) String() string {
 func (*CommandEncoders) ProtoMessage() {}
 
 func (x *CommandEncoders) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[6]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +364,7 @@ func (x *CommandEncoders) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommandEncoders.ProtoReflect.Descriptor instead.
 func (*CommandEncoders) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{7}
 }
 
 type CompatibleExecutionPlatforms struct {
@@ -340,7 +375,7 @@ type CompatibleExecutionPlatforms struct {
 
 func (x *CompatibleExecutionPlatforms) Reset() {
 	*x = CompatibleExecutionPlatforms{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[7]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -352,7 +387,7 @@ func (x *CompatibleExecutionPlatforms) String() string {
 func (*CompatibleExecutionPlatforms) ProtoMessage() {}
 
 func (x *CompatibleExecutionPlatforms) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[7]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +400,7 @@ func (x *CompatibleExecutionPlatforms) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompatibleExecutionPlatforms.ProtoReflect.Descriptor instead.
 func (*CompatibleExecutionPlatforms) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{7}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8}
 }
 
 type CompatibleToolchainsForType struct {
@@ -376,7 +411,7 @@ type CompatibleToolchainsForType struct {
 
 func (x *CompatibleToolchainsForType) Reset() {
 	*x = CompatibleToolchainsForType{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[8]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -388,7 +423,7 @@ func (x *CompatibleToolchainsForType) String() string {
 func (*CompatibleToolchainsForType) ProtoMessage() {}
 
 func (x *CompatibleToolchainsForType) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[8]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,7 +436,7 @@ func (x *CompatibleToolchainsForType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompatibleToolchainsForType.ProtoReflect.Descriptor instead.
 func (*CompatibleToolchainsForType) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{9}
 }
 
 type CompiledBzlFile struct {
@@ -412,7 +447,7 @@ type CompiledBzlFile struct {
 
 func (x *CompiledBzlFile) Reset() {
 	*x = CompiledBzlFile{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[9]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -424,7 +459,7 @@ func (x *CompiledBzlFile) String() string {
 func (*CompiledBzlFile) ProtoMessage() {}
 
 func (x *CompiledBzlFile) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[9]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +472,7 @@ func (x *CompiledBzlFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFile.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFile) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{9}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{10}
 }
 
 type CompiledBzlFileDecodedGlobals struct {
@@ -448,7 +483,7 @@ type CompiledBzlFileDecodedGlobals struct {
 
 func (x *CompiledBzlFileDecodedGlobals) Reset() {
 	*x = CompiledBzlFileDecodedGlobals{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[10]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -460,7 +495,7 @@ func (x *CompiledBzlFileDecodedGlobals) String() string {
 func (*CompiledBzlFileDecodedGlobals) ProtoMessage() {}
 
 func (x *CompiledBzlFileDecodedGlobals) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[10]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +508,7 @@ func (x *CompiledBzlFileDecodedGlobals) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFileDecodedGlobals.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileDecodedGlobals) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{10}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{11}
 }
 
 type CompiledBzlFileFunctionFactory struct {
@@ -484,7 +519,7 @@ type CompiledBzlFileFunctionFactory struct {
 
 func (x *CompiledBzlFileFunctionFactory) Reset() {
 	*x = CompiledBzlFileFunctionFactory{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[11]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -496,7 +531,7 @@ func (x *CompiledBzlFileFunctionFactory) String() string {
 func (*CompiledBzlFileFunctionFactory) ProtoMessage() {}
 
 func (x *CompiledBzlFileFunctionFactory) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[11]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,7 +544,7 @@ func (x *CompiledBzlFileFunctionFactory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFileFunctionFactory.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileFunctionFactory) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{11}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{12}
 }
 
 type CompiledBzlFileGlobal struct {
@@ -520,7 +555,7 @@ type CompiledBzlFileGlobal struct {
 
 func (x *CompiledBzlFileGlobal) Reset() {
 	*x = CompiledBzlFileGlobal{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[12]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -532,7 +567,7 @@ func (x *CompiledBzlFileGlobal) String() string {
 func (*CompiledBzlFileGlobal) ProtoMessage() {}
 
 func (x *CompiledBzlFileGlobal) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[12]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +580,7 @@ func (x *CompiledBzlFileGlobal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFileGlobal.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileGlobal) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{12}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{13}
 }
 
 type Configuration struct {
@@ -557,7 +592,7 @@ type Configuration struct {
 
 func (x *Configuration) Reset() {
 	*x = Configuration{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[13]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -569,7 +604,7 @@ func (x *Configuration) String() string {
 func (*Configuration) ProtoMessage() {}
 
 func (x *Configuration) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[13]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +617,7 @@ func (x *Configuration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Configuration.ProtoReflect.Descriptor instead.
 func (*Configuration) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{13}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Configuration) GetBuildSettingOverrides() []*Configuration_BuildSettingOverride {
@@ -592,6 +627,42 @@ func (x *Configuration) GetBuildSettingOverrides() []*Configuration_BuildSetting
 	return nil
 }
 
+type ConfiguredAspect struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfiguredAspect) Reset() {
+	*x = ConfiguredAspect{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfiguredAspect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfiguredAspect) ProtoMessage() {}
+
+func (x *ConfiguredAspect) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfiguredAspect.ProtoReflect.Descriptor instead.
+func (*ConfiguredAspect) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{15}
+}
+
 type ConfiguredTarget struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -600,7 +671,7 @@ type ConfiguredTarget struct {
 
 func (x *ConfiguredTarget) Reset() {
 	*x = ConfiguredTarget{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[14]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -612,7 +683,7 @@ func (x *ConfiguredTarget) String() string {
 func (*ConfiguredTarget) ProtoMessage() {}
 
 func (x *ConfiguredTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[14]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +696,7 @@ func (x *ConfiguredTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfiguredTarget.ProtoReflect.Descriptor instead.
 func (*ConfiguredTarget) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{14}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16}
 }
 
 type DirectoryAccessParameters struct {
@@ -636,7 +707,7 @@ type DirectoryAccessParameters struct {
 
 func (x *DirectoryAccessParameters) Reset() {
 	*x = DirectoryAccessParameters{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[15]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -648,7 +719,7 @@ func (x *DirectoryAccessParameters) String() string {
 func (*DirectoryAccessParameters) ProtoMessage() {}
 
 func (x *DirectoryAccessParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[15]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,7 +732,7 @@ func (x *DirectoryAccessParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectoryAccessParameters.ProtoReflect.Descriptor instead.
 func (*DirectoryAccessParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{15}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17}
 }
 
 type DirectoryCreationParameters struct {
@@ -672,7 +743,7 @@ type DirectoryCreationParameters struct {
 
 func (x *DirectoryCreationParameters) Reset() {
 	*x = DirectoryCreationParameters{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[16]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -684,7 +755,7 @@ func (x *DirectoryCreationParameters) String() string {
 func (*DirectoryCreationParameters) ProtoMessage() {}
 
 func (x *DirectoryCreationParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[16]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +768,7 @@ func (x *DirectoryCreationParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectoryCreationParameters.ProtoReflect.Descriptor instead.
 func (*DirectoryCreationParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{18}
 }
 
 type DirectoryCreationParametersObject struct {
@@ -708,7 +779,7 @@ type DirectoryCreationParametersObject struct {
 
 func (x *DirectoryCreationParametersObject) Reset() {
 	*x = DirectoryCreationParametersObject{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[17]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -720,7 +791,7 @@ func (x *DirectoryCreationParametersObject) String() string {
 func (*DirectoryCreationParametersObject) ProtoMessage() {}
 
 func (x *DirectoryCreationParametersObject) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[17]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +804,43 @@ func (x *DirectoryCreationParametersObject) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DirectoryCreationParametersObject.ProtoReflect.Descriptor instead.
 func (*DirectoryCreationParametersObject) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{19}
+}
+
+type ExecTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecTransition) Reset() {
+	*x = ExecTransition{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecTransition) ProtoMessage() {}
+
+func (x *ExecTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecTransition.ProtoReflect.Descriptor instead.
+func (*ExecTransition) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20}
 }
 
 type FileAccessParameters struct {
@@ -744,7 +851,7 @@ type FileAccessParameters struct {
 
 func (x *FileAccessParameters) Reset() {
 	*x = FileAccessParameters{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[18]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -756,7 +863,7 @@ func (x *FileAccessParameters) String() string {
 func (*FileAccessParameters) ProtoMessage() {}
 
 func (x *FileAccessParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[18]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,7 +876,7 @@ func (x *FileAccessParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileAccessParameters.ProtoReflect.Descriptor instead.
 func (*FileAccessParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{18}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21}
 }
 
 type FileCreationParameters struct {
@@ -780,7 +887,7 @@ type FileCreationParameters struct {
 
 func (x *FileCreationParameters) Reset() {
 	*x = FileCreationParameters{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[19]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -792,7 +899,7 @@ func (x *FileCreationParameters) String() string {
 func (*FileCreationParameters) ProtoMessage() {}
 
 func (x *FileCreationParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[19]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,7 +912,7 @@ func (x *FileCreationParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileCreationParameters.ProtoReflect.Descriptor instead.
 func (*FileCreationParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{19}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22}
 }
 
 type FileCreationParametersObject struct {
@@ -816,7 +923,7 @@ type FileCreationParametersObject struct {
 
 func (x *FileCreationParametersObject) Reset() {
 	*x = FileCreationParametersObject{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[20]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -828,7 +935,7 @@ func (x *FileCreationParametersObject) String() string {
 func (*FileCreationParametersObject) ProtoMessage() {}
 
 func (x *FileCreationParametersObject) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[20]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,7 +948,7 @@ func (x *FileCreationParametersObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileCreationParametersObject.ProtoReflect.Descriptor instead.
 func (*FileCreationParametersObject) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{23}
 }
 
 type FileProperties struct {
@@ -852,7 +959,7 @@ type FileProperties struct {
 
 func (x *FileProperties) Reset() {
 	*x = FileProperties{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[21]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -864,7 +971,7 @@ func (x *FileProperties) String() string {
 func (*FileProperties) ProtoMessage() {}
 
 func (x *FileProperties) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[21]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,7 +984,7 @@ func (x *FileProperties) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileProperties.ProtoReflect.Descriptor instead.
 func (*FileProperties) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{24}
 }
 
 type FileReader struct {
@@ -888,7 +995,7 @@ type FileReader struct {
 
 func (x *FileReader) Reset() {
 	*x = FileReader{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[22]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -900,7 +1007,7 @@ func (x *FileReader) String() string {
 func (*FileReader) ProtoMessage() {}
 
 func (x *FileReader) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[22]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,7 +1020,7 @@ func (x *FileReader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileReader.ProtoReflect.Descriptor instead.
 func (*FileReader) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{25}
 }
 
 type HttpArchiveContents struct {
@@ -924,7 +1031,7 @@ type HttpArchiveContents struct {
 
 func (x *HttpArchiveContents) Reset() {
 	*x = HttpArchiveContents{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[23]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -936,7 +1043,7 @@ func (x *HttpArchiveContents) String() string {
 func (*HttpArchiveContents) ProtoMessage() {}
 
 func (x *HttpArchiveContents) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[23]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,7 +1056,7 @@ func (x *HttpArchiveContents) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpArchiveContents.ProtoReflect.Descriptor instead.
 func (*HttpArchiveContents) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{23}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{26}
 }
 
 type HttpFileContents struct {
@@ -960,7 +1067,7 @@ type HttpFileContents struct {
 
 func (x *HttpFileContents) Reset() {
 	*x = HttpFileContents{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[24]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -972,7 +1079,7 @@ func (x *HttpFileContents) String() string {
 func (*HttpFileContents) ProtoMessage() {}
 
 func (x *HttpFileContents) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[24]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -985,7 +1092,7 @@ func (x *HttpFileContents) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpFileContents.ProtoReflect.Descriptor instead.
 func (*HttpFileContents) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{24}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27}
 }
 
 type ModuleDotBazelContents struct {
@@ -996,7 +1103,7 @@ type ModuleDotBazelContents struct {
 
 func (x *ModuleDotBazelContents) Reset() {
 	*x = ModuleDotBazelContents{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[25]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1008,7 +1115,7 @@ func (x *ModuleDotBazelContents) String() string {
 func (*ModuleDotBazelContents) ProtoMessage() {}
 
 func (x *ModuleDotBazelContents) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[25]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1021,7 +1128,7 @@ func (x *ModuleDotBazelContents) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleDotBazelContents.ProtoReflect.Descriptor instead.
 func (*ModuleDotBazelContents) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{25}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{28}
 }
 
 type ModuleRegistryUrls struct {
@@ -1032,7 +1139,7 @@ type ModuleRegistryUrls struct {
 
 func (x *ModuleRegistryUrls) Reset() {
 	*x = ModuleRegistryUrls{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[26]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1044,7 +1151,7 @@ func (x *ModuleRegistryUrls) String() string {
 func (*ModuleRegistryUrls) ProtoMessage() {}
 
 func (x *ModuleRegistryUrls) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[26]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,7 +1164,7 @@ func (x *ModuleRegistryUrls) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRegistryUrls.ProtoReflect.Descriptor instead.
 func (*ModuleRegistryUrls) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{26}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{29}
 }
 
 type ModuleRepoMapping struct {
@@ -1068,7 +1175,7 @@ type ModuleRepoMapping struct {
 
 func (x *ModuleRepoMapping) Reset() {
 	*x = ModuleRepoMapping{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[27]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1080,7 +1187,7 @@ func (x *ModuleRepoMapping) String() string {
 func (*ModuleRepoMapping) ProtoMessage() {}
 
 func (x *ModuleRepoMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[27]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1093,30 +1200,66 @@ func (x *ModuleRepoMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRepoMapping.ProtoReflect.Descriptor instead.
 func (*ModuleRepoMapping) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30}
 }
 
-type ModuleExtensionRepo struct {
+type ModuleExtensionImportsCheck struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModuleExtensionRepo) Reset() {
-	*x = ModuleExtensionRepo{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[28]
+func (x *ModuleExtensionImportsCheck) Reset() {
+	*x = ModuleExtensionImportsCheck{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModuleExtensionRepo) String() string {
+func (x *ModuleExtensionImportsCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModuleExtensionRepo) ProtoMessage() {}
+func (*ModuleExtensionImportsCheck) ProtoMessage() {}
 
-func (x *ModuleExtensionRepo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[28]
+func (x *ModuleExtensionImportsCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleExtensionImportsCheck.ProtoReflect.Descriptor instead.
+func (*ModuleExtensionImportsCheck) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{31}
+}
+
+type ModuleExtensionRepo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleExtensionRepo) Reset() {
+	*x = ModuleExtensionRepo{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleExtensionRepo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleExtensionRepo) ProtoMessage() {}
+
+func (x *ModuleExtensionRepo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,7 +1272,7 @@ func (x *ModuleExtensionRepo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepo.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepo) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{28}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{32}
 }
 
 type ModuleExtensionRepoNames struct {
@@ -1140,7 +1283,7 @@ type ModuleExtensionRepoNames struct {
 
 func (x *ModuleExtensionRepoNames) Reset() {
 	*x = ModuleExtensionRepoNames{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[29]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1152,7 +1295,7 @@ func (x *ModuleExtensionRepoNames) String() string {
 func (*ModuleExtensionRepoNames) ProtoMessage() {}
 
 func (x *ModuleExtensionRepoNames) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[29]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1165,7 +1308,7 @@ func (x *ModuleExtensionRepoNames) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepoNames.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepoNames) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{29}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{33}
 }
 
 type ModuleExtensionRepos struct {
@@ -1176,7 +1319,7 @@ type ModuleExtensionRepos struct {
 
 func (x *ModuleExtensionRepos) Reset() {
 	*x = ModuleExtensionRepos{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[30]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1188,7 +1331,7 @@ func (x *ModuleExtensionRepos) String() string {
 func (*ModuleExtensionRepos) ProtoMessage() {}
 
 func (x *ModuleExtensionRepos) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[30]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1201,7 +1344,7 @@ func (x *ModuleExtensionRepos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepos.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepos) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{34}
 }
 
 type BuildListModule struct {
@@ -1216,7 +1359,7 @@ type BuildListModule struct {
 
 func (x *BuildListModule) Reset() {
 	*x = BuildListModule{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[31]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1228,7 +1371,7 @@ func (x *BuildListModule) String() string {
 func (*BuildListModule) ProtoMessage() {}
 
 func (x *BuildListModule) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[31]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,7 +1384,7 @@ func (x *BuildListModule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildListModule.ProtoReflect.Descriptor instead.
 func (*BuildListModule) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{31}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *BuildListModule) GetName() string {
@@ -1280,7 +1423,7 @@ type ModuleFinalBuildList struct {
 
 func (x *ModuleFinalBuildList) Reset() {
 	*x = ModuleFinalBuildList{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[32]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1292,7 +1435,7 @@ func (x *ModuleFinalBuildList) String() string {
 func (*ModuleFinalBuildList) ProtoMessage() {}
 
 func (x *ModuleFinalBuildList) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[32]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1305,7 +1448,79 @@ func (x *ModuleFinalBuildList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleFinalBuildList.ProtoReflect.Descriptor instead.
 func (*ModuleFinalBuildList) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{32}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{36}
+}
+
+type ModuleLockfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleLockfile) Reset() {
+	*x = ModuleLockfile{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleLockfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleLockfile) ProtoMessage() {}
+
+func (x *ModuleLockfile) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleLockfile.ProtoReflect.Descriptor instead.
+func (*ModuleLockfile) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{37}
+}
+
+type ModuleQueryResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleQueryResult) Reset() {
+	*x = ModuleQueryResult{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleQueryResult) ProtoMessage() {}
+
+func (x *ModuleQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleQueryResult.ProtoReflect.Descriptor instead.
+func (*ModuleQueryResult) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38}
 }
 
 type ModuleRoughBuildList struct {
@@ -1316,7 +1531,7 @@ type ModuleRoughBuildList struct {
 
 func (x *ModuleRoughBuildList) Reset() {
 	*x = ModuleRoughBuildList{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[33]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1328,7 +1543,7 @@ func (x *ModuleRoughBuildList) String() string {
 func (*ModuleRoughBuildList) ProtoMessage() {}
 
 func (x *ModuleRoughBuildList) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[33]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,7 +1556,7 @@ func (x *ModuleRoughBuildList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRoughBuildList.ProtoReflect.Descriptor instead.
 func (*ModuleRoughBuildList) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{33}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{39}
 }
 
 type OverridesListModule struct {
@@ -1354,7 +1569,7 @@ type OverridesListModule struct {
 
 func (x *OverridesListModule) Reset() {
 	*x = OverridesListModule{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[34]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1366,7 +1581,7 @@ func (x *OverridesListModule) String() string {
 func (*OverridesListModule) ProtoMessage() {}
 
 func (x *OverridesListModule) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[34]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1379,7 +1594,7 @@ func (x *OverridesListModule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OverridesListModule.ProtoReflect.Descriptor instead.
 func (*OverridesListModule) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{34}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *OverridesListModule) GetName() string {
@@ -1404,7 +1619,7 @@ type ModulesWithMultipleVersions struct {
 
 func (x *ModulesWithMultipleVersions) Reset() {
 	*x = ModulesWithMultipleVersions{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[35]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1416,7 +1631,7 @@ func (x *ModulesWithMultipleVersions) String() string {
 func (*ModulesWithMultipleVersions) ProtoMessage() {}
 
 func (x *ModulesWithMultipleVersions) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[35]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1429,7 +1644,7 @@ func (x *ModulesWithMultipleVersions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithMultipleVersions.ProtoReflect.Descriptor instead.
 func (*ModulesWithMultipleVersions) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{35}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{41}
 }
 
 type ModulesWithMultipleVersionsObject struct {
@@ -1440,7 +1655,7 @@ type ModulesWithMultipleVersionsObject struct {
 
 func (x *ModulesWithMultipleVersionsObject) Reset() {
 	*x = ModulesWithMultipleVersionsObject{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[36]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1452,7 +1667,7 @@ func (x *ModulesWithMultipleVersionsObject) String() string {
 func (*ModulesWithMultipleVersionsObject) ProtoMessage() {}
 
 func (x *ModulesWithMultipleVersionsObject) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[36]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1465,7 +1680,7 @@ func (x *ModulesWithMultipleVersionsObject) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ModulesWithMultipleVersionsObject.ProtoReflect.Descriptor instead.
 func (*ModulesWithMultipleVersionsObject) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{36}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{42}
 }
 
 type ModulesWithOverrides struct {
@@ -1476,7 +1691,7 @@ type ModulesWithOverrides struct {
 
 func (x *ModulesWithOverrides) Reset() {
 	*x = ModulesWithOverrides{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[37]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1488,7 +1703,7 @@ func (x *ModulesWithOverrides) String() string {
 func (*ModulesWithOverrides) ProtoMessage() {}
 
 func (x *ModulesWithOverrides) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[37]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1501,7 +1716,7 @@ func (x *ModulesWithOverrides) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithOverrides.ProtoReflect.Descriptor instead.
 func (*ModulesWithOverrides) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{37}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{43}
 }
 
 type ModuleOverride struct {
@@ -1519,7 +1734,7 @@ type ModuleOverride struct {
 
 func (x *ModuleOverride) Reset() {
 	*x = ModuleOverride{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[38]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1531,7 +1746,7 @@ func (x *ModuleOverride) String() string {
 func (*ModuleOverride) ProtoMessage() {}
 
 func (x *ModuleOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[38]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1544,7 +1759,7 @@ func (x *ModuleOverride) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleOverride.ProtoReflect.Descriptor instead.
 func (*ModuleOverride) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ModuleOverride) GetName() string {
@@ -1618,7 +1833,7 @@ type ModulesWithRemoteOverrides struct {
 
 func (x *ModulesWithRemoteOverrides) Reset() {
 	*x = ModulesWithRemoteOverrides{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[39]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1630,7 +1845,7 @@ func (x *ModulesWithRemoteOverrides) String() string {
 func (*ModulesWithRemoteOverrides) ProtoMessage() {}
 
 func (x *ModulesWithRemoteOverrides) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[39]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1643,7 +1858,7 @@ func (x *ModulesWithRemoteOverrides) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithRemoteOverrides.ProtoReflect.Descriptor instead.
 func (*ModulesWithRemoteOverrides) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{39}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{45}
 }
 
 type Package struct {
@@ -1654,7 +1869,7 @@ type Package struct {
 
 func (x *Package) Reset() {
 	*x = Package{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[40]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1666,7 +1881,7 @@ func (x *Package) String() string {
 func (*Package) ProtoMessage() {}
 
 func (x *Package) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[40]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,7 +1894,43 @@ func (x *Package) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Package.ProtoReflect.Descriptor instead.
 func (*Package) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{40}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46}
+}
+
+type PackagesAtAndBelow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackagesAtAndBelow) Reset() {
+	*x = PackagesAtAndBelow{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackagesAtAndBelow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackagesAtAndBelow) ProtoMessage() {}
+
+func (x *PackagesAtAndBelow) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackagesAtAndBelow.ProtoReflect.Descriptor instead.
+func (*PackagesAtAndBelow) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{47}
 }
 
 type Constraint struct {
@@ -1692,7 +1943,7 @@ type Constraint struct {
 
 func (x *Constraint) Reset() {
 	*x = Constraint{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[41]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1704,7 +1955,7 @@ func (x *Constraint) String() string {
 func (*Constraint) ProtoMessage() {}
 
 func (x *Constraint) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[41]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1717,7 +1968,7 @@ func (x *Constraint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Constraint.ProtoReflect.Descriptor instead.
 func (*Constraint) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{41}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *Constraint) GetSetting() string {
@@ -1738,13 +1989,14 @@ type ExecutionPlatform struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Constraints       []*Constraint          `protobuf:"bytes,1,rep,name=constraints,proto3" json:"constraints,omitempty"`
 	ExecPkixPublicKey []byte                 `protobuf:"bytes,2,opt,name=exec_pkix_public_key,json=execPkixPublicKey,proto3" json:"exec_pkix_public_key,omitempty"`
+	Label             string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ExecutionPlatform) Reset() {
 	*x = ExecutionPlatform{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[42]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1756,7 +2008,7 @@ func (x *ExecutionPlatform) String() string {
 func (*ExecutionPlatform) ProtoMessage() {}
 
 func (x *ExecutionPlatform) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[42]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1769,7 +2021,7 @@ func (x *ExecutionPlatform) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutionPlatform.ProtoReflect.Descriptor instead.
 func (*ExecutionPlatform) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{42}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ExecutionPlatform) GetConstraints() []*Constraint {
@@ -1786,6 +2038,13 @@ func (x *ExecutionPlatform) GetExecPkixPublicKey() []byte {
 	return nil
 }
 
+func (x *ExecutionPlatform) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
 type RegisteredExecutionPlatforms struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1794,7 +2053,7 @@ type RegisteredExecutionPlatforms struct {
 
 func (x *RegisteredExecutionPlatforms) Reset() {
 	*x = RegisteredExecutionPlatforms{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[43]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1806,7 +2065,7 @@ func (x *RegisteredExecutionPlatforms) String() string {
 func (*RegisteredExecutionPlatforms) ProtoMessage() {}
 
 func (x *RegisteredExecutionPlatforms) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[43]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1819,7 +2078,7 @@ func (x *RegisteredExecutionPlatforms) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredExecutionPlatforms.ProtoReflect.Descriptor instead.
 func (*RegisteredExecutionPlatforms) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{43}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{50}
 }
 
 type RegisteredRepoPlatform struct {
@@ -1830,7 +2089,7 @@ type RegisteredRepoPlatform struct {
 
 func (x *RegisteredRepoPlatform) Reset() {
 	*x = RegisteredRepoPlatform{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[44]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1842,7 +2101,7 @@ func (x *RegisteredRepoPlatform) String() string {
 func (*RegisteredRepoPlatform) ProtoMessage() {}
 
 func (x *RegisteredRepoPlatform) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[44]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1855,7 +2114,7 @@ func (x *RegisteredRepoPlatform) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredRepoPlatform.ProtoReflect.Descriptor instead.
 func (*RegisteredRepoPlatform) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{51}
 }
 
 type RegisteredToolchain struct {
@@ -1871,7 +2130,7 @@ type RegisteredToolchain struct {
 
 func (x *RegisteredToolchain) Reset() {
 	*x = RegisteredToolchain{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[45]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1883,7 +2142,7 @@ func (x *RegisteredToolchain) String() string {
 func (*RegisteredToolchain) ProtoMessage() {}
 
 func (x *RegisteredToolchain) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[45]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1896,7 +2155,7 @@ func (x *RegisteredToolchain) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredToolchain.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchain) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{45}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *RegisteredToolchain) GetExecCompatibleWith() []*Constraint {
@@ -1942,7 +2201,7 @@ type RegisteredToolchains struct {
 
 func (x *RegisteredToolchains) Reset() {
 	*x = RegisteredToolchains{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[46]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1954,7 +2213,7 @@ func (x *RegisteredToolchains) String() string {
 func (*RegisteredToolchains) ProtoMessage() {}
 
 func (x *RegisteredToolchains) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[46]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1967,7 +2226,7 @@ func (x *RegisteredToolchains) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredToolchains.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchains) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53}
 }
 
 type RegisteredToolchainsForType struct {
@@ -1978,7 +2237,7 @@ type RegisteredToolchainsForType struct {
 
 func (x *RegisteredToolchainsForType) Reset() {
 	*x = RegisteredToolchainsForType{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[47]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1990,7 +2249,7 @@ func (x *RegisteredToolchainsForType) String() string {
 func (*RegisteredToolchainsForType) ProtoMessage() {}
 
 func (x *RegisteredToolchainsForType) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[47]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,30 +2262,32 @@ func (x *RegisteredToolchainsForType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredToolchainsForType.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchainsForType) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{47}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{54}
 }
 
-type Repo struct {
+type RegistryFileHash struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Sha256        []byte                 `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Repo) Reset() {
-	*x = Repo{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[48]
+func (x *RegistryFileHash) Reset() {
+	*x = RegistryFileHash{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Repo) String() string {
+func (x *RegistryFileHash) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repo) ProtoMessage() {}
+func (*RegistryFileHash) ProtoMessage() {}
 
-func (x *Repo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[48]
+func (x *RegistryFileHash) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2037,20 +2298,70 @@ func (x *Repo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repo.ProtoReflect.Descriptor instead.
-func (*Repo) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use RegistryFileHash.ProtoReflect.Descriptor instead.
+func (*RegistryFileHash) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{55}
 }
 
-type RepoDefaultAttrs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *RegistryFileHash) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RegistryFileHash) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+type Repo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Repo) Reset() {
+	*x = Repo{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Repo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repo) ProtoMessage() {}
+
+func (x *Repo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repo.ProtoReflect.Descriptor instead.
+func (*Repo) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{56}
+}
+
+type RepoDefaultAttrs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RepoDefaultAttrs) Reset() {
 	*x = RepoDefaultAttrs{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[49]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2062,7 +2373,7 @@ func (x *RepoDefaultAttrs) String() string {
 func (*RepoDefaultAttrs) ProtoMessage() {}
 
 func (x *RepoDefaultAttrs) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[49]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2075,7 +2386,43 @@ func (x *RepoDefaultAttrs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoDefaultAttrs.ProtoReflect.Descriptor instead.
 func (*RepoDefaultAttrs) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{49}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57}
+}
+
+type RepoEnvironmentVariable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepoEnvironmentVariable) Reset() {
+	*x = RepoEnvironmentVariable{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepoEnvironmentVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoEnvironmentVariable) ProtoMessage() {}
+
+func (x *RepoEnvironmentVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoEnvironmentVariable.ProtoReflect.Descriptor instead.
+func (*RepoEnvironmentVariable) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{58}
 }
 
 type ResolvedToolchains struct {
@@ -2086,7 +2433,7 @@ type ResolvedToolchains struct {
 
 func (x *ResolvedToolchains) Reset() {
 	*x = ResolvedToolchains{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[50]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2098,7 +2445,7 @@ func (x *ResolvedToolchains) String() string {
 func (*ResolvedToolchains) ProtoMessage() {}
 
 func (x *ResolvedToolchains) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[50]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2111,7 +2458,7 @@ func (x *ResolvedToolchains) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedToolchains.ProtoReflect.Descriptor instead.
 func (*ResolvedToolchains) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{50}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{59}
 }
 
 type RootModule struct {
@@ -2122,7 +2469,7 @@ type RootModule struct {
 
 func (x *RootModule) Reset() {
 	*x = RootModule{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[51]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2134,7 +2481,7 @@ func (x *RootModule) String() string {
 func (*RootModule) ProtoMessage() {}
 
 func (x *RootModule) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[51]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2147,7 +2494,7 @@ func (x *RootModule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RootModule.ProtoReflect.Descriptor instead.
 func (*RootModule) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{51}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60}
 }
 
 type Select struct {
@@ -2158,7 +2505,7 @@ type Select struct {
 
 func (x *Select) Reset() {
 	*x = Select{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[52]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2170,7 +2517,7 @@ func (x *Select) String() string {
 func (*Select) ProtoMessage() {}
 
 func (x *Select) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[52]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2183,7 +2530,7 @@ func (x *Select) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Select.ProtoReflect.Descriptor instead.
 func (*Select) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{52}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{61}
 }
 
 type StableInputRootPath struct {
@@ -2194,7 +2541,7 @@ type StableInputRootPath struct {
 
 func (x *StableInputRootPath) Reset() {
 	*x = StableInputRootPath{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[53]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2206,7 +2553,7 @@ func (x *StableInputRootPath) String() string {
 func (*StableInputRootPath) ProtoMessage() {}
 
 func (x *StableInputRootPath) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[53]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2219,7 +2566,7 @@ func (x *StableInputRootPath) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StableInputRootPath.ProtoReflect.Descriptor instead.
 func (*StableInputRootPath) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62}
 }
 
 type StableInputRootPathObject struct {
@@ -2230,7 +2577,7 @@ type StableInputRootPathObject struct {
 
 func (x *StableInputRootPathObject) Reset() {
 	*x = StableInputRootPathObject{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[54]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2242,7 +2589,7 @@ func (x *StableInputRootPathObject) String() string {
 func (*StableInputRootPathObject) ProtoMessage() {}
 
 func (x *StableInputRootPathObject) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[54]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2255,7 +2602,7 @@ func (x *StableInputRootPathObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StableInputRootPathObject.ProtoReflect.Descriptor instead.
 func (*StableInputRootPathObject) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{54}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{63}
 }
 
 type Target struct {
@@ -2266,7 +2613,7 @@ type Target struct {
 
 func (x *Target) Reset() {
 	*x = Target{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[55]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2278,7 +2625,7 @@ func (x *Target) String() string {
 func (*Target) ProtoMessage() {}
 
 func (x *Target) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[55]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2291,7 +2638,7 @@ func (x *Target) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Target.ProtoReflect.Descriptor instead.
 func (*Target) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{55}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{64}
 }
 
 type TargetCompletion struct {
@@ -2302,7 +2649,7 @@ type TargetCompletion struct {
 
 func (x *TargetCompletion) Reset() {
 	*x = TargetCompletion{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[56]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2314,7 +2661,7 @@ func (x *TargetCompletion) String() string {
 func (*TargetCompletion) ProtoMessage() {}
 
 func (x *TargetCompletion) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[56]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2327,7 +2674,43 @@ func (x *TargetCompletion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetCompletion.ProtoReflect.Descriptor instead.
 func (*TargetCompletion) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{56}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{65}
+}
+
+type TargetCompatibility struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetCompatibility) Reset() {
+	*x = TargetCompatibility{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetCompatibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetCompatibility) ProtoMessage() {}
+
+func (x *TargetCompatibility) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetCompatibility.ProtoReflect.Descriptor instead.
+func (*TargetCompatibility) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{66}
 }
 
 type TargetPatternExpansion struct {
@@ -2338,7 +2721,7 @@ type TargetPatternExpansion struct {
 
 func (x *TargetPatternExpansion) Reset() {
 	*x = TargetPatternExpansion{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[57]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2350,7 +2733,7 @@ func (x *TargetPatternExpansion) String() string {
 func (*TargetPatternExpansion) ProtoMessage() {}
 
 func (x *TargetPatternExpansion) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[57]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2363,7 +2746,43 @@ func (x *TargetPatternExpansion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetPatternExpansion.ProtoReflect.Descriptor instead.
 func (*TargetPatternExpansion) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{67}
+}
+
+type TargetPlatformConstraints struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetPlatformConstraints) Reset() {
+	*x = TargetPlatformConstraints{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetPlatformConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetPlatformConstraints) ProtoMessage() {}
+
+func (x *TargetPlatformConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetPlatformConstraints.ProtoReflect.Descriptor instead.
+func (*TargetPlatformConstraints) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{68}
 }
 
 type ModuleExtension struct {
@@ -2376,7 +2795,7 @@ type ModuleExtension struct {
 
 func (x *ModuleExtension) Reset() {
 	*x = ModuleExtension{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[58]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2388,7 +2807,7 @@ func (x *ModuleExtension) String() string {
 func (*ModuleExtension) ProtoMessage() {}
 
 func (x *ModuleExtension) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[58]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2401,7 +2820,7 @@ func (x *ModuleExtension) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtension.ProtoReflect.Descriptor instead.
 func (*ModuleExtension) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{58}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *ModuleExtension) GetIdentifier() string {
@@ -2418,27 +2837,29 @@ func (x *ModuleExtension) GetUsers() []*ModuleExtension_User {
 	return nil
 }
 
-type RepositoryRuleObject struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type ModuleExtensionImports struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Identifier    string                         `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Users         []*ModuleExtensionImports_User `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RepositoryRuleObject) Reset() {
-	*x = RepositoryRuleObject{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[59]
+func (x *ModuleExtensionImports) Reset() {
+	*x = ModuleExtensionImports{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RepositoryRuleObject) String() string {
+func (x *ModuleExtensionImports) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepositoryRuleObject) ProtoMessage() {}
+func (*ModuleExtensionImports) ProtoMessage() {}
 
-func (x *RepositoryRuleObject) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[59]
+func (x *ModuleExtensionImports) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2449,32 +2870,46 @@ func (x *RepositoryRuleObject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepositoryRuleObject.ProtoReflect.Descriptor instead.
-func (*RepositoryRuleObject) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use ModuleExtensionImports.ProtoReflect.Descriptor instead.
+func (*ModuleExtensionImports) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{70}
 }
 
-type UsedModuleExtension struct {
+func (x *ModuleExtensionImports) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *ModuleExtensionImports) GetUsers() []*ModuleExtensionImports_User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type RepositoryRuleObject struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UsedModuleExtension) Reset() {
-	*x = UsedModuleExtension{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[60]
+func (x *RepositoryRuleObject) Reset() {
+	*x = RepositoryRuleObject{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UsedModuleExtension) String() string {
+func (x *RepositoryRuleObject) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsedModuleExtension) ProtoMessage() {}
+func (*RepositoryRuleObject) ProtoMessage() {}
 
-func (x *UsedModuleExtension) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[60]
+func (x *RepositoryRuleObject) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2485,32 +2920,32 @@ func (x *UsedModuleExtension) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsedModuleExtension.ProtoReflect.Descriptor instead.
-func (*UsedModuleExtension) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use RepositoryRuleObject.ProtoReflect.Descriptor instead.
+func (*RepositoryRuleObject) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{71}
 }
 
-type UsedModuleExtensions struct {
+type UpdatedModuleLockfile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UsedModuleExtensions) Reset() {
-	*x = UsedModuleExtensions{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[61]
+func (x *UpdatedModuleLockfile) Reset() {
+	*x = UpdatedModuleLockfile{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UsedModuleExtensions) String() string {
+func (x *UpdatedModuleLockfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsedModuleExtensions) ProtoMessage() {}
+func (*UpdatedModuleLockfile) ProtoMessage() {}
 
-func (x *UsedModuleExtensions) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[61]
+func (x *UpdatedModuleLockfile) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2521,32 +2956,104 @@ func (x *UsedModuleExtensions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsedModuleExtensions.ProtoReflect.Descriptor instead.
-func (*UsedModuleExtensions) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use UpdatedModuleLockfile.ProtoReflect.Descriptor instead.
+func (*UpdatedModuleLockfile) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{72}
 }
 
-type UserDefinedTransition struct {
+type UsedModuleExtension struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserDefinedTransition) Reset() {
-	*x = UserDefinedTransition{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[62]
+func (x *UsedModuleExtension) Reset() {
+	*x = UsedModuleExtension{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserDefinedTransition) String() string {
+func (x *UsedModuleExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsedModuleExtension) ProtoMessage() {}
+
+func (x *UsedModuleExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsedModuleExtension.ProtoReflect.Descriptor instead.
+func (*UsedModuleExtension) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{73}
+}
+
+type UsedModuleExtensions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsedModuleExtensions) Reset() {
+	*x = UsedModuleExtensions{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsedModuleExtensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsedModuleExtensions) ProtoMessage() {}
+
+func (x *UsedModuleExtensions) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsedModuleExtensions.ProtoReflect.Descriptor instead.
+func (*UsedModuleExtensions) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{74}
+}
+
+type UserDefinedTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDefinedTransition) Reset() {
+	*x = UserDefinedTransition{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDefinedTransition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*UserDefinedTransition) ProtoMessage() {}
 
 func (x *UserDefinedTransition) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[62]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2559,7 +3066,7 @@ func (x *UserDefinedTransition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserDefinedTransition.ProtoReflect.Descriptor instead.
 func (*UserDefinedTransition) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{75}
 }
 
 type VisibleTarget struct {
@@ -2570,7 +3077,7 @@ type VisibleTarget struct {
 
 func (x *VisibleTarget) Reset() {
 	*x = VisibleTarget{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[63]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2582,7 +3089,7 @@ func (x *VisibleTarget) String() string {
 func (*VisibleTarget) ProtoMessage() {}
 
 func (x *VisibleTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[63]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2595,7 +3102,7 @@ func (x *VisibleTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VisibleTarget.ProtoReflect.Descriptor instead.
 func (*VisibleTarget) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{63}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{76}
 }
 
 type ActionResult_Key struct {
@@ -2611,7 +3118,7 @@ type ActionResult_Key struct {
 
 func (x *ActionResult_Key) Reset() {
 	*x = ActionResult_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[64]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2623,7 +3130,7 @@ func (x *ActionResult_Key) String() string {
 func (*ActionResult_Key) ProtoMessage() {}
 
 func (x *ActionResult_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[64]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2684,7 +3191,7 @@ type ActionResult_Value struct {
 
 func (x *ActionResult_Value) Reset() {
 	*x = ActionResult_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[65]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2696,7 +3203,7 @@ func (x *ActionResult_Value) String() string {
 func (*ActionResult_Value) ProtoMessage() {}
 
 func (x *ActionResult_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[65]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2726,6 +3233,102 @@ func (x *ActionResult_Value) GetOutputsReference() *core.Reference {
 	return nil
 }
 
+type AnalysisTestTransition_Key struct {
+	state                       protoimpl.MessageState                      `protogen:"open.v1"`
+	Transition                  *starlark.Transition_Reference_AnalysisTest `protobuf:"bytes,1,opt,name=transition,proto3" json:"transition,omitempty"`
+	InputConfigurationReference *core.Reference                             `protobuf:"bytes,2,opt,name=input_configuration_reference,json=inputConfigurationReference,proto3" json:"input_configuration_reference,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *AnalysisTestTransition_Key) Reset() {
+	*x = AnalysisTestTransition_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalysisTestTransition_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisTestTransition_Key) ProtoMessage() {}
+
+func (x *AnalysisTestTransition_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisTestTransition_Key.ProtoReflect.Descriptor instead.
+func (*AnalysisTestTransition_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *AnalysisTestTransition_Key) GetTransition() *starlark.Transition_Reference_AnalysisTest {
+	if x != nil {
+		return x.Transition
+	}
+	return nil
+}
+
+func (x *AnalysisTestTransition_Key) GetInputConfigurationReference() *core.Reference {
+	if x != nil {
+		return x.InputConfigurationReference
+	}
+	return nil
+}
+
+type AnalysisTestTransition_Value struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	OutputConfigurationReference *core.Reference        `protobuf:"bytes,1,opt,name=output_configuration_reference,json=outputConfigurationReference,proto3" json:"output_configuration_reference,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *AnalysisTestTransition_Value) Reset() {
+	*x = AnalysisTestTransition_Value{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalysisTestTransition_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisTestTransition_Value) ProtoMessage() {}
+
+func (x *AnalysisTestTransition_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisTestTransition_Value.ProtoReflect.Descriptor instead.
+func (*AnalysisTestTransition_Value) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *AnalysisTestTransition_Value) GetOutputConfigurationReference() *core.Reference {
+	if x != nil {
+		return x.OutputConfigurationReference
+	}
+	return nil
+}
+
 type BuildSpecification_Key struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -2734,7 +3337,7 @@ type BuildSpecification_Key struct {
 
 func (x *BuildSpecification_Key) Reset() {
 	*x = BuildSpecification_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[66]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2746,7 +3349,7 @@ func (x *BuildSpecification_Key) String() string {
 func (*BuildSpecification_Key) ProtoMessage() {}
 
 func (x *BuildSpecification_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[66]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2759,7 +3362,7 @@ func (x *BuildSpecification_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildSpecification_Key.ProtoReflect.Descriptor instead.
 func (*BuildSpecification_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{1, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{2, 0}
 }
 
 type BuildSpecification_Value struct {
@@ -2771,7 +3374,7 @@ type BuildSpecification_Value struct {
 
 func (x *BuildSpecification_Value) Reset() {
 	*x = BuildSpecification_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[67]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2783,7 +3386,7 @@ func (x *BuildSpecification_Value) String() string {
 func (*BuildSpecification_Value) ProtoMessage() {}
 
 func (x *BuildSpecification_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[67]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2796,7 +3399,7 @@ func (x *BuildSpecification_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildSpecification_Value.ProtoReflect.Descriptor instead.
 func (*BuildSpecification_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{1, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{2, 1}
 }
 
 func (x *BuildSpecification_Value) GetBuildSpecification() *build.BuildSpecification {
@@ -2814,7 +3417,7 @@ type BuiltinsModuleNames_Key struct {
 
 func (x *BuiltinsModuleNames_Key) Reset() {
 	*x = BuiltinsModuleNames_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[68]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2826,7 +3429,7 @@ func (x *BuiltinsModuleNames_Key) String() string {
 func (*BuiltinsModuleNames_Key) ProtoMessage() {}
 
 func (x *BuiltinsModuleNames_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[68]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2839,7 +3442,7 @@ func (x *BuiltinsModuleNames_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuiltinsModuleNames_Key.ProtoReflect.Descriptor instead.
 func (*BuiltinsModuleNames_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{2, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{3, 0}
 }
 
 type BuiltinsModuleNames_Value struct {
@@ -2851,7 +3454,7 @@ type BuiltinsModuleNames_Value struct {
 
 func (x *BuiltinsModuleNames_Value) Reset() {
 	*x = BuiltinsModuleNames_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[69]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2863,7 +3466,7 @@ func (x *BuiltinsModuleNames_Value) String() string {
 func (*BuiltinsModuleNames_Value) ProtoMessage() {}
 
 func (x *BuiltinsModuleNames_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[69]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2876,7 +3479,7 @@ func (x *BuiltinsModuleNames_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuiltinsModuleNames_Value.ProtoReflect.Descriptor instead.
 func (*BuiltinsModuleNames_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{2, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{3, 1}
 }
 
 func (x *BuiltinsModuleNames_Value) GetBuiltinsModuleNames() []string {
@@ -2894,7 +3497,7 @@ type BuildResult_Key struct {
 
 func (x *BuildResult_Key) Reset() {
 	*x = BuildResult_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[70]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2906,7 +3509,7 @@ func (x *BuildResult_Key) String() string {
 func (*BuildResult_Key) ProtoMessage() {}
 
 func (x *BuildResult_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[70]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2919,18 +3522,20 @@ func (x *BuildResult_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildResult_Key.ProtoReflect.Descriptor instead.
 func (*BuildResult_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{3, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{4, 0}
 }
 
 type BuildResult_Value struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ModuleLockfile []byte                 `protobuf:"bytes,1,opt,name=module_lockfile,json=moduleLockfile,proto3" json:"module_lockfile,omitempty"`
+	Targets        []*build.Target        `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BuildResult_Value) Reset() {
 	*x = BuildResult_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[71]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2942,7 +3547,7 @@ func (x *BuildResult_Value) String() string {
 func (*BuildResult_Value) ProtoMessage() {}
 
 func (x *BuildResult_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[71]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2955,7 +3560,21 @@ func (x *BuildResult_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildResult_Value.ProtoReflect.Descriptor instead.
 func (*BuildResult_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{3, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *BuildResult_Value) GetModuleLockfile() []byte {
+	if x != nil {
+		return x.ModuleLockfile
+	}
+	return nil
+}
+
+func (x *BuildResult_Value) GetTargets() []*build.Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
 }
 
 type CanonicalRepoName_Key struct {
@@ -2968,7 +3587,7 @@ type CanonicalRepoName_Key struct {
 
 func (x *CanonicalRepoName_Key) Reset() {
 	*x = CanonicalRepoName_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[72]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2980,7 +3599,7 @@ func (x *CanonicalRepoName_Key) String() string {
 func (*CanonicalRepoName_Key) ProtoMessage() {}
 
 func (x *CanonicalRepoName_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[72]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2993,7 +3612,7 @@ func (x *CanonicalRepoName_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanonicalRepoName_Key.ProtoReflect.Descriptor instead.
 func (*CanonicalRepoName_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{4, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *CanonicalRepoName_Key) GetFromCanonicalRepo() string {
@@ -3019,7 +3638,7 @@ type CanonicalRepoName_Value struct {
 
 func (x *CanonicalRepoName_Value) Reset() {
 	*x = CanonicalRepoName_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[73]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3031,7 +3650,7 @@ func (x *CanonicalRepoName_Value) String() string {
 func (*CanonicalRepoName_Value) ProtoMessage() {}
 
 func (x *CanonicalRepoName_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[73]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3044,7 +3663,7 @@ func (x *CanonicalRepoName_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanonicalRepoName_Value.ProtoReflect.Descriptor instead.
 func (*CanonicalRepoName_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{4, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5, 1}
 }
 
 func (x *CanonicalRepoName_Value) GetToCanonicalRepo() string {
@@ -3062,7 +3681,7 @@ type CommandEncoderObject_Key struct {
 
 func (x *CommandEncoderObject_Key) Reset() {
 	*x = CommandEncoderObject_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[74]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3074,7 +3693,7 @@ func (x *CommandEncoderObject_Key) String() string {
 func (*CommandEncoderObject_Key) ProtoMessage() {}
 
 func (x *CommandEncoderObject_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[74]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3087,7 +3706,7 @@ func (x *CommandEncoderObject_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommandEncoderObject_Key.ProtoReflect.Descriptor instead.
 func (*CommandEncoderObject_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{5, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6, 0}
 }
 
 type CommandEncoders_Key struct {
@@ -3098,7 +3717,7 @@ type CommandEncoders_Key struct {
 
 func (x *CommandEncoders_Key) Reset() {
 	*x = CommandEncoders_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[75]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3110,7 +3729,7 @@ func (x *CommandEncoders_Key) String() string {
 func (*CommandEncoders_Key) ProtoMessage() {}
 
 func (x *CommandEncoders_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[75]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3123,7 +3742,7 @@ func (x *CommandEncoders_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommandEncoders_Key.ProtoReflect.Descriptor instead.
 func (*CommandEncoders_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{7, 0}
 }
 
 type CommandEncoders_Value struct {
@@ -3135,7 +3754,7 @@ type CommandEncoders_Value struct {
 
 func (x *CommandEncoders_Value) Reset() {
 	*x = CommandEncoders_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[76]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3147,7 +3766,7 @@ func (x *CommandEncoders_Value) String() string {
 func (*CommandEncoders_Value) ProtoMessage() {}
 
 func (x *CommandEncoders_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[76]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3160,7 +3779,7 @@ func (x *CommandEncoders_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommandEncoders_Value.ProtoReflect.Descriptor instead.
 func (*CommandEncoders_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{6, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{7, 1}
 }
 
 func (x *CommandEncoders_Value) GetCommandEncoders() []*encoding.BinaryEncoder {
@@ -3179,7 +3798,7 @@ type CompatibleExecutionPlatforms_Key struct {
 
 func (x *CompatibleExecutionPlatforms_Key) Reset() {
 	*x = CompatibleExecutionPlatforms_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[77]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3191,7 +3810,7 @@ func (x *CompatibleExecutionPlatforms_Key) String() string {
 func (*CompatibleExecutionPlatforms_Key) ProtoMessage() {}
 
 func (x *CompatibleExecutionPlatforms_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[77]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3204,7 +3823,7 @@ func (x *CompatibleExecutionPlatforms_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompatibleExecutionPlatforms_Key.ProtoReflect.Descriptor instead.
 func (*CompatibleExecutionPlatforms_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{7, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *CompatibleExecutionPlatforms_Key) GetConstraints() []*Constraint {
@@ -3223,7 +3842,7 @@ type CompatibleExecutionPlatforms_Value struct {
 
 func (x *CompatibleExecutionPlatforms_Value) Reset() {
 	*x = CompatibleExecutionPlatforms_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[78]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3235,7 +3854,7 @@ func (x *CompatibleExecutionPlatforms_Value) String() string {
 func (*CompatibleExecutionPlatforms_Value) ProtoMessage() {}
 
 func (x *CompatibleExecutionPlatforms_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[78]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3248,7 +3867,7 @@ func (x *CompatibleExecutionPlatforms_Value) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CompatibleExecutionPlatforms_Value.ProtoReflect.Descriptor instead.
 func (*CompatibleExecutionPlatforms_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{7, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8, 1}
 }
 
 func (x *CompatibleExecutionPlatforms_Value) GetExecutionPlatforms() []*ExecutionPlatform {
@@ -3268,7 +3887,7 @@ type CompatibleToolchainsForType_Key struct {
 
 func (x *CompatibleToolchainsForType_Key) Reset() {
 	*x = CompatibleToolchainsForType_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[79]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3280,7 +3899,7 @@ func (x *CompatibleToolchainsForType_Key) String() string {
 func (*CompatibleToolchainsForType_Key) ProtoMessage() {}
 
 func (x *CompatibleToolchainsForType_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[79]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3293,7 +3912,7 @@ func (x *CompatibleToolchainsForType_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompatibleToolchainsForType_Key.ProtoReflect.Descriptor instead.
 func (*CompatibleToolchainsForType_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *CompatibleToolchainsForType_Key) GetToolchainType() string {
@@ -3319,7 +3938,7 @@ type CompatibleToolchainsForType_Value struct {
 
 func (x *CompatibleToolchainsForType_Value) Reset() {
 	*x = CompatibleToolchainsForType_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[80]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3331,7 +3950,7 @@ func (x *CompatibleToolchainsForType_Value) String() string {
 func (*CompatibleToolchainsForType_Value) ProtoMessage() {}
 
 func (x *CompatibleToolchainsForType_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[80]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3344,7 +3963,7 @@ func (x *CompatibleToolchainsForType_Value) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CompatibleToolchainsForType_Value.ProtoReflect.Descriptor instead.
 func (*CompatibleToolchainsForType_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{8, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{9, 1}
 }
 
 func (x *CompatibleToolchainsForType_Value) GetToolchains() []*RegisteredToolchain {
@@ -3364,7 +3983,7 @@ type CompiledBzlFile_Key struct {
 
 func (x *CompiledBzlFile_Key) Reset() {
 	*x = CompiledBzlFile_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[81]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3376,7 +3995,7 @@ func (x *CompiledBzlFile_Key) String() string {
 func (*CompiledBzlFile_Key) ProtoMessage() {}
 
 func (x *CompiledBzlFile_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[81]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3389,7 +4008,7 @@ func (x *CompiledBzlFile_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFile_Key.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFile_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{9, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *CompiledBzlFile_Key) GetLabel() string {
@@ -3409,13 +4028,14 @@ func (x *CompiledBzlFile_Key) GetBuiltinsModuleNames() []string {
 type CompiledBzlFile_Value struct {
 	state           protoimpl.MessageState    `protogen:"open.v1"`
 	CompiledProgram *starlark.CompiledProgram `protobuf:"bytes,1,opt,name=compiled_program,json=compiledProgram,proto3" json:"compiled_program,omitempty"`
+	LoadVisibility  *starlark.PackageGroup    `protobuf:"bytes,2,opt,name=load_visibility,json=loadVisibility,proto3" json:"load_visibility,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CompiledBzlFile_Value) Reset() {
 	*x = CompiledBzlFile_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[82]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3427,7 +4047,7 @@ func (x *CompiledBzlFile_Value) String() string {
 func (*CompiledBzlFile_Value) ProtoMessage() {}
 
 func (x *CompiledBzlFile_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[82]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3440,7 +4060,7 @@ func (x *CompiledBzlFile_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFile_Value.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFile_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{9, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{10, 1}
 }
 
 func (x *CompiledBzlFile_Value) GetCompiledProgram() *starlark.CompiledProgram {
@@ -3450,6 +4070,13 @@ func (x *CompiledBzlFile_Value) GetCompiledProgram() *starlark.CompiledProgram {
 	return nil
 }
 
+func (x *CompiledBzlFile_Value) GetLoadVisibility() *starlark.PackageGroup {
+	if x != nil {
+		return x.LoadVisibility
+	}
+	return nil
+}
+
 type CompiledBzlFileDecodedGlobals_Key struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Label               string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
@@ -3460,7 +4087,7 @@ type CompiledBzlFileDecodedGlobals_Key struct {
 
 func (x *CompiledBzlFileDecodedGlobals_Key) Reset() {
 	*x = CompiledBzlFileDecodedGlobals_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[83]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3472,7 +4099,7 @@ func (x *CompiledBzlFileDecodedGlobals_Key) String() string {
 func (*CompiledBzlFileDecodedGlobals_Key) ProtoMessage() {}
 
 func (x *CompiledBzlFileDecodedGlobals_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[83]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3485,7 +4112,7 @@ func (x *CompiledBzlFileDecodedGlobals_Key) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CompiledBzlFileDecodedGlobals_Key.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileDecodedGlobals_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{10, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *CompiledBzlFileDecodedGlobals_Key) GetLabel() string {
@@ -3512,7 +4139,7 @@ type CompiledBzlFileFunctionFactory_Key struct {
 
 func (x *CompiledBzlFileFunctionFactory_Key) Reset() {
 	*x = CompiledBzlFileFunctionFactory_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[84]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3524,7 +4151,7 @@ func (x *CompiledBzlFileFunctionFactory_Key) String() string {
 func (*CompiledBzlFileFunctionFactory_Key) ProtoMessage() {}
 
 func (x *CompiledBzlFileFunctionFactory_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[84]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3537,7 +4164,7 @@ func (x *CompiledBzlFileFunctionFactory_Key) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CompiledBzlFileFunctionFactory_Key.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileFunctionFactory_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{11, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{12, 0}
 }
 
 func (x *CompiledBzlFileFunctionFactory_Key) GetLabel() string {
@@ -3563,7 +4190,7 @@ type CompiledBzlFileGlobal_Key struct {
 
 func (x *CompiledBzlFileGlobal_Key) Reset() {
 	*x = CompiledBzlFileGlobal_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[85]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3575,7 +4202,7 @@ func (x *CompiledBzlFileGlobal_Key) String() string {
 func (*CompiledBzlFileGlobal_Key) ProtoMessage() {}
 
 func (x *CompiledBzlFileGlobal_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[85]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3588,7 +4215,7 @@ func (x *CompiledBzlFileGlobal_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFileGlobal_Key.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileGlobal_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{12, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *CompiledBzlFileGlobal_Key) GetIdentifier() string {
@@ -3607,7 +4234,7 @@ type CompiledBzlFileGlobal_Value struct {
 
 func (x *CompiledBzlFileGlobal_Value) Reset() {
 	*x = CompiledBzlFileGlobal_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[86]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3619,7 +4246,7 @@ func (x *CompiledBzlFileGlobal_Value) String() string {
 func (*CompiledBzlFileGlobal_Value) ProtoMessage() {}
 
 func (x *CompiledBzlFileGlobal_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[86]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3632,7 +4259,7 @@ func (x *CompiledBzlFileGlobal_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompiledBzlFileGlobal_Value.ProtoReflect.Descriptor instead.
 func (*CompiledBzlFileGlobal_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{12, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{13, 1}
 }
 
 func (x *CompiledBzlFileGlobal_Value) GetGlobal() *starlark.Value {
@@ -3655,7 +4282,7 @@ type Configuration_BuildSettingOverride struct {
 
 func (x *Configuration_BuildSettingOverride) Reset() {
 	*x = Configuration_BuildSettingOverride{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[87]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3667,7 +4294,7 @@ func (x *Configuration_BuildSettingOverride) String() string {
 func (*Configuration_BuildSettingOverride) ProtoMessage() {}
 
 func (x *Configuration_BuildSettingOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[87]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3680,7 +4307,7 @@ func (x *Configuration_BuildSettingOverride) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use Configuration_BuildSettingOverride.ProtoReflect.Descriptor instead.
 func (*Configuration_BuildSettingOverride) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{13, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *Configuration_BuildSettingOverride) GetLevel() isConfiguration_BuildSettingOverride_Level {
@@ -3734,7 +4361,7 @@ type Configuration_BuildSettingOverride_Leaf struct {
 
 func (x *Configuration_BuildSettingOverride_Leaf) Reset() {
 	*x = Configuration_BuildSettingOverride_Leaf{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[88]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3746,7 +4373,7 @@ func (x *Configuration_BuildSettingOverride_Leaf) String() string {
 func (*Configuration_BuildSettingOverride_Leaf) ProtoMessage() {}
 
 func (x *Configuration_BuildSettingOverride_Leaf) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[88]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3759,7 +4386,7 @@ func (x *Configuration_BuildSettingOverride_Leaf) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use Configuration_BuildSettingOverride_Leaf.ProtoReflect.Descriptor instead.
 func (*Configuration_BuildSettingOverride_Leaf) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{13, 0, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{14, 0, 0}
 }
 
 func (x *Configuration_BuildSettingOverride_Leaf) GetLabel() string {
@@ -3786,7 +4413,7 @@ type Configuration_BuildSettingOverride_Parent struct {
 
 func (x *Configuration_BuildSettingOverride_Parent) Reset() {
 	*x = Configuration_BuildSettingOverride_Parent{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[89]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3798,7 +4425,7 @@ func (x *Configuration_BuildSettingOverride_Parent) String() string {
 func (*Configuration_BuildSettingOverride_Parent) ProtoMessage() {}
 
 func (x *Configuration_BuildSettingOverride_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[89]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3811,7 +4438,7 @@ func (x *Configuration_BuildSettingOverride_Parent) ProtoReflect() protoreflect.
 
 // Deprecated: Use Configuration_BuildSettingOverride_Parent.ProtoReflect.Descriptor instead.
 func (*Configuration_BuildSettingOverride_Parent) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{13, 0, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{14, 0, 1}
 }
 
 func (x *Configuration_BuildSettingOverride_Parent) GetReference() *core.Reference {
@@ -3828,6 +4455,110 @@ func (x *Configuration_BuildSettingOverride_Parent) GetFirstLabel() string {
 	return ""
 }
 
+type ConfiguredAspect_Key struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Label                  string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	ConfigurationReference *core.Reference        `protobuf:"bytes,2,opt,name=configuration_reference,json=configurationReference,proto3" json:"configuration_reference,omitempty"`
+	AspectIdentifiers      []string               `protobuf:"bytes,3,rep,name=aspect_identifiers,json=aspectIdentifiers,proto3" json:"aspect_identifiers,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ConfiguredAspect_Key) Reset() {
+	*x = ConfiguredAspect_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfiguredAspect_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfiguredAspect_Key) ProtoMessage() {}
+
+func (x *ConfiguredAspect_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfiguredAspect_Key.ProtoReflect.Descriptor instead.
+func (*ConfiguredAspect_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *ConfiguredAspect_Key) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ConfiguredAspect_Key) GetConfigurationReference() *core.Reference {
+	if x != nil {
+		return x.ConfigurationReference
+	}
+	return nil
+}
+
+func (x *ConfiguredAspect_Key) GetAspectIdentifiers() []string {
+	if x != nil {
+		return x.AspectIdentifiers
+	}
+	return nil
+}
+
+type ConfiguredAspect_Value struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProviderInstances []*starlark.Struct     `protobuf:"bytes,1,rep,name=provider_instances,json=providerInstances,proto3" json:"provider_instances,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConfiguredAspect_Value) Reset() {
+	*x = ConfiguredAspect_Value{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfiguredAspect_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfiguredAspect_Value) ProtoMessage() {}
+
+func (x *ConfiguredAspect_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfiguredAspect_Value.ProtoReflect.Descriptor instead.
+func (*ConfiguredAspect_Value) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *ConfiguredAspect_Value) GetProviderInstances() []*starlark.Struct {
+	if x != nil {
+		return x.ProviderInstances
+	}
+	return nil
+}
+
 type ConfiguredTarget_Key struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	Label                  string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
@@ -3838,7 +4569,7 @@ type ConfiguredTarget_Key struct {
 
 func (x *ConfiguredTarget_Key) Reset() {
 	*x = ConfiguredTarget_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[90]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3850,7 +4581,7 @@ func (x *ConfiguredTarget_Key) String() string {
 func (*ConfiguredTarget_Key) ProtoMessage() {}
 
 func (x *ConfiguredTarget_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[90]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3863,7 +4594,7 @@ func (x *ConfiguredTarget_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfiguredTarget_Key.ProtoReflect.Descriptor instead.
 func (*ConfiguredTarget_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{14, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *ConfiguredTarget_Key) GetLabel() string {
@@ -3889,7 +4620,7 @@ type ConfiguredTarget_Value struct {
 
 func (x *ConfiguredTarget_Value) Reset() {
 	*x = ConfiguredTarget_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[91]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3901,7 +4632,7 @@ func (x *ConfiguredTarget_Value) String() string {
 func (*ConfiguredTarget_Value) ProtoMessage() {}
 
 func (x *ConfiguredTarget_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[91]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3914,7 +4645,7 @@ func (x *ConfiguredTarget_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfiguredTarget_Value.ProtoReflect.Descriptor instead.
 func (*ConfiguredTarget_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{14, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 1}
 }
 
 func (x *ConfiguredTarget_Value) GetProviderInstances() []*starlark.Struct {
@@ -3932,7 +4663,7 @@ type DirectoryAccessParameters_Key struct {
 
 func (x *DirectoryAccessParameters_Key) Reset() {
 	*x = DirectoryAccessParameters_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[92]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3944,7 +4675,7 @@ func (x *DirectoryAccessParameters_Key) String() string {
 func (*DirectoryAccessParameters_Key) ProtoMessage() {}
 
 func (x *DirectoryAccessParameters_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[92]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3957,7 +4688,7 @@ func (x *DirectoryAccessParameters_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectoryAccessParameters_Key.ProtoReflect.Descriptor instead.
 func (*DirectoryAccessParameters_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{15, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 0}
 }
 
 type DirectoryAccessParameters_Value struct {
@@ -3969,7 +4700,7 @@ type DirectoryAccessParameters_Value struct {
 
 func (x *DirectoryAccessParameters_Value) Reset() {
 	*x = DirectoryAccessParameters_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[93]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3981,7 +4712,7 @@ func (x *DirectoryAccessParameters_Value) String() string {
 func (*DirectoryAccessParameters_Value) ProtoMessage() {}
 
 func (x *DirectoryAccessParameters_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[93]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3994,7 +4725,7 @@ func (x *DirectoryAccessParameters_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectoryAccessParameters_Value.ProtoReflect.Descriptor instead.
 func (*DirectoryAccessParameters_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{15, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 1}
 }
 
 func (x *DirectoryAccessParameters_Value) GetDirectoryAccessParameters() *filesystem.DirectoryAccessParameters {
@@ -4012,7 +4743,7 @@ type DirectoryCreationParameters_Key struct {
 
 func (x *DirectoryCreationParameters_Key) Reset() {
 	*x = DirectoryCreationParameters_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[94]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4024,7 +4755,7 @@ func (x *DirectoryCreationParameters_Key) String() string {
 func (*DirectoryCreationParameters_Key) ProtoMessage() {}
 
 func (x *DirectoryCreationParameters_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[94]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4037,7 +4768,7 @@ func (x *DirectoryCreationParameters_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectoryCreationParameters_Key.ProtoReflect.Descriptor instead.
 func (*DirectoryCreationParameters_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{18, 0}
 }
 
 type DirectoryCreationParameters_Value struct {
@@ -4049,7 +4780,7 @@ type DirectoryCreationParameters_Value struct {
 
 func (x *DirectoryCreationParameters_Value) Reset() {
 	*x = DirectoryCreationParameters_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[95]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4061,7 +4792,7 @@ func (x *DirectoryCreationParameters_Value) String() string {
 func (*DirectoryCreationParameters_Value) ProtoMessage() {}
 
 func (x *DirectoryCreationParameters_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[95]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4074,7 +4805,7 @@ func (x *DirectoryCreationParameters_Value) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DirectoryCreationParameters_Value.ProtoReflect.Descriptor instead.
 func (*DirectoryCreationParameters_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{16, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{18, 1}
 }
 
 func (x *DirectoryCreationParameters_Value) GetDirectoryCreationParameters() *filesystem.DirectoryCreationParameters {
@@ -4092,7 +4823,7 @@ type DirectoryCreationParametersObject_Key struct {
 
 func (x *DirectoryCreationParametersObject_Key) Reset() {
 	*x = DirectoryCreationParametersObject_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[96]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4104,7 +4835,7 @@ func (x *DirectoryCreationParametersObject_Key) String() string {
 func (*DirectoryCreationParametersObject_Key) ProtoMessage() {}
 
 func (x *DirectoryCreationParametersObject_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[96]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4117,30 +4848,32 @@ func (x *DirectoryCreationParametersObject_Key) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use DirectoryCreationParametersObject_Key.ProtoReflect.Descriptor instead.
 func (*DirectoryCreationParametersObject_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{17, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{19, 0}
 }
 
-type FileAccessParameters_Key struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ExecTransition_Key struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	PlatformLabel               string                 `protobuf:"bytes,1,opt,name=platform_label,json=platformLabel,proto3" json:"platform_label,omitempty"`
+	InputConfigurationReference *core.Reference        `protobuf:"bytes,2,opt,name=input_configuration_reference,json=inputConfigurationReference,proto3" json:"input_configuration_reference,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *FileAccessParameters_Key) Reset() {
-	*x = FileAccessParameters_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[97]
+func (x *ExecTransition_Key) Reset() {
+	*x = ExecTransition_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileAccessParameters_Key) String() string {
+func (x *ExecTransition_Key) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileAccessParameters_Key) ProtoMessage() {}
+func (*ExecTransition_Key) ProtoMessage() {}
 
-func (x *FileAccessParameters_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[97]
+func (x *ExecTransition_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4151,33 +4884,47 @@ func (x *FileAccessParameters_Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileAccessParameters_Key.ProtoReflect.Descriptor instead.
-func (*FileAccessParameters_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{18, 0}
+// Deprecated: Use ExecTransition_Key.ProtoReflect.Descriptor instead.
+func (*ExecTransition_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 0}
 }
 
-type FileAccessParameters_Value struct {
-	state                protoimpl.MessageState           `protogen:"open.v1"`
-	FileAccessParameters *filesystem.FileAccessParameters `protobuf:"bytes,1,opt,name=file_access_parameters,json=fileAccessParameters,proto3" json:"file_access_parameters,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *ExecTransition_Key) GetPlatformLabel() string {
+	if x != nil {
+		return x.PlatformLabel
+	}
+	return ""
 }
 
-func (x *FileAccessParameters_Value) Reset() {
-	*x = FileAccessParameters_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[98]
+func (x *ExecTransition_Key) GetInputConfigurationReference() *core.Reference {
+	if x != nil {
+		return x.InputConfigurationReference
+	}
+	return nil
+}
+
+type ExecTransition_Value struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	OutputConfigurationReference *core.Reference        `protobuf:"bytes,1,opt,name=output_configuration_reference,json=outputConfigurationReference,proto3" json:"output_configuration_reference,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ExecTransition_Value) Reset() {
+	*x = ExecTransition_Value{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileAccessParameters_Value) String() string {
+func (x *ExecTransition_Value) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileAccessParameters_Value) ProtoMessage() {}
+func (*ExecTransition_Value) ProtoMessage() {}
 
-func (x *FileAccessParameters_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[98]
+func (x *ExecTransition_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4188,39 +4935,39 @@ func (x *FileAccessParameters_Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileAccessParameters_Value.ProtoReflect.Descriptor instead.
-func (*FileAccessParameters_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{18, 1}
+// Deprecated: Use ExecTransition_Value.ProtoReflect.Descriptor instead.
+func (*ExecTransition_Value) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 1}
 }
 
-func (x *FileAccessParameters_Value) GetFileAccessParameters() *filesystem.FileAccessParameters {
+func (x *ExecTransition_Value) GetOutputConfigurationReference() *core.Reference {
 	if x != nil {
-		return x.FileAccessParameters
+		return x.OutputConfigurationReference
 	}
 	return nil
 }
 
-type FileCreationParameters_Key struct {
+type FileAccessParameters_Key struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FileCreationParameters_Key) Reset() {
-	*x = FileCreationParameters_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[99]
+func (x *FileAccessParameters_Key) Reset() {
+	*x = FileAccessParameters_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileCreationParameters_Key) String() string {
+func (x *FileAccessParameters_Key) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileCreationParameters_Key) ProtoMessage() {}
+func (*FileAccessParameters_Key) ProtoMessage() {}
 
-func (x *FileCreationParameters_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[99]
+func (x *FileAccessParameters_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4231,9 +4978,89 @@ func (x *FileCreationParameters_Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileCreationParameters_Key.ProtoReflect.Descriptor instead.
-func (*FileCreationParameters_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{19, 0}
+// Deprecated: Use FileAccessParameters_Key.ProtoReflect.Descriptor instead.
+func (*FileAccessParameters_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21, 0}
+}
+
+type FileAccessParameters_Value struct {
+	state                protoimpl.MessageState           `protogen:"open.v1"`
+	FileAccessParameters *filesystem.FileAccessParameters `protobuf:"bytes,1,opt,name=file_access_parameters,json=fileAccessParameters,proto3" json:"file_access_parameters,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *FileAccessParameters_Value) Reset() {
+	*x = FileAccessParameters_Value{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileAccessParameters_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileAccessParameters_Value) ProtoMessage() {}
+
+func (x *FileAccessParameters_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileAccessParameters_Value.ProtoReflect.Descriptor instead.
+func (*FileAccessParameters_Value) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21, 1}
+}
+
+func (x *FileAccessParameters_Value) GetFileAccessParameters() *filesystem.FileAccessParameters {
+	if x != nil {
+		return x.FileAccessParameters
+	}
+	return nil
+}
+
+type FileCreationParameters_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileCreationParameters_Key) Reset() {
+	*x = FileCreationParameters_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileCreationParameters_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileCreationParameters_Key) ProtoMessage() {}
+
+func (x *FileCreationParameters_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileCreationParameters_Key.ProtoReflect.Descriptor instead.
+func (*FileCreationParameters_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 0}
 }
 
 type FileCreationParameters_Value struct {
@@ -4245,7 +5072,7 @@ type FileCreationParameters_Value struct {
 
 func (x *FileCreationParameters_Value) Reset() {
 	*x = FileCreationParameters_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[100]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4257,7 +5084,7 @@ func (x *FileCreationParameters_Value) String() string {
 func (*FileCreationParameters_Value) ProtoMessage() {}
 
 func (x *FileCreationParameters_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[100]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4270,7 +5097,7 @@ func (x *FileCreationParameters_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileCreationParameters_Value.ProtoReflect.Descriptor instead.
 func (*FileCreationParameters_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{19, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 1}
 }
 
 func (x *FileCreationParameters_Value) GetFileCreationParameters() *filesystem.FileCreationParameters {
@@ -4288,7 +5115,7 @@ type FileCreationParametersObject_Key struct {
 
 func (x *FileCreationParametersObject_Key) Reset() {
 	*x = FileCreationParametersObject_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[101]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4300,7 +5127,7 @@ func (x *FileCreationParametersObject_Key) String() string {
 func (*FileCreationParametersObject_Key) ProtoMessage() {}
 
 func (x *FileCreationParametersObject_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[101]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4313,7 +5140,7 @@ func (x *FileCreationParametersObject_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileCreationParametersObject_Key.ProtoReflect.Descriptor instead.
 func (*FileCreationParametersObject_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{20, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type FileProperties_Key struct {
@@ -4326,7 +5153,7 @@ type FileProperties_Key struct {
 
 func (x *FileProperties_Key) Reset() {
 	*x = FileProperties_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[102]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4338,7 +5165,7 @@ func (x *FileProperties_Key) String() string {
 func (*FileProperties_Key) ProtoMessage() {}
 
 func (x *FileProperties_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[102]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4351,7 +5178,7 @@ func (x *FileProperties_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileProperties_Key.ProtoReflect.Descriptor instead.
 func (*FileProperties_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{24, 0}
 }
 
 func (x *FileProperties_Key) GetCanonicalRepo() string {
@@ -4377,7 +5204,7 @@ type FileProperties_Value struct {
 
 func (x *FileProperties_Value) Reset() {
 	*x = FileProperties_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[103]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4389,7 +5216,7 @@ func (x *FileProperties_Value) String() string {
 func (*FileProperties_Value) ProtoMessage() {}
 
 func (x *FileProperties_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[103]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4402,7 +5229,7 @@ func (x *FileProperties_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileProperties_Value.ProtoReflect.Descriptor instead.
 func (*FileProperties_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{21, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{24, 1}
 }
 
 func (x *FileProperties_Value) GetExists() *filesystem.FileProperties {
@@ -4420,7 +5247,7 @@ type FileReader_Key struct {
 
 func (x *FileReader_Key) Reset() {
 	*x = FileReader_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[104]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4432,7 +5259,7 @@ func (x *FileReader_Key) String() string {
 func (*FileReader_Key) ProtoMessage() {}
 
 func (x *FileReader_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[104]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4445,7 +5272,7 @@ func (x *FileReader_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileReader_Key.ProtoReflect.Descriptor instead.
 func (*FileReader_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{22, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{25, 0}
 }
 
 type HttpArchiveContents_Key struct {
@@ -4460,7 +5287,7 @@ type HttpArchiveContents_Key struct {
 
 func (x *HttpArchiveContents_Key) Reset() {
 	*x = HttpArchiveContents_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[105]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4472,7 +5299,7 @@ func (x *HttpArchiveContents_Key) String() string {
 func (*HttpArchiveContents_Key) ProtoMessage() {}
 
 func (x *HttpArchiveContents_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[105]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4485,7 +5312,7 @@ func (x *HttpArchiveContents_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpArchiveContents_Key.ProtoReflect.Descriptor instead.
 func (*HttpArchiveContents_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{23, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{26, 0}
 }
 
 func (x *HttpArchiveContents_Key) GetUrls() []string {
@@ -4525,7 +5352,7 @@ type HttpArchiveContents_Value struct {
 
 func (x *HttpArchiveContents_Value) Reset() {
 	*x = HttpArchiveContents_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[106]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4537,7 +5364,7 @@ func (x *HttpArchiveContents_Value) String() string {
 func (*HttpArchiveContents_Value) ProtoMessage() {}
 
 func (x *HttpArchiveContents_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[106]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4550,7 +5377,7 @@ func (x *HttpArchiveContents_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpArchiveContents_Value.ProtoReflect.Descriptor instead.
 func (*HttpArchiveContents_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{23, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{26, 1}
 }
 
 func (x *HttpArchiveContents_Value) GetExists() *filesystem.DirectoryReference {
@@ -4571,7 +5398,7 @@ type HttpFileContents_Key struct {
 
 func (x *HttpFileContents_Key) Reset() {
 	*x = HttpFileContents_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[107]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4583,7 +5410,7 @@ func (x *HttpFileContents_Key) String() string {
 func (*HttpFileContents_Key) ProtoMessage() {}
 
 func (x *HttpFileContents_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[107]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4596,7 +5423,7 @@ func (x *HttpFileContents_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpFileContents_Key.ProtoReflect.Descriptor instead.
 func (*HttpFileContents_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{24, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27, 0}
 }
 
 func (x *HttpFileContents_Key) GetUrls() []string {
@@ -4629,7 +5456,7 @@ type HttpFileContents_Value struct {
 
 func (x *HttpFileContents_Value) Reset() {
 	*x = HttpFileContents_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[108]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4641,7 +5468,7 @@ func (x *HttpFileContents_Value) String() string {
 func (*HttpFileContents_Value) ProtoMessage() {}
 
 func (x *HttpFileContents_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[108]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4654,7 +5481,7 @@ func (x *HttpFileContents_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpFileContents_Value.ProtoReflect.Descriptor instead.
 func (*HttpFileContents_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{24, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27, 1}
 }
 
 func (x *HttpFileContents_Value) GetExists() *HttpFileContents_Value_Exists {
@@ -4673,7 +5500,7 @@ type HttpFileContents_Value_Exists struct {
 
 func (x *HttpFileContents_Value_Exists) Reset() {
 	*x = HttpFileContents_Value_Exists{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[109]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4685,7 +5512,7 @@ func (x *HttpFileContents_Value_Exists) String() string {
 func (*HttpFileContents_Value_Exists) ProtoMessage() {}
 
 func (x *HttpFileContents_Value_Exists) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[109]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4698,7 +5525,7 @@ func (x *HttpFileContents_Value_Exists) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpFileContents_Value_Exists.ProtoReflect.Descriptor instead.
 func (*HttpFileContents_Value_Exists) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{24, 1, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27, 1, 0}
 }
 
 func (x *HttpFileContents_Value_Exists) GetContents() *filesystem.FileContents {
@@ -4717,7 +5544,7 @@ type ModuleDotBazelContents_Key struct {
 
 func (x *ModuleDotBazelContents_Key) Reset() {
 	*x = ModuleDotBazelContents_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[110]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4729,7 +5556,7 @@ func (x *ModuleDotBazelContents_Key) String() string {
 func (*ModuleDotBazelContents_Key) ProtoMessage() {}
 
 func (x *ModuleDotBazelContents_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[110]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4742,7 +5569,7 @@ func (x *ModuleDotBazelContents_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleDotBazelContents_Key.ProtoReflect.Descriptor instead.
 func (*ModuleDotBazelContents_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{25, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{28, 0}
 }
 
 func (x *ModuleDotBazelContents_Key) GetModuleInstance() string {
@@ -4761,7 +5588,7 @@ type ModuleDotBazelContents_Value struct {
 
 func (x *ModuleDotBazelContents_Value) Reset() {
 	*x = ModuleDotBazelContents_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[111]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4773,7 +5600,7 @@ func (x *ModuleDotBazelContents_Value) String() string {
 func (*ModuleDotBazelContents_Value) ProtoMessage() {}
 
 func (x *ModuleDotBazelContents_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[111]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4786,7 +5613,7 @@ func (x *ModuleDotBazelContents_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleDotBazelContents_Value.ProtoReflect.Descriptor instead.
 func (*ModuleDotBazelContents_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{25, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{28, 1}
 }
 
 func (x *ModuleDotBazelContents_Value) GetContents() *filesystem.FileContents {
@@ -4804,7 +5631,7 @@ type ModuleRegistryUrls_Key struct {
 
 func (x *ModuleRegistryUrls_Key) Reset() {
 	*x = ModuleRegistryUrls_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[112]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4816,7 +5643,7 @@ func (x *ModuleRegistryUrls_Key) String() string {
 func (*ModuleRegistryUrls_Key) ProtoMessage() {}
 
 func (x *ModuleRegistryUrls_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[112]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4829,7 +5656,7 @@ func (x *ModuleRegistryUrls_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRegistryUrls_Key.ProtoReflect.Descriptor instead.
 func (*ModuleRegistryUrls_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{26, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{29, 0}
 }
 
 type ModuleRegistryUrls_Value struct {
@@ -4841,7 +5668,7 @@ type ModuleRegistryUrls_Value struct {
 
 func (x *ModuleRegistryUrls_Value) Reset() {
 	*x = ModuleRegistryUrls_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[113]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4853,7 +5680,7 @@ func (x *ModuleRegistryUrls_Value) String() string {
 func (*ModuleRegistryUrls_Value) ProtoMessage() {}
 
 func (x *ModuleRegistryUrls_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[113]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4866,7 +5693,7 @@ func (x *ModuleRegistryUrls_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRegistryUrls_Value.ProtoReflect.Descriptor instead.
 func (*ModuleRegistryUrls_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{26, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{29, 1}
 }
 
 func (x *ModuleRegistryUrls_Value) GetRegistryUrls() []string {
@@ -4885,7 +5712,7 @@ type ModuleRepoMapping_Key struct {
 
 func (x *ModuleRepoMapping_Key) Reset() {
 	*x = ModuleRepoMapping_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[114]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4897,7 +5724,7 @@ func (x *ModuleRepoMapping_Key) String() string {
 func (*ModuleRepoMapping_Key) ProtoMessage() {}
 
 func (x *ModuleRepoMapping_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[114]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4910,7 +5737,7 @@ func (x *ModuleRepoMapping_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRepoMapping_Key.ProtoReflect.Descriptor instead.
 func (*ModuleRepoMapping_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30, 0}
 }
 
 func (x *ModuleRepoMapping_Key) GetModuleInstance() string {
@@ -4929,7 +5756,7 @@ type ModuleRepoMapping_Value struct {
 
 func (x *ModuleRepoMapping_Value) Reset() {
 	*x = ModuleRepoMapping_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[115]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4941,7 +5768,7 @@ func (x *ModuleRepoMapping_Value) String() string {
 func (*ModuleRepoMapping_Value) ProtoMessage() {}
 
 func (x *ModuleRepoMapping_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[115]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4954,7 +5781,7 @@ func (x *ModuleRepoMapping_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRepoMapping_Value.ProtoReflect.Descriptor instead.
 func (*ModuleRepoMapping_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30, 1}
 }
 
 func (x *ModuleRepoMapping_Value) GetMappings() []*ModuleRepoMapping_Value_Mapping {
@@ -4974,7 +5801,7 @@ type ModuleRepoMapping_Value_Mapping struct {
 
 func (x *ModuleRepoMapping_Value_Mapping) Reset() {
 	*x = ModuleRepoMapping_Value_Mapping{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[116]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4986,7 +5813,7 @@ func (x *ModuleRepoMapping_Value_Mapping) String() string {
 func (*ModuleRepoMapping_Value_Mapping) ProtoMessage() {}
 
 func (x *ModuleRepoMapping_Value_Mapping) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[116]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4999,7 +5826,7 @@ func (x *ModuleRepoMapping_Value_Mapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRepoMapping_Value_Mapping.ProtoReflect.Descriptor instead.
 func (*ModuleRepoMapping_Value_Mapping) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{27, 1, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30, 1, 0}
 }
 
 func (x *ModuleRepoMapping_Value_Mapping) GetFromApparentRepo() string {
@@ -5016,6 +5843,86 @@ func (x *ModuleRepoMapping_Value_Mapping) GetToCanonicalRepo() string {
 	return ""
 }
 
+type ModuleExtensionImportsCheck_Key struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ModuleExtension string                 `protobuf:"bytes,1,opt,name=module_extension,json=moduleExtension,proto3" json:"module_extension,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModuleExtensionImportsCheck_Key) Reset() {
+	*x = ModuleExtensionImportsCheck_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleExtensionImportsCheck_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleExtensionImportsCheck_Key) ProtoMessage() {}
+
+func (x *ModuleExtensionImportsCheck_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleExtensionImportsCheck_Key.ProtoReflect.Descriptor instead.
+func (*ModuleExtensionImportsCheck_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *ModuleExtensionImportsCheck_Key) GetModuleExtension() string {
+	if x != nil {
+		return x.ModuleExtension
+	}
+	return ""
+}
+
+type ModuleExtensionImportsCheck_Value struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleExtensionImportsCheck_Value) Reset() {
+	*x = ModuleExtensionImportsCheck_Value{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleExtensionImportsCheck_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleExtensionImportsCheck_Value) ProtoMessage() {}
+
+func (x *ModuleExtensionImportsCheck_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleExtensionImportsCheck_Value.ProtoReflect.Descriptor instead.
+func (*ModuleExtensionImportsCheck_Value) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{31, 1}
+}
+
 type ModuleExtensionRepo_Key struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanonicalRepo string                 `protobuf:"bytes,1,opt,name=canonical_repo,json=canonicalRepo,proto3" json:"canonical_repo,omitempty"`
@@ -5025,7 +5932,7 @@ type ModuleExtensionRepo_Key struct {
 
 func (x *ModuleExtensionRepo_Key) Reset() {
 	*x = ModuleExtensionRepo_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[117]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5037,7 +5944,7 @@ func (x *ModuleExtensionRepo_Key) String() string {
 func (*ModuleExtensionRepo_Key) ProtoMessage() {}
 
 func (x *ModuleExtensionRepo_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[117]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5050,7 +5957,7 @@ func (x *ModuleExtensionRepo_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepo_Key.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepo_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{28, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{32, 0}
 }
 
 func (x *ModuleExtensionRepo_Key) GetCanonicalRepo() string {
@@ -5069,7 +5976,7 @@ type ModuleExtensionRepo_Value struct {
 
 func (x *ModuleExtensionRepo_Value) Reset() {
 	*x = ModuleExtensionRepo_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[118]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5081,7 +5988,7 @@ func (x *ModuleExtensionRepo_Value) String() string {
 func (*ModuleExtensionRepo_Value) ProtoMessage() {}
 
 func (x *ModuleExtensionRepo_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[118]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5094,7 +6001,7 @@ func (x *ModuleExtensionRepo_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepo_Value.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepo_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{28, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{32, 1}
 }
 
 func (x *ModuleExtensionRepo_Value) GetDefinition() *starlark.Repo_Definition {
@@ -5113,7 +6020,7 @@ type ModuleExtensionRepoNames_Key struct {
 
 func (x *ModuleExtensionRepoNames_Key) Reset() {
 	*x = ModuleExtensionRepoNames_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[119]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5125,7 +6032,7 @@ func (x *ModuleExtensionRepoNames_Key) String() string {
 func (*ModuleExtensionRepoNames_Key) ProtoMessage() {}
 
 func (x *ModuleExtensionRepoNames_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[119]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5138,7 +6045,7 @@ func (x *ModuleExtensionRepoNames_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepoNames_Key.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepoNames_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{29, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{33, 0}
 }
 
 func (x *ModuleExtensionRepoNames_Key) GetModuleExtension() string {
@@ -5157,7 +6064,7 @@ type ModuleExtensionRepoNames_Value struct {
 
 func (x *ModuleExtensionRepoNames_Value) Reset() {
 	*x = ModuleExtensionRepoNames_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[120]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5169,7 +6076,7 @@ func (x *ModuleExtensionRepoNames_Value) String() string {
 func (*ModuleExtensionRepoNames_Value) ProtoMessage() {}
 
 func (x *ModuleExtensionRepoNames_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[120]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5182,7 +6089,7 @@ func (x *ModuleExtensionRepoNames_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepoNames_Value.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepoNames_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{29, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{33, 1}
 }
 
 func (x *ModuleExtensionRepoNames_Value) GetRepoNames() []string {
@@ -5201,7 +6108,7 @@ type ModuleExtensionRepos_Key struct {
 
 func (x *ModuleExtensionRepos_Key) Reset() {
 	*x = ModuleExtensionRepos_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[121]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5213,7 +6120,7 @@ func (x *ModuleExtensionRepos_Key) String() string {
 func (*ModuleExtensionRepos_Key) ProtoMessage() {}
 
 func (x *ModuleExtensionRepos_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[121]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5226,7 +6133,7 @@ func (x *ModuleExtensionRepos_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepos_Key.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepos_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{34, 0}
 }
 
 func (x *ModuleExtensionRepos_Key) GetModuleExtension() string {
@@ -5237,15 +6144,17 @@ func (x *ModuleExtensionRepos_Key) GetModuleExtension() string {
 }
 
 type ModuleExtensionRepos_Value struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	Repos         []*ModuleExtensionRepos_Value_Repo `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState                           `protogen:"open.v1"`
+	Repos                []*ModuleExtensionRepos_Value_Repo               `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	RootModuleDirectDeps *ModuleExtensionRepos_Value_RootModuleDirectDeps `protobuf:"bytes,2,opt,name=root_module_direct_deps,json=rootModuleDirectDeps,proto3" json:"root_module_direct_deps,omitempty"`
+	Reproducible         bool                                             `protobuf:"varint,3,opt,name=reproducible,proto3" json:"reproducible,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *ModuleExtensionRepos_Value) Reset() {
 	*x = ModuleExtensionRepos_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[122]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5257,7 +6166,7 @@ func (x *ModuleExtensionRepos_Value) String() string {
 func (*ModuleExtensionRepos_Value) ProtoMessage() {}
 
 func (x *ModuleExtensionRepos_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[122]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5270,7 +6179,7 @@ func (x *ModuleExtensionRepos_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepos_Value.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepos_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{34, 1}
 }
 
 func (x *ModuleExtensionRepos_Value) GetRepos() []*ModuleExtensionRepos_Value_Repo {
@@ -5280,6 +6189,20 @@ func (x *ModuleExtensionRepos_Value) GetRepos() []*ModuleExtensionRepos_Value_Re
 	return nil
 }
 
+func (x *ModuleExtensionRepos_Value) GetRootModuleDirectDeps() *ModuleExtensionRepos_Value_RootModuleDirectDeps {
+	if x != nil {
+		return x.RootModuleDirectDeps
+	}
+	return nil
+}
+
+func (x *ModuleExtensionRepos_Value) GetReproducible() bool {
+	if x != nil {
+		return x.Reproducible
+	}
+	return false
+}
+
 type ModuleExtensionRepos_Value_Repo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Level:
@@ -5293,7 +6216,7 @@ type ModuleExtensionRepos_Value_Repo struct {
 
 func (x *ModuleExtensionRepos_Value_Repo) Reset() {
 	*x = ModuleExtensionRepos_Value_Repo{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[123]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5305,7 +6228,7 @@ func (x *ModuleExtensionRepos_Value_Repo) String() string {
 func (*ModuleExtensionRepos_Value_Repo) ProtoMessage() {}
 
 func (x *ModuleExtensionRepos_Value_Repo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[123]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5318,7 +6241,7 @@ func (x *ModuleExtensionRepos_Value_Repo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtensionRepos_Value_Repo.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepos_Value_Repo) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30, 1, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{34, 1, 0}
 }
 
 func (x *ModuleExtensionRepos_Value_Repo) GetLevel() isModuleExtensionRepos_Value_Repo_Level {
@@ -5362,6 +6285,58 @@ func (*ModuleExtensionRepos_Value_Repo_Leaf) isModuleExtensionRepos_Value_Repo_L
 
 func (*ModuleExtensionRepos_Value_Repo_Parent_) isModuleExtensionRepos_Value_Repo_Level() {}
 
+type ModuleExtensionRepos_Value_RootModuleDirectDeps struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DirectDeps    []string               `protobuf:"bytes,1,rep,name=direct_deps,json=directDeps,proto3" json:"direct_deps,omitempty"`
+	DirectDevDeps []string               `protobuf:"bytes,2,rep,name=direct_dev_deps,json=directDevDeps,proto3" json:"direct_dev_deps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleExtensionRepos_Value_RootModuleDirectDeps) Reset() {
+	*x = ModuleExtensionRepos_Value_RootModuleDirectDeps{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleExtensionRepos_Value_RootModuleDirectDeps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleExtensionRepos_Value_RootModuleDirectDeps) ProtoMessage() {}
+
+func (x *ModuleExtensionRepos_Value_RootModuleDirectDeps) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleExtensionRepos_Value_RootModuleDirectDeps.ProtoReflect.Descriptor instead.
+func (*ModuleExtensionRepos_Value_RootModuleDirectDeps) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{34, 1, 1}
+}
+
+func (x *ModuleExtensionRepos_Value_RootModuleDirectDeps) GetDirectDeps() []string {
+	if x != nil {
+		return x.DirectDeps
+	}
+	return nil
+}
+
+func (x *ModuleExtensionRepos_Value_RootModuleDirectDeps) GetDirectDevDeps() []string {
+	if x != nil {
+		return x.DirectDevDeps
+	}
+	return nil
+}
+
 type ModuleExtensionRepos_Value_Repo_Parent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Reference     *core.Reference        `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
@@ -5372,7 +6347,7 @@ type ModuleExtensionRepos_Value_Repo_Parent struct {
 
 func (x *ModuleExtensionRepos_Value_Repo_Parent) Reset() {
 	*x = ModuleExtensionRepos_Value_Repo_Parent{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[124]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5384,7 +6359,7 @@ func (x *ModuleExtensionRepos_Value_Repo_Parent) String() string {
 func (*ModuleExtensionRepos_Value_Repo_Parent) ProtoMessage() {}
 
 func (x *ModuleExtensionRepos_Value_Repo_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[124]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5397,7 +6372,7 @@ func (x *ModuleExtensionRepos_Value_Repo_Parent) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use ModuleExtensionRepos_Value_Repo_Parent.ProtoReflect.Descriptor instead.
 func (*ModuleExtensionRepos_Value_Repo_Parent) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{30, 1, 0, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{34, 1, 0, 0}
 }
 
 func (x *ModuleExtensionRepos_Value_Repo_Parent) GetReference() *core.Reference {
@@ -5422,7 +6397,7 @@ type ModuleFinalBuildList_Key struct {
 
 func (x *ModuleFinalBuildList_Key) Reset() {
 	*x = ModuleFinalBuildList_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[125]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5434,7 +6409,7 @@ func (x *ModuleFinalBuildList_Key) String() string {
 func (*ModuleFinalBuildList_Key) ProtoMessage() {}
 
 func (x *ModuleFinalBuildList_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[125]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5447,7 +6422,7 @@ func (x *ModuleFinalBuildList_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleFinalBuildList_Key.ProtoReflect.Descriptor instead.
 func (*ModuleFinalBuildList_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{32, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{36, 0}
 }
 
 type ModuleFinalBuildList_Value struct {
@@ -5459,7 +6434,7 @@ type ModuleFinalBuildList_Value struct {
 
 func (x *ModuleFinalBuildList_Value) Reset() {
 	*x = ModuleFinalBuildList_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[126]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5471,7 +6446,7 @@ func (x *ModuleFinalBuildList_Value) String() string {
 func (*ModuleFinalBuildList_Value) ProtoMessage() {}
 
 func (x *ModuleFinalBuildList_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[126]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5484,7 +6459,7 @@ func (x *ModuleFinalBuildList_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleFinalBuildList_Value.ProtoReflect.Descriptor instead.
 func (*ModuleFinalBuildList_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{32, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{36, 1}
 }
 
 func (x *ModuleFinalBuildList_Value) GetBuildList() []*BuildListModule {
@@ -5494,6 +6469,174 @@ func (x *ModuleFinalBuildList_Value) GetBuildList() []*BuildListModule {
 	return nil
 }
 
+type ModuleLockfile_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleLockfile_Key) Reset() {
+	*x = ModuleLockfile_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleLockfile_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleLockfile_Key) ProtoMessage() {}
+
+func (x *ModuleLockfile_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleLockfile_Key.ProtoReflect.Descriptor instead.
+func (*ModuleLockfile_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{37, 0}
+}
+
+type ModuleLockfile_Value struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RegistryFileHashes []*RegistryFileHash    `protobuf:"bytes,1,rep,name=registry_file_hashes,json=registryFileHashes,proto3" json:"registry_file_hashes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ModuleLockfile_Value) Reset() {
+	*x = ModuleLockfile_Value{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleLockfile_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleLockfile_Value) ProtoMessage() {}
+
+func (x *ModuleLockfile_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleLockfile_Value.ProtoReflect.Descriptor instead.
+func (*ModuleLockfile_Value) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{37, 1}
+}
+
+func (x *ModuleLockfile_Value) GetRegistryFileHashes() []*RegistryFileHash {
+	if x != nil {
+		return x.RegistryFileHashes
+	}
+	return nil
+}
+
+type ModuleQueryResult_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         *build.ModuleQuery     `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleQueryResult_Key) Reset() {
+	*x = ModuleQueryResult_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleQueryResult_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleQueryResult_Key) ProtoMessage() {}
+
+func (x *ModuleQueryResult_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleQueryResult_Key.ProtoReflect.Descriptor instead.
+func (*ModuleQueryResult_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *ModuleQueryResult_Key) GetQuery() *build.ModuleQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type ModuleQueryResult_Value struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Result        *build.ModuleQueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleQueryResult_Value) Reset() {
+	*x = ModuleQueryResult_Value{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleQueryResult_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleQueryResult_Value) ProtoMessage() {}
+
+func (x *ModuleQueryResult_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleQueryResult_Value.ProtoReflect.Descriptor instead.
+func (*ModuleQueryResult_Value) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38, 1}
+}
+
+func (x *ModuleQueryResult_Value) GetResult() *build.ModuleQueryResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
 type ModuleRoughBuildList_Key struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -5502,7 +6645,7 @@ type ModuleRoughBuildList_Key struct {
 
 func (x *ModuleRoughBuildList_Key) Reset() {
 	*x = ModuleRoughBuildList_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[127]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5514,7 +6657,7 @@ func (x *ModuleRoughBuildList_Key) String() string {
 func (*ModuleRoughBuildList_Key) ProtoMessage() {}
 
 func (x *ModuleRoughBuildList_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[127]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5527,19 +6670,20 @@ func (x *ModuleRoughBuildList_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRoughBuildList_Key.ProtoReflect.Descriptor instead.
 func (*ModuleRoughBuildList_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{33, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{39, 0}
 }
 
 type ModuleRoughBuildList_Value struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BuildList     []*BuildListModule     `protobuf:"bytes,1,rep,name=build_list,json=buildList,proto3" json:"build_list,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BuildList          []*BuildListModule     `protobuf:"bytes,1,rep,name=build_list,json=buildList,proto3" json:"build_list,omitempty"`
+	RegistryFileHashes []*RegistryFileHash    `protobuf:"bytes,2,rep,name=registry_file_hashes,json=registryFileHashes,proto3" json:"registry_file_hashes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ModuleRoughBuildList_Value) Reset() {
 	*x = ModuleRoughBuildList_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[128]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5551,7 +6695,7 @@ func (x *ModuleRoughBuildList_Value) String() string {
 func (*ModuleRoughBuildList_Value) ProtoMessage() {}
 
 func (x *ModuleRoughBuildList_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[128]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5564,7 +6708,7 @@ func (x *ModuleRoughBuildList_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleRoughBuildList_Value.ProtoReflect.Descriptor instead.
 func (*ModuleRoughBuildList_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{33, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{39, 1}
 }
 
 func (x *ModuleRoughBuildList_Value) GetBuildList() []*BuildListModule {
@@ -5574,6 +6718,13 @@ func (x *ModuleRoughBuildList_Value) GetBuildList() []*BuildListModule {
 	return nil
 }
 
+func (x *ModuleRoughBuildList_Value) GetRegistryFileHashes() []*RegistryFileHash {
+	if x != nil {
+		return x.RegistryFileHashes
+	}
+	return nil
+}
+
 type ModulesWithMultipleVersions_Key struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -5582,7 +6733,7 @@ type ModulesWithMultipleVersions_Key struct {
 
 func (x *ModulesWithMultipleVersions_Key) Reset() {
 	*x = ModulesWithMultipleVersions_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[129]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5594,7 +6745,7 @@ func (x *ModulesWithMultipleVersions_Key) String() string {
 func (*ModulesWithMultipleVersions_Key) ProtoMessage() {}
 
 func (x *ModulesWithMultipleVersions_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[129]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5607,7 +6758,7 @@ func (x *ModulesWithMultipleVersions_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithMultipleVersions_Key.ProtoReflect.Descriptor instead.
 func (*ModulesWithMultipleVersions_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{35, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{41, 0}
 }
 
 type ModulesWithMultipleVersions_Value struct {
@@ -5619,7 +6770,7 @@ type ModulesWithMultipleVersions_Value struct {
 
 func (x *ModulesWithMultipleVersions_Value) Reset() {
 	*x = ModulesWithMultipleVersions_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[130]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5631,7 +6782,7 @@ func (x *ModulesWithMultipleVersions_Value) String() string {
 func (*ModulesWithMultipleVersions_Value) ProtoMessage() {}
 
 func (x *ModulesWithMultipleVersions_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[130]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5644,7 +6795,7 @@ func (x *ModulesWithMultipleVersions_Value) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ModulesWithMultipleVersions_Value.ProtoReflect.Descriptor instead.
 func (*ModulesWithMultipleVersions_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{35, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{41, 1}
 }
 
 func (x *ModulesWithMultipleVersions_Value) GetOverridesList() []*OverridesListModule {
@@ -5662,7 +6813,7 @@ type ModulesWithMultipleVersionsObject_Key struct {
 
 func (x *ModulesWithMultipleVersionsObject_Key) Reset() {
 	*x = ModulesWithMultipleVersionsObject_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[131]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5674,7 +6825,7 @@ func (x *ModulesWithMultipleVersionsObject_Key) String() string {
 func (*ModulesWithMultipleVersionsObject_Key) ProtoMessage() {}
 
 func (x *ModulesWithMultipleVersionsObject_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[131]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5687,7 +6838,7 @@ func (x *ModulesWithMultipleVersionsObject_Key) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ModulesWithMultipleVersionsObject_Key.ProtoReflect.Descriptor instead.
 func (*ModulesWithMultipleVersionsObject_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{36, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{42, 0}
 }
 
 type ModulesWithOverrides_Key struct {
@@ -5698,7 +6849,7 @@ type ModulesWithOverrides_Key struct {
 
 func (x *ModulesWithOverrides_Key) Reset() {
 	*x = ModulesWithOverrides_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[132]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5710,7 +6861,7 @@ func (x *ModulesWithOverrides_Key) String() string {
 func (*ModulesWithOverrides_Key) ProtoMessage() {}
 
 func (x *ModulesWithOverrides_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[132]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5723,7 +6874,7 @@ func (x *ModulesWithOverrides_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithOverrides_Key.ProtoReflect.Descriptor instead.
 func (*ModulesWithOverrides_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{37, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{43, 0}
 }
 
 type ModulesWithOverrides_Value struct {
@@ -5735,7 +6886,7 @@ type ModulesWithOverrides_Value struct {
 
 func (x *ModulesWithOverrides_Value) Reset() {
 	*x = ModulesWithOverrides_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[133]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5747,7 +6898,7 @@ func (x *ModulesWithOverrides_Value) String() string {
 func (*ModulesWithOverrides_Value) ProtoMessage() {}
 
 func (x *ModulesWithOverrides_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[133]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5760,7 +6911,7 @@ func (x *ModulesWithOverrides_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithOverrides_Value.ProtoReflect.Descriptor instead.
 func (*ModulesWithOverrides_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{37, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{43, 1}
 }
 
 func (x *ModulesWithOverrides_Value) GetOverridesList() []*OverridesListModule {
@@ -5783,7 +6934,7 @@ type ModuleOverride_SingleVersion struct {
 
 func (x *ModuleOverride_SingleVersion) Reset() {
 	*x = ModuleOverride_SingleVersion{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[134]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5795,7 +6946,7 @@ func (x *ModuleOverride_SingleVersion) String() string {
 func (*ModuleOverride_SingleVersion) ProtoMessage() {}
 
 func (x *ModuleOverride_SingleVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[134]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5808,7 +6959,7 @@ func (x *ModuleOverride_SingleVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleOverride_SingleVersion.ProtoReflect.Descriptor instead.
 func (*ModuleOverride_SingleVersion) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44, 0}
 }
 
 func (x *ModuleOverride_SingleVersion) GetVersion() string {
@@ -5856,7 +7007,7 @@ type ModuleOverride_MultipleVersions struct {
 
 func (x *ModuleOverride_MultipleVersions) Reset() {
 	*x = ModuleOverride_MultipleVersions{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[135]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5868,7 +7019,7 @@ func (x *ModuleOverride_MultipleVersions) String() string {
 func (*ModuleOverride_MultipleVersions) ProtoMessage() {}
 
 func (x *ModuleOverride_MultipleVersions) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[135]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5881,7 +7032,7 @@ func (x *ModuleOverride_MultipleVersions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleOverride_MultipleVersions.ProtoReflect.Descriptor instead.
 func (*ModuleOverride_MultipleVersions) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{38, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44, 1}
 }
 
 func (x *ModuleOverride_MultipleVersions) GetVersions() []string {
@@ -5906,7 +7057,7 @@ type ModulesWithRemoteOverrides_Key struct {
 
 func (x *ModulesWithRemoteOverrides_Key) Reset() {
 	*x = ModulesWithRemoteOverrides_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[136]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5918,7 +7069,7 @@ func (x *ModulesWithRemoteOverrides_Key) String() string {
 func (*ModulesWithRemoteOverrides_Key) ProtoMessage() {}
 
 func (x *ModulesWithRemoteOverrides_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[136]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5931,7 +7082,7 @@ func (x *ModulesWithRemoteOverrides_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithRemoteOverrides_Key.ProtoReflect.Descriptor instead.
 func (*ModulesWithRemoteOverrides_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{39, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{45, 0}
 }
 
 type ModulesWithRemoteOverrides_Value struct {
@@ -5943,7 +7094,7 @@ type ModulesWithRemoteOverrides_Value struct {
 
 func (x *ModulesWithRemoteOverrides_Value) Reset() {
 	*x = ModulesWithRemoteOverrides_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[137]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5955,7 +7106,7 @@ func (x *ModulesWithRemoteOverrides_Value) String() string {
 func (*ModulesWithRemoteOverrides_Value) ProtoMessage() {}
 
 func (x *ModulesWithRemoteOverrides_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[137]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5968,7 +7119,7 @@ func (x *ModulesWithRemoteOverrides_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModulesWithRemoteOverrides_Value.ProtoReflect.Descriptor instead.
 func (*ModulesWithRemoteOverrides_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{39, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{45, 1}
 }
 
 func (x *ModulesWithRemoteOverrides_Value) GetModuleOverrides() []*ModuleOverride {
@@ -5987,7 +7138,7 @@ type Package_Key struct {
 
 func (x *Package_Key) Reset() {
 	*x = Package_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[138]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5999,7 +7150,7 @@ func (x *Package_Key) String() string {
 func (*Package_Key) ProtoMessage() {}
 
 func (x *Package_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[138]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6012,7 +7163,7 @@ func (x *Package_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Package_Key.ProtoReflect.Descriptor instead.
 func (*Package_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{40, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46, 0}
 }
 
 func (x *Package_Key) GetLabel() string {
@@ -6031,7 +7182,7 @@ type Package_Value struct {
 
 func (x *Package_Value) Reset() {
 	*x = Package_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[139]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6043,7 +7194,7 @@ func (x *Package_Value) String() string {
 func (*Package_Value) ProtoMessage() {}
 
 func (x *Package_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[139]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6056,7 +7207,7 @@ func (x *Package_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Package_Value.ProtoReflect.Descriptor instead.
 func (*Package_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{40, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46, 1}
 }
 
 func (x *Package_Value) GetTargets() []*Package_Value_Target {
@@ -6079,7 +7230,7 @@ type Package_Value_Target struct {
 
 func (x *Package_Value_Target) Reset() {
 	*x = Package_Value_Target{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[140]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6091,7 +7242,7 @@ func (x *Package_Value_Target) String() string {
 func (*Package_Value_Target) ProtoMessage() {}
 
 func (x *Package_Value_Target) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[140]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6104,7 +7255,7 @@ func (x *Package_Value_Target) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Package_Value_Target.ProtoReflect.Descriptor instead.
 func (*Package_Value_Target) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{40, 1, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46, 1, 0}
 }
 
 func (x *Package_Value_Target) GetLevel() isPackage_Value_Target_Level {
@@ -6158,7 +7309,7 @@ type Package_Value_Target_Parent struct {
 
 func (x *Package_Value_Target_Parent) Reset() {
 	*x = Package_Value_Target_Parent{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[141]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6170,7 +7321,7 @@ func (x *Package_Value_Target_Parent) String() string {
 func (*Package_Value_Target_Parent) ProtoMessage() {}
 
 func (x *Package_Value_Target_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[141]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6183,7 +7334,7 @@ func (x *Package_Value_Target_Parent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Package_Value_Target_Parent.ProtoReflect.Descriptor instead.
 func (*Package_Value_Target_Parent) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{40, 1, 0, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46, 1, 0, 0}
 }
 
 func (x *Package_Value_Target_Parent) GetReference() *core.Reference {
@@ -6200,6 +7351,102 @@ func (x *Package_Value_Target_Parent) GetFirstName() string {
 	return ""
 }
 
+type PackagesAtAndBelow_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BasePackage   string                 `protobuf:"bytes,1,opt,name=base_package,json=basePackage,proto3" json:"base_package,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackagesAtAndBelow_Key) Reset() {
+	*x = PackagesAtAndBelow_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackagesAtAndBelow_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackagesAtAndBelow_Key) ProtoMessage() {}
+
+func (x *PackagesAtAndBelow_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackagesAtAndBelow_Key.ProtoReflect.Descriptor instead.
+func (*PackagesAtAndBelow_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *PackagesAtAndBelow_Key) GetBasePackage() string {
+	if x != nil {
+		return x.BasePackage
+	}
+	return ""
+}
+
+type PackagesAtAndBelow_Value struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	PackageAtBasePackage     bool                   `protobuf:"varint,1,opt,name=package_at_base_package,json=packageAtBasePackage,proto3" json:"package_at_base_package,omitempty"`
+	PackagesBelowBasePackage []string               `protobuf:"bytes,2,rep,name=packages_below_base_package,json=packagesBelowBasePackage,proto3" json:"packages_below_base_package,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *PackagesAtAndBelow_Value) Reset() {
+	*x = PackagesAtAndBelow_Value{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackagesAtAndBelow_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackagesAtAndBelow_Value) ProtoMessage() {}
+
+func (x *PackagesAtAndBelow_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackagesAtAndBelow_Value.ProtoReflect.Descriptor instead.
+func (*PackagesAtAndBelow_Value) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{47, 1}
+}
+
+func (x *PackagesAtAndBelow_Value) GetPackageAtBasePackage() bool {
+	if x != nil {
+		return x.PackageAtBasePackage
+	}
+	return false
+}
+
+func (x *PackagesAtAndBelow_Value) GetPackagesBelowBasePackage() []string {
+	if x != nil {
+		return x.PackagesBelowBasePackage
+	}
+	return nil
+}
+
 type RegisteredExecutionPlatforms_Key struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -6208,7 +7455,7 @@ type RegisteredExecutionPlatforms_Key struct {
 
 func (x *RegisteredExecutionPlatforms_Key) Reset() {
 	*x = RegisteredExecutionPlatforms_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[142]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6220,7 +7467,7 @@ func (x *RegisteredExecutionPlatforms_Key) String() string {
 func (*RegisteredExecutionPlatforms_Key) ProtoMessage() {}
 
 func (x *RegisteredExecutionPlatforms_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[142]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6233,7 +7480,7 @@ func (x *RegisteredExecutionPlatforms_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredExecutionPlatforms_Key.ProtoReflect.Descriptor instead.
 func (*RegisteredExecutionPlatforms_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{43, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{50, 0}
 }
 
 type RegisteredExecutionPlatforms_Value struct {
@@ -6245,7 +7492,7 @@ type RegisteredExecutionPlatforms_Value struct {
 
 func (x *RegisteredExecutionPlatforms_Value) Reset() {
 	*x = RegisteredExecutionPlatforms_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[143]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6257,7 +7504,7 @@ func (x *RegisteredExecutionPlatforms_Value) String() string {
 func (*RegisteredExecutionPlatforms_Value) ProtoMessage() {}
 
 func (x *RegisteredExecutionPlatforms_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[143]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6270,7 +7517,7 @@ func (x *RegisteredExecutionPlatforms_Value) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RegisteredExecutionPlatforms_Value.ProtoReflect.Descriptor instead.
 func (*RegisteredExecutionPlatforms_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{43, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{50, 1}
 }
 
 func (x *RegisteredExecutionPlatforms_Value) GetExecutionPlatforms() []*ExecutionPlatform {
@@ -6288,7 +7535,7 @@ type RegisteredRepoPlatform_Key struct {
 
 func (x *RegisteredRepoPlatform_Key) Reset() {
 	*x = RegisteredRepoPlatform_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[144]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6300,7 +7547,7 @@ func (x *RegisteredRepoPlatform_Key) String() string {
 func (*RegisteredRepoPlatform_Key) ProtoMessage() {}
 
 func (x *RegisteredRepoPlatform_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[144]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6313,7 +7560,7 @@ func (x *RegisteredRepoPlatform_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredRepoPlatform_Key.ProtoReflect.Descriptor instead.
 func (*RegisteredRepoPlatform_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{51, 0}
 }
 
 type RegisteredRepoPlatform_Value struct {
@@ -6328,7 +7575,7 @@ type RegisteredRepoPlatform_Value struct {
 
 func (x *RegisteredRepoPlatform_Value) Reset() {
 	*x = RegisteredRepoPlatform_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[145]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6340,7 +7587,7 @@ func (x *RegisteredRepoPlatform_Value) String() string {
 func (*RegisteredRepoPlatform_Value) ProtoMessage() {}
 
 func (x *RegisteredRepoPlatform_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[145]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6353,7 +7600,7 @@ func (x *RegisteredRepoPlatform_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredRepoPlatform_Value.ProtoReflect.Descriptor instead.
 func (*RegisteredRepoPlatform_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{51, 1}
 }
 
 func (x *RegisteredRepoPlatform_Value) GetExecPkixPublicKey() []byte {
@@ -6394,7 +7641,7 @@ type RegisteredRepoPlatform_Value_EnvironmentVariable struct {
 
 func (x *RegisteredRepoPlatform_Value_EnvironmentVariable) Reset() {
 	*x = RegisteredRepoPlatform_Value_EnvironmentVariable{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[146]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6406,7 +7653,7 @@ func (x *RegisteredRepoPlatform_Value_EnvironmentVariable) String() string {
 func (*RegisteredRepoPlatform_Value_EnvironmentVariable) ProtoMessage() {}
 
 func (x *RegisteredRepoPlatform_Value_EnvironmentVariable) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[146]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6419,7 +7666,7 @@ func (x *RegisteredRepoPlatform_Value_EnvironmentVariable) ProtoReflect() protor
 
 // Deprecated: Use RegisteredRepoPlatform_Value_EnvironmentVariable.ProtoReflect.Descriptor instead.
 func (*RegisteredRepoPlatform_Value_EnvironmentVariable) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{44, 1, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{51, 1, 0}
 }
 
 func (x *RegisteredRepoPlatform_Value_EnvironmentVariable) GetName() string {
@@ -6444,7 +7691,7 @@ type RegisteredToolchains_Key struct {
 
 func (x *RegisteredToolchains_Key) Reset() {
 	*x = RegisteredToolchains_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[147]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6456,7 +7703,7 @@ func (x *RegisteredToolchains_Key) String() string {
 func (*RegisteredToolchains_Key) ProtoMessage() {}
 
 func (x *RegisteredToolchains_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[147]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6469,7 +7716,7 @@ func (x *RegisteredToolchains_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredToolchains_Key.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchains_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53, 0}
 }
 
 type RegisteredToolchains_Value struct {
@@ -6481,7 +7728,7 @@ type RegisteredToolchains_Value struct {
 
 func (x *RegisteredToolchains_Value) Reset() {
 	*x = RegisteredToolchains_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[148]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6493,7 +7740,7 @@ func (x *RegisteredToolchains_Value) String() string {
 func (*RegisteredToolchains_Value) ProtoMessage() {}
 
 func (x *RegisteredToolchains_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[148]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6506,7 +7753,7 @@ func (x *RegisteredToolchains_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredToolchains_Value.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchains_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53, 1}
 }
 
 func (x *RegisteredToolchains_Value) GetToolchainTypes() []*RegisteredToolchains_Value_RegisteredToolchainType {
@@ -6526,7 +7773,7 @@ type RegisteredToolchains_Value_RegisteredToolchainType struct {
 
 func (x *RegisteredToolchains_Value_RegisteredToolchainType) Reset() {
 	*x = RegisteredToolchains_Value_RegisteredToolchainType{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[149]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6538,7 +7785,7 @@ func (x *RegisteredToolchains_Value_RegisteredToolchainType) String() string {
 func (*RegisteredToolchains_Value_RegisteredToolchainType) ProtoMessage() {}
 
 func (x *RegisteredToolchains_Value_RegisteredToolchainType) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[149]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6551,7 +7798,7 @@ func (x *RegisteredToolchains_Value_RegisteredToolchainType) ProtoReflect() prot
 
 // Deprecated: Use RegisteredToolchains_Value_RegisteredToolchainType.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchains_Value_RegisteredToolchainType) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{46, 1, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53, 1, 0}
 }
 
 func (x *RegisteredToolchains_Value_RegisteredToolchainType) GetToolchainType() string {
@@ -6577,7 +7824,7 @@ type RegisteredToolchainsForType_Key struct {
 
 func (x *RegisteredToolchainsForType_Key) Reset() {
 	*x = RegisteredToolchainsForType_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[150]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6589,7 +7836,7 @@ func (x *RegisteredToolchainsForType_Key) String() string {
 func (*RegisteredToolchainsForType_Key) ProtoMessage() {}
 
 func (x *RegisteredToolchainsForType_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[150]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6602,7 +7849,7 @@ func (x *RegisteredToolchainsForType_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredToolchainsForType_Key.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchainsForType_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{47, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{54, 0}
 }
 
 func (x *RegisteredToolchainsForType_Key) GetToolchainType() string {
@@ -6621,7 +7868,7 @@ type RegisteredToolchainsForType_Value struct {
 
 func (x *RegisteredToolchainsForType_Value) Reset() {
 	*x = RegisteredToolchainsForType_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[151]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6633,7 +7880,7 @@ func (x *RegisteredToolchainsForType_Value) String() string {
 func (*RegisteredToolchainsForType_Value) ProtoMessage() {}
 
 func (x *RegisteredToolchainsForType_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[151]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6646,7 +7893,7 @@ func (x *RegisteredToolchainsForType_Value) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RegisteredToolchainsForType_Value.ProtoReflect.Descriptor instead.
 func (*RegisteredToolchainsForType_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{47, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{54, 1}
 }
 
 func (x *RegisteredToolchainsForType_Value) GetToolchains() []*RegisteredToolchain {
@@ -6665,7 +7912,7 @@ type Repo_Key struct {
 
 func (x *Repo_Key) Reset() {
 	*x = Repo_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[152]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6677,7 +7924,7 @@ func (x *Repo_Key) String() string {
 func (*Repo_Key) ProtoMessage() {}
 
 func (x *Repo_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[152]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6690,7 +7937,7 @@ func (x *Repo_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Repo_Key.ProtoReflect.Descriptor instead.
 func (*Repo_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{48, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{56, 0}
 }
 
 func (x *Repo_Key) GetCanonicalRepo() string {
@@ -6709,7 +7956,7 @@ type Repo_Value struct {
 
 func (x *Repo_Value) Reset() {
 	*x = Repo_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[153]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6721,7 +7968,7 @@ func (x *Repo_Value) String() string {
 func (*Repo_Value) ProtoMessage() {}
 
 func (x *Repo_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[153]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6734,7 +7981,7 @@ func (x *Repo_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Repo_Value.ProtoReflect.Descriptor instead.
 func (*Repo_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{48, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{56, 1}
 }
 
 func (x *Repo_Value) GetRootDirectoryReference() *filesystem.DirectoryReference {
@@ -6753,7 +8000,7 @@ type RepoDefaultAttrs_Key struct {
 
 func (x *RepoDefaultAttrs_Key) Reset() {
 	*x = RepoDefaultAttrs_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[154]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6765,7 +8012,7 @@ func (x *RepoDefaultAttrs_Key) String() string {
 func (*RepoDefaultAttrs_Key) ProtoMessage() {}
 
 func (x *RepoDefaultAttrs_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[154]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6778,7 +8025,7 @@ func (x *RepoDefaultAttrs_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoDefaultAttrs_Key.ProtoReflect.Descriptor instead.
 func (*RepoDefaultAttrs_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{49, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57, 0}
 }
 
 func (x *RepoDefaultAttrs_Key) GetCanonicalRepo() string {
@@ -6789,15 +8036,16 @@ func (x *RepoDefaultAttrs_Key) GetCanonicalRepo() string {
 }
 
 type RepoDefaultAttrs_Value struct {
-	state            protoimpl.MessageState     `protogen:"open.v1"`
-	InheritableAttrs *starlark.InheritableAttrs `protobuf:"bytes,1,opt,name=inheritable_attrs,json=inheritableAttrs,proto3" json:"inheritable_attrs,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state              protoimpl.MessageState     `protogen:"open.v1"`
+	InheritableAttrs   *starlark.InheritableAttrs `protobuf:"bytes,1,opt,name=inheritable_attrs,json=inheritableAttrs,proto3" json:"inheritable_attrs,omitempty"`
+	IgnoredDirectories []string                   `protobuf:"bytes,2,rep,name=ignored_directories,json=ignoredDirectories,proto3" json:"ignored_directories,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *RepoDefaultAttrs_Value) Reset() {
 	*x = RepoDefaultAttrs_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[155]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6809,7 +8057,7 @@ func (x *RepoDefaultAttrs_Value) String() string {
 func (*RepoDefaultAttrs_Value) ProtoMessage() {}
 
 func (x *RepoDefaultAttrs_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[155]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6822,7 +8070,7 @@ func (x *RepoDefaultAttrs_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoDefaultAttrs_Value.ProtoReflect.Descriptor instead.
 func (*RepoDefaultAttrs_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{49, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57, 1}
 }
 
 func (x *RepoDefaultAttrs_Value) GetInheritableAttrs() *starlark.InheritableAttrs {
@@ -6832,30 +8080,35 @@ func (x *RepoDefaultAttrs_Value) GetInheritableAttrs() *starlark.InheritableAttr
 	return nil
 }
 
-type ResolvedToolchains_Key struct {
-	state                  protoimpl.MessageState    `protogen:"open.v1"`
-	ExecCompatibleWith     []*Constraint             `protobuf:"bytes,1,rep,name=exec_compatible_with,json=execCompatibleWith,proto3" json:"exec_compatible_with,omitempty"`
-	ConfigurationReference *core.Reference           `protobuf:"bytes,2,opt,name=configuration_reference,json=configurationReference,proto3" json:"configuration_reference,omitempty"`
-	Toolchains             []*starlark.ToolchainType `protobuf:"bytes,3,rep,name=toolchains,proto3" json:"toolchains,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *RepoDefaultAttrs_Value) GetIgnoredDirectories() []string {
+	if x != nil {
+		return x.IgnoredDirectories
+	}
+	return nil
 }
 
-func (x *ResolvedToolchains_Key) Reset() {
-	*x = ResolvedToolchains_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[156]
+type RepoEnvironmentVariable_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepoEnvironmentVariable_Key) Reset() {
+	*x = RepoEnvironmentVariable_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolvedToolchains_Key) String() string {
+func (x *RepoEnvironmentVariable_Key) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolvedToolchains_Key) ProtoMessage() {}
+func (*RepoEnvironmentVariable_Key) ProtoMessage() {}
 
-func (x *ResolvedToolchains_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[156]
+func (x *RepoEnvironmentVariable_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6866,42 +8119,141 @@ func (x *ResolvedToolchains_Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolvedToolchains_Key.ProtoReflect.Descriptor instead.
-func (*ResolvedToolchains_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{50, 0}
+// Deprecated: Use RepoEnvironmentVariable_Key.ProtoReflect.Descriptor instead.
+func (*RepoEnvironmentVariable_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{58, 0}
 }
 
-func (x *ResolvedToolchains_Key) GetExecCompatibleWith() []*Constraint {
+func (x *RepoEnvironmentVariable_Key) GetName() string {
 	if x != nil {
-		return x.ExecCompatibleWith
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ResolvedToolchains_Key) GetConfigurationReference() *core.Reference {
-	if x != nil {
-		return x.ConfigurationReference
-	}
-	return nil
+type RepoEnvironmentVariable_Value struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsSet         bool                   `protobuf:"varint,1,opt,name=is_set,json=isSet,proto3" json:"is_set,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolvedToolchains_Key) GetToolchains() []*starlark.ToolchainType {
-	if x != nil {
-		return x.Toolchains
-	}
-	return nil
+func (x *RepoEnvironmentVariable_Value) Reset() {
+	*x = RepoEnvironmentVariable_Value{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type ResolvedToolchains_Value struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	ToolchainIdentifiers []string               `protobuf:"bytes,1,rep,name=toolchain_identifiers,json=toolchainIdentifiers,proto3" json:"toolchain_identifiers,omitempty"`
-	unknownFields        protoimpl.UnknownFields
+func (x *RepoEnvironmentVariable_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoEnvironmentVariable_Value) ProtoMessage() {}
+
+func (x *RepoEnvironmentVariable_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoEnvironmentVariable_Value.ProtoReflect.Descriptor instead.
+func (*RepoEnvironmentVariable_Value) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{58, 1}
+}
+
+func (x *RepoEnvironmentVariable_Value) GetIsSet() bool {
+	if x != nil {
+		return x.IsSet
+	}
+	return false
+}
+
+func (x *RepoEnvironmentVariable_Value) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ResolvedToolchains_Key struct {
+	state                  protoimpl.MessageState    `protogen:"open.v1"`
+	ExecCompatibleWith     []*Constraint             `protobuf:"bytes,1,rep,name=exec_compatible_with,json=execCompatibleWith,proto3" json:"exec_compatible_with,omitempty"`
+	ConfigurationReference *core.Reference           `protobuf:"bytes,2,opt,name=configuration_reference,json=configurationReference,proto3" json:"configuration_reference,omitempty"`
+	Toolchains             []*starlark.ToolchainType `protobuf:"bytes,3,rep,name=toolchains,proto3" json:"toolchains,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ResolvedToolchains_Key) Reset() {
+	*x = ResolvedToolchains_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvedToolchains_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedToolchains_Key) ProtoMessage() {}
+
+func (x *ResolvedToolchains_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedToolchains_Key.ProtoReflect.Descriptor instead.
+func (*ResolvedToolchains_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{59, 0}
+}
+
+func (x *ResolvedToolchains_Key) GetExecCompatibleWith() []*Constraint {
+	if x != nil {
+		return x.ExecCompatibleWith
+	}
+	return nil
+}
+
+func (x *ResolvedToolchains_Key) GetConfigurationReference() *core.Reference {
+	if x != nil {
+		return x.ConfigurationReference
+	}
+	return nil
+}
+
+func (x *ResolvedToolchains_Key) GetToolchains() []*starlark.ToolchainType {
+	if x != nil {
+		return x.Toolchains
+	}
+	return nil
+}
+
+type ResolvedToolchains_Value struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionPlatform    *ExecutionPlatform     `protobuf:"bytes,2,opt,name=execution_platform,json=executionPlatform,proto3" json:"execution_platform,omitempty"`
+	ToolchainIdentifiers []string               `protobuf:"bytes,1,rep,name=toolchain_identifiers,json=toolchainIdentifiers,proto3" json:"toolchain_identifiers,omitempty"`
+	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
 func (x *ResolvedToolchains_Value) Reset() {
 	*x = ResolvedToolchains_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[157]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6913,7 +8265,7 @@ func (x *ResolvedToolchains_Value) String() string {
 func (*ResolvedToolchains_Value) ProtoMessage() {}
 
 func (x *ResolvedToolchains_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[157]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6926,7 +8278,14 @@ func (x *ResolvedToolchains_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedToolchains_Value.ProtoReflect.Descriptor instead.
 func (*ResolvedToolchains_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{50, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{59, 1}
+}
+
+func (x *ResolvedToolchains_Value) GetExecutionPlatform() *ExecutionPlatform {
+	if x != nil {
+		return x.ExecutionPlatform
+	}
+	return nil
 }
 
 func (x *ResolvedToolchains_Value) GetToolchainIdentifiers() []string {
@@ -6944,7 +8303,7 @@ type RootModule_Key struct {
 
 func (x *RootModule_Key) Reset() {
 	*x = RootModule_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[158]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6956,7 +8315,7 @@ func (x *RootModule_Key) String() string {
 func (*RootModule_Key) ProtoMessage() {}
 
 func (x *RootModule_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[158]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6969,7 +8328,7 @@ func (x *RootModule_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RootModule_Key.ProtoReflect.Descriptor instead.
 func (*RootModule_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{51, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60, 0}
 }
 
 type RootModule_Value struct {
@@ -6982,7 +8341,7 @@ type RootModule_Value struct {
 
 func (x *RootModule_Value) Reset() {
 	*x = RootModule_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[159]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6994,7 +8353,7 @@ func (x *RootModule_Value) String() string {
 func (*RootModule_Value) ProtoMessage() {}
 
 func (x *RootModule_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[159]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7007,7 +8366,7 @@ func (x *RootModule_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RootModule_Value.ProtoReflect.Descriptor instead.
 func (*RootModule_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{51, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60, 1}
 }
 
 func (x *RootModule_Value) GetRootModuleName() string {
@@ -7033,7 +8392,7 @@ type Select_Key struct {
 
 func (x *Select_Key) Reset() {
 	*x = Select_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[160]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7045,7 +8404,7 @@ func (x *Select_Key) String() string {
 func (*Select_Key) ProtoMessage() {}
 
 func (x *Select_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[160]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7058,7 +8417,7 @@ func (x *Select_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Select_Key.ProtoReflect.Descriptor instead.
 func (*Select_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{52, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{61, 0}
 }
 
 func (x *Select_Key) GetConditionIdentifiers() []string {
@@ -7077,7 +8436,7 @@ type Select_Value struct {
 
 func (x *Select_Value) Reset() {
 	*x = Select_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[161]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7089,7 +8448,7 @@ func (x *Select_Value) String() string {
 func (*Select_Value) ProtoMessage() {}
 
 func (x *Select_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[161]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7102,7 +8461,7 @@ func (x *Select_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Select_Value.ProtoReflect.Descriptor instead.
 func (*Select_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{52, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{61, 1}
 }
 
 func (x *Select_Value) GetConditionIndices() []int32 {
@@ -7120,7 +8479,7 @@ type StableInputRootPath_Key struct {
 
 func (x *StableInputRootPath_Key) Reset() {
 	*x = StableInputRootPath_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[162]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7132,7 +8491,7 @@ func (x *StableInputRootPath_Key) String() string {
 func (*StableInputRootPath_Key) ProtoMessage() {}
 
 func (x *StableInputRootPath_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[162]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7145,7 +8504,7 @@ func (x *StableInputRootPath_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StableInputRootPath_Key.ProtoReflect.Descriptor instead.
 func (*StableInputRootPath_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62, 0}
 }
 
 type StableInputRootPath_Value struct {
@@ -7157,7 +8516,7 @@ type StableInputRootPath_Value struct {
 
 func (x *StableInputRootPath_Value) Reset() {
 	*x = StableInputRootPath_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[163]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7169,7 +8528,7 @@ func (x *StableInputRootPath_Value) String() string {
 func (*StableInputRootPath_Value) ProtoMessage() {}
 
 func (x *StableInputRootPath_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[163]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7182,7 +8541,7 @@ func (x *StableInputRootPath_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StableInputRootPath_Value.ProtoReflect.Descriptor instead.
 func (*StableInputRootPath_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{53, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62, 1}
 }
 
 func (x *StableInputRootPath_Value) GetInputRootPath() string {
@@ -7200,7 +8559,7 @@ type StableInputRootPathObject_Key struct {
 
 func (x *StableInputRootPathObject_Key) Reset() {
 	*x = StableInputRootPathObject_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[164]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7212,7 +8571,7 @@ func (x *StableInputRootPathObject_Key) String() string {
 func (*StableInputRootPathObject_Key) ProtoMessage() {}
 
 func (x *StableInputRootPathObject_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[164]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7225,7 +8584,7 @@ func (x *StableInputRootPathObject_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StableInputRootPathObject_Key.ProtoReflect.Descriptor instead.
 func (*StableInputRootPathObject_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{54, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{63, 0}
 }
 
 type Target_Key struct {
@@ -7237,7 +8596,7 @@ type Target_Key struct {
 
 func (x *Target_Key) Reset() {
 	*x = Target_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[165]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7249,7 +8608,7 @@ func (x *Target_Key) String() string {
 func (*Target_Key) ProtoMessage() {}
 
 func (x *Target_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[165]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7262,7 +8621,7 @@ func (x *Target_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Target_Key.ProtoReflect.Descriptor instead.
 func (*Target_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{55, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{64, 0}
 }
 
 func (x *Target_Key) GetLabel() string {
@@ -7281,7 +8640,7 @@ type Target_Value struct {
 
 func (x *Target_Value) Reset() {
 	*x = Target_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[166]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7293,7 +8652,7 @@ func (x *Target_Value) String() string {
 func (*Target_Value) ProtoMessage() {}
 
 func (x *Target_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[166]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7306,7 +8665,7 @@ func (x *Target_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Target_Value.ProtoReflect.Descriptor instead.
 func (*Target_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{55, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{64, 1}
 }
 
 func (x *Target_Value) GetDefinition() *starlark.Target_Definition {
@@ -7326,7 +8685,7 @@ type TargetCompletion_Key struct {
 
 func (x *TargetCompletion_Key) Reset() {
 	*x = TargetCompletion_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[167]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7338,7 +8697,7 @@ func (x *TargetCompletion_Key) String() string {
 func (*TargetCompletion_Key) ProtoMessage() {}
 
 func (x *TargetCompletion_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[167]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7351,7 +8710,7 @@ func (x *TargetCompletion_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetCompletion_Key.ProtoReflect.Descriptor instead.
 func (*TargetCompletion_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{56, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{65, 0}
 }
 
 func (x *TargetCompletion_Key) GetLabel() string {
@@ -7376,7 +8735,7 @@ type TargetCompletion_Value struct {
 
 func (x *TargetCompletion_Value) Reset() {
 	*x = TargetCompletion_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[168]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7388,7 +8747,7 @@ func (x *TargetCompletion_Value) String() string {
 func (*TargetCompletion_Value) ProtoMessage() {}
 
 func (x *TargetCompletion_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[168]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7401,7 +8760,103 @@ func (x *TargetCompletion_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetCompletion_Value.ProtoReflect.Descriptor instead.
 func (*TargetCompletion_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{56, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{65, 1}
+}
+
+type TargetCompatibility_Key struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Label                  string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	ConfigurationReference *core.Reference        `protobuf:"bytes,2,opt,name=configuration_reference,json=configurationReference,proto3" json:"configuration_reference,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TargetCompatibility_Key) Reset() {
+	*x = TargetCompatibility_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetCompatibility_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetCompatibility_Key) ProtoMessage() {}
+
+func (x *TargetCompatibility_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetCompatibility_Key.ProtoReflect.Descriptor instead.
+func (*TargetCompatibility_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{66, 0}
+}
+
+func (x *TargetCompatibility_Key) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TargetCompatibility_Key) GetConfigurationReference() *core.Reference {
+	if x != nil {
+		return x.ConfigurationReference
+	}
+	return nil
+}
+
+type TargetCompatibility_Value struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Compatible    bool                   `protobuf:"varint,1,opt,name=compatible,proto3" json:"compatible,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetCompatibility_Value) Reset() {
+	*x = TargetCompatibility_Value{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetCompatibility_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetCompatibility_Value) ProtoMessage() {}
+
+func (x *TargetCompatibility_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetCompatibility_Value.ProtoReflect.Descriptor instead.
+func (*TargetCompatibility_Value) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{66, 1}
+}
+
+func (x *TargetCompatibility_Value) GetCompatible() bool {
+	if x != nil {
+		return x.Compatible
+	}
+	return false
 }
 
 type TargetPatternExpansion_Key struct {
@@ -7413,7 +8868,7 @@ type TargetPatternExpansion_Key struct {
 
 func (x *TargetPatternExpansion_Key) Reset() {
 	*x = TargetPatternExpansion_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[169]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7425,7 +8880,7 @@ func (x *TargetPatternExpansion_Key) String() string {
 func (*TargetPatternExpansion_Key) ProtoMessage() {}
 
 func (x *TargetPatternExpansion_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[169]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7438,7 +8893,7 @@ func (x *TargetPatternExpansion_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetPatternExpansion_Key.ProtoReflect.Descriptor instead.
 func (*TargetPatternExpansion_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{67, 0}
 }
 
 func (x *TargetPatternExpansion_Key) GetTargetPattern() string {
@@ -7457,7 +8912,7 @@ type TargetPatternExpansion_Value struct {
 
 func (x *TargetPatternExpansion_Value) Reset() {
 	*x = TargetPatternExpansion_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[170]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7469,7 +8924,7 @@ func (x *TargetPatternExpansion_Value) String() string {
 func (*TargetPatternExpansion_Value) ProtoMessage() {}
 
 func (x *TargetPatternExpansion_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[170]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7482,7 +8937,7 @@ func (x *TargetPatternExpansion_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetPatternExpansion_Value.ProtoReflect.Descriptor instead.
 func (*TargetPatternExpansion_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{67, 1}
 }
 
 func (x *TargetPatternExpansion_Value) GetTargetLabels() []*TargetPatternExpansion_Value_TargetLabel {
@@ -7505,7 +8960,7 @@ type TargetPatternExpansion_Value_TargetLabel struct {
 
 func (x *TargetPatternExpansion_Value_TargetLabel) Reset() {
 	*x = TargetPatternExpansion_Value_TargetLabel{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[171]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7517,7 +8972,7 @@ func (x *TargetPatternExpansion_Value_TargetLabel) String() string {
 func (*TargetPatternExpansion_Value_TargetLabel) ProtoMessage() {}
 
 func (x *TargetPatternExpansion_Value_TargetLabel) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[171]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7530,7 +8985,7 @@ func (x *TargetPatternExpansion_Value_TargetLabel) ProtoReflect() protoreflect.M
 
 // Deprecated: Use TargetPatternExpansion_Value_TargetLabel.ProtoReflect.Descriptor instead.
 func (*TargetPatternExpansion_Value_TargetLabel) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57, 1, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{67, 1, 0}
 }
 
 func (x *TargetPatternExpansion_Value_TargetLabel) GetLevel() isTargetPatternExpansion_Value_TargetLabel_Level {
@@ -7585,7 +9040,7 @@ type TargetPatternExpansion_Value_TargetLabel_Parent struct {
 
 func (x *TargetPatternExpansion_Value_TargetLabel_Parent) Reset() {
 	*x = TargetPatternExpansion_Value_TargetLabel_Parent{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[172]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7597,7 +9052,7 @@ func (x *TargetPatternExpansion_Value_TargetLabel_Parent) String() string {
 func (*TargetPatternExpansion_Value_TargetLabel_Parent) ProtoMessage() {}
 
 func (x *TargetPatternExpansion_Value_TargetLabel_Parent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[172]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7610,7 +9065,7 @@ func (x *TargetPatternExpansion_Value_TargetLabel_Parent) ProtoReflect() protore
 
 // Deprecated: Use TargetPatternExpansion_Value_TargetLabel_Parent.ProtoReflect.Descriptor instead.
 func (*TargetPatternExpansion_Value_TargetLabel_Parent) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{57, 1, 0, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{67, 1, 0, 0}
 }
 
 func (x *TargetPatternExpansion_Value_TargetLabel_Parent) GetReference() *core.Reference {
@@ -7620,6 +9075,94 @@ func (x *TargetPatternExpansion_Value_TargetLabel_Parent) GetReference() *core.R
 	return nil
 }
 
+type TargetPlatformConstraints_Key struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ConfigurationReference *core.Reference        `protobuf:"bytes,1,opt,name=configuration_reference,json=configurationReference,proto3" json:"configuration_reference,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TargetPlatformConstraints_Key) Reset() {
+	*x = TargetPlatformConstraints_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetPlatformConstraints_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetPlatformConstraints_Key) ProtoMessage() {}
+
+func (x *TargetPlatformConstraints_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[205]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetPlatformConstraints_Key.ProtoReflect.Descriptor instead.
+func (*TargetPlatformConstraints_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{68, 0}
+}
+
+func (x *TargetPlatformConstraints_Key) GetConfigurationReference() *core.Reference {
+	if x != nil {
+		return x.ConfigurationReference
+	}
+	return nil
+}
+
+type TargetPlatformConstraints_Value struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Constraints   []*Constraint          `protobuf:"bytes,1,rep,name=constraints,proto3" json:"constraints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetPlatformConstraints_Value) Reset() {
+	*x = TargetPlatformConstraints_Value{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetPlatformConstraints_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetPlatformConstraints_Value) ProtoMessage() {}
+
+func (x *TargetPlatformConstraints_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[206]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetPlatformConstraints_Value.ProtoReflect.Descriptor instead.
+func (*TargetPlatformConstraints_Value) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{68, 1}
+}
+
+func (x *TargetPlatformConstraints_Value) GetConstraints() []*Constraint {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
 type ModuleExtension_User struct {
 	state          protoimpl.MessageState      `protogen:"open.v1"`
 	ModuleInstance string                      `protobuf:"bytes,1,opt,name=module_instance,json=moduleInstance,proto3" json:"module_instance,omitempty"`
@@ -7631,7 +9174,7 @@ type ModuleExtension_User struct {
 
 func (x *ModuleExtension_User) Reset() {
 	*x = ModuleExtension_User{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[173]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7643,7 +9186,7 @@ func (x *ModuleExtension_User) String() string {
 func (*ModuleExtension_User) ProtoMessage() {}
 
 func (x *ModuleExtension_User) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[173]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7656,7 +9199,7 @@ func (x *ModuleExtension_User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtension_User.ProtoReflect.Descriptor instead.
 func (*ModuleExtension_User) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{58, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{69, 0}
 }
 
 func (x *ModuleExtension_User) GetModuleInstance() string {
@@ -7690,7 +9233,7 @@ type ModuleExtension_TagClass struct {
 
 func (x *ModuleExtension_TagClass) Reset() {
 	*x = ModuleExtension_TagClass{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[174]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7702,7 +9245,7 @@ func (x *ModuleExtension_TagClass) String() string {
 func (*ModuleExtension_TagClass) ProtoMessage() {}
 
 func (x *ModuleExtension_TagClass) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[174]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7715,7 +9258,7 @@ func (x *ModuleExtension_TagClass) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModuleExtension_TagClass.ProtoReflect.Descriptor instead.
 func (*ModuleExtension_TagClass) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{58, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{69, 1}
 }
 
 func (x *ModuleExtension_TagClass) GetName() string {
@@ -7740,21 +9283,183 @@ type ModuleExtension_Tag struct {
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ModuleExtension_Tag) Reset() {
-	*x = ModuleExtension_Tag{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[175]
+func (x *ModuleExtension_Tag) Reset() {
+	*x = ModuleExtension_Tag{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleExtension_Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleExtension_Tag) ProtoMessage() {}
+
+func (x *ModuleExtension_Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[209]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleExtension_Tag.ProtoReflect.Descriptor instead.
+func (*ModuleExtension_Tag) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{69, 2}
+}
+
+func (x *ModuleExtension_Tag) GetAttrs() *starlark.Struct_Fields {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+func (x *ModuleExtension_Tag) GetIsDevDependency() bool {
+	if x != nil {
+		return x.IsDevDependency
+	}
+	return false
+}
+
+type ModuleExtensionImports_User struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ModuleInstance   string                 `protobuf:"bytes,1,opt,name=module_instance,json=moduleInstance,proto3" json:"module_instance,omitempty"`
+	IsRoot           bool                   `protobuf:"varint,2,opt,name=is_root,json=isRoot,proto3" json:"is_root,omitempty"`
+	ImportedRepos    []string               `protobuf:"bytes,3,rep,name=imported_repos,json=importedRepos,proto3" json:"imported_repos,omitempty"`
+	DevImportedRepos []string               `protobuf:"bytes,4,rep,name=dev_imported_repos,json=devImportedRepos,proto3" json:"dev_imported_repos,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ModuleExtensionImports_User) Reset() {
+	*x = ModuleExtensionImports_User{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[210]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleExtensionImports_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleExtensionImports_User) ProtoMessage() {}
+
+func (x *ModuleExtensionImports_User) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[210]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleExtensionImports_User.ProtoReflect.Descriptor instead.
+func (*ModuleExtensionImports_User) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{70, 0}
+}
+
+func (x *ModuleExtensionImports_User) GetModuleInstance() string {
+	if x != nil {
+		return x.ModuleInstance
+	}
+	return ""
+}
+
+func (x *ModuleExtensionImports_User) GetIsRoot() bool {
+	if x != nil {
+		return x.IsRoot
+	}
+	return false
+}
+
+func (x *ModuleExtensionImports_User) GetImportedRepos() []string {
+	if x != nil {
+		return x.ImportedRepos
+	}
+	return nil
+}
+
+func (x *ModuleExtensionImports_User) GetDevImportedRepos() []string {
+	if x != nil {
+		return x.DevImportedRepos
+	}
+	return nil
+}
+
+type RepositoryRuleObject_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepositoryRuleObject_Key) Reset() {
+	*x = RepositoryRuleObject_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[211]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepositoryRuleObject_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryRuleObject_Key) ProtoMessage() {}
+
+func (x *RepositoryRuleObject_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[211]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryRuleObject_Key.ProtoReflect.Descriptor instead.
+func (*RepositoryRuleObject_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{71, 0}
+}
+
+func (x *RepositoryRuleObject_Key) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+type UpdatedModuleLockfile_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatedModuleLockfile_Key) Reset() {
+	*x = UpdatedModuleLockfile_Key{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModuleExtension_Tag) String() string {
+func (x *UpdatedModuleLockfile_Key) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModuleExtension_Tag) ProtoMessage() {}
+func (*UpdatedModuleLockfile_Key) ProtoMessage() {}
 
-func (x *ModuleExtension_Tag) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[175]
+func (x *UpdatedModuleLockfile_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7765,47 +9470,33 @@ func (x *ModuleExtension_Tag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModuleExtension_Tag.ProtoReflect.Descriptor instead.
-func (*ModuleExtension_Tag) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{58, 2}
-}
-
-func (x *ModuleExtension_Tag) GetAttrs() *starlark.Struct_Fields {
-	if x != nil {
-		return x.Attrs
-	}
-	return nil
-}
-
-func (x *ModuleExtension_Tag) GetIsDevDependency() bool {
-	if x != nil {
-		return x.IsDevDependency
-	}
-	return false
+// Deprecated: Use UpdatedModuleLockfile_Key.ProtoReflect.Descriptor instead.
+func (*UpdatedModuleLockfile_Key) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{72, 0}
 }
 
-type RepositoryRuleObject_Key struct {
+type UpdatedModuleLockfile_Value struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Contents      []byte                 `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RepositoryRuleObject_Key) Reset() {
-	*x = RepositoryRuleObject_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[176]
+func (x *UpdatedModuleLockfile_Value) Reset() {
+	*x = UpdatedModuleLockfile_Value{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RepositoryRuleObject_Key) String() string {
+func (x *UpdatedModuleLockfile_Value) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepositoryRuleObject_Key) ProtoMessage() {}
+func (*UpdatedModuleLockfile_Value) ProtoMessage() {}
 
-func (x *RepositoryRuleObject_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[176]
+func (x *UpdatedModuleLockfile_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7816,16 +9507,16 @@ func (x *RepositoryRuleObject_Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepositoryRuleObject_Key.ProtoReflect.Descriptor instead.
-func (*RepositoryRuleObject_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{59, 0}
+// Deprecated: Use UpdatedModuleLockfile_Value.ProtoReflect.Descriptor instead.
+func (*UpdatedModuleLockfile_Value) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{72, 1}
 }
 
-func (x *RepositoryRuleObject_Key) GetIdentifier() string {
+func (x *UpdatedModuleLockfile_Value) GetContents() []byte {
 	if x != nil {
-		return x.Identifier
+		return x.Contents
 	}
-	return ""
+	return nil
 }
 
 type UsedModuleExtension_Key struct {
@@ -7837,7 +9528,7 @@ type UsedModuleExtension_Key struct {
 
 func (x *UsedModuleExtension_Key) Reset() {
 	*x = UsedModuleExtension_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[177]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7849,7 +9540,7 @@ func (x *UsedModuleExtension_Key) String() string {
 func (*UsedModuleExtension_Key) ProtoMessage() {}
 
 func (x *UsedModuleExtension_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[177]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7862,7 +9553,7 @@ func (x *UsedModuleExtension_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsedModuleExtension_Key.ProtoReflect.Descriptor instead.
 func (*UsedModuleExtension_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{73, 0}
 }
 
 func (x *UsedModuleExtension_Key) GetModuleExtension() string {
@@ -7881,7 +9572,7 @@ type UsedModuleExtension_Value struct {
 
 func (x *UsedModuleExtension_Value) Reset() {
 	*x = UsedModuleExtension_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[178]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7893,7 +9584,7 @@ func (x *UsedModuleExtension_Value) String() string {
 func (*UsedModuleExtension_Value) ProtoMessage() {}
 
 func (x *UsedModuleExtension_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[178]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7906,7 +9597,7 @@ func (x *UsedModuleExtension_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsedModuleExtension_Value.ProtoReflect.Descriptor instead.
 func (*UsedModuleExtension_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{60, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{73, 1}
 }
 
 func (x *UsedModuleExtension_Value) GetModuleExtension() *ModuleExtension {
@@ -7924,7 +9615,7 @@ type UsedModuleExtensions_Key struct {
 
 func (x *UsedModuleExtensions_Key) Reset() {
 	*x = UsedModuleExtensions_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[179]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7936,7 +9627,7 @@ func (x *UsedModuleExtensions_Key) String() string {
 func (*UsedModuleExtensions_Key) ProtoMessage() {}
 
 func (x *UsedModuleExtensions_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[179]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7949,19 +9640,20 @@ func (x *UsedModuleExtensions_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsedModuleExtensions_Key.ProtoReflect.Descriptor instead.
 func (*UsedModuleExtensions_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{61, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{74, 0}
 }
 
 type UsedModuleExtensions_Value struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ModuleExtensions []*ModuleExtension     `protobuf:"bytes,1,rep,name=module_extensions,json=moduleExtensions,proto3" json:"module_extensions,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state                  protoimpl.MessageState    `protogen:"open.v1"`
+	ModuleExtensions       []*ModuleExtension        `protobuf:"bytes,1,rep,name=module_extensions,json=moduleExtensions,proto3" json:"module_extensions,omitempty"`
+	ModuleExtensionImports []*ModuleExtensionImports `protobuf:"bytes,2,rep,name=module_extension_imports,json=moduleExtensionImports,proto3" json:"module_extension_imports,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *UsedModuleExtensions_Value) Reset() {
 	*x = UsedModuleExtensions_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[180]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7973,7 +9665,7 @@ func (x *UsedModuleExtensions_Value) String() string {
 func (*UsedModuleExtensions_Value) ProtoMessage() {}
 
 func (x *UsedModuleExtensions_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[180]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7986,7 +9678,7 @@ func (x *UsedModuleExtensions_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsedModuleExtensions_Value.ProtoReflect.Descriptor instead.
 func (*UsedModuleExtensions_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{61, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{74, 1}
 }
 
 func (x *UsedModuleExtensions_Value) GetModuleExtensions() []*ModuleExtension {
@@ -7996,17 +9688,25 @@ func (x *UsedModuleExtensions_Value) GetModuleExtensions() []*ModuleExtension {
 	return nil
 }
 
+func (x *UsedModuleExtensions_Value) GetModuleExtensionImports() []*ModuleExtensionImports {
+	if x != nil {
+		return x.ModuleExtensionImports
+	}
+	return nil
+}
+
 type UserDefinedTransition_Key struct {
-	state                       protoimpl.MessageState `protogen:"open.v1"`
-	TransitionIdentifier        string                 `protobuf:"bytes,1,opt,name=transition_identifier,json=transitionIdentifier,proto3" json:"transition_identifier,omitempty"`
-	InputConfigurationReference *core.Reference        `protobuf:"bytes,2,opt,name=input_configuration_reference,json=inputConfigurationReference,proto3" json:"input_configuration_reference,omitempty"`
+	state                       protoimpl.MessageState                 `protogen:"open.v1"`
+	TransitionIdentifier        string                                 `protobuf:"bytes,1,opt,name=transition_identifier,json=transitionIdentifier,proto3" json:"transition_identifier,omitempty"`
+	InputConfigurationReference *core.Reference                        `protobuf:"bytes,2,opt,name=input_configuration_reference,json=inputConfigurationReference,proto3" json:"input_configuration_reference,omitempty"`
+	AttrValues                  []*UserDefinedTransition_Key_AttrValue `protobuf:"bytes,3,rep,name=attr_values,json=attrValues,proto3" json:"attr_values,omitempty"`
 	unknownFields               protoimpl.UnknownFields
 	sizeCache                   protoimpl.SizeCache
 }
 
 func (x *UserDefinedTransition_Key) Reset() {
 	*x = UserDefinedTransition_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[181]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8018,7 +9718,7 @@ func (x *UserDefinedTransition_Key) String() string {
 func (*UserDefinedTransition_Key) ProtoMessage() {}
 
 func (x *UserDefinedTransition_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[181]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8031,7 +9731,7 @@ func (x *UserDefinedTransition_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserDefinedTransition_Key.ProtoReflect.Descriptor instead.
 func (*UserDefinedTransition_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{75, 0}
 }
 
 func (x *UserDefinedTransition_Key) GetTransitionIdentifier() string {
@@ -8048,11 +9748,18 @@ func (x *UserDefinedTransition_Key) GetInputConfigurationReference() *core.Refer
 	return nil
 }
 
+func (x *UserDefinedTransition_Key) GetAttrValues() []*UserDefinedTransition_Key_AttrValue {
+	if x != nil {
+		return x.AttrValues
+	}
+	return nil
+}
+
 type UserDefinedTransition_Value struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Result:
 	//
-	//	*UserDefinedTransition_Value_TransitionDependsOnAttrs
+	//	*UserDefinedTransition_Value_MissingAttr
 	//	*UserDefinedTransition_Value_Success_
 	Result        isUserDefinedTransition_Value_Result `protobuf_oneof:"result"`
 	unknownFields protoimpl.UnknownFields
@@ -8061,7 +9768,7 @@ type UserDefinedTransition_Value struct {
 
 func (x *UserDefinedTransition_Value) Reset() {
 	*x = UserDefinedTransition_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[182]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8073,7 +9780,7 @@ func (x *UserDefinedTransition_Value) String() string {
 func (*UserDefinedTransition_Value) ProtoMessage() {}
 
 func (x *UserDefinedTransition_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[182]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8086,7 +9793,7 @@ func (x *UserDefinedTransition_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserDefinedTransition_Value.ProtoReflect.Descriptor instead.
 func (*UserDefinedTransition_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{75, 1}
 }
 
 func (x *UserDefinedTransition_Value) GetResult() isUserDefinedTransition_Value_Result {
@@ -8096,13 +9803,13 @@ func (x *UserDefinedTransition_Value) GetResult() isUserDefinedTransition_Value_
 	return nil
 }
 
-func (x *UserDefinedTransition_Value) GetTransitionDependsOnAttrs() *emptypb.Empty {
+func (x *UserDefinedTransition_Value) GetMissingAttr() string {
 	if x != nil {
-		if x, ok := x.Result.(*UserDefinedTransition_Value_TransitionDependsOnAttrs); ok {
-			return x.TransitionDependsOnAttrs
+		if x, ok := x.Result.(*UserDefinedTransition_Value_MissingAttr); ok {
+			return x.MissingAttr
 		}
 	}
-	return nil
+	return ""
 }
 
 func (x *UserDefinedTransition_Value) GetSuccess() *UserDefinedTransition_Value_Success {
@@ -8118,18 +9825,70 @@ type isUserDefinedTransition_Value_Result interface {
 	isUserDefinedTransition_Value_Result()
 }
 
-type UserDefinedTransition_Value_TransitionDependsOnAttrs struct {
-	TransitionDependsOnAttrs *emptypb.Empty `protobuf:"bytes,1,opt,name=transition_depends_on_attrs,json=transitionDependsOnAttrs,proto3,oneof"`
+type UserDefinedTransition_Value_MissingAttr struct {
+	MissingAttr string `protobuf:"bytes,3,opt,name=missing_attr,json=missingAttr,proto3,oneof"`
 }
 
 type UserDefinedTransition_Value_Success_ struct {
 	Success *UserDefinedTransition_Value_Success `protobuf:"bytes,2,opt,name=success,proto3,oneof"`
 }
 
-func (*UserDefinedTransition_Value_TransitionDependsOnAttrs) isUserDefinedTransition_Value_Result() {}
+func (*UserDefinedTransition_Value_MissingAttr) isUserDefinedTransition_Value_Result() {}
 
 func (*UserDefinedTransition_Value_Success_) isUserDefinedTransition_Value_Result() {}
 
+type UserDefinedTransition_Key_AttrValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ValueParts    []*starlark.Value      `protobuf:"bytes,2,rep,name=value_parts,json=valueParts,proto3" json:"value_parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDefinedTransition_Key_AttrValue) Reset() {
+	*x = UserDefinedTransition_Key_AttrValue{}
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[220]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDefinedTransition_Key_AttrValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDefinedTransition_Key_AttrValue) ProtoMessage() {}
+
+func (x *UserDefinedTransition_Key_AttrValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[220]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDefinedTransition_Key_AttrValue.ProtoReflect.Descriptor instead.
+func (*UserDefinedTransition_Key_AttrValue) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{75, 0, 0}
+}
+
+func (x *UserDefinedTransition_Key_AttrValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserDefinedTransition_Key_AttrValue) GetValueParts() []*starlark.Value {
+	if x != nil {
+		return x.ValueParts
+	}
+	return nil
+}
+
 type UserDefinedTransition_Value_Success struct {
 	state         protoimpl.MessageState                       `protogen:"open.v1"`
 	Entries       []*UserDefinedTransition_Value_Success_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
@@ -8139,7 +9898,7 @@ type UserDefinedTransition_Value_Success struct {
 
 func (x *UserDefinedTransition_Value_Success) Reset() {
 	*x = UserDefinedTransition_Value_Success{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[183]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8151,7 +9910,7 @@ func (x *UserDefinedTransition_Value_Success) String() string {
 func (*UserDefinedTransition_Value_Success) ProtoMessage() {}
 
 func (x *UserDefinedTransition_Value_Success) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[183]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8164,7 +9923,7 @@ func (x *UserDefinedTransition_Value_Success) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UserDefinedTransition_Value_Success.ProtoReflect.Descriptor instead.
 func (*UserDefinedTransition_Value_Success) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62, 1, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{75, 1, 0}
 }
 
 func (x *UserDefinedTransition_Value_Success) GetEntries() []*UserDefinedTransition_Value_Success_Entry {
@@ -8184,7 +9943,7 @@ type UserDefinedTransition_Value_Success_Entry struct {
 
 func (x *UserDefinedTransition_Value_Success_Entry) Reset() {
 	*x = UserDefinedTransition_Value_Success_Entry{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[184]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8196,7 +9955,7 @@ func (x *UserDefinedTransition_Value_Success_Entry) String() string {
 func (*UserDefinedTransition_Value_Success_Entry) ProtoMessage() {}
 
 func (x *UserDefinedTransition_Value_Success_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[184]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8209,7 +9968,7 @@ func (x *UserDefinedTransition_Value_Success_Entry) ProtoReflect() protoreflect.
 
 // Deprecated: Use UserDefinedTransition_Value_Success_Entry.ProtoReflect.Descriptor instead.
 func (*UserDefinedTransition_Value_Success_Entry) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{62, 1, 0, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{75, 1, 0, 0}
 }
 
 func (x *UserDefinedTransition_Value_Success_Entry) GetKey() string {
@@ -8239,7 +9998,7 @@ type VisibleTarget_Key struct {
 
 func (x *VisibleTarget_Key) Reset() {
 	*x = VisibleTarget_Key{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[185]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8251,7 +10010,7 @@ func (x *VisibleTarget_Key) String() string {
 func (*VisibleTarget_Key) ProtoMessage() {}
 
 func (x *VisibleTarget_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[185]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8264,7 +10023,7 @@ func (x *VisibleTarget_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VisibleTarget_Key.ProtoReflect.Descriptor instead.
 func (*VisibleTarget_Key) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{63, 0}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{76, 0}
 }
 
 func (x *VisibleTarget_Key) GetFromPackage() string {
@@ -8311,7 +10070,7 @@ type VisibleTarget_Value struct {
 
 func (x *VisibleTarget_Value) Reset() {
 	*x = VisibleTarget_Value{}
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[186]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8323,7 +10082,7 @@ func (x *VisibleTarget_Value) String() string {
 func (*VisibleTarget_Value) ProtoMessage() {}
 
 func (x *VisibleTarget_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[186]
+	mi := &file_pkg_proto_model_analysis_analysis_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8336,7 +10095,7 @@ func (x *VisibleTarget_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VisibleTarget_Value.ProtoReflect.Descriptor instead.
 func (*VisibleTarget_Value) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{63, 1}
+	return file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP(), []int{76, 1}
 }
 
 func (x *VisibleTarget_Value) GetLabel() string {
@@ -8355,388 +10114,498 @@ var file_pkg_proto_model_analysis_analysis_proto_rawDesc = string([]byte{
 	0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
 	0x73, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21,
+	0x6f, 0x1a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x2f,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2b,
 	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x27, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x65, 0x6e, 0x63,
-	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2b, 0x70, 0x6b, 0x67,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61,
-	0x72, 0x6b, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xda, 0x03, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x1a, 0xd7, 0x02, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x70, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x70, 0x6b, 0x69, 0x78, 0x5f, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x70, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x6b, 0x69, 0x78, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x12, 0x4a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x4f, 0x0a, 0x14, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x12, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x16, 0x65, 0x78, 0x69, 0x74,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x62, 0x65, 0x5f, 0x7a, 0x65,
-	0x72, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f,
-	0x64, 0x65, 0x4d, 0x75, 0x73, 0x74, 0x42, 0x65, 0x5a, 0x65, 0x72, 0x6f, 0x1a, 0x70, 0x0a, 0x05,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x5f, 0x72, 0x65,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x73, 0x74, 0x61,
+	0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x03, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0xd7, 0x02, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a,
+	0x18, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x70, 0x6b, 0x69, 0x78, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x15, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x6b, 0x69, 0x78, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x4a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x4f, 0x0a, 0x14, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x12, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x16, 0x65,
+	0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x62, 0x65,
+	0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x78, 0x69,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x4d, 0x75, 0x73, 0x74, 0x42, 0x65, 0x5a, 0x65, 0x72, 0x6f, 0x1a,
+	0x70, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x69,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0xcc, 0x02, 0x0a, 0x16, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x54, 0x65,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xc3, 0x01, 0x0a,
+	0x03, 0x4b, 0x65, 0x79, 0x12, 0x59, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e,
+	0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72,
+	0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x61, 0x0a, 0x1d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x1b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x1a, 0x6c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x63, 0x0a, 0x1e, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x1c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x22, 0x7e, 0x0a, 0x12, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x61, 0x0a,
+	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x58, 0x0a, 0x13, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x59, 0x0a, 0x13, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x73, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x3b,
+	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x75, 0x69, 0x6c, 0x74,
+	0x69, 0x6e, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x73,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x0b, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65,
+	0x79, 0x1a, 0x67, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x11, 0x43,
+	0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65,
+	0x1a, 0x5f, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69,
+	0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x5f, 0x61, 0x70,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x1a, 0x33, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f,
+	0x5f, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63,
+	0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x22, 0x1d, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x05,
+	0x0a, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x73, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a,
+	0x59, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x50, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x1c, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x1a, 0x4b, 0x0a, 0x03, 0x4b,
+	0x65, 0x79, 0x12, 0x44, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a,
+	0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x63, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x5a, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22, 0xfa, 0x01,
+	0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x6f, 0x6c,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x84, 0x01,
+	0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x56, 0x0a, 0x17,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
 	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
 	0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x7e,
-	0x0a, 0x12, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x61, 0x0a, 0x05, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x58, 0x0a, 0x13, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x70,
-	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x62, 0x75, 0x69, 0x6c,
-	0x64, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x59,
-	0x0a, 0x13, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x73, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x3b, 0x0a, 0x05,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e,
-	0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x73, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x1d, 0x0a, 0x0b, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a,
-	0x07, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x6e,
-	0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x5f,
-	0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x61,
-	0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61,
-	0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x5f, 0x61, 0x70, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x1a,
-	0x33, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x5f, 0x63,
-	0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c,
-	0x52, 0x65, 0x70, 0x6f, 0x22, 0x1d, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x05, 0x0a, 0x03,
-	0x4b, 0x65, 0x79, 0x22, 0x73, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x59, 0x0a,
-	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x50, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x1c, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x1a, 0x4b, 0x0a, 0x03, 0x4b, 0x65, 0x79,
-	0x12, 0x44, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x43,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x63, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x5a, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62,
-	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61,
-	0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x1b,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x84, 0x01, 0x0a, 0x03,
-	0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x6f,
-	0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x56, 0x0a, 0x17, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f,
-	0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x1a, 0x54, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x74,
-	0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2b, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
-	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0a, 0x74, 0x6f,
-	0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d,
-	0x70, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x7a, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x4f, 0x0a, 0x03,
-	0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x75, 0x69,
-	0x6c, 0x74, 0x69, 0x6e, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69,
-	0x6e, 0x73, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x5b, 0x0a,
-	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
-	0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
-	0x65, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x69,
-	0x6c, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x22, 0x70, 0x0a, 0x1d, 0x43, 0x6f,
-	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x7a, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x63,
-	0x6f, 0x64, 0x65, 0x64, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x73, 0x1a, 0x4f, 0x0a, 0x03, 0x4b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x75, 0x69, 0x6c,
-	0x74, 0x69, 0x6e, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e,
-	0x73, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x1e,
-	0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x7a, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x46,
-	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x1a, 0x4f,
+	0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x16, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x1a, 0x54, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4b, 0x0a,
+	0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0a,
+	0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x0f, 0x43,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x7a, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x4f,
 	0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x62,
 	0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e,
 	0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x62, 0x75, 0x69, 0x6c,
-	0x74, 0x69, 0x6e, 0x73, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22,
-	0x7e, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x7a, 0x6c, 0x46, 0x69,
-	0x6c, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x1a, 0x25, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12,
-	0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x1a,
-	0x3e, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e,
-	0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72,
-	0x6b, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x22,
-	0x94, 0x04, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x72, 0x0a, 0x17, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x15,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x76, 0x65, 0x72,
-	0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x8e, 0x03, 0x0a, 0x14, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x55,
-	0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x62,
-	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61,
-	0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x66, 0x48, 0x00, 0x52,
-	0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x5b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
-	0x65, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x1a, 0x51, 0x0a, 0x04, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
-	0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x66, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12,
-	0x3b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x07, 0x0a,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xdf, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x1a, 0x73, 0x0a, 0x03, 0x4b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x56, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61,
-	0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x1a, 0x56, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x19, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x7c, 0x0a,
-	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x73, 0x0a, 0x1b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x62, 0x6f,
-	0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x66, 0x69, 0x6c, 0x65,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x52, 0x19, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x1b,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b,
-	0x65, 0x79, 0x1a, 0x82, 0x01, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x79, 0x0a, 0x1d,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x1b, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x2a, 0x0a, 0x21, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x05, 0x0a, 0x03,
-	0x4b, 0x65, 0x79, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x05, 0x0a, 0x03,
-	0x4b, 0x65, 0x79, 0x1a, 0x6d, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x64, 0x0a, 0x16,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62,
-	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x14, 0x66, 0x69,
-	0x6c, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x16, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x05, 0x0a,
-	0x03, 0x4b, 0x65, 0x79, 0x1a, 0x73, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x6a, 0x0a,
-	0x18, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x74, 0x69, 0x6e, 0x73, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x1a,
+	0xaa, 0x01, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x4d, 0x0a,
+	0x0f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0e, 0x6c, 0x6f,
+	0x61, 0x64, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x70, 0x0a, 0x1d,
+	0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x7a, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x73, 0x1a, 0x4f, 0x0a,
+	0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x75,
+	0x69, 0x6c, 0x74, 0x69, 0x6e, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x62, 0x75, 0x69, 0x6c, 0x74,
+	0x69, 0x6e, 0x73, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x71,
+	0x0a, 0x1e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x7a, 0x6c, 0x46, 0x69, 0x6c,
+	0x65, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x1a, 0x4f, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x32, 0x0a,
+	0x15, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x62, 0x75,
+	0x69, 0x6c, 0x74, 0x69, 0x6e, 0x73, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x7e, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x7a, 0x6c,
+	0x46, 0x69, 0x6c, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x1a, 0x25, 0x0a, 0x03, 0x4b, 0x65,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x1a, 0x3e, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x67, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e,
+	0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c,
+	0x61, 0x72, 0x6b, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x22, 0x94, 0x04, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x72, 0x0a, 0x17, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x52, 0x15, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x8e, 0x03, 0x0a, 0x14, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x12, 0x55, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f,
+	0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x66, 0x48,
+	0x00, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x5b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a,
+	0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x1a, 0x51, 0x0a, 0x04, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x66, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x3b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x42,
+	0x07, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x8f, 0x02, 0x0a, 0x10, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x70, 0x65, 0x63, 0x74, 0x1a, 0xa2, 0x01,
+	0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x56, 0x0a, 0x17, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62,
+	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x16, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x11, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x1a, 0x56, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a,
+	0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x10, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x1a,
+	0x73, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x56, 0x0a, 0x17,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x16, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x1a, 0x56, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4d, 0x0a,
+	0x12, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x6f, 0x6e, 0x61,
+	0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61,
+	0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xa0, 0x01, 0x0a,
+	0x19, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65,
+	0x79, 0x1a, 0x7c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x73, 0x0a, 0x1b, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70,
 	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x30, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x52, 0x16, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x25, 0x0a, 0x1c, 0x46, 0x69, 0x6c,
-	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79,
-	0x22, 0x9d, 0x01, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x1a, 0x40, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61,
-	0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70,
-	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x1a, 0x49, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40,
-	0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73,
-	0x22, 0x13, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x05,
-	0x0a, 0x03, 0x4b, 0x65, 0x79, 0x22, 0xc5, 0x02, 0x0a, 0x13, 0x48, 0x74, 0x74, 0x70, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0xde, 0x01,
-	0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x4e, 0x0a, 0x06, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x62, 0x6f, 0x6e, 0x61,
-	0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73,
-	0x69, 0x73, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74,
-	0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
-	0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x46, 0x61, 0x69, 0x6c, 0x22, 0x36, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x54, 0x41, 0x52, 0x5f, 0x47, 0x5a, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x54, 0x41, 0x52,
-	0x5f, 0x58, 0x5a, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x5a, 0x49, 0x50, 0x10, 0x03, 0x1a, 0x4d,
-	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a,
-	0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x91, 0x02,
-	0x0a, 0x10, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x73, 0x1a, 0x56, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x61, 0x69, 0x6c, 0x1a, 0xa4, 0x01, 0x0a, 0x05, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x48, 0x74,
-	0x74, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x06, 0x65, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x1a, 0x4c, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x42, 0x0a,
-	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x95, 0x01, 0x0a, 0x16, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x6f, 0x74, 0x42,
-	0x61, 0x7a, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x2e, 0x0a, 0x03,
-	0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x4b, 0x0a, 0x05,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a,
+	0x33, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x19, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22,
+	0xa9, 0x01, 0x0a, 0x1b, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a,
+	0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x82, 0x01, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x79, 0x0a, 0x1d, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a,
 	0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x49, 0x0a, 0x12, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x73, 0x1a,
-	0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x2c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x55, 0x72, 0x6c, 0x73, 0x22, 0x87, 0x02, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x2e, 0x0a, 0x03, 0x4b, 0x65,
-	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0xc1, 0x01, 0x0a, 0x05, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x53, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e,
+	0x65, 0x6d, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x1b,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x2a, 0x0a, 0x21, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x90, 0x02, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x8f, 0x01, 0x0a, 0x03, 0x4b,
+	0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x61, 0x0a, 0x1d, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x1b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x6c, 0x0a, 0x05,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x63, 0x0a, 0x1e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x1c, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x46,
+	0x69, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x6d, 0x0a, 0x05, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x64, 0x0a, 0x16, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x14, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x16, 0x46, 0x69,
+	0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x73, 0x0a, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x6a, 0x0a, 0x18, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x16, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x22, 0x25, 0x0a, 0x1c, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x9d, 0x01, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x65,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x40, 0x0a, 0x03, 0x4b, 0x65,
+	0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x6f, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x1a, 0x49, 0x0a, 0x05,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x13, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x22, 0xc5, 0x02, 0x0a,
+	0x13, 0x48, 0x74, 0x74, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x73, 0x1a, 0xde, 0x01, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73,
+	0x12, 0x4e, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x36, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x4b, 0x65,
+	0x79, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x61, 0x69, 0x6c, 0x22, 0x36, 0x0a,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x54, 0x41, 0x52, 0x5f, 0x47, 0x5a, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x54, 0x41, 0x52, 0x5f, 0x58, 0x5a, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
+	0x5a, 0x49, 0x50, 0x10, 0x03, 0x1a, 0x4d, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x44,
+	0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x22, 0x91, 0x02, 0x0a, 0x10, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x56, 0x0a, 0x03, 0x4b, 0x65, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x72, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x61, 0x69,
+	0x6c, 0x1a, 0xa4, 0x01, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x65,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x62, 0x6f,
+	0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x1a, 0x4c, 0x0a, 0x06, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x16, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x44, 0x6f, 0x74, 0x42, 0x61, 0x7a, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x1a, 0x2e, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x1a, 0x4b, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x42, 0x0a, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x49, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x55, 0x72, 0x6c, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x2c, 0x0a,
+	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x73, 0x22, 0x87, 0x02, 0x0a, 0x11,
 	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
-	0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x63, 0x0a, 0x07, 0x4d, 0x61, 0x70,
-	0x70, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x70, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x70, 0x6f, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x5f, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63,
-	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74,
-	0x6f, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x22, 0x95,
-	0x01, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x1a, 0x2c, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a,
-	0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c,
-	0x52, 0x65, 0x70, 0x6f, 0x1a, 0x50, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x47, 0x0a,
-	0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x2e,
-	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x1a, 0x30, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x26, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xa7, 0x03, 0x0a,
-	0x14, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x70, 0x6f, 0x73, 0x1a, 0x30, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xdc, 0x02, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x4d, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x37, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73,
-	0x1a, 0x83, 0x02, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x32, 0x0a, 0x04, 0x6c, 0x65, 0x61,
-	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a,
-	0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b,
-	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x58, 0x0a,
-	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x67, 0x1a, 0x2e, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x1a, 0xc1, 0x01, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x53, 0x0a, 0x08, 0x6d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
 	0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e,
-	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x1a, 0x64, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x12, 0x3b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x93, 0x01, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x22, 0x6e, 0x0a, 0x14,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x4f, 0x0a, 0x05, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e,
-	0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
-	0x73, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x6e, 0x0a, 0x14,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x67, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x4f, 0x0a, 0x05, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e,
-	0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
-	0x73, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x13,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73,
+	0x1a, 0x63, 0x0a, 0x07, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x70, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x5f,
+	0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x70, 0x6f, 0x22, 0x58, 0x0a, 0x1b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x1a, 0x30, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x07, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x95, 0x01, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x1a, 0x2c, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x70, 0x6f, 0x1a, 0x50, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x47,
+	0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x1a, 0x30, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x26, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xac, 0x05,
+	0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x1a, 0x30, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a,
+	0x10, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xe1, 0x04, 0x0a, 0x05, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x37, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x12, 0x7e, 0x0a, 0x17, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x47, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x70, 0x73, 0x52, 0x14, 0x72, 0x6f, 0x6f,
+	0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x70,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x69, 0x62, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x69, 0x62, 0x6c, 0x65, 0x1a, 0x83, 0x02, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x32,
+	0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62,
+	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61,
+	0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x65,
+	0x61, 0x66, 0x12, 0x58, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x1a, 0x64, 0x0a, 0x06,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61,
+	0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x1a, 0x5f, 0x0a, 0x14, 0x52,
+	0x6f, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44,
+	0x65, 0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x44, 0x65, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64,
+	0x65, 0x76, 0x5f, 0x64, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x76, 0x44, 0x65, 0x70, 0x73, 0x22, 0x93, 0x01, 0x0a,
+	0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
+	0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55,
+	0x72, 0x6c, 0x22, 0x6e, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65,
+	0x79, 0x1a, 0x4f, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x7c, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x6b,
+	0x66, 0x69, 0x6c, 0x65, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x63, 0x0a, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x5a, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x52, 0x12, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x22, 0x9b, 0x01, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x3d, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x36, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62,
+	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x47, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3e,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xcb,
+	0x01, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x67, 0x68, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0xab,
+	0x01, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62,
+	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x5a, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x13,
 	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64,
 	0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69,
@@ -8828,11 +10697,23 @@ var file_pkg_proto_model_analysis_analysis_proto_rawDesc = string([]byte{
 	0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
 	0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x22, 0x3c, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x76, 0x65, 0x6c, 0x22, 0xbd, 0x01, 0x0a, 0x12, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x41, 0x74, 0x41, 0x6e, 0x64, 0x42, 0x65, 0x6c, 0x6f, 0x77, 0x1a, 0x28, 0x0a, 0x03, 0x4b, 0x65,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x1a, 0x7d, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x35, 0x0a,
+	0x17, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x74, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x5f, 0x62, 0x65, 0x6c, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x42, 0x65, 0x6c, 0x6f, 0x77, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x22, 0x3c, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
 	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x8a, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x65, 0x22, 0xa0, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50,
 	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x44, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74,
 	0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62,
 	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61,
@@ -8840,310 +10721,396 @@ var file_pkg_proto_model_analysis_analysis_proto_rawDesc = string([]byte{
 	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a,
 	0x14, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x70, 0x6b, 0x69, 0x78, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
 	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x65, 0x78, 0x65,
-	0x63, 0x50, 0x6b, 0x69, 0x78, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x8a,
-	0x01, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x1a,
-	0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x63, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x5a, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62,
-	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61,
-	0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22, 0xf5, 0x02, 0x0a, 0x16,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0xd3, 0x02,
-	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x65, 0x78, 0x65, 0x63, 0x5f,
-	0x70, 0x6b, 0x69, 0x78, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x65, 0x78, 0x65, 0x63, 0x50, 0x6b, 0x69, 0x78, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6f, 0x73, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x4f, 0x73, 0x41, 0x72, 0x63, 0x68, 0x12, 0x7c, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6f, 0x73, 0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e,
+	0x63, 0x50, 0x6b, 0x69, 0x78, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x22, 0x8a, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x63, 0x0a, 0x05,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x5a, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x12, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x73, 0x22, 0xf5, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x52, 0x65, 0x70, 0x6f, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x1a, 0x05, 0x0a, 0x03,
+	0x4b, 0x65, 0x79, 0x1a, 0xd3, 0x02, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2f, 0x0a,
+	0x14, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x70, 0x6b, 0x69, 0x78, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x65, 0x78, 0x65,
+	0x63, 0x50, 0x6b, 0x69, 0x78, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2c,
+	0x0a, 0x12, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6f, 0x73, 0x5f,
+	0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x73, 0x41, 0x72, 0x63, 0x68, 0x12, 0x7c, 0x0a, 0x15,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6f, 0x73, 0x5f, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x62, 0x6f,
+	0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52,
+	0x65, 0x70, 0x6f, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x13, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x4f, 0x73, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6f, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x4f, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x3f, 0x0a, 0x13, 0x45, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x13, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x12, 0x54, 0x0a, 0x14, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x69, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69,
+	0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x12, 0x58, 0x0a, 0x16, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a,
+	0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x14, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74,
+	0x68, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f,
+	0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x22, 0xac, 0x02, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b,
+	0x65, 0x79, 0x1a, 0x8c, 0x02, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x73, 0x0a, 0x0f,
+	0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e,
 	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x13, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x73, 0x45, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x5f, 0x6f, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x73, 0x4e, 0x61,
-	0x6d, 0x65, 0x1a, 0x3f, 0x0a, 0x13, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x54, 0x0a, 0x14, 0x65,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0e, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x1a, 0x8d, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e,
+	0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x22, 0xa1, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x1a, 0x2c, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6f, 0x6c,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x1a,
+	0x54, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x74, 0x6f, 0x6f, 0x6c,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62,
+	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x3c, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x22, 0xa5, 0x01, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x1a, 0x2c, 0x0a, 0x03,
+	0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e,
+	0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x1a, 0x6f, 0x0a, 0x05, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x66, 0x0a, 0x18, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x16, 0x72, 0x6f, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x10,
+	0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x74, 0x74, 0x72, 0x73,
+	0x1a, 0x2c, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x1a, 0x8f,
+	0x01, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x55, 0x0a, 0x11, 0x69, 0x6e, 0x68, 0x65,
+	0x72, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x49, 0x6e, 0x68,
+	0x65, 0x72, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x73, 0x52, 0x10, 0x69,
+	0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x73, 0x12,
+	0x2f, 0x0a, 0x13, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x69, 0x67,
+	0x6e, 0x6f, 0x72, 0x65, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x6a, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x19, 0x0a, 0x03, 0x4b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x34, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x69, 0x73, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xaa, 0x03, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x73, 0x1a, 0xfa, 0x01, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x14, 0x65,
 	0x78, 0x65, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x77,
 	0x69, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x6f, 0x6e, 0x61,
 	0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73,
 	0x69, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x12, 0x65,
 	0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74,
-	0x68, 0x12, 0x58, 0x0a, 0x16, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x14, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0xac, 0x02, 0x0a,
-	0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x8c, 0x02, 0x0a,
-	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x73, 0x0a, 0x0f, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x4a, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
-	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x2e, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x6f,
-	0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x74, 0x6f, 0x6f,
-	0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x8d, 0x01, 0x0a, 0x17,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6f, 0x6c, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4b,
-	0x0a, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52,
-	0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x1b,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x2c, 0x0a, 0x03, 0x4b,
-	0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x6f, 0x6c,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x54, 0x0a, 0x05, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
+	0x68, 0x12, 0x56, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x74, 0x6f, 0x6f,
+	0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74,
+	0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x1a, 0x96, 0x01, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x58, 0x0a, 0x12, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
 	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x52, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22,
-	0xa5, 0x01, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x1a, 0x2c, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12,
-	0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63,
-	0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x1a, 0x6f, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x66, 0x0a, 0x18, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x16, 0x72, 0x6f, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x74, 0x74, 0x72, 0x73, 0x1a, 0x2c, 0x0a, 0x03,
-	0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c,
-	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e,
-	0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x1a, 0x5e, 0x0a, 0x05, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x55, 0x0a, 0x11, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73,
-	0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x73, 0x52, 0x10, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x73, 0x22, 0xcf, 0x02, 0x0a, 0x12, 0x52,
-	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x73, 0x1a, 0xfa, 0x01, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x14, 0x65, 0x78, 0x65,
-	0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a,
-	0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
-	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x12, 0x65, 0x78, 0x65,
-	0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x12,
-	0x56, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x6f,
-	0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72,
-	0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x1a, 0x3c,
-	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x6f, 0x6f, 0x6c, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x94, 0x01, 0x0a,
-	0x0a, 0x52, 0x6f, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x1a, 0x05, 0x0a, 0x03, 0x4b,
-	0x65, 0x79, 0x1a, 0x7f, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72,
-	0x6f, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f,
-	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x76, 0x5f,
-	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x1f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x76, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x06, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x1a, 0x3a, 0x0a,
-	0x03, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x52, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69,
 	0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x34, 0x0a, 0x05, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x63,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x22,
-	0x4d, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x6f,
-	0x6f, 0x74, 0x50, 0x61, 0x74, 0x68, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x2f, 0x0a,
-	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f,
-	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x22,
-	0x0a, 0x19, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x6f, 0x6f,
-	0x74, 0x50, 0x61, 0x74, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x05, 0x0a, 0x03, 0x4b,
-	0x65, 0x79, 0x22, 0x79, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x1a, 0x1b, 0x0a, 0x03,
-	0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x1a, 0x52, 0x0a, 0x05, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x90, 0x01,
-	0x0a, 0x10, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x73, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
-	0x56, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x07, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x90, 0x03, 0x0a, 0x16, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65,
-	0x72, 0x6e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2c, 0x0a, 0x03, 0x4b,
-	0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74,
-	0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x1a, 0xc7, 0x02, 0x0a, 0x05, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x65, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x62, 0x6f, 0x6e,
+	0x03, 0x28, 0x09, 0x52, 0x14, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x52, 0x6f,
+	0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a,
+	0x7f, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x1f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x44, 0x65, 0x76, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x22, 0x7a, 0x0a, 0x06, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x1a, 0x3a, 0x0a, 0x03, 0x4b, 0x65,
+	0x79, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x14, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x34, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x13,
+	0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x2f, 0x0a, 0x05, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x22, 0x0a, 0x19, 0x53,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x22,
+	0x79, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x1a, 0x1b, 0x0a, 0x03, 0x4b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x1a, 0x52, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x49, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x90, 0x01, 0x0a, 0x10, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x73, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x56, 0x0a, 0x17,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x16, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x1a, 0x07, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb3, 0x01,
+	0x0a, 0x13, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x1a, 0x73, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x56, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x27, 0x0a, 0x05, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69,
+	0x62, 0x6c, 0x65, 0x22, 0x90, 0x03, 0x0a, 0x16, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2c,
+	0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x1a, 0xc7, 0x02, 0x0a,
+	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x65, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e,
+	0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0xd6, 0x01,
+	0x0a, 0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a,
+	0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6c,
+	0x65, 0x61, 0x66, 0x12, 0x61, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x1a, 0x45, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x3b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xc9, 0x01, 0x0a, 0x19, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x73, 0x1a, 0x5d, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x56, 0x0a, 0x17, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62,
+	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x16, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x1a, 0x4d, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x44, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x73, 0x22, 0xe4, 0x03, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x9b, 0x01, 0x0a, 0x04, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x69, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69,
+	0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x51, 0x0a, 0x0b, 0x74, 0x61, 0x67, 0x5f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x6f, 0x6e,
 	0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79,
-	0x73, 0x69, 0x73, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
-	0x6e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x0c, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0xd6, 0x01, 0x0a, 0x0b, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x6c, 0x65,
-	0x61, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66,
-	0x12, 0x61, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x47, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x1a, 0x45, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a,
-	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x22, 0xe4, 0x03, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
+	0x73, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x61, 0x67, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0a, 0x74, 0x61,
+	0x67, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x5f, 0x0a, 0x08, 0x54, 0x61, 0x67, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
 	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e,
 	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x9b, 0x01, 0x0a, 0x04,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a,
-	0x07, 0x69, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x69, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x51, 0x0a, 0x0b, 0x74, 0x61, 0x67, 0x5f, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x6f,
-	0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c,
-	0x79, 0x73, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x61, 0x67, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0a, 0x74,
-	0x61, 0x67, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x5f, 0x0a, 0x08, 0x54, 0x61, 0x67,
-	0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a,
-	0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
-	0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x6e, 0x0a, 0x03, 0x54, 0x61,
-	0x67, 0x12, 0x3b, 0x0a, 0x05, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x05, 0x61, 0x74, 0x74, 0x72, 0x73, 0x12, 0x2a,
-	0x0a, 0x11, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x44, 0x65, 0x76,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x3d, 0x0a, 0x14, 0x52, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x1a, 0x25, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x13, 0x55, 0x73,
-	0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x1a, 0x30, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x1a, 0x5b, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x52, 0x0a, 0x10,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x7c, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a,
-	0x5d, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x54, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
+	0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x6e, 0x0a, 0x03, 0x54, 0x61, 0x67,
+	0x12, 0x3b, 0x0a, 0x05, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x05, 0x61, 0x74, 0x74, 0x72, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x44, 0x65, 0x76, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xa3, 0x02, 0x0a, 0x16, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
 	0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe6,
-	0x04, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x9d, 0x01, 0x0a, 0x03, 0x4b, 0x65, 0x79,
-	0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x61, 0x0a, 0x1d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62,
-	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x1b, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0xac, 0x03, 0x0a, 0x05, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x57, 0x0a, 0x1b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48,
-	0x00, 0x52, 0x18, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70,
-	0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x73, 0x12, 0x57, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x62,
+	0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a,
+	0x9d, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x76, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x64,
+	0x65, 0x76, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x22,
+	0x3d, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x25, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x43,
+	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4c,
+	0x6f, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0x23,
+	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x30, 0x0a, 0x03, 0x4b,
+	0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x5b, 0x0a,
+	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe7, 0x01, 0x0a, 0x14, 0x55,
+	0x73, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x1a, 0xc7, 0x01, 0x0a, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x54, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x68, 0x0a, 0x18, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62,
 	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61,
-	0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65,
-	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x1a, 0xe6, 0x01, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x5b, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x41, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44,
-	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x7e, 0x0a,
-	0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x63, 0x0a, 0x1e, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x1c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x08, 0x0a,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xb2, 0x02, 0x0a, 0x0d, 0x56, 0x69, 0x73, 0x69,
-	0x62, 0x6c, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x1a, 0x81, 0x02, 0x0a, 0x03, 0x4b, 0x65,
-	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
-	0x31, 0x0a, 0x15, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x5f,
-	0x6e, 0x6f, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
-	0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x4e, 0x6f, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x12, 0x56, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x73, 0x74,
-	0x6f, 0x70, 0x5f, 0x61, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x70, 0x41,
-	0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x1d, 0x0a,
-	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x37, 0x5a, 0x35,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x61, 0x6e, 0x61,
-	0x6c, 0x79, 0x73, 0x69, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x16, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x22, 0x94, 0x06, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xdc,
+	0x02, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x61, 0x0a, 0x1d, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x1b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5c,
+	0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x4b, 0x65, 0x79, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x5f, 0x0a, 0x09,
+	0x41, 0x74, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a,
+	0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73, 0x1a, 0x9b, 0x03,
+	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0b, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x12, 0x57, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x1a, 0xe6, 0x01, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x5b, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x41, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x7e,
+	0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x63, 0x0a, 0x1e, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x1c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x08,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x1b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x0d,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x1a, 0x81, 0x02,
+	0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f,
+	0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x31, 0x0a, 0x15, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x5f, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x5f, 0x6e, 0x6f, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x4e,
+	0x6f, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x56, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a,
+	0x61, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x31,
+	0x0a, 0x15, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x61, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x73,
+	0x74, 0x6f, 0x70, 0x41, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x1a, 0x1d, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 })
 
 var (
@@ -9159,302 +11126,365 @@ func file_pkg_proto_model_analysis_analysis_proto_rawDescGZIP() []byte {
 }
 
 var file_pkg_proto_model_analysis_analysis_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_proto_model_analysis_analysis_proto_msgTypes = make([]protoimpl.MessageInfo, 187)
+var file_pkg_proto_model_analysis_analysis_proto_msgTypes = make([]protoimpl.MessageInfo, 225)
 var file_pkg_proto_model_analysis_analysis_proto_goTypes = []any{
 	(HttpArchiveContents_Key_Format)(0),                        // 0: bonanza.model.analysis.HttpArchiveContents.Key.Format
 	(*ActionResult)(nil),                                       // 1: bonanza.model.analysis.ActionResult
-	(*BuildSpecification)(nil),                                 // 2: bonanza.model.analysis.BuildSpecification
-	(*BuiltinsModuleNames)(nil),                                // 3: bonanza.model.analysis.BuiltinsModuleNames
-	(*BuildResult)(nil),                                        // 4: bonanza.model.analysis.BuildResult
-	(*CanonicalRepoName)(nil),                                  // 5: bonanza.model.analysis.CanonicalRepoName
-	(*CommandEncoderObject)(nil),                               // 6: bonanza.model.analysis.CommandEncoderObject
-	(*CommandEncoders)(nil),                                    // 7: bonanza.model.analysis.CommandEncoders
-	(*CompatibleExecutionPlatforms)(nil),                       // 8: bonanza.model.analysis.CompatibleExecutionPlatforms
-	(*CompatibleToolchainsForType)(nil),                        // 9: bonanza.model.analysis.CompatibleToolchainsForType
-	(*CompiledBzlFile)(nil),                                    // 10: bonanza.model.analysis.CompiledBzlFile
-	(*CompiledBzlFileDecodedGlobals)(nil),                      // 11: bonanza.model.analysis.CompiledBzlFileDecodedGlobals
-	(*CompiledBzlFileFunctionFactory)(nil),                     // 12: bonanza.model.analysis.CompiledBzlFileFunctionFactory
-	(*CompiledBzlFileGlobal)(nil),                              // 13: bonanza.model.analysis.CompiledBzlFileGlobal
-	(*Configuration)(nil),                                      // 14: bonanza.model.analysis.Configuration
-	(*ConfiguredTarget)(nil),                                   // 15: bonanza.model.analysis.ConfiguredTarget
-	(*DirectoryAccessParameters)(nil),                          // 16: bonanza.model.analysis.DirectoryAccessParameters
-	(*DirectoryCreationParameters)(nil),                        // 17: bonanza.model.analysis.DirectoryCreationParameters
-	(*DirectoryCreationParametersObject)(nil),                  // 18: bonanza.model.analysis.DirectoryCreationParametersObject
-	(*FileAccessParameters)(nil),                               // 19: bonanza.model.analysis.FileAccessParameters
-	(*FileCreationParameters)(nil),                             // 20: bonanza.model.analysis.FileCreationParameters
-	(*FileCreationParametersObject)(nil),                       // 21: bonanza.model.analysis.FileCreationParametersObject
-	(*FileProperties)(nil),                                     // 22: bonanza.model.analysis.FileProperties
-	(*FileReader)(nil),                                         // 23: bonanza.model.analysis.FileReader
-	(*HttpArchiveContents)(nil),                                // 24: bonanza.model.analysis.HttpArchiveContents
-	(*HttpFileContents)(nil),                                   // 25: bonanza.model.analysis.HttpFileContents
-	(*ModuleDotBazelContents)(nil),                             // 26: bonanza.model.analysis.ModuleDotBazelContents
-	(*ModuleRegistryUrls)(nil),                                 // 27: bonanza.model.analysis.ModuleRegistryUrls
-	(*ModuleRepoMapping)(nil),                                  // 28: bonanza.model.analysis.ModuleRepoMapping
-	(*ModuleExtensionRepo)(nil),                                // 29: bonanza.model.analysis.ModuleExtensionRepo
-	(*ModuleExtensionRepoNames)(nil),                           // 30: bonanza.model.analysis.ModuleExtensionRepoNames
-	(*ModuleExtensionRepos)(nil),                               // 31: bonanza.model.analysis.ModuleExtensionRepos
-	(*BuildListModule)(nil),                                    // 32: bonanza.model.analysis.BuildListModule
-	(*ModuleFinalBuildList)(nil),                               // 33: bonanza.model.analysis.ModuleFinalBuildList
-	(*ModuleRoughBuildList)(nil),                               // 34: bonanza.model.analysis.ModuleRoughBuildList
-	(*OverridesListModule)(nil),                                // 35: bonanza.model.analysis.OverridesListModule
-	(*ModulesWithMultipleVersions)(nil),                        // 36: bonanza.model.analysis.ModulesWithMultipleVersions
-	(*ModulesWithMultipleVersionsObject)(nil),                  // 37: bonanza.model.analysis.ModulesWithMultipleVersionsObject
-	(*ModulesWithOverrides)(nil),                               // 38: bonanza.model.analysis.ModulesWithOverrides
-	(*ModuleOverride)(nil),                                     // 39: bonanza.model.analysis.ModuleOverride
-	(*ModulesWithRemoteOverrides)(nil),                         // 40: bonanza.model.analysis.ModulesWithRemoteOverrides
-	(*Package)(nil),                                            // 41: bonanza.model.analysis.Package
-	(*Constraint)(nil),                                         // 42: bonanza.model.analysis.Constraint
-	(*ExecutionPlatform)(nil),                                  // 43: bonanza.model.analysis.ExecutionPlatform
-	(*RegisteredExecutionPlatforms)(nil),                       // 44: bonanza.model.analysis.RegisteredExecutionPlatforms
-	(*RegisteredRepoPlatform)(nil),                             // 45: bonanza.model.analysis.RegisteredRepoPlatform
-	(*RegisteredToolchain)(nil),                                // 46: bonanza.model.analysis.RegisteredToolchain
-	(*RegisteredToolchains)(nil),                               // 47: bonanza.model.analysis.RegisteredToolchains
-	(*RegisteredToolchainsForType)(nil),                        // 48: bonanza.model.analysis.RegisteredToolchainsForType
-	(*Repo)(nil),                                               // 49: bonanza.model.analysis.Repo
-	(*RepoDefaultAttrs)(nil),                                   // 50: bonanza.model.analysis.RepoDefaultAttrs
-	(*ResolvedToolchains)(nil),                                 // 51: bonanza.model.analysis.ResolvedToolchains
-	(*RootModule)(nil),                                         // 52: bonanza.model.analysis.RootModule
-	(*Select)(nil),                                             // 53: bonanza.model.analysis.Select
-	(*StableInputRootPath)(nil),                                // 54: bonanza.model.analysis.StableInputRootPath
-	(*StableInputRootPathObject)(nil),                          // 55: bonanza.model.analysis.StableInputRootPathObject
-	(*Target)(nil),                                             // 56: bonanza.model.analysis.Target
-	(*TargetCompletion)(nil),                                   // 57: bonanza.model.analysis.TargetCompletion
-	(*TargetPatternExpansion)(nil),                             // 58: bonanza.model.analysis.TargetPatternExpansion
-	(*ModuleExtension)(nil),                                    // 59: bonanza.model.analysis.ModuleExtension
-	(*RepositoryRuleObject)(nil),                               // 60: bonanza.model.analysis.RepositoryRuleObject
-	(*UsedModuleExtension)(nil),                                // 61: bonanza.model.analysis.UsedModuleExtension
-	(*UsedModuleExtensions)(nil),                               // 62: bonanza.model.analysis.UsedModuleExtensions
-	(*UserDefinedTransition)(nil),                              // 63: bonanza.model.analysis.UserDefinedTransition
-	(*VisibleTarget)(nil),                                      // 64: bonanza.model.analysis.VisibleTarget
-	(*ActionResult_Key)(nil),                                   // 65: bonanza.model.analysis.ActionResult.Key
-	(*ActionResult_Value)(nil),                                 // 66: bonanza.model.analysis.ActionResult.Value
-	(*BuildSpecification_Key)(nil),                             // 67: bonanza.model.analysis.BuildSpecification.Key
-	(*BuildSpecification_Value)(nil),                           // 68: bonanza.model.analysis.BuildSpecification.Value
-	(*BuiltinsModuleNames_Key)(nil),                            // 69: bonanza.model.analysis.BuiltinsModuleNames.Key
-	(*BuiltinsModuleNames_Value)(nil),                          // 70: bonanza.model.analysis.BuiltinsModuleNames.Value
-	(*BuildResult_Key)(nil),                                    // 71: bonanza.model.analysis.BuildResult.Key
-	(*BuildResult_Value)(nil),                                  // 72: bonanza.model.analysis.BuildResult.Value
-	(*CanonicalRepoName_Key)(nil),                              // 73: bonanza.model.analysis.CanonicalRepoName.Key
-	(*CanonicalRepoName_Value)(nil),                            // 74: bonanza.model.analysis.CanonicalRepoName.Value
-	(*CommandEncoderObject_Key)(nil),                           // 75: bonanza.model.analysis.CommandEncoderObject.Key
-	(*CommandEncoders_Key)(nil),                                // 76: bonanza.model.analysis.CommandEncoders.Key
-	(*CommandEncoders_Value)(nil),                              // 77: bonanza.model.analysis.CommandEncoders.Value
-	(*CompatibleExecutionPlatforms_Key)(nil),                   // 78: bonanza.model.analysis.CompatibleExecutionPlatforms.Key
-	(*CompatibleExecutionPlatforms_Value)(nil),                 // 79: bonanza.model.analysis.CompatibleExecutionPlatforms.Value
-	(*CompatibleToolchainsForType_Key)(nil),                    // 80: bonanza.model.analysis.CompatibleToolchainsForType.Key
-	(*CompatibleToolchainsForType_Value)(nil),                  // 81: bonanza.model.analysis.CompatibleToolchainsForType.Value
-	(*CompiledBzlFile_Key)(nil),                                // 82: bonanza.model.analysis.CompiledBzlFile.Key
-	(*CompiledBzlFile_Value)(nil),                              // 83: bonanza.model.analysis.CompiledBzlFile.Value
-	(*CompiledBzlFileDecodedGlobals_Key)(nil),                  // 84: bonanza.model.analysis.CompiledBzlFileDecodedGlobals.Key
-	(*CompiledBzlFileFunctionFactory_Key)(nil),                 // 85: bonanza.model.analysis.CompiledBzlFileFunctionFactory.Key
-	(*CompiledBzlFileGlobal_Key)(nil),                          // 86: bonanza.model.analysis.CompiledBzlFileGlobal.Key
-	(*CompiledBzlFileGlobal_Value)(nil),                        // 87: bonanza.model.analysis.CompiledBzlFileGlobal.Value
-	(*Configuration_BuildSettingOverride)(nil),                 // 88: bonanza.model.analysis.Configuration.BuildSettingOverride
-	(*Configuration_BuildSettingOverride_Leaf)(nil),            // 89: bonanza.model.analysis.Configuration.BuildSettingOverride.Leaf
-	(*Configuration_BuildSettingOverride_Parent)(nil),          // 90: bonanza.model.analysis.Configuration.BuildSettingOverride.Parent
-	(*ConfiguredTarget_Key)(nil),                               // 91: bonanza.model.analysis.ConfiguredTarget.Key
-	(*ConfiguredTarget_Value)(nil),                             // 92: bonanza.model.analysis.ConfiguredTarget.Value
-	(*DirectoryAccessParameters_Key)(nil),                      // 93: bonanza.model.analysis.DirectoryAccessParameters.Key
-	(*DirectoryAccessParameters_Value)(nil),                    // 94: bonanza.model.analysis.DirectoryAccessParameters.Value
-	(*DirectoryCreationParameters_Key)(nil),                    // 95: bonanza.model.analysis.DirectoryCreationParameters.Key
-	(*DirectoryCreationParameters_Value)(nil),                  // 96: bonanza.model.analysis.DirectoryCreationParameters.Value
-	(*DirectoryCreationParametersObject_Key)(nil),              // 97: bonanza.model.analysis.DirectoryCreationParametersObject.Key
-	(*FileAccessParameters_Key)(nil),                           // 98: bonanza.model.analysis.FileAccessParameters.Key
-	(*FileAccessParameters_Value)(nil),                         // 99: bonanza.model.analysis.FileAccessParameters.Value
-	(*FileCreationParameters_Key)(nil),                         // 100: bonanza.model.analysis.FileCreationParameters.Key
-	(*FileCreationParameters_Value)(nil),                       // 101: bonanza.model.analysis.FileCreationParameters.Value
-	(*FileCreationParametersObject_Key)(nil),                   // 102: bonanza.model.analysis.FileCreationParametersObject.Key
-	(*FileProperties_Key)(nil),                                 // 103: bonanza.model.analysis.FileProperties.Key
-	(*FileProperties_Value)(nil),                               // 104: bonanza.model.analysis.FileProperties.Value
-	(*FileReader_Key)(nil),                                     // 105: bonanza.model.analysis.FileReader.Key
-	(*HttpArchiveContents_Key)(nil),                            // 106: bonanza.model.analysis.HttpArchiveContents.Key
-	(*HttpArchiveContents_Value)(nil),                          // 107: bonanza.model.analysis.HttpArchiveContents.Value
-	(*HttpFileContents_Key)(nil),                               // 108: bonanza.model.analysis.HttpFileContents.Key
-	(*HttpFileContents_Value)(nil),                             // 109: bonanza.model.analysis.HttpFileContents.Value
-	(*HttpFileContents_Value_Exists)(nil),                      // 110: bonanza.model.analysis.HttpFileContents.Value.Exists
-	(*ModuleDotBazelContents_Key)(nil),                         // 111: bonanza.model.analysis.ModuleDotBazelContents.Key
-	(*ModuleDotBazelContents_Value)(nil),                       // 112: bonanza.model.analysis.ModuleDotBazelContents.Value
-	(*ModuleRegistryUrls_Key)(nil),                             // 113: bonanza.model.analysis.ModuleRegistryUrls.Key
-	(*ModuleRegistryUrls_Value)(nil),                           // 114: bonanza.model.analysis.ModuleRegistryUrls.Value
-	(*ModuleRepoMapping_Key)(nil),                              // 115: bonanza.model.analysis.ModuleRepoMapping.Key
-	(*ModuleRepoMapping_Value)(nil),                            // 116: bonanza.model.analysis.ModuleRepoMapping.Value
-	(*ModuleRepoMapping_Value_Mapping)(nil),                    // 117: bonanza.model.analysis.ModuleRepoMapping.Value.Mapping
-	(*ModuleExtensionRepo_Key)(nil),                            // 118: bonanza.model.analysis.ModuleExtensionRepo.Key
-	(*ModuleExtensionRepo_Value)(nil),                          // 119: bonanza.model.analysis.ModuleExtensionRepo.Value
-	(*ModuleExtensionRepoNames_Key)(nil),                       // 120: bonanza.model.analysis.ModuleExtensionRepoNames.Key
-	(*ModuleExtensionRepoNames_Value)(nil),                     // 121: bonanza.model.analysis.ModuleExtensionRepoNames.Value
-	(*ModuleExtensionRepos_Key)(nil),                           // 122: bonanza.model.analysis.ModuleExtensionRepos.Key
-	(*ModuleExtensionRepos_Value)(nil),                         // 123: bonanza.model.analysis.ModuleExtensionRepos.Value
-	(*ModuleExtensionRepos_Value_Repo)(nil),                    // 124: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo
-	(*ModuleExtensionRepos_Value_Repo_Parent)(nil),             // 125: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.Parent
-	(*ModuleFinalBuildList_Key)(nil),                           // 126: bonanza.model.analysis.ModuleFinalBuildList.Key
-	(*ModuleFinalBuildList_Value)(nil),                         // 127: bonanza.model.analysis.ModuleFinalBuildList.Value
-	(*ModuleRoughBuildList_Key)(nil),                           // 128: bonanza.model.analysis.ModuleRoughBuildList.Key
-	(*ModuleRoughBuildList_Value)(nil),                         // 129: bonanza.model.analysis.ModuleRoughBuildList.Value
-	(*ModulesWithMultipleVersions_Key)(nil),                    // 130: bonanza.model.analysis.ModulesWithMultipleVersions.Key
-	(*ModulesWithMultipleVersions_Value)(nil),                  // 131: bonanza.model.analysis.ModulesWithMultipleVersions.Value
-	(*ModulesWithMultipleVersionsObject_Key)(nil),              // 132: bonanza.model.analysis.ModulesWithMultipleVersionsObject.Key
-	(*ModulesWithOverrides_Key)(nil),                           // 133: bonanza.model.analysis.ModulesWithOverrides.Key
-	(*ModulesWithOverrides_Value)(nil),                         // 134: bonanza.model.analysis.ModulesWithOverrides.Value
-	(*ModuleOverride_SingleVersion)(nil),                       // 135: bonanza.model.analysis.ModuleOverride.SingleVersion
-	(*ModuleOverride_MultipleVersions)(nil),                    // 136: bonanza.model.analysis.ModuleOverride.MultipleVersions
-	(*ModulesWithRemoteOverrides_Key)(nil),                     // 137: bonanza.model.analysis.ModulesWithRemoteOverrides.Key
-	(*ModulesWithRemoteOverrides_Value)(nil),                   // 138: bonanza.model.analysis.ModulesWithRemoteOverrides.Value
-	(*Package_Key)(nil),                                        // 139: bonanza.model.analysis.Package.Key
-	(*Package_Value)(nil),                                      // 140: bonanza.model.analysis.Package.Value
-	(*Package_Value_Target)(nil),                               // 141: bonanza.model.analysis.Package.Value.Target
-	(*Package_Value_Target_Parent)(nil),                        // 142: bonanza.model.analysis.Package.Value.Target.Parent
-	(*RegisteredExecutionPlatforms_Key)(nil),                   // 143: bonanza.model.analysis.RegisteredExecutionPlatforms.Key
-	(*RegisteredExecutionPlatforms_Value)(nil),                 // 144: bonanza.model.analysis.RegisteredExecutionPlatforms.Value
-	(*RegisteredRepoPlatform_Key)(nil),                         // 145: bonanza.model.analysis.RegisteredRepoPlatform.Key
-	(*RegisteredRepoPlatform_Value)(nil),                       // 146: bonanza.model.analysis.RegisteredRepoPlatform.Value
-	(*RegisteredRepoPlatform_Value_EnvironmentVariable)(nil),   // 147: bonanza.model.analysis.RegisteredRepoPlatform.Value.EnvironmentVariable
-	(*RegisteredToolchains_Key)(nil),                           // 148: bonanza.model.analysis.RegisteredToolchains.Key
-	(*RegisteredToolchains_Value)(nil),                         // 149: bonanza.model.analysis.RegisteredToolchains.Value
-	(*RegisteredToolchains_Value_RegisteredToolchainType)(nil), // 150: bonanza.model.analysis.RegisteredToolchains.Value.RegisteredToolchainType
-	(*RegisteredToolchainsForType_Key)(nil),                    // 151: bonanza.model.analysis.RegisteredToolchainsForType.Key
-	(*RegisteredToolchainsForType_Value)(nil),                  // 152: bonanza.model.analysis.RegisteredToolchainsForType.Value
-	(*Repo_Key)(nil),                                           // 153: bonanza.model.analysis.Repo.Key
-	(*Repo_Value)(nil),                                         // 154: bonanza.model.analysis.Repo.Value
-	(*RepoDefaultAttrs_Key)(nil),                               // 155: bonanza.model.analysis.RepoDefaultAttrs.Key
-	(*RepoDefaultAttrs_Value)(nil),                             // 156: bonanza.model.analysis.RepoDefaultAttrs.Value
-	(*ResolvedToolchains_Key)(nil),                             // 157: bonanza.model.analysis.ResolvedToolchains.Key
-	(*ResolvedToolchains_Value)(nil),                           // 158: bonanza.model.analysis.ResolvedToolchains.Value
-	(*RootModule_Key)(nil),                                     // 159: bonanza.model.analysis.RootModule.Key
-	(*RootModule_Value)(nil),                                   // 160: bonanza.model.analysis.RootModule.Value
-	(*Select_Key)(nil),                                         // 161: bonanza.model.analysis.Select.Key
-	(*Select_Value)(nil),                                       // 162: bonanza.model.analysis.Select.Value
-	(*StableInputRootPath_Key)(nil),                            // 163: bonanza.model.analysis.StableInputRootPath.Key
-	(*StableInputRootPath_Value)(nil),                          // 164: bonanza.model.analysis.StableInputRootPath.Value
-	(*StableInputRootPathObject_Key)(nil),                      // 165: bonanza.model.analysis.StableInputRootPathObject.Key
-	(*Target_Key)(nil),                                         // 166: bonanza.model.analysis.Target.Key
-	(*Target_Value)(nil),                                       // 167: bonanza.model.analysis.Target.Value
-	(*TargetCompletion_Key)(nil),                               // 168: bonanza.model.analysis.TargetCompletion.Key
-	(*TargetCompletion_Value)(nil),                             // 169: bonanza.model.analysis.TargetCompletion.Value
-	(*TargetPatternExpansion_Key)(nil),                         // 170: bonanza.model.analysis.TargetPatternExpansion.Key
-	(*TargetPatternExpansion_Value)(nil),                       // 171: bonanza.model.analysis.TargetPatternExpansion.Value
-	(*TargetPatternExpansion_Value_TargetLabel)(nil),           // 172: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel
-	(*TargetPatternExpansion_Value_TargetLabel_Parent)(nil),    // 173: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.Parent
-	(*ModuleExtension_User)(nil),                               // 174: bonanza.model.analysis.ModuleExtension.User
-	(*ModuleExtension_TagClass)(nil),                           // 175: bonanza.model.analysis.ModuleExtension.TagClass
-	(*ModuleExtension_Tag)(nil),                                // 176: bonanza.model.analysis.ModuleExtension.Tag
-	(*RepositoryRuleObject_Key)(nil),                           // 177: bonanza.model.analysis.RepositoryRuleObject.Key
-	(*UsedModuleExtension_Key)(nil),                            // 178: bonanza.model.analysis.UsedModuleExtension.Key
-	(*UsedModuleExtension_Value)(nil),                          // 179: bonanza.model.analysis.UsedModuleExtension.Value
-	(*UsedModuleExtensions_Key)(nil),                           // 180: bonanza.model.analysis.UsedModuleExtensions.Key
-	(*UsedModuleExtensions_Value)(nil),                         // 181: bonanza.model.analysis.UsedModuleExtensions.Value
-	(*UserDefinedTransition_Key)(nil),                          // 182: bonanza.model.analysis.UserDefinedTransition.Key
-	(*UserDefinedTransition_Value)(nil),                        // 183: bonanza.model.analysis.UserDefinedTransition.Value
-	(*UserDefinedTransition_Value_Success)(nil),                // 184: bonanza.model.analysis.UserDefinedTransition.Value.Success
-	(*UserDefinedTransition_Value_Success_Entry)(nil),          // 185: bonanza.model.analysis.UserDefinedTransition.Value.Success.Entry
-	(*VisibleTarget_Key)(nil),                                  // 186: bonanza.model.analysis.VisibleTarget.Key
-	(*VisibleTarget_Value)(nil),                                // 187: bonanza.model.analysis.VisibleTarget.Value
-	(*starlark.Repo_Definition)(nil),                           // 188: bonanza.model.starlark.Repo.Definition
-	(*core.Reference)(nil),                                     // 189: bonanza.model.core.Reference
-	(*durationpb.Duration)(nil),                                // 190: google.protobuf.Duration
-	(*build.BuildSpecification)(nil),                           // 191: bonanza.model.build.BuildSpecification
-	(*encoding.BinaryEncoder)(nil),                             // 192: bonanza.model.encoding.BinaryEncoder
-	(*starlark.CompiledProgram)(nil),                           // 193: bonanza.model.starlark.CompiledProgram
-	(*starlark.Value)(nil),                                     // 194: bonanza.model.starlark.Value
-	(*starlark.Struct)(nil),                                    // 195: bonanza.model.starlark.Struct
-	(*filesystem.DirectoryAccessParameters)(nil),               // 196: bonanza.model.filesystem.DirectoryAccessParameters
-	(*filesystem.DirectoryCreationParameters)(nil),             // 197: bonanza.model.filesystem.DirectoryCreationParameters
-	(*filesystem.FileAccessParameters)(nil),                    // 198: bonanza.model.filesystem.FileAccessParameters
-	(*filesystem.FileCreationParameters)(nil),                  // 199: bonanza.model.filesystem.FileCreationParameters
-	(*filesystem.FileProperties)(nil),                          // 200: bonanza.model.filesystem.FileProperties
-	(*filesystem.DirectoryReference)(nil),                      // 201: bonanza.model.filesystem.DirectoryReference
-	(*filesystem.FileContents)(nil),                            // 202: bonanza.model.filesystem.FileContents
-	(*starlark.Repo)(nil),                                      // 203: bonanza.model.starlark.Repo
-	(*starlark.Target)(nil),                                    // 204: bonanza.model.starlark.Target
-	(*starlark.InheritableAttrs)(nil),                          // 205: bonanza.model.starlark.InheritableAttrs
-	(*starlark.ToolchainType)(nil),                             // 206: bonanza.model.starlark.ToolchainType
-	(*starlark.Target_Definition)(nil),                         // 207: bonanza.model.starlark.Target.Definition
-	(*starlark.Struct_Fields)(nil),                             // 208: bonanza.model.starlark.Struct.Fields
-	(*emptypb.Empty)(nil),                                      // 209: google.protobuf.Empty
+	(*AnalysisTestTransition)(nil),                             // 2: bonanza.model.analysis.AnalysisTestTransition
+	(*BuildSpecification)(nil),                                 // 3: bonanza.model.analysis.BuildSpecification
+	(*BuiltinsModuleNames)(nil),                                // 4: bonanza.model.analysis.BuiltinsModuleNames
+	(*BuildResult)(nil),                                        // 5: bonanza.model.analysis.BuildResult
+	(*CanonicalRepoName)(nil),                                  // 6: bonanza.model.analysis.CanonicalRepoName
+	(*CommandEncoderObject)(nil),                               // 7: bonanza.model.analysis.CommandEncoderObject
+	(*CommandEncoders)(nil),                                    // 8: bonanza.model.analysis.CommandEncoders
+	(*CompatibleExecutionPlatforms)(nil),                       // 9: bonanza.model.analysis.CompatibleExecutionPlatforms
+	(*CompatibleToolchainsForType)(nil),                        // 10: bonanza.model.analysis.CompatibleToolchainsForType
+	(*CompiledBzlFile)(nil),                                    // 11: bonanza.model.analysis.CompiledBzlFile
+	(*CompiledBzlFileDecodedGlobals)(nil),                      // 12: bonanza.model.analysis.CompiledBzlFileDecodedGlobals
+	(*CompiledBzlFileFunctionFactory)(nil),                     // 13: bonanza.model.analysis.CompiledBzlFileFunctionFactory
+	(*CompiledBzlFileGlobal)(nil),                              // 14: bonanza.model.analysis.CompiledBzlFileGlobal
+	(*Configuration)(nil),                                      // 15: bonanza.model.analysis.Configuration
+	(*ConfiguredAspect)(nil),                                   // 16: bonanza.model.analysis.ConfiguredAspect
+	(*ConfiguredTarget)(nil),                                   // 17: bonanza.model.analysis.ConfiguredTarget
+	(*DirectoryAccessParameters)(nil),                          // 18: bonanza.model.analysis.DirectoryAccessParameters
+	(*DirectoryCreationParameters)(nil),                        // 19: bonanza.model.analysis.DirectoryCreationParameters
+	(*DirectoryCreationParametersObject)(nil),                  // 20: bonanza.model.analysis.DirectoryCreationParametersObject
+	(*ExecTransition)(nil),                                     // 21: bonanza.model.analysis.ExecTransition
+	(*FileAccessParameters)(nil),                               // 22: bonanza.model.analysis.FileAccessParameters
+	(*FileCreationParameters)(nil),                             // 23: bonanza.model.analysis.FileCreationParameters
+	(*FileCreationParametersObject)(nil),                       // 24: bonanza.model.analysis.FileCreationParametersObject
+	(*FileProperties)(nil),                                     // 25: bonanza.model.analysis.FileProperties
+	(*FileReader)(nil),                                         // 26: bonanza.model.analysis.FileReader
+	(*HttpArchiveContents)(nil),                                // 27: bonanza.model.analysis.HttpArchiveContents
+	(*HttpFileContents)(nil),                                   // 28: bonanza.model.analysis.HttpFileContents
+	(*ModuleDotBazelContents)(nil),                             // 29: bonanza.model.analysis.ModuleDotBazelContents
+	(*ModuleRegistryUrls)(nil),                                 // 30: bonanza.model.analysis.ModuleRegistryUrls
+	(*ModuleRepoMapping)(nil),                                  // 31: bonanza.model.analysis.ModuleRepoMapping
+	(*ModuleExtensionImportsCheck)(nil),                        // 32: bonanza.model.analysis.ModuleExtensionImportsCheck
+	(*ModuleExtensionRepo)(nil),                                // 33: bonanza.model.analysis.ModuleExtensionRepo
+	(*ModuleExtensionRepoNames)(nil),                           // 34: bonanza.model.analysis.ModuleExtensionRepoNames
+	(*ModuleExtensionRepos)(nil),                               // 35: bonanza.model.analysis.ModuleExtensionRepos
+	(*BuildListModule)(nil),                                    // 36: bonanza.model.analysis.BuildListModule
+	(*ModuleFinalBuildList)(nil),                               // 37: bonanza.model.analysis.ModuleFinalBuildList
+	(*ModuleLockfile)(nil),                                     // 38: bonanza.model.analysis.ModuleLockfile
+	(*ModuleQueryResult)(nil),                                  // 39: bonanza.model.analysis.ModuleQueryResult
+	(*ModuleRoughBuildList)(nil),                               // 40: bonanza.model.analysis.ModuleRoughBuildList
+	(*OverridesListModule)(nil),                                // 41: bonanza.model.analysis.OverridesListModule
+	(*ModulesWithMultipleVersions)(nil),                        // 42: bonanza.model.analysis.ModulesWithMultipleVersions
+	(*ModulesWithMultipleVersionsObject)(nil),                  // 43: bonanza.model.analysis.ModulesWithMultipleVersionsObject
+	(*ModulesWithOverrides)(nil),                               // 44: bonanza.model.analysis.ModulesWithOverrides
+	(*ModuleOverride)(nil),                                     // 45: bonanza.model.analysis.ModuleOverride
+	(*ModulesWithRemoteOverrides)(nil),                         // 46: bonanza.model.analysis.ModulesWithRemoteOverrides
+	(*Package)(nil),                                            // 47: bonanza.model.analysis.Package
+	(*PackagesAtAndBelow)(nil),                                 // 48: bonanza.model.analysis.PackagesAtAndBelow
+	(*Constraint)(nil),                                         // 49: bonanza.model.analysis.Constraint
+	(*ExecutionPlatform)(nil),                                  // 50: bonanza.model.analysis.ExecutionPlatform
+	(*RegisteredExecutionPlatforms)(nil),                       // 51: bonanza.model.analysis.RegisteredExecutionPlatforms
+	(*RegisteredRepoPlatform)(nil),                             // 52: bonanza.model.analysis.RegisteredRepoPlatform
+	(*RegisteredToolchain)(nil),                                // 53: bonanza.model.analysis.RegisteredToolchain
+	(*RegisteredToolchains)(nil),                               // 54: bonanza.model.analysis.RegisteredToolchains
+	(*RegisteredToolchainsForType)(nil),                        // 55: bonanza.model.analysis.RegisteredToolchainsForType
+	(*RegistryFileHash)(nil),                                   // 56: bonanza.model.analysis.RegistryFileHash
+	(*Repo)(nil),                                               // 57: bonanza.model.analysis.Repo
+	(*RepoDefaultAttrs)(nil),                                   // 58: bonanza.model.analysis.RepoDefaultAttrs
+	(*RepoEnvironmentVariable)(nil),                            // 59: bonanza.model.analysis.RepoEnvironmentVariable
+	(*ResolvedToolchains)(nil),                                 // 60: bonanza.model.analysis.ResolvedToolchains
+	(*RootModule)(nil),                                         // 61: bonanza.model.analysis.RootModule
+	(*Select)(nil),                                             // 62: bonanza.model.analysis.Select
+	(*StableInputRootPath)(nil),                                // 63: bonanza.model.analysis.StableInputRootPath
+	(*StableInputRootPathObject)(nil),                          // 64: bonanza.model.analysis.StableInputRootPathObject
+	(*Target)(nil),                                             // 65: bonanza.model.analysis.Target
+	(*TargetCompletion)(nil),                                   // 66: bonanza.model.analysis.TargetCompletion
+	(*TargetCompatibility)(nil),                                // 67: bonanza.model.analysis.TargetCompatibility
+	(*TargetPatternExpansion)(nil),                             // 68: bonanza.model.analysis.TargetPatternExpansion
+	(*TargetPlatformConstraints)(nil),                          // 69: bonanza.model.analysis.TargetPlatformConstraints
+	(*ModuleExtension)(nil),                                    // 70: bonanza.model.analysis.ModuleExtension
+	(*ModuleExtensionImports)(nil),                             // 71: bonanza.model.analysis.ModuleExtensionImports
+	(*RepositoryRuleObject)(nil),                               // 72: bonanza.model.analysis.RepositoryRuleObject
+	(*UpdatedModuleLockfile)(nil),                              // 73: bonanza.model.analysis.UpdatedModuleLockfile
+	(*UsedModuleExtension)(nil),                                // 74: bonanza.model.analysis.UsedModuleExtension
+	(*UsedModuleExtensions)(nil),                               // 75: bonanza.model.analysis.UsedModuleExtensions
+	(*UserDefinedTransition)(nil),                              // 76: bonanza.model.analysis.UserDefinedTransition
+	(*VisibleTarget)(nil),                                      // 77: bonanza.model.analysis.VisibleTarget
+	(*ActionResult_Key)(nil),                                   // 78: bonanza.model.analysis.ActionResult.Key
+	(*ActionResult_Value)(nil),                                 // 79: bonanza.model.analysis.ActionResult.Value
+	(*AnalysisTestTransition_Key)(nil),                         // 80: bonanza.model.analysis.AnalysisTestTransition.Key
+	(*AnalysisTestTransition_Value)(nil),                       // 81: bonanza.model.analysis.AnalysisTestTransition.Value
+	(*BuildSpecification_Key)(nil),                             // 82: bonanza.model.analysis.BuildSpecification.Key
+	(*BuildSpecification_Value)(nil),                           // 83: bonanza.model.analysis.BuildSpecification.Value
+	(*BuiltinsModuleNames_Key)(nil),                            // 84: bonanza.model.analysis.BuiltinsModuleNames.Key
+	(*BuiltinsModuleNames_Value)(nil),                          // 85: bonanza.model.analysis.BuiltinsModuleNames.Value
+	(*BuildResult_Key)(nil),                                    // 86: bonanza.model.analysis.BuildResult.Key
+	(*BuildResult_Value)(nil),                                  // 87: bonanza.model.analysis.BuildResult.Value
+	(*CanonicalRepoName_Key)(nil),                              // 88: bonanza.model.analysis.CanonicalRepoName.Key
+	(*CanonicalRepoName_Value)(nil),                            // 89: bonanza.model.analysis.CanonicalRepoName.Value
+	(*CommandEncoderObject_Key)(nil),                           // 90: bonanza.model.analysis.CommandEncoderObject.Key
+	(*CommandEncoders_Key)(nil),                                // 91: bonanza.model.analysis.CommandEncoders.Key
+	(*CommandEncoders_Value)(nil),                              // 92: bonanza.model.analysis.CommandEncoders.Value
+	(*CompatibleExecutionPlatforms_Key)(nil),                   // 93: bonanza.model.analysis.CompatibleExecutionPlatforms.Key
+	(*CompatibleExecutionPlatforms_Value)(nil),                 // 94: bonanza.model.analysis.CompatibleExecutionPlatforms.Value
+	(*CompatibleToolchainsForType_Key)(nil),                    // 95: bonanza.model.analysis.CompatibleToolchainsForType.Key
+	(*CompatibleToolchainsForType_Value)(nil),                  // 96: bonanza.model.analysis.CompatibleToolchainsForType.Value
+	(*CompiledBzlFile_Key)(nil),                                // 97: bonanza.model.analysis.CompiledBzlFile.Key
+	(*CompiledBzlFile_Value)(nil),                              // 98: bonanza.model.analysis.CompiledBzlFile.Value
+	(*CompiledBzlFileDecodedGlobals_Key)(nil),                  // 99: bonanza.model.analysis.CompiledBzlFileDecodedGlobals.Key
+	(*CompiledBzlFileFunctionFactory_Key)(nil),                 // 100: bonanza.model.analysis.CompiledBzlFileFunctionFactory.Key
+	(*CompiledBzlFileGlobal_Key)(nil),                          // 101: bonanza.model.analysis.CompiledBzlFileGlobal.Key
+	(*CompiledBzlFileGlobal_Value)(nil),                        // 102: bonanza.model.analysis.CompiledBzlFileGlobal.Value
+	(*Configuration_BuildSettingOverride)(nil),                 // 103: bonanza.model.analysis.Configuration.BuildSettingOverride
+	(*Configuration_BuildSettingOverride_Leaf)(nil),            // 104: bonanza.model.analysis.Configuration.BuildSettingOverride.Leaf
+	(*Configuration_BuildSettingOverride_Parent)(nil),          // 105: bonanza.model.analysis.Configuration.BuildSettingOverride.Parent
+	(*ConfiguredAspect_Key)(nil),                               // 106: bonanza.model.analysis.ConfiguredAspect.Key
+	(*ConfiguredAspect_Value)(nil),                             // 107: bonanza.model.analysis.ConfiguredAspect.Value
+	(*ConfiguredTarget_Key)(nil),                               // 108: bonanza.model.analysis.ConfiguredTarget.Key
+	(*ConfiguredTarget_Value)(nil),                             // 109: bonanza.model.analysis.ConfiguredTarget.Value
+	(*DirectoryAccessParameters_Key)(nil),                      // 110: bonanza.model.analysis.DirectoryAccessParameters.Key
+	(*DirectoryAccessParameters_Value)(nil),                    // 111: bonanza.model.analysis.DirectoryAccessParameters.Value
+	(*DirectoryCreationParameters_Key)(nil),                    // 112: bonanza.model.analysis.DirectoryCreationParameters.Key
+	(*DirectoryCreationParameters_Value)(nil),                  // 113: bonanza.model.analysis.DirectoryCreationParameters.Value
+	(*DirectoryCreationParametersObject_Key)(nil),              // 114: bonanza.model.analysis.DirectoryCreationParametersObject.Key
+	(*ExecTransition_Key)(nil),                                 // 115: bonanza.model.analysis.ExecTransition.Key
+	(*ExecTransition_Value)(nil),                               // 116: bonanza.model.analysis.ExecTransition.Value
+	(*FileAccessParameters_Key)(nil),                           // 117: bonanza.model.analysis.FileAccessParameters.Key
+	(*FileAccessParameters_Value)(nil),                         // 118: bonanza.model.analysis.FileAccessParameters.Value
+	(*FileCreationParameters_Key)(nil),                         // 119: bonanza.model.analysis.FileCreationParameters.Key
+	(*FileCreationParameters_Value)(nil),                       // 120: bonanza.model.analysis.FileCreationParameters.Value
+	(*FileCreationParametersObject_Key)(nil),                   // 121: bonanza.model.analysis.FileCreationParametersObject.Key
+	(*FileProperties_Key)(nil),                                 // 122: bonanza.model.analysis.FileProperties.Key
+	(*FileProperties_Value)(nil),                               // 123: bonanza.model.analysis.FileProperties.Value
+	(*FileReader_Key)(nil),                                     // 124: bonanza.model.analysis.FileReader.Key
+	(*HttpArchiveContents_Key)(nil),                            // 125: bonanza.model.analysis.HttpArchiveContents.Key
+	(*HttpArchiveContents_Value)(nil),                          // 126: bonanza.model.analysis.HttpArchiveContents.Value
+	(*HttpFileContents_Key)(nil),                               // 127: bonanza.model.analysis.HttpFileContents.Key
+	(*HttpFileContents_Value)(nil),                             // 128: bonanza.model.analysis.HttpFileContents.Value
+	(*HttpFileContents_Value_Exists)(nil),                      // 129: bonanza.model.analysis.HttpFileContents.Value.Exists
+	(*ModuleDotBazelContents_Key)(nil),                         // 130: bonanza.model.analysis.ModuleDotBazelContents.Key
+	(*ModuleDotBazelContents_Value)(nil),                       // 131: bonanza.model.analysis.ModuleDotBazelContents.Value
+	(*ModuleRegistryUrls_Key)(nil),                             // 132: bonanza.model.analysis.ModuleRegistryUrls.Key
+	(*ModuleRegistryUrls_Value)(nil),                           // 133: bonanza.model.analysis.ModuleRegistryUrls.Value
+	(*ModuleRepoMapping_Key)(nil),                              // 134: bonanza.model.analysis.ModuleRepoMapping.Key
+	(*ModuleRepoMapping_Value)(nil),                            // 135: bonanza.model.analysis.ModuleRepoMapping.Value
+	(*ModuleRepoMapping_Value_Mapping)(nil),                    // 136: bonanza.model.analysis.ModuleRepoMapping.Value.Mapping
+	(*ModuleExtensionImportsCheck_Key)(nil),                    // 137: bonanza.model.analysis.ModuleExtensionImportsCheck.Key
+	(*ModuleExtensionImportsCheck_Value)(nil),                  // 138: bonanza.model.analysis.ModuleExtensionImportsCheck.Value
+	(*ModuleExtensionRepo_Key)(nil),                            // 139: bonanza.model.analysis.ModuleExtensionRepo.Key
+	(*ModuleExtensionRepo_Value)(nil),                          // 140: bonanza.model.analysis.ModuleExtensionRepo.Value
+	(*ModuleExtensionRepoNames_Key)(nil),                       // 141: bonanza.model.analysis.ModuleExtensionRepoNames.Key
+	(*ModuleExtensionRepoNames_Value)(nil),                     // 142: bonanza.model.analysis.ModuleExtensionRepoNames.Value
+	(*ModuleExtensionRepos_Key)(nil),                           // 143: bonanza.model.analysis.ModuleExtensionRepos.Key
+	(*ModuleExtensionRepos_Value)(nil),                         // 144: bonanza.model.analysis.ModuleExtensionRepos.Value
+	(*ModuleExtensionRepos_Value_Repo)(nil),                    // 145: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo
+	(*ModuleExtensionRepos_Value_RootModuleDirectDeps)(nil),    // 146: bonanza.model.analysis.ModuleExtensionRepos.Value.RootModuleDirectDeps
+	(*ModuleExtensionRepos_Value_Repo_Parent)(nil),             // 147: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.Parent
+	(*ModuleFinalBuildList_Key)(nil),                           // 148: bonanza.model.analysis.ModuleFinalBuildList.Key
+	(*ModuleFinalBuildList_Value)(nil),                         // 149: bonanza.model.analysis.ModuleFinalBuildList.Value
+	(*ModuleLockfile_Key)(nil),                                 // 150: bonanza.model.analysis.ModuleLockfile.Key
+	(*ModuleLockfile_Value)(nil),                               // 151: bonanza.model.analysis.ModuleLockfile.Value
+	(*ModuleQueryResult_Key)(nil),                              // 152: bonanza.model.analysis.ModuleQueryResult.Key
+	(*ModuleQueryResult_Value)(nil),                            // 153: bonanza.model.analysis.ModuleQueryResult.Value
+	(*ModuleRoughBuildList_Key)(nil),                           // 154: bonanza.model.analysis.ModuleRoughBuildList.Key
+	(*ModuleRoughBuildList_Value)(nil),                         // 155: bonanza.model.analysis.ModuleRoughBuildList.Value
+	(*ModulesWithMultipleVersions_Key)(nil),                    // 156: bonanza.model.analysis.ModulesWithMultipleVersions.Key
+	(*ModulesWithMultipleVersions_Value)(nil),                  // 157: bonanza.model.analysis.ModulesWithMultipleVersions.Value
+	(*ModulesWithMultipleVersionsObject_Key)(nil),              // 158: bonanza.model.analysis.ModulesWithMultipleVersionsObject.Key
+	(*ModulesWithOverrides_Key)(nil),                           // 159: bonanza.model.analysis.ModulesWithOverrides.Key
+	(*ModulesWithOverrides_Value)(nil),                         // 160: bonanza.model.analysis.ModulesWithOverrides.Value
+	(*ModuleOverride_SingleVersion)(nil),                       // 161: bonanza.model.analysis.ModuleOverride.SingleVersion
+	(*ModuleOverride_MultipleVersions)(nil),                    // 162: bonanza.model.analysis.ModuleOverride.MultipleVersions
+	(*ModulesWithRemoteOverrides_Key)(nil),                     // 163: bonanza.model.analysis.ModulesWithRemoteOverrides.Key
+	(*ModulesWithRemoteOverrides_Value)(nil),                   // 164: bonanza.model.analysis.ModulesWithRemoteOverrides.Value
+	(*Package_Key)(nil),                                        // 165: bonanza.model.analysis.Package.Key
+	(*Package_Value)(nil),                                      // 166: bonanza.model.analysis.Package.Value
+	(*Package_Value_Target)(nil),                               // 167: bonanza.model.analysis.Package.Value.Target
+	(*Package_Value_Target_Parent)(nil),                        // 168: bonanza.model.analysis.Package.Value.Target.Parent
+	(*PackagesAtAndBelow_Key)(nil),                             // 169: bonanza.model.analysis.PackagesAtAndBelow.Key
+	(*PackagesAtAndBelow_Value)(nil),                           // 170: bonanza.model.analysis.PackagesAtAndBelow.Value
+	(*RegisteredExecutionPlatforms_Key)(nil),                   // 171: bonanza.model.analysis.RegisteredExecutionPlatforms.Key
+	(*RegisteredExecutionPlatforms_Value)(nil),                 // 172: bonanza.model.analysis.RegisteredExecutionPlatforms.Value
+	(*RegisteredRepoPlatform_Key)(nil),                         // 173: bonanza.model.analysis.RegisteredRepoPlatform.Key
+	(*RegisteredRepoPlatform_Value)(nil),                       // 174: bonanza.model.analysis.RegisteredRepoPlatform.Value
+	(*RegisteredRepoPlatform_Value_EnvironmentVariable)(nil),   // 175: bonanza.model.analysis.RegisteredRepoPlatform.Value.EnvironmentVariable
+	(*RegisteredToolchains_Key)(nil),                           // 176: bonanza.model.analysis.RegisteredToolchains.Key
+	(*RegisteredToolchains_Value)(nil),                         // 177: bonanza.model.analysis.RegisteredToolchains.Value
+	(*RegisteredToolchains_Value_RegisteredToolchainType)(nil), // 178: bonanza.model.analysis.RegisteredToolchains.Value.RegisteredToolchainType
+	(*RegisteredToolchainsForType_Key)(nil),                    // 179: bonanza.model.analysis.RegisteredToolchainsForType.Key
+	(*RegisteredToolchainsForType_Value)(nil),                  // 180: bonanza.model.analysis.RegisteredToolchainsForType.Value
+	(*Repo_Key)(nil),                                           // 181: bonanza.model.analysis.Repo.Key
+	(*Repo_Value)(nil),                                         // 182: bonanza.model.analysis.Repo.Value
+	(*RepoDefaultAttrs_Key)(nil),                               // 183: bonanza.model.analysis.RepoDefaultAttrs.Key
+	(*RepoDefaultAttrs_Value)(nil),                             // 184: bonanza.model.analysis.RepoDefaultAttrs.Value
+	(*RepoEnvironmentVariable_Key)(nil),                        // 185: bonanza.model.analysis.RepoEnvironmentVariable.Key
+	(*RepoEnvironmentVariable_Value)(nil),                      // 186: bonanza.model.analysis.RepoEnvironmentVariable.Value
+	(*ResolvedToolchains_Key)(nil),                             // 187: bonanza.model.analysis.ResolvedToolchains.Key
+	(*ResolvedToolchains_Value)(nil),                           // 188: bonanza.model.analysis.ResolvedToolchains.Value
+	(*RootModule_Key)(nil),                                     // 189: bonanza.model.analysis.RootModule.Key
+	(*RootModule_Value)(nil),                                   // 190: bonanza.model.analysis.RootModule.Value
+	(*Select_Key)(nil),                                         // 191: bonanza.model.analysis.Select.Key
+	(*Select_Value)(nil),                                       // 192: bonanza.model.analysis.Select.Value
+	(*StableInputRootPath_Key)(nil),                            // 193: bonanza.model.analysis.StableInputRootPath.Key
+	(*StableInputRootPath_Value)(nil),                          // 194: bonanza.model.analysis.StableInputRootPath.Value
+	(*StableInputRootPathObject_Key)(nil),                      // 195: bonanza.model.analysis.StableInputRootPathObject.Key
+	(*Target_Key)(nil),                                         // 196: bonanza.model.analysis.Target.Key
+	(*Target_Value)(nil),                                       // 197: bonanza.model.analysis.Target.Value
+	(*TargetCompletion_Key)(nil),                               // 198: bonanza.model.analysis.TargetCompletion.Key
+	(*TargetCompletion_Value)(nil),                             // 199: bonanza.model.analysis.TargetCompletion.Value
+	(*TargetCompatibility_Key)(nil),                            // 200: bonanza.model.analysis.TargetCompatibility.Key
+	(*TargetCompatibility_Value)(nil),                          // 201: bonanza.model.analysis.TargetCompatibility.Value
+	(*TargetPatternExpansion_Key)(nil),                         // 202: bonanza.model.analysis.TargetPatternExpansion.Key
+	(*TargetPatternExpansion_Value)(nil),                       // 203: bonanza.model.analysis.TargetPatternExpansion.Value
+	(*TargetPatternExpansion_Value_TargetLabel)(nil),           // 204: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel
+	(*TargetPatternExpansion_Value_TargetLabel_Parent)(nil),    // 205: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.Parent
+	(*TargetPlatformConstraints_Key)(nil),                      // 206: bonanza.model.analysis.TargetPlatformConstraints.Key
+	(*TargetPlatformConstraints_Value)(nil),                    // 207: bonanza.model.analysis.TargetPlatformConstraints.Value
+	(*ModuleExtension_User)(nil),                               // 208: bonanza.model.analysis.ModuleExtension.User
+	(*ModuleExtension_TagClass)(nil),                           // 209: bonanza.model.analysis.ModuleExtension.TagClass
+	(*ModuleExtension_Tag)(nil),                                // 210: bonanza.model.analysis.ModuleExtension.Tag
+	(*ModuleExtensionImports_User)(nil),                        // 211: bonanza.model.analysis.ModuleExtensionImports.User
+	(*RepositoryRuleObject_Key)(nil),                           // 212: bonanza.model.analysis.RepositoryRuleObject.Key
+	(*UpdatedModuleLockfile_Key)(nil),                          // 213: bonanza.model.analysis.UpdatedModuleLockfile.Key
+	(*UpdatedModuleLockfile_Value)(nil),                        // 214: bonanza.model.analysis.UpdatedModuleLockfile.Value
+	(*UsedModuleExtension_Key)(nil),                            // 215: bonanza.model.analysis.UsedModuleExtension.Key
+	(*UsedModuleExtension_Value)(nil),                          // 216: bonanza.model.analysis.UsedModuleExtension.Value
+	(*UsedModuleExtensions_Key)(nil),                           // 217: bonanza.model.analysis.UsedModuleExtensions.Key
+	(*UsedModuleExtensions_Value)(nil),                         // 218: bonanza.model.analysis.UsedModuleExtensions.Value
+	(*UserDefinedTransition_Key)(nil),                          // 219: bonanza.model.analysis.UserDefinedTransition.Key
+	(*UserDefinedTransition_Value)(nil),                        // 220: bonanza.model.analysis.UserDefinedTransition.Value
+	(*UserDefinedTransition_Key_AttrValue)(nil),                // 221: bonanza.model.analysis.UserDefinedTransition.Key.AttrValue
+	(*UserDefinedTransition_Value_Success)(nil),                // 222: bonanza.model.analysis.UserDefinedTransition.Value.Success
+	(*UserDefinedTransition_Value_Success_Entry)(nil),          // 223: bonanza.model.analysis.UserDefinedTransition.Value.Success.Entry
+	(*VisibleTarget_Key)(nil),                                  // 224: bonanza.model.analysis.VisibleTarget.Key
+	(*VisibleTarget_Value)(nil),                                // 225: bonanza.model.analysis.VisibleTarget.Value
+	(*starlark.Repo_Definition)(nil),                           // 226: bonanza.model.starlark.Repo.Definition
+	(*core.Reference)(nil),                                     // 227: bonanza.model.core.Reference
+	(*durationpb.Duration)(nil),                                // 228: google.protobuf.Duration
+	(*starlark.Transition_Reference_AnalysisTest)(nil),         // 229: bonanza.model.starlark.Transition.Reference.AnalysisTest
+	(*build.BuildSpecification)(nil),                           // 230: bonanza.model.build.BuildSpecification
+	(*build.Target)(nil),                                       // 231: bonanza.model.build.Target
+	(*encoding.BinaryEncoder)(nil),                             // 232: bonanza.model.encoding.BinaryEncoder
+	(*starlark.CompiledProgram)(nil),                           // 233: bonanza.model.starlark.CompiledProgram
+	(*starlark.PackageGroup)(nil),                              // 234: bonanza.model.starlark.PackageGroup
+	(*starlark.Value)(nil),                                     // 235: bonanza.model.starlark.Value
+	(*starlark.Struct)(nil),                                    // 236: bonanza.model.starlark.Struct
+	(*filesystem.DirectoryAccessParameters)(nil),               // 237: bonanza.model.filesystem.DirectoryAccessParameters
+	(*filesystem.DirectoryCreationParameters)(nil),             // 238: bonanza.model.filesystem.DirectoryCreationParameters
+	(*filesystem.FileAccessParameters)(nil),                    // 239: bonanza.model.filesystem.FileAccessParameters
+	(*filesystem.FileCreationParameters)(nil),                  // 240: bonanza.model.filesystem.FileCreationParameters
+	(*filesystem.FileProperties)(nil),                          // 241: bonanza.model.filesystem.FileProperties
+	(*filesystem.DirectoryReference)(nil),                      // 242: bonanza.model.filesystem.DirectoryReference
+	(*filesystem.FileContents)(nil),                            // 243: bonanza.model.filesystem.FileContents
+	(*starlark.Repo)(nil),                                      // 244: bonanza.model.starlark.Repo
+	(*build.ModuleQuery)(nil),                                  // 245: bonanza.model.build.ModuleQuery
+	(*build.ModuleQueryResult)(nil),                            // 246: bonanza.model.build.ModuleQueryResult
+	(*starlark.Target)(nil),                                    // 247: bonanza.model.starlark.Target
+	(*starlark.InheritableAttrs)(nil),                          // 248: bonanza.model.starlark.InheritableAttrs
+	(*starlark.ToolchainType)(nil),                             // 249: bonanza.model.starlark.ToolchainType
+	(*starlark.Target_Definition)(nil),                         // 250: bonanza.model.starlark.Target.Definition
+	(*starlark.Struct_Fields)(nil),                             // 251: bonanza.model.starlark.Struct.Fields
 }
 var file_pkg_proto_model_analysis_analysis_proto_depIdxs = []int32{
-	88,  // 0: bonanza.model.analysis.Configuration.build_setting_overrides:type_name -> bonanza.model.analysis.Configuration.BuildSettingOverride
-	135, // 1: bonanza.model.analysis.ModuleOverride.single_version:type_name -> bonanza.model.analysis.ModuleOverride.SingleVersion
-	136, // 2: bonanza.model.analysis.ModuleOverride.multiple_versions:type_name -> bonanza.model.analysis.ModuleOverride.MultipleVersions
-	188, // 3: bonanza.model.analysis.ModuleOverride.repository_rule:type_name -> bonanza.model.starlark.Repo.Definition
-	42,  // 4: bonanza.model.analysis.ExecutionPlatform.constraints:type_name -> bonanza.model.analysis.Constraint
-	42,  // 5: bonanza.model.analysis.RegisteredToolchain.exec_compatible_with:type_name -> bonanza.model.analysis.Constraint
-	42,  // 6: bonanza.model.analysis.RegisteredToolchain.target_compatible_with:type_name -> bonanza.model.analysis.Constraint
-	174, // 7: bonanza.model.analysis.ModuleExtension.users:type_name -> bonanza.model.analysis.ModuleExtension.User
-	189, // 8: bonanza.model.analysis.ActionResult.Key.command_reference:type_name -> bonanza.model.core.Reference
-	189, // 9: bonanza.model.analysis.ActionResult.Key.input_root_reference:type_name -> bonanza.model.core.Reference
-	190, // 10: bonanza.model.analysis.ActionResult.Key.execution_timeout:type_name -> google.protobuf.Duration
-	189, // 11: bonanza.model.analysis.ActionResult.Value.outputs_reference:type_name -> bonanza.model.core.Reference
-	191, // 12: bonanza.model.analysis.BuildSpecification.Value.build_specification:type_name -> bonanza.model.build.BuildSpecification
-	192, // 13: bonanza.model.analysis.CommandEncoders.Value.command_encoders:type_name -> bonanza.model.encoding.BinaryEncoder
-	42,  // 14: bonanza.model.analysis.CompatibleExecutionPlatforms.Key.constraints:type_name -> bonanza.model.analysis.Constraint
-	43,  // 15: bonanza.model.analysis.CompatibleExecutionPlatforms.Value.execution_platforms:type_name -> bonanza.model.analysis.ExecutionPlatform
-	189, // 16: bonanza.model.analysis.CompatibleToolchainsForType.Key.configuration_reference:type_name -> bonanza.model.core.Reference
-	46,  // 17: bonanza.model.analysis.CompatibleToolchainsForType.Value.toolchains:type_name -> bonanza.model.analysis.RegisteredToolchain
-	193, // 18: bonanza.model.analysis.CompiledBzlFile.Value.compiled_program:type_name -> bonanza.model.starlark.CompiledProgram
-	194, // 19: bonanza.model.analysis.CompiledBzlFileGlobal.Value.global:type_name -> bonanza.model.starlark.Value
-	89,  // 20: bonanza.model.analysis.Configuration.BuildSettingOverride.leaf:type_name -> bonanza.model.analysis.Configuration.BuildSettingOverride.Leaf
-	90,  // 21: bonanza.model.analysis.Configuration.BuildSettingOverride.parent:type_name -> bonanza.model.analysis.Configuration.BuildSettingOverride.Parent
-	194, // 22: bonanza.model.analysis.Configuration.BuildSettingOverride.Leaf.value:type_name -> bonanza.model.starlark.Value
-	189, // 23: bonanza.model.analysis.Configuration.BuildSettingOverride.Parent.reference:type_name -> bonanza.model.core.Reference
-	189, // 24: bonanza.model.analysis.ConfiguredTarget.Key.configuration_reference:type_name -> bonanza.model.core.Reference
-	195, // 25: bonanza.model.analysis.ConfiguredTarget.Value.provider_instances:type_name -> bonanza.model.starlark.Struct
-	196, // 26: bonanza.model.analysis.DirectoryAccessParameters.Value.directory_access_parameters:type_name -> bonanza.model.filesystem.DirectoryAccessParameters
-	197, // 27: bonanza.model.analysis.DirectoryCreationParameters.Value.directory_creation_parameters:type_name -> bonanza.model.filesystem.DirectoryCreationParameters
-	198, // 28: bonanza.model.analysis.FileAccessParameters.Value.file_access_parameters:type_name -> bonanza.model.filesystem.FileAccessParameters
-	199, // 29: bonanza.model.analysis.FileCreationParameters.Value.file_creation_parameters:type_name -> bonanza.model.filesystem.FileCreationParameters
-	200, // 30: bonanza.model.analysis.FileProperties.Value.exists:type_name -> bonanza.model.filesystem.FileProperties
-	0,   // 31: bonanza.model.analysis.HttpArchiveContents.Key.format:type_name -> bonanza.model.analysis.HttpArchiveContents.Key.Format
-	201, // 32: bonanza.model.analysis.HttpArchiveContents.Value.exists:type_name -> bonanza.model.filesystem.DirectoryReference
-	110, // 33: bonanza.model.analysis.HttpFileContents.Value.exists:type_name -> bonanza.model.analysis.HttpFileContents.Value.Exists
-	202, // 34: bonanza.model.analysis.HttpFileContents.Value.Exists.contents:type_name -> bonanza.model.filesystem.FileContents
-	202, // 35: bonanza.model.analysis.ModuleDotBazelContents.Value.contents:type_name -> bonanza.model.filesystem.FileContents
-	117, // 36: bonanza.model.analysis.ModuleRepoMapping.Value.mappings:type_name -> bonanza.model.analysis.ModuleRepoMapping.Value.Mapping
-	188, // 37: bonanza.model.analysis.ModuleExtensionRepo.Value.definition:type_name -> bonanza.model.starlark.Repo.Definition
-	124, // 38: bonanza.model.analysis.ModuleExtensionRepos.Value.repos:type_name -> bonanza.model.analysis.ModuleExtensionRepos.Value.Repo
-	203, // 39: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.leaf:type_name -> bonanza.model.starlark.Repo
-	125, // 40: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.parent:type_name -> bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.Parent
-	189, // 41: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.Parent.reference:type_name -> bonanza.model.core.Reference
-	32,  // 42: bonanza.model.analysis.ModuleFinalBuildList.Value.build_list:type_name -> bonanza.model.analysis.BuildListModule
-	32,  // 43: bonanza.model.analysis.ModuleRoughBuildList.Value.build_list:type_name -> bonanza.model.analysis.BuildListModule
-	35,  // 44: bonanza.model.analysis.ModulesWithMultipleVersions.Value.overrides_list:type_name -> bonanza.model.analysis.OverridesListModule
-	35,  // 45: bonanza.model.analysis.ModulesWithOverrides.Value.overrides_list:type_name -> bonanza.model.analysis.OverridesListModule
-	39,  // 46: bonanza.model.analysis.ModulesWithRemoteOverrides.Value.module_overrides:type_name -> bonanza.model.analysis.ModuleOverride
-	141, // 47: bonanza.model.analysis.Package.Value.targets:type_name -> bonanza.model.analysis.Package.Value.Target
-	204, // 48: bonanza.model.analysis.Package.Value.Target.leaf:type_name -> bonanza.model.starlark.Target
-	142, // 49: bonanza.model.analysis.Package.Value.Target.parent:type_name -> bonanza.model.analysis.Package.Value.Target.Parent
-	189, // 50: bonanza.model.analysis.Package.Value.Target.Parent.reference:type_name -> bonanza.model.core.Reference
-	43,  // 51: bonanza.model.analysis.RegisteredExecutionPlatforms.Value.execution_platforms:type_name -> bonanza.model.analysis.ExecutionPlatform
-	147, // 52: bonanza.model.analysis.RegisteredRepoPlatform.Value.repository_os_environ:type_name -> bonanza.model.analysis.RegisteredRepoPlatform.Value.EnvironmentVariable
-	150, // 53: bonanza.model.analysis.RegisteredToolchains.Value.toolchain_types:type_name -> bonanza.model.analysis.RegisteredToolchains.Value.RegisteredToolchainType
-	46,  // 54: bonanza.model.analysis.RegisteredToolchains.Value.RegisteredToolchainType.toolchains:type_name -> bonanza.model.analysis.RegisteredToolchain
-	46,  // 55: bonanza.model.analysis.RegisteredToolchainsForType.Value.toolchains:type_name -> bonanza.model.analysis.RegisteredToolchain
-	201, // 56: bonanza.model.analysis.Repo.Value.root_directory_reference:type_name -> bonanza.model.filesystem.DirectoryReference
-	205, // 57: bonanza.model.analysis.RepoDefaultAttrs.Value.inheritable_attrs:type_name -> bonanza.model.starlark.InheritableAttrs
-	42,  // 58: bonanza.model.analysis.ResolvedToolchains.Key.exec_compatible_with:type_name -> bonanza.model.analysis.Constraint
-	189, // 59: bonanza.model.analysis.ResolvedToolchains.Key.configuration_reference:type_name -> bonanza.model.core.Reference
-	206, // 60: bonanza.model.analysis.ResolvedToolchains.Key.toolchains:type_name -> bonanza.model.starlark.ToolchainType
-	207, // 61: bonanza.model.analysis.Target.Value.definition:type_name -> bonanza.model.starlark.Target.Definition
-	189, // 62: bonanza.model.analysis.TargetCompletion.Key.configuration_reference:type_name -> bonanza.model.core.Reference
-	172, // 63: bonanza.model.analysis.TargetPatternExpansion.Value.target_labels:type_name -> bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel
-	173, // 64: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.parent:type_name -> bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.Parent
-	189, // 65: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.Parent.reference:type_name -> bonanza.model.core.Reference
-	175, // 66: bonanza.model.analysis.ModuleExtension.User.tag_classes:type_name -> bonanza.model.analysis.ModuleExtension.TagClass
-	176, // 67: bonanza.model.analysis.ModuleExtension.TagClass.tags:type_name -> bonanza.model.analysis.ModuleExtension.Tag
-	208, // 68: bonanza.model.analysis.ModuleExtension.Tag.attrs:type_name -> bonanza.model.starlark.Struct.Fields
-	59,  // 69: bonanza.model.analysis.UsedModuleExtension.Value.module_extension:type_name -> bonanza.model.analysis.ModuleExtension
-	59,  // 70: bonanza.model.analysis.UsedModuleExtensions.Value.module_extensions:type_name -> bonanza.model.analysis.ModuleExtension
-	189, // 71: bonanza.model.analysis.UserDefinedTransition.Key.input_configuration_reference:type_name -> bonanza.model.core.Reference
-	209, // 72: bonanza.model.analysis.UserDefinedTransition.Value.transition_depends_on_attrs:type_name -> google.protobuf.Empty
-	184, // 73: bonanza.model.analysis.UserDefinedTransition.Value.success:type_name -> bonanza.model.analysis.UserDefinedTransition.Value.Success
-	185, // 74: bonanza.model.analysis.UserDefinedTransition.Value.Success.entries:type_name -> bonanza.model.analysis.UserDefinedTransition.Value.Success.Entry
-	189, // 75: bonanza.model.analysis.UserDefinedTransition.Value.Success.Entry.output_configuration_reference:type_name -> bonanza.model.core.Reference
-	189, // 76: bonanza.model.analysis.VisibleTarget.Key.configuration_reference:type_name -> bonanza.model.core.Reference
-	77,  // [77:77] is the sub-list for method output_type
-	77,  // [77:77] is the sub-list for method input_type
-	77,  // [77:77] is the sub-list for extension type_name
-	77,  // [77:77] is the sub-list for extension extendee
-	0,   // [0:77] is the sub-list for field type_name
+	103, // 0: bonanza.model.analysis.Configuration.build_setting_overrides:type_name -> bonanza.model.analysis.Configuration.BuildSettingOverride
+	161, // 1: bonanza.model.analysis.ModuleOverride.single_version:type_name -> bonanza.model.analysis.ModuleOverride.SingleVersion
+	162, // 2: bonanza.model.analysis.ModuleOverride.multiple_versions:type_name -> bonanza.model.analysis.ModuleOverride.MultipleVersions
+	226, // 3: bonanza.model.analysis.ModuleOverride.repository_rule:type_name -> bonanza.model.starlark.Repo.Definition
+	49,  // 4: bonanza.model.analysis.ExecutionPlatform.constraints:type_name -> bonanza.model.analysis.Constraint
+	49,  // 5: bonanza.model.analysis.RegisteredToolchain.exec_compatible_with:type_name -> bonanza.model.analysis.Constraint
+	49,  // 6: bonanza.model.analysis.RegisteredToolchain.target_compatible_with:type_name -> bonanza.model.analysis.Constraint
+	208, // 7: bonanza.model.analysis.ModuleExtension.users:type_name -> bonanza.model.analysis.ModuleExtension.User
+	211, // 8: bonanza.model.analysis.ModuleExtensionImports.users:type_name -> bonanza.model.analysis.ModuleExtensionImports.User
+	227, // 9: bonanza.model.analysis.ActionResult.Key.command_reference:type_name -> bonanza.model.core.Reference
+	227, // 10: bonanza.model.analysis.ActionResult.Key.input_root_reference:type_name -> bonanza.model.core.Reference
+	228, // 11: bonanza.model.analysis.ActionResult.Key.execution_timeout:type_name -> google.protobuf.Duration
+	227, // 12: bonanza.model.analysis.ActionResult.Value.outputs_reference:type_name -> bonanza.model.core.Reference
+	229, // 13: bonanza.model.analysis.AnalysisTestTransition.Key.transition:type_name -> bonanza.model.starlark.Transition.Reference.AnalysisTest
+	227, // 14: bonanza.model.analysis.AnalysisTestTransition.Key.input_configuration_reference:type_name -> bonanza.model.core.Reference
+	227, // 15: bonanza.model.analysis.AnalysisTestTransition.Value.output_configuration_reference:type_name -> bonanza.model.core.Reference
+	230, // 16: bonanza.model.analysis.BuildSpecification.Value.build_specification:type_name -> bonanza.model.build.BuildSpecification
+	231, // 17: bonanza.model.analysis.BuildResult.Value.targets:type_name -> bonanza.model.build.Target
+	232, // 18: bonanza.model.analysis.CommandEncoders.Value.command_encoders:type_name -> bonanza.model.encoding.BinaryEncoder
+	49,  // 19: bonanza.model.analysis.CompatibleExecutionPlatforms.Key.constraints:type_name -> bonanza.model.analysis.Constraint
+	50,  // 20: bonanza.model.analysis.CompatibleExecutionPlatforms.Value.execution_platforms:type_name -> bonanza.model.analysis.ExecutionPlatform
+	227, // 21: bonanza.model.analysis.CompatibleToolchainsForType.Key.configuration_reference:type_name -> bonanza.model.core.Reference
+	53,  // 22: bonanza.model.analysis.CompatibleToolchainsForType.Value.toolchains:type_name -> bonanza.model.analysis.RegisteredToolchain
+	233, // 23: bonanza.model.analysis.CompiledBzlFile.Value.compiled_program:type_name -> bonanza.model.starlark.CompiledProgram
+	234, // 24: bonanza.model.analysis.CompiledBzlFile.Value.load_visibility:type_name -> bonanza.model.starlark.PackageGroup
+	235, // 25: bonanza.model.analysis.CompiledBzlFileGlobal.Value.global:type_name -> bonanza.model.starlark.Value
+	104, // 26: bonanza.model.analysis.Configuration.BuildSettingOverride.leaf:type_name -> bonanza.model.analysis.Configuration.BuildSettingOverride.Leaf
+	105, // 27: bonanza.model.analysis.Configuration.BuildSettingOverride.parent:type_name -> bonanza.model.analysis.Configuration.BuildSettingOverride.Parent
+	235, // 28: bonanza.model.analysis.Configuration.BuildSettingOverride.Leaf.value:type_name -> bonanza.model.starlark.Value
+	227, // 29: bonanza.model.analysis.Configuration.BuildSettingOverride.Parent.reference:type_name -> bonanza.model.core.Reference
+	227, // 30: bonanza.model.analysis.ConfiguredAspect.Key.configuration_reference:type_name -> bonanza.model.core.Reference
+	236, // 31: bonanza.model.analysis.ConfiguredAspect.Value.provider_instances:type_name -> bonanza.model.starlark.Struct
+	227, // 32: bonanza.model.analysis.ConfiguredTarget.Key.configuration_reference:type_name -> bonanza.model.core.Reference
+	236, // 33: bonanza.model.analysis.ConfiguredTarget.Value.provider_instances:type_name -> bonanza.model.starlark.Struct
+	237, // 34: bonanza.model.analysis.DirectoryAccessParameters.Value.directory_access_parameters:type_name -> bonanza.model.filesystem.DirectoryAccessParameters
+	238, // 35: bonanza.model.analysis.DirectoryCreationParameters.Value.directory_creation_parameters:type_name -> bonanza.model.filesystem.DirectoryCreationParameters
+	227, // 36: bonanza.model.analysis.ExecTransition.Key.input_configuration_reference:type_name -> bonanza.model.core.Reference
+	227, // 37: bonanza.model.analysis.ExecTransition.Value.output_configuration_reference:type_name -> bonanza.model.core.Reference
+	239, // 38: bonanza.model.analysis.FileAccessParameters.Value.file_access_parameters:type_name -> bonanza.model.filesystem.FileAccessParameters
+	240, // 39: bonanza.model.analysis.FileCreationParameters.Value.file_creation_parameters:type_name -> bonanza.model.filesystem.FileCreationParameters
+	241, // 40: bonanza.model.analysis.FileProperties.Value.exists:type_name -> bonanza.model.filesystem.FileProperties
+	0,   // 41: bonanza.model.analysis.HttpArchiveContents.Key.format:type_name -> bonanza.model.analysis.HttpArchiveContents.Key.Format
+	242, // 42: bonanza.model.analysis.HttpArchiveContents.Value.exists:type_name -> bonanza.model.filesystem.DirectoryReference
+	129, // 43: bonanza.model.analysis.HttpFileContents.Value.exists:type_name -> bonanza.model.analysis.HttpFileContents.Value.Exists
+	243, // 44: bonanza.model.analysis.HttpFileContents.Value.Exists.contents:type_name -> bonanza.model.filesystem.FileContents
+	243, // 45: bonanza.model.analysis.ModuleDotBazelContents.Value.contents:type_name -> bonanza.model.filesystem.FileContents
+	136, // 46: bonanza.model.analysis.ModuleRepoMapping.Value.mappings:type_name -> bonanza.model.analysis.ModuleRepoMapping.Value.Mapping
+	226, // 47: bonanza.model.analysis.ModuleExtensionRepo.Value.definition:type_name -> bonanza.model.starlark.Repo.Definition
+	145, // 48: bonanza.model.analysis.ModuleExtensionRepos.Value.repos:type_name -> bonanza.model.analysis.ModuleExtensionRepos.Value.Repo
+	146, // 49: bonanza.model.analysis.ModuleExtensionRepos.Value.root_module_direct_deps:type_name -> bonanza.model.analysis.ModuleExtensionRepos.Value.RootModuleDirectDeps
+	244, // 50: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.leaf:type_name -> bonanza.model.starlark.Repo
+	147, // 51: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.parent:type_name -> bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.Parent
+	227, // 52: bonanza.model.analysis.ModuleExtensionRepos.Value.Repo.Parent.reference:type_name -> bonanza.model.core.Reference
+	36,  // 53: bonanza.model.analysis.ModuleFinalBuildList.Value.build_list:type_name -> bonanza.model.analysis.BuildListModule
+	56,  // 54: bonanza.model.analysis.ModuleLockfile.Value.registry_file_hashes:type_name -> bonanza.model.analysis.RegistryFileHash
+	245, // 55: bonanza.model.analysis.ModuleQueryResult.Key.query:type_name -> bonanza.model.build.ModuleQuery
+	246, // 56: bonanza.model.analysis.ModuleQueryResult.Value.result:type_name -> bonanza.model.build.ModuleQueryResult
+	36,  // 57: bonanza.model.analysis.ModuleRoughBuildList.Value.build_list:type_name -> bonanza.model.analysis.BuildListModule
+	56,  // 58: bonanza.model.analysis.ModuleRoughBuildList.Value.registry_file_hashes:type_name -> bonanza.model.analysis.RegistryFileHash
+	41,  // 59: bonanza.model.analysis.ModulesWithMultipleVersions.Value.overrides_list:type_name -> bonanza.model.analysis.OverridesListModule
+	41,  // 60: bonanza.model.analysis.ModulesWithOverrides.Value.overrides_list:type_name -> bonanza.model.analysis.OverridesListModule
+	45,  // 61: bonanza.model.analysis.ModulesWithRemoteOverrides.Value.module_overrides:type_name -> bonanza.model.analysis.ModuleOverride
+	167, // 62: bonanza.model.analysis.Package.Value.targets:type_name -> bonanza.model.analysis.Package.Value.Target
+	247, // 63: bonanza.model.analysis.Package.Value.Target.leaf:type_name -> bonanza.model.starlark.Target
+	168, // 64: bonanza.model.analysis.Package.Value.Target.parent:type_name -> bonanza.model.analysis.Package.Value.Target.Parent
+	227, // 65: bonanza.model.analysis.Package.Value.Target.Parent.reference:type_name -> bonanza.model.core.Reference
+	50,  // 66: bonanza.model.analysis.RegisteredExecutionPlatforms.Value.execution_platforms:type_name -> bonanza.model.analysis.ExecutionPlatform
+	175, // 67: bonanza.model.analysis.RegisteredRepoPlatform.Value.repository_os_environ:type_name -> bonanza.model.analysis.RegisteredRepoPlatform.Value.EnvironmentVariable
+	178, // 68: bonanza.model.analysis.RegisteredToolchains.Value.toolchain_types:type_name -> bonanza.model.analysis.RegisteredToolchains.Value.RegisteredToolchainType
+	53,  // 69: bonanza.model.analysis.RegisteredToolchains.Value.RegisteredToolchainType.toolchains:type_name -> bonanza.model.analysis.RegisteredToolchain
+	53,  // 70: bonanza.model.analysis.RegisteredToolchainsForType.Value.toolchains:type_name -> bonanza.model.analysis.RegisteredToolchain
+	242, // 71: bonanza.model.analysis.Repo.Value.root_directory_reference:type_name -> bonanza.model.filesystem.DirectoryReference
+	248, // 72: bonanza.model.analysis.RepoDefaultAttrs.Value.inheritable_attrs:type_name -> bonanza.model.starlark.InheritableAttrs
+	49,  // 73: bonanza.model.analysis.ResolvedToolchains.Key.exec_compatible_with:type_name -> bonanza.model.analysis.Constraint
+	227, // 74: bonanza.model.analysis.ResolvedToolchains.Key.configuration_reference:type_name -> bonanza.model.core.Reference
+	249, // 75: bonanza.model.analysis.ResolvedToolchains.Key.toolchains:type_name -> bonanza.model.starlark.ToolchainType
+	50,  // 76: bonanza.model.analysis.ResolvedToolchains.Value.execution_platform:type_name -> bonanza.model.analysis.ExecutionPlatform
+	250, // 77: bonanza.model.analysis.Target.Value.definition:type_name -> bonanza.model.starlark.Target.Definition
+	227, // 78: bonanza.model.analysis.TargetCompletion.Key.configuration_reference:type_name -> bonanza.model.core.Reference
+	227, // 79: bonanza.model.analysis.TargetCompatibility.Key.configuration_reference:type_name -> bonanza.model.core.Reference
+	204, // 80: bonanza.model.analysis.TargetPatternExpansion.Value.target_labels:type_name -> bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel
+	205, // 81: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.parent:type_name -> bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.Parent
+	227, // 82: bonanza.model.analysis.TargetPatternExpansion.Value.TargetLabel.Parent.reference:type_name -> bonanza.model.core.Reference
+	227, // 83: bonanza.model.analysis.TargetPlatformConstraints.Key.configuration_reference:type_name -> bonanza.model.core.Reference
+	49,  // 84: bonanza.model.analysis.TargetPlatformConstraints.Value.constraints:type_name -> bonanza.model.analysis.Constraint
+	209, // 85: bonanza.model.analysis.ModuleExtension.User.tag_classes:type_name -> bonanza.model.analysis.ModuleExtension.TagClass
+	210, // 86: bonanza.model.analysis.ModuleExtension.TagClass.tags:type_name -> bonanza.model.analysis.ModuleExtension.Tag
+	251, // 87: bonanza.model.analysis.ModuleExtension.Tag.attrs:type_name -> bonanza.model.starlark.Struct.Fields
+	70,  // 88: bonanza.model.analysis.UsedModuleExtension.Value.module_extension:type_name -> bonanza.model.analysis.ModuleExtension
+	70,  // 89: bonanza.model.analysis.UsedModuleExtensions.Value.module_extensions:type_name -> bonanza.model.analysis.ModuleExtension
+	71,  // 90: bonanza.model.analysis.UsedModuleExtensions.Value.module_extension_imports:type_name -> bonanza.model.analysis.ModuleExtensionImports
+	227, // 91: bonanza.model.analysis.UserDefinedTransition.Key.input_configuration_reference:type_name -> bonanza.model.core.Reference
+	221, // 92: bonanza.model.analysis.UserDefinedTransition.Key.attr_values:type_name -> bonanza.model.analysis.UserDefinedTransition.Key.AttrValue
+	222, // 93: bonanza.model.analysis.UserDefinedTransition.Value.success:type_name -> bonanza.model.analysis.UserDefinedTransition.Value.Success
+	235, // 94: bonanza.model.analysis.UserDefinedTransition.Key.AttrValue.value_parts:type_name -> bonanza.model.starlark.Value
+	223, // 95: bonanza.model.analysis.UserDefinedTransition.Value.Success.entries:type_name -> bonanza.model.analysis.UserDefinedTransition.Value.Success.Entry
+	227, // 96: bonanza.model.analysis.UserDefinedTransition.Value.Success.Entry.output_configuration_reference:type_name -> bonanza.model.core.Reference
+	227, // 97: bonanza.model.analysis.VisibleTarget.Key.configuration_reference:type_name -> bonanza.model.core.Reference
+	98,  // [98:98] is the sub-list for method output_type
+	98,  // [98:98] is the sub-list for method input_type
+	98,  // [98:98] is the sub-list for extension type_name
+	98,  // [98:98] is the sub-list for extension extendee
+	0,   // [0:98] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_model_analysis_analysis_proto_init() }
@@ -9462,29 +11492,29 @@ func file_pkg_proto_model_analysis_analysis_proto_init() {
 	if File_pkg_proto_model_analysis_analysis_proto != nil {
 		return
 	}
-	file_pkg_proto_model_analysis_analysis_proto_msgTypes[38].OneofWrappers = []any{
+	file_pkg_proto_model_analysis_analysis_proto_msgTypes[44].OneofWrappers = []any{
 		(*ModuleOverride_SingleVersion_)(nil),
 		(*ModuleOverride_MultipleVersions_)(nil),
 		(*ModuleOverride_RepositoryRule)(nil),
 	}
-	file_pkg_proto_model_analysis_analysis_proto_msgTypes[87].OneofWrappers = []any{
+	file_pkg_proto_model_analysis_analysis_proto_msgTypes[102].OneofWrappers = []any{
 		(*Configuration_BuildSettingOverride_Leaf_)(nil),
 		(*Configuration_BuildSettingOverride_Parent_)(nil),
 	}
-	file_pkg_proto_model_analysis_analysis_proto_msgTypes[123].OneofWrappers = []any{
+	file_pkg_proto_model_analysis_analysis_proto_msgTypes[144].OneofWrappers = []any{
 		(*ModuleExtensionRepos_Value_Repo_Leaf)(nil),
 		(*ModuleExtensionRepos_Value_Repo_Parent_)(nil),
 	}
-	file_pkg_proto_model_analysis_analysis_proto_msgTypes[140].OneofWrappers = []any{
+	file_pkg_proto_model_analysis_analysis_proto_msgTypes[166].OneofWrappers = []any{
 		(*Package_Value_Target_Leaf)(nil),
 		(*Package_Value_Target_Parent_)(nil),
 	}
-	file_pkg_proto_model_analysis_analysis_proto_msgTypes[171].OneofWrappers = []any{
+	file_pkg_proto_model_analysis_analysis_proto_msgTypes[203].OneofWrappers = []any{
 		(*TargetPatternExpansion_Value_TargetLabel_Leaf)(nil),
 		(*TargetPatternExpansion_Value_TargetLabel_Parent_)(nil),
 	}
-	file_pkg_proto_model_analysis_analysis_proto_msgTypes[182].OneofWrappers = []any{
-		(*UserDefinedTransition_Value_TransitionDependsOnAttrs)(nil),
+	file_pkg_proto_model_analysis_analysis_proto_msgTypes[219].OneofWrappers = []any{
+		(*UserDefinedTransition_Value_MissingAttr)(nil),
 		(*UserDefinedTransition_Value_Success_)(nil),
 	}
 	type x struct{}
@@ -9493,7 +11523,7 @@ func file_pkg_proto_model_analysis_analysis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_model_analysis_analysis_proto_rawDesc), len(file_pkg_proto_model_analysis_analysis_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   187,
+			NumMessages:   225,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
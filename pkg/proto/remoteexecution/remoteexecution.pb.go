@@ -150,6 +150,58 @@ func (x *ExecuteRequest) GetPriority() int32 {
 	return 0
 }
 
+type RequestMetadata struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	ToolInvocationId        string                 `protobuf:"bytes,1,opt,name=tool_invocation_id,json=toolInvocationId,proto3" json:"tool_invocation_id,omitempty"`
+	CorrelatedInvocationsId string                 `protobuf:"bytes,2,opt,name=correlated_invocations_id,json=correlatedInvocationsId,proto3" json:"correlated_invocations_id,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RequestMetadata) Reset() {
+	*x = RequestMetadata{}
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMetadata) ProtoMessage() {}
+
+func (x *RequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMetadata.ProtoReflect.Descriptor instead.
+func (*RequestMetadata) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RequestMetadata) GetToolInvocationId() string {
+	if x != nil {
+		return x.ToolInvocationId
+	}
+	return ""
+}
+
+func (x *RequestMetadata) GetCorrelatedInvocationsId() string {
+	if x != nil {
+		return x.CorrelatedInvocationsId
+	}
+	return ""
+}
+
 type WaitExecutionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -159,7 +211,7 @@ type WaitExecutionRequest struct {
 
 func (x *WaitExecutionRequest) Reset() {
 	*x = WaitExecutionRequest{}
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[2]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -171,7 +223,7 @@ func (x *WaitExecutionRequest) String() string {
 func (*WaitExecutionRequest) ProtoMessage() {}
 
 func (x *WaitExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[2]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -184,7 +236,7 @@ func (x *WaitExecutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitExecutionRequest.ProtoReflect.Descriptor instead.
 func (*WaitExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{2}
+	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *WaitExecutionRequest) GetName() string {
@@ -204,7 +256,7 @@ type ExecutionEvent struct {
 
 func (x *ExecutionEvent) Reset() {
 	*x = ExecutionEvent{}
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[3]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -216,7 +268,7 @@ func (x *ExecutionEvent) String() string {
 func (*ExecutionEvent) ProtoMessage() {}
 
 func (x *ExecutionEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[3]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -229,7 +281,7 @@ func (x *ExecutionEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutionEvent.ProtoReflect.Descriptor instead.
 func (*ExecutionEvent) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{3}
+	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ExecutionEvent) GetNonce() []byte {
@@ -261,7 +313,7 @@ type ExecuteResponse struct {
 
 func (x *ExecuteResponse) Reset() {
 	*x = ExecuteResponse{}
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[4]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -273,7 +325,7 @@ func (x *ExecuteResponse) String() string {
 func (*ExecuteResponse) ProtoMessage() {}
 
 func (x *ExecuteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[4]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -286,7 +338,7 @@ func (x *ExecuteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteResponse.ProtoReflect.Descriptor instead.
 func (*ExecuteResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{4}
+	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ExecuteResponse) GetName() string {
@@ -362,7 +414,7 @@ type Action_AdditionalData struct {
 
 func (x *Action_AdditionalData) Reset() {
 	*x = Action_AdditionalData{}
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[5]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -374,7 +426,7 @@ func (x *Action_AdditionalData) String() string {
 func (*Action_AdditionalData) ProtoMessage() {}
 
 func (x *Action_AdditionalData) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[5]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +464,7 @@ type ExecuteResponse_Queued struct {
 
 func (x *ExecuteResponse_Queued) Reset() {
 	*x = ExecuteResponse_Queued{}
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[6]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -424,7 +476,7 @@ func (x *ExecuteResponse_Queued) String() string {
 func (*ExecuteResponse_Queued) ProtoMessage() {}
 
 func (x *ExecuteResponse_Queued) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[6]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +489,7 @@ func (x *ExecuteResponse_Queued) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteResponse_Queued.ProtoReflect.Descriptor instead.
 func (*ExecuteResponse_Queued) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{4, 0}
+	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type ExecuteResponse_Executing struct {
@@ -449,7 +501,7 @@ type ExecuteResponse_Executing struct {
 
 func (x *ExecuteResponse_Executing) Reset() {
 	*x = ExecuteResponse_Executing{}
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[7]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -461,7 +513,7 @@ func (x *ExecuteResponse_Executing) String() string {
 func (*ExecuteResponse_Executing) ProtoMessage() {}
 
 func (x *ExecuteResponse_Executing) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[7]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,7 +526,7 @@ func (x *ExecuteResponse_Executing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteResponse_Executing.ProtoReflect.Descriptor instead.
 func (*ExecuteResponse_Executing) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{4, 1}
+	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{5, 1}
 }
 
 func (x *ExecuteResponse_Executing) GetLastEvent() *ExecutionEvent {
@@ -493,7 +545,7 @@ type ExecuteResponse_Completed struct {
 
 func (x *ExecuteResponse_Completed) Reset() {
 	*x = ExecuteResponse_Completed{}
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[8]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -505,7 +557,7 @@ func (x *ExecuteResponse_Completed) String() string {
 func (*ExecuteResponse_Completed) ProtoMessage() {}
 
 func (x *ExecuteResponse_Completed) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[8]
+	mi := &file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +570,7 @@ func (x *ExecuteResponse_Completed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteResponse_Completed.ProtoReflect.Descriptor instead.
 func (*ExecuteResponse_Completed) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{4, 2}
+	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP(), []int{5, 2}
 }
 
 func (x *ExecuteResponse_Completed) GetCompletionEvent() *ExecutionEvent {
@@ -569,62 +621,70 @@ var file_pkg_proto_remoteexecution_remoteexecution_proto_rawDesc = string([]byte
 	0x6d, 0x6f, 0x74, 0x65, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
 	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x2a, 0x0a, 0x14, 0x57, 0x61, 0x69, 0x74,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe1, 0x03, 0x0a,
-	0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12,
-	0x52, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x52, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x7b, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x12, 0x74,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x76,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x14, 0x57, 0x61, 0x69, 0x74, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x46, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x69, 0x70,
+	0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63,
+	0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe1, 0x03, 0x0a, 0x0f, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x49, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x09,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x52, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x1a, 0x08, 0x0a, 0x06, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x1a, 0x53,
+	0x0a, 0x09, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x0a, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x1a, 0x5f, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x12, 0x52, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e,
+	0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x32, 0xd7, 0x01,
+	0x0a, 0x09, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x07, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61,
 	0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x1a, 0x08, 0x0a, 0x06, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x64, 0x1a, 0x53, 0x0a, 0x09, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x46,
-	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x6c, 0x61, 0x73,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x5f, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65,
-	0x32, 0xd7, 0x01, 0x0a, 0x09, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e,
-	0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x62, 0x6f, 0x6e, 0x61,
-	0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x6a,
-	0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2d, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
-	0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61,
-	0x72, 0x6e, 0x2f, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x0d, 0x57,
+	0x61, 0x69, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x62,
+	0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x6f,
+	0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f,
+	0x62, 0x6f, 0x6e, 0x61, 0x6e, 0x7a, 0x61, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -639,32 +699,33 @@ func file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_remoteexecution_remoteexecution_proto_rawDescData
 }
 
-var file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_pkg_proto_remoteexecution_remoteexecution_proto_goTypes = []any{
 	(*Action)(nil),                    // 0: bonanza.remoteexecution.Action
 	(*ExecuteRequest)(nil),            // 1: bonanza.remoteexecution.ExecuteRequest
-	(*WaitExecutionRequest)(nil),      // 2: bonanza.remoteexecution.WaitExecutionRequest
-	(*ExecutionEvent)(nil),            // 3: bonanza.remoteexecution.ExecutionEvent
-	(*ExecuteResponse)(nil),           // 4: bonanza.remoteexecution.ExecuteResponse
-	(*Action_AdditionalData)(nil),     // 5: bonanza.remoteexecution.Action.AdditionalData
-	(*ExecuteResponse_Queued)(nil),    // 6: bonanza.remoteexecution.ExecuteResponse.Queued
-	(*ExecuteResponse_Executing)(nil), // 7: bonanza.remoteexecution.ExecuteResponse.Executing
-	(*ExecuteResponse_Completed)(nil), // 8: bonanza.remoteexecution.ExecuteResponse.Completed
-	(*durationpb.Duration)(nil),       // 9: google.protobuf.Duration
+	(*RequestMetadata)(nil),           // 2: bonanza.remoteexecution.RequestMetadata
+	(*WaitExecutionRequest)(nil),      // 3: bonanza.remoteexecution.WaitExecutionRequest
+	(*ExecutionEvent)(nil),            // 4: bonanza.remoteexecution.ExecutionEvent
+	(*ExecuteResponse)(nil),           // 5: bonanza.remoteexecution.ExecuteResponse
+	(*Action_AdditionalData)(nil),     // 6: bonanza.remoteexecution.Action.AdditionalData
+	(*ExecuteResponse_Queued)(nil),    // 7: bonanza.remoteexecution.ExecuteResponse.Queued
+	(*ExecuteResponse_Executing)(nil), // 8: bonanza.remoteexecution.ExecuteResponse.Executing
+	(*ExecuteResponse_Completed)(nil), // 9: bonanza.remoteexecution.ExecuteResponse.Completed
+	(*durationpb.Duration)(nil),       // 10: google.protobuf.Duration
 }
 var file_pkg_proto_remoteexecution_remoteexecution_proto_depIdxs = []int32{
-	5,  // 0: bonanza.remoteexecution.Action.additional_data:type_name -> bonanza.remoteexecution.Action.AdditionalData
+	6,  // 0: bonanza.remoteexecution.Action.additional_data:type_name -> bonanza.remoteexecution.Action.AdditionalData
 	0,  // 1: bonanza.remoteexecution.ExecuteRequest.action:type_name -> bonanza.remoteexecution.Action
-	6,  // 2: bonanza.remoteexecution.ExecuteResponse.queued:type_name -> bonanza.remoteexecution.ExecuteResponse.Queued
-	7,  // 3: bonanza.remoteexecution.ExecuteResponse.executing:type_name -> bonanza.remoteexecution.ExecuteResponse.Executing
-	8,  // 4: bonanza.remoteexecution.ExecuteResponse.completed:type_name -> bonanza.remoteexecution.ExecuteResponse.Completed
-	9,  // 5: bonanza.remoteexecution.Action.AdditionalData.execution_timeout:type_name -> google.protobuf.Duration
-	3,  // 6: bonanza.remoteexecution.ExecuteResponse.Executing.last_event:type_name -> bonanza.remoteexecution.ExecutionEvent
-	3,  // 7: bonanza.remoteexecution.ExecuteResponse.Completed.completion_event:type_name -> bonanza.remoteexecution.ExecutionEvent
+	7,  // 2: bonanza.remoteexecution.ExecuteResponse.queued:type_name -> bonanza.remoteexecution.ExecuteResponse.Queued
+	8,  // 3: bonanza.remoteexecution.ExecuteResponse.executing:type_name -> bonanza.remoteexecution.ExecuteResponse.Executing
+	9,  // 4: bonanza.remoteexecution.ExecuteResponse.completed:type_name -> bonanza.remoteexecution.ExecuteResponse.Completed
+	10, // 5: bonanza.remoteexecution.Action.AdditionalData.execution_timeout:type_name -> google.protobuf.Duration
+	4,  // 6: bonanza.remoteexecution.ExecuteResponse.Executing.last_event:type_name -> bonanza.remoteexecution.ExecutionEvent
+	4,  // 7: bonanza.remoteexecution.ExecuteResponse.Completed.completion_event:type_name -> bonanza.remoteexecution.ExecutionEvent
 	1,  // 8: bonanza.remoteexecution.Execution.Execute:input_type -> bonanza.remoteexecution.ExecuteRequest
-	2,  // 9: bonanza.remoteexecution.Execution.WaitExecution:input_type -> bonanza.remoteexecution.WaitExecutionRequest
-	4,  // 10: bonanza.remoteexecution.Execution.Execute:output_type -> bonanza.remoteexecution.ExecuteResponse
-	4,  // 11: bonanza.remoteexecution.Execution.WaitExecution:output_type -> bonanza.remoteexecution.ExecuteResponse
+	3,  // 9: bonanza.remoteexecution.Execution.WaitExecution:input_type -> bonanza.remoteexecution.WaitExecutionRequest
+	5,  // 10: bonanza.remoteexecution.Execution.Execute:output_type -> bonanza.remoteexecution.ExecuteResponse
+	5,  // 11: bonanza.remoteexecution.Execution.WaitExecution:output_type -> bonanza.remoteexecution.ExecuteResponse
 	10, // [10:12] is the sub-list for method output_type
 	8,  // [8:10] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
@@ -677,7 +738,7 @@ func file_pkg_proto_remoteexecution_remoteexecution_proto_init() {
 	if File_pkg_proto_remoteexecution_remoteexecution_proto != nil {
 		return
 	}
-	file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[4].OneofWrappers = []any{
+	file_pkg_proto_remoteexecution_remoteexecution_proto_msgTypes[5].OneofWrappers = []any{
 		(*ExecuteResponse_Queued_)(nil),
 		(*ExecuteResponse_Executing_)(nil),
 		(*ExecuteResponse_Completed_)(nil),
@@ -688,7 +749,7 @@ func file_pkg_proto_remoteexecution_remoteexecution_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_remoteexecution_remoteexecution_proto_rawDesc), len(file_pkg_proto_remoteexecution_remoteexecution_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
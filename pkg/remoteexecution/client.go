@@ -7,7 +7,9 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"iter"
+	"time"
 
+	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/util"
 	remoteexecution_pb "github.com/buildbarn/bonanza/pkg/proto/remoteexecution"
 	"github.com/secure-io/siv-go"
@@ -30,6 +32,7 @@ type Client[
 	executionClient        remoteexecution_pb.ExecutionClient
 	clientPrivateKey       *ecdh.PrivateKey
 	clientCertificateChain [][]byte
+	clock                  clock.Clock
 }
 
 func NewClient[
@@ -44,15 +47,42 @@ func NewClient[
 	executionClient remoteexecution_pb.ExecutionClient,
 	clientPrivateKey *ecdh.PrivateKey,
 	clientCertificateChain [][]byte,
+	clock clock.Clock,
 ) *Client[TAction, TEvent, TEventPtr, TResult] {
 	return &Client[TAction, TEvent, TEventPtr, TResult]{
 		executionClient:        executionClient,
 		clientPrivateKey:       clientPrivateKey,
 		clientCertificateChain: clientCertificateChain,
+		clock:                  clock,
 	}
 }
 
-func (c *Client[TAction, TEvent, TEventPtr, TResult]) RunAction(ctx context.Context, platformECDHPublicKey *ecdh.PublicKey, action TAction, actionAdditionalData *remoteexecution_pb.Action_AdditionalData, result TResult, errOut *error) iter.Seq[TEventPtr] {
+// Operation contains the properties of an action that has been
+// submitted to the scheduler that are needed to reattach to it.
+type Operation struct {
+	// The name of the operation, as assigned by the scheduler.
+	Name string
+
+	// The public key of the platform on which the action executes.
+	PlatformECDHPublicKey *ecdh.PublicKey
+
+	// SHA-256 hash of the encrypted action. Execution events and the
+	// completion event are encrypted using this hash as additional
+	// data.
+	ActionCiphertextDigest [sha256.Size]byte
+}
+
+// RunAction submits an action to the scheduler for execution, yielding
+// any execution events that are posted by the worker while the action
+// executes. Upon completion, the completion event is stored in result.
+//
+// The priority is forwarded to the scheduler as is. Its interpretation
+// is up to the scheduler, where a priority of zero denotes the default.
+//
+// If provided, onOperationCreated is called as soon as the scheduler
+// has assigned a name to the operation, so that the caller may reattach
+// to it later on using WaitAction().
+func (c *Client[TAction, TEvent, TEventPtr, TResult]) RunAction(ctx context.Context, platformECDHPublicKey *ecdh.PublicKey, action TAction, actionAdditionalData *remoteexecution_pb.Action_AdditionalData, priority int32, onOperationCreated func(operation *Operation), result TResult, errOut *error) iter.Seq[TEventPtr] {
 	marshaledPlatformECDHPublicKey, err := x509.MarshalPKIXPublicKey(platformECDHPublicKey)
 	if err != nil {
 		*errOut = util.StatusWrapfWithCode(err, codes.InvalidArgument, "Failed to obtain marshal platform ECDH public key")
@@ -96,112 +126,223 @@ func (c *Client[TAction, TEvent, TEventPtr, TResult]) RunAction(ctx context.Cont
 	}
 	actionCiphertext := actionAEAD.Seal(nil, actionNonce, actionData, marshaledActionAdditionalData)
 
+	request := &remoteexecution_pb.ExecuteRequest{
+		Action: &remoteexecution_pb.Action{
+			PlatformPkixPublicKey:  marshaledPlatformECDHPublicKey,
+			ClientCertificateChain: c.clientCertificateChain,
+			Nonce:                  actionNonce,
+			AdditionalData:         actionAdditionalData,
+			Ciphertext:             actionCiphertext,
+		},
+		Priority: priority,
+	}
+	operation := Operation{
+		PlatformECDHPublicKey:  platformECDHPublicKey,
+		ActionCiphertextDigest: sha256.Sum256(actionCiphertext),
+	}
 	return func(yield func(TEventPtr) bool) {
-		ctxWithCancel, cancel := context.WithCancel(ctx)
-		defer cancel()
-		client, err := c.executionClient.Execute(ctxWithCancel, &remoteexecution_pb.ExecuteRequest{
-			Action: &remoteexecution_pb.Action{
-				PlatformPkixPublicKey:  marshaledPlatformECDHPublicKey,
-				ClientCertificateChain: c.clientCertificateChain,
-				Nonce:                  actionNonce,
-				AdditionalData:         actionAdditionalData,
-				Ciphertext:             actionCiphertext,
+		c.waitForOperation(
+			ctx,
+			func(ctx context.Context) (remoteexecution_pb.Execution_ExecuteClient, error) {
+				return c.executionClient.Execute(ctx, request)
 			},
-			// TODO: Priority.
-		})
-		if err != nil {
+			&operation,
+			sharedSecret,
+			onOperationCreated,
+			result,
+			errOut,
+			yield,
+		)
+	}
+}
+
+// WaitAction reattaches to an action that was previously submitted
+// using RunAction(). This can be used to continue monitoring an action
+// after the process that submitted it got disconnected.
+func (c *Client[TAction, TEvent, TEventPtr, TResult]) WaitAction(ctx context.Context, operation *Operation, result TResult, errOut *error) iter.Seq[TEventPtr] {
+	sharedSecret, err := c.clientPrivateKey.ECDH(operation.PlatformECDHPublicKey)
+	if err != nil {
+		*errOut = util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to obtain shared secret")
+		return func(func(TEventPtr) bool) {}
+	}
+	request := &remoteexecution_pb.WaitExecutionRequest{
+		Name: operation.Name,
+	}
+	return func(yield func(TEventPtr) bool) {
+		c.waitForOperation(
+			ctx,
+			func(ctx context.Context) (remoteexecution_pb.Execution_ExecuteClient, error) {
+				return c.executionClient.WaitExecution(ctx, request)
+			},
+			operation,
+			sharedSecret,
+			/* onOperationCreated = */ nil,
+			result,
+			errOut,
+			yield,
+		)
+	}
+}
+
+// maximumReconnectAttempts is the maximum number of times
+// waitForOperation() attempts to reattach to an operation after the
+// connection to the scheduler was lost, without receiving any
+// responses in between.
+const maximumReconnectAttempts = 5
+
+// waitForOperation processes the stream of responses returned by
+// Execute() or WaitExecution(), yielding execution events and storing
+// the completion event in the result. If the stream fails with a
+// transient error after the scheduler assigned a name to the
+// operation, WaitExecution() is called to reattach to it.
+func (c *Client[TAction, TEvent, TEventPtr, TResult]) waitForOperation(
+	ctx context.Context,
+	startStream func(ctx context.Context) (remoteexecution_pb.Execution_ExecuteClient, error),
+	operation *Operation,
+	sharedSecret []byte,
+	onOperationCreated func(operation *Operation),
+	result TResult,
+	errOut *error,
+	yield func(TEventPtr) bool,
+) {
+	reconnectAttempts := 0
+	for {
+		completed, receivedResponses, err := c.processExecuteResponses(ctx, startStream, operation, sharedSecret, onOperationCreated, result, yield)
+		if completed {
 			*errOut = err
 			return
 		}
-		defer func() {
-			cancel()
-			for {
-				if _, err := client.Recv(); err != nil {
-					return
-				}
-			}
-		}()
+		if receivedResponses {
+			reconnectAttempts = 0
+		}
+		if operation.Name == "" || status.Code(err) != codes.Unavailable || reconnectAttempts >= maximumReconnectAttempts {
+			*errOut = err
+			return
+		}
+
+		// Transient failure. Reattach to the operation after
+		// backing off.
+		reconnectAttempts++
+		timer, t := c.clock.NewTimer(time.Duration(reconnectAttempts) * time.Second)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			*errOut = util.StatusFromContext(ctx)
+			return
+		case <-t:
+		}
+		request := &remoteexecution_pb.WaitExecutionRequest{
+			Name: operation.Name,
+		}
+		startStream = func(ctx context.Context) (remoteexecution_pb.Execution_ExecuteClient, error) {
+			return c.executionClient.WaitExecution(ctx, request)
+		}
+	}
+}
 
-		executionEventAdditionalData := sha256.Sum256(actionCiphertext)
+// processExecuteResponses processes the responses returned by a single
+// call to Execute() or WaitExecution(). It returns whether processing
+// has completed, either because the action completed or because the
+// caller stopped iterating.
+func (c *Client[TAction, TEvent, TEventPtr, TResult]) processExecuteResponses(
+	ctx context.Context,
+	startStream func(ctx context.Context) (remoteexecution_pb.Execution_ExecuteClient, error),
+	operation *Operation,
+	sharedSecret []byte,
+	onOperationCreated func(operation *Operation),
+	result TResult,
+	yield func(TEventPtr) bool,
+) (bool, bool, error) {
+	ctxWithCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+	client, err := startStream(ctxWithCancel)
+	if err != nil {
+		return false, false, err
+	}
+	defer func() {
+		cancel()
 		for {
-			response, err := client.Recv()
-			if err != nil {
-				*errOut = err
+			if _, err := client.Recv(); err != nil {
 				return
 			}
+		}
+	}()
 
-			switch stage := response.Stage.(type) {
-			case *remoteexecution_pb.ExecuteResponse_Executing_:
-				// Worker has posted an execution event.
-				// Unmarshal it and yield it to the
-				// caller.
-				if lastEventMessage := stage.Executing.LastEvent; lastEventMessage != nil {
-					lastEventKey := append([]byte(nil), sharedSecret...)
-					lastEventKey[0] ^= 2
-					completionEventAEAD, err := siv.NewGCM(lastEventKey)
-					if err != nil {
-						*errOut = util.StatusWrapWithCode(err, codes.Internal, "Failed to create AES-GCM-SIV for last event")
-						return
-					}
-
-					lastEventData, err := completionEventAEAD.Open(
-						/* dst = */ nil,
-						lastEventMessage.Nonce,
-						lastEventMessage.Ciphertext,
-						executionEventAdditionalData[:],
-					)
-					if err != nil {
-						*errOut = util.StatusWrapWithCode(err, codes.Internal, "Failed to decrypt last event")
-						return
-					}
-
-					var lastEvent TEvent
-					if err := proto.Unmarshal(lastEventData, TEventPtr(&lastEvent)); err != nil {
-						*errOut = util.StatusWrapWithCode(err, codes.Internal, "Failed to unmarshal last event")
-						return
-					}
-
-					if !yield(&lastEvent) {
-						*errOut = nil
-						return
-					}
-				}
-			case *remoteexecution_pb.ExecuteResponse_Completed_:
-				// Action has completed. Unmarshal and
-				// return the completion event.
-				completionEventMessage := stage.Completed.CompletionEvent
-				if completionEventMessage == nil {
-					*errOut = status.Error(codes.Internal, "Action completed, but no completion event was returned")
-					return
-				}
+	receivedResponses := false
+	for {
+		response, err := client.Recv()
+		if err != nil {
+			return false, receivedResponses, err
+		}
+		receivedResponses = true
 
-				completionEventKey := append([]byte(nil), sharedSecret...)
-				completionEventKey[0] ^= 3
-				completionEventAEAD, err := siv.NewGCM(completionEventKey)
+		if operation.Name == "" && response.Name != "" {
+			operation.Name = response.Name
+			if onOperationCreated != nil {
+				onOperationCreated(operation)
+			}
+		}
+
+		switch stage := response.Stage.(type) {
+		case *remoteexecution_pb.ExecuteResponse_Executing_:
+			// Worker has posted an execution event.
+			// Unmarshal it and yield it to the caller.
+			if lastEventMessage := stage.Executing.LastEvent; lastEventMessage != nil {
+				lastEventKey := append([]byte(nil), sharedSecret...)
+				lastEventKey[0] ^= 2
+				completionEventAEAD, err := siv.NewGCM(lastEventKey)
 				if err != nil {
-					*errOut = util.StatusWrapWithCode(err, codes.Internal, "Failed to create AES-GCM-SIV for completion event")
-					return
+					return true, receivedResponses, util.StatusWrapWithCode(err, codes.Internal, "Failed to create AES-GCM-SIV for last event")
 				}
 
-				completionEventData, err := completionEventAEAD.Open(
+				lastEventData, err := completionEventAEAD.Open(
 					/* dst = */ nil,
-					completionEventMessage.Nonce,
-					completionEventMessage.Ciphertext,
-					executionEventAdditionalData[:],
+					lastEventMessage.Nonce,
+					lastEventMessage.Ciphertext,
+					operation.ActionCiphertextDigest[:],
 				)
 				if err != nil {
-					*errOut = util.StatusWrapWithCode(err, codes.Internal, "Failed to decrypt completion event")
-					return
+					return true, receivedResponses, util.StatusWrapWithCode(err, codes.Internal, "Failed to decrypt last event")
 				}
 
-				if err := proto.Unmarshal(completionEventData, result); err != nil {
-					*errOut = util.StatusWrapWithCode(err, codes.Internal, "Failed to unmarshal completion event")
-					return
+				var lastEvent TEvent
+				if err := proto.Unmarshal(lastEventData, TEventPtr(&lastEvent)); err != nil {
+					return true, receivedResponses, util.StatusWrapWithCode(err, codes.Internal, "Failed to unmarshal last event")
 				}
 
-				*errOut = nil
-				return
+				if !yield(&lastEvent) {
+					return true, receivedResponses, nil
+				}
+			}
+		case *remoteexecution_pb.ExecuteResponse_Completed_:
+			// Action has completed. Unmarshal and return
+			// the completion event.
+			completionEventMessage := stage.Completed.CompletionEvent
+			if completionEventMessage == nil {
+				return true, receivedResponses, status.Error(codes.Internal, "Action completed, but no completion event was returned")
+			}
+
+			completionEventKey := append([]byte(nil), sharedSecret...)
+			completionEventKey[0] ^= 3
+			completionEventAEAD, err := siv.NewGCM(completionEventKey)
+			if err != nil {
+				return true, receivedResponses, util.StatusWrapWithCode(err, codes.Internal, "Failed to create AES-GCM-SIV for completion event")
+			}
+
+			completionEventData, err := completionEventAEAD.Open(
+				/* dst = */ nil,
+				completionEventMessage.Nonce,
+				completionEventMessage.Ciphertext,
+				operation.ActionCiphertextDigest[:],
+			)
+			if err != nil {
+				return true, receivedResponses, util.StatusWrapWithCode(err, codes.Internal, "Failed to decrypt completion event")
+			}
+
+			if err := proto.Unmarshal(completionEventData, result); err != nil {
+				return true, receivedResponses, util.StatusWrapWithCode(err, codes.Internal, "Failed to unmarshal completion event")
 			}
+			return true, receivedResponses, nil
 		}
 	}
 }
@@ -0,0 +1,293 @@
+package remoteexecution_test
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	remoteexecution_pb "github.com/buildbarn/bonanza/pkg/proto/remoteexecution"
+	"github.com/buildbarn/bonanza/pkg/remoteexecution"
+	"github.com/secure-io/siv-go"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	clientPrivateKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	platformPrivateKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sharedSecret, err := platformPrivateKey.ECDH(clientPrivateKey.PublicKey())
+	require.NoError(t, err)
+
+	operation := remoteexecution.Operation{
+		Name:                   "2c6a3d4e-ba0c-4f8e-9d39-4a0c4f3c2f52",
+		PlatformECDHPublicKey:  platformPrivateKey.PublicKey(),
+		ActionCiphertextDigest: sha256.Sum256([]byte("Action ciphertext")),
+	}
+	waitExecutionRequest := &remoteexecution_pb.WaitExecutionRequest{
+		Name: operation.Name,
+	}
+
+	// seal encrypts an execution event in the same way as the
+	// worker does, using a key derived from the shared secret.
+	seal := func(keyModifier byte, message proto.Message) *remoteexecution_pb.ExecutionEvent {
+		key := append([]byte(nil), sharedSecret...)
+		key[0] ^= keyModifier
+		aead, err := siv.NewGCM(key)
+		require.NoError(t, err)
+		data, err := proto.Marshal(message)
+		require.NoError(t, err)
+		nonce := make([]byte, aead.NonceSize())
+		return &remoteexecution_pb.ExecutionEvent{
+			Nonce:      nonce,
+			Ciphertext: aead.Seal(nil, nonce, data, operation.ActionCiphertextDigest[:]),
+		}
+	}
+	executingResponse := func(event string) *remoteexecution_pb.ExecuteResponse {
+		return &remoteexecution_pb.ExecuteResponse{
+			Name: operation.Name,
+			Stage: &remoteexecution_pb.ExecuteResponse_Executing_{
+				Executing: &remoteexecution_pb.ExecuteResponse_Executing{
+					LastEvent: seal(2, wrapperspb.String(event)),
+				},
+			},
+		}
+	}
+	completedResponse := func(result int64) *remoteexecution_pb.ExecuteResponse {
+		return &remoteexecution_pb.ExecuteResponse{
+			Name: operation.Name,
+			Stage: &remoteexecution_pb.ExecuteResponse_Completed_{
+				Completed: &remoteexecution_pb.ExecuteResponse_Completed{
+					CompletionEvent: seal(3, wrapperspb.Int64(result)),
+				},
+			},
+		}
+	}
+
+	// expectBackoff sets up an expectation for a timer that is
+	// used to back off before reattaching to the operation.
+	expectBackoff := func(mockClock *MockClock, d time.Duration) {
+		timer := NewMockTimer(ctrl)
+		mockClock.EXPECT().NewTimer(d).DoAndReturn(func(d time.Duration) (clock.Timer, <-chan time.Time) {
+			t := make(chan time.Time, 1)
+			t <- time.Unix(1700000000, 0).Add(d)
+			return timer, t
+		})
+	}
+
+	// waitAction calls WaitAction() and collects all events that
+	// are yielded.
+	waitAction := func(ctx context.Context, client *remoteexecution.Client[*emptypb.Empty, wrapperspb.StringValue, *wrapperspb.StringValue, *wrapperspb.Int64Value]) ([]string, *wrapperspb.Int64Value, error) {
+		var events []string
+		var result wrapperspb.Int64Value
+		var errWait error
+		operation := operation
+		for event := range client.WaitAction(ctx, &operation, &result, &errWait) {
+			events = append(events, event.Value)
+		}
+		return events, &result, errWait
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		executionClient := NewMockExecutionClient(ctrl)
+		client := remoteexecution.NewClient[*emptypb.Empty, wrapperspb.StringValue, *wrapperspb.Int64Value](
+			executionClient,
+			clientPrivateKey,
+			nil,
+			NewMockClock(ctrl),
+		)
+
+		stream := NewMockExecution_ExecuteClient(ctrl)
+		gomock.InOrder(
+			executionClient.EXPECT().WaitExecution(gomock.Any(), testutil.EqProto(t, waitExecutionRequest)).Return(stream, nil),
+			stream.EXPECT().Recv().Return(executingResponse("Event 1"), nil),
+			stream.EXPECT().Recv().Return(executingResponse("Event 2"), nil),
+			stream.EXPECT().Recv().Return(completedResponse(42), nil),
+			stream.EXPECT().Recv().Return(nil, status.Error(codes.Canceled, "Context canceled")),
+		)
+
+		events, result, err := waitAction(context.Background(), client)
+		require.NoError(t, err)
+		require.Equal(t, []string{"Event 1", "Event 2"}, events)
+		testutil.RequireEqualProto(t, wrapperspb.Int64(42), result)
+	})
+
+	t.Run("StreamDropped", func(t *testing.T) {
+		// If the connection to the scheduler is lost, the
+		// client should back off and reattach to the operation
+		// by calling WaitExecution().
+		executionClient := NewMockExecutionClient(ctrl)
+		mockClock := NewMockClock(ctrl)
+		client := remoteexecution.NewClient[*emptypb.Empty, wrapperspb.StringValue, *wrapperspb.Int64Value](
+			executionClient,
+			clientPrivateKey,
+			nil,
+			mockClock,
+		)
+
+		stream1 := NewMockExecution_ExecuteClient(ctrl)
+		stream2 := NewMockExecution_ExecuteClient(ctrl)
+		stream3 := NewMockExecution_ExecuteClient(ctrl)
+		gomock.InOrder(
+			executionClient.EXPECT().WaitExecution(gomock.Any(), testutil.EqProto(t, waitExecutionRequest)).Return(stream1, nil),
+			stream1.EXPECT().Recv().Return(executingResponse("Event 1"), nil),
+			stream1.EXPECT().Recv().Return(nil, status.Error(codes.Unavailable, "Connection reset by peer")),
+			stream1.EXPECT().Recv().Return(nil, status.Error(codes.Unavailable, "Connection reset by peer")),
+		)
+		expectBackoff(mockClock, time.Second)
+		gomock.InOrder(
+			executionClient.EXPECT().WaitExecution(gomock.Any(), testutil.EqProto(t, waitExecutionRequest)).Return(stream2, nil),
+			stream2.EXPECT().Recv().Return(executingResponse("Event 2"), nil),
+			stream2.EXPECT().Recv().Return(nil, status.Error(codes.Unavailable, "Connection reset by peer")),
+			stream2.EXPECT().Recv().Return(nil, status.Error(codes.Unavailable, "Connection reset by peer")),
+		)
+		// As responses were received in the meantime, the
+		// backoff should not increase.
+		expectBackoff(mockClock, time.Second)
+		gomock.InOrder(
+			executionClient.EXPECT().WaitExecution(gomock.Any(), testutil.EqProto(t, waitExecutionRequest)).Return(stream3, nil),
+			stream3.EXPECT().Recv().Return(completedResponse(42), nil),
+			stream3.EXPECT().Recv().Return(nil, status.Error(codes.Canceled, "Context canceled")),
+		)
+
+		events, result, err := waitAction(context.Background(), client)
+		require.NoError(t, err)
+		require.Equal(t, []string{"Event 1", "Event 2"}, events)
+		testutil.RequireEqualProto(t, wrapperspb.Int64(42), result)
+	})
+
+	t.Run("ReconnectAttemptsExhausted", func(t *testing.T) {
+		// If the scheduler remains unavailable, the client
+		// should give up after a bounded number of attempts,
+		// backing off linearly in between.
+		executionClient := NewMockExecutionClient(ctrl)
+		mockClock := NewMockClock(ctrl)
+		client := remoteexecution.NewClient[*emptypb.Empty, wrapperspb.StringValue, *wrapperspb.Int64Value](
+			executionClient,
+			clientPrivateKey,
+			nil,
+			mockClock,
+		)
+
+		executionClient.EXPECT().WaitExecution(gomock.Any(), testutil.EqProto(t, waitExecutionRequest)).
+			Return(nil, status.Error(codes.Unavailable, "Server offline"))
+		for i := 1; i <= 5; i++ {
+			expectBackoff(mockClock, time.Duration(i)*time.Second)
+			executionClient.EXPECT().WaitExecution(gomock.Any(), testutil.EqProto(t, waitExecutionRequest)).
+				Return(nil, status.Error(codes.Unavailable, "Server offline"))
+		}
+
+		events, _, err := waitAction(context.Background(), client)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+		require.Empty(t, events)
+	})
+
+	t.Run("NonRetriableError", func(t *testing.T) {
+		// Errors other than UNAVAILABLE should be returned
+		// without reattaching to the operation.
+		executionClient := NewMockExecutionClient(ctrl)
+		client := remoteexecution.NewClient[*emptypb.Empty, wrapperspb.StringValue, *wrapperspb.Int64Value](
+			executionClient,
+			clientPrivateKey,
+			nil,
+			NewMockClock(ctrl),
+		)
+
+		stream := NewMockExecution_ExecuteClient(ctrl)
+		gomock.InOrder(
+			executionClient.EXPECT().WaitExecution(gomock.Any(), testutil.EqProto(t, waitExecutionRequest)).Return(stream, nil),
+			stream.EXPECT().Recv().Return(executingResponse("Event 1"), nil),
+			stream.EXPECT().Recv().Return(nil, status.Error(codes.NotFound, "Operation not found")),
+			stream.EXPECT().Recv().Return(nil, status.Error(codes.NotFound, "Operation not found")),
+		)
+
+		events, _, err := waitAction(context.Background(), client)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Operation not found"), err)
+		require.Equal(t, []string{"Event 1"}, events)
+	})
+
+	t.Run("CanceledDuringBackoff", func(t *testing.T) {
+		// Canceling the context while backing off should cause
+		// the client to stop immediately.
+		executionClient := NewMockExecutionClient(ctrl)
+		mockClock := NewMockClock(ctrl)
+		client := remoteexecution.NewClient[*emptypb.Empty, wrapperspb.StringValue, *wrapperspb.Int64Value](
+			executionClient,
+			clientPrivateKey,
+			nil,
+			mockClock,
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		executionClient.EXPECT().WaitExecution(gomock.Any(), testutil.EqProto(t, waitExecutionRequest)).
+			Return(nil, status.Error(codes.Unavailable, "Server offline"))
+		timer := NewMockTimer(ctrl)
+		mockClock.EXPECT().NewTimer(time.Second).DoAndReturn(func(d time.Duration) (clock.Timer, <-chan time.Time) {
+			cancel()
+			return timer, nil
+		})
+		timer.EXPECT().Stop().Return(true)
+
+		events, _, err := waitAction(ctx, client)
+		testutil.RequireEqualStatus(t, status.Error(codes.Canceled, "context canceled"), err)
+		require.Empty(t, events)
+	})
+
+	t.Run("NoOperationName", func(t *testing.T) {
+		// If the connection to the scheduler is lost before
+		// the scheduler assigned a name to the operation, there
+		// is nothing to reattach to. The priority should be
+		// forwarded to the scheduler.
+		executionClient := NewMockExecutionClient(ctrl)
+		client := remoteexecution.NewClient[*emptypb.Empty, wrapperspb.StringValue, *wrapperspb.Int64Value](
+			executionClient,
+			clientPrivateKey,
+			nil,
+			NewMockClock(ctrl),
+		)
+
+		stream := NewMockExecution_ExecuteClient(ctrl)
+		gomock.InOrder(
+			executionClient.EXPECT().Execute(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, request *remoteexecution_pb.ExecuteRequest, opts ...grpc.CallOption) (remoteexecution_pb.Execution_ExecuteClient, error) {
+					require.Equal(t, int32(7), request.Priority)
+					return stream, nil
+				}),
+			stream.EXPECT().Recv().Return(nil, status.Error(codes.Unavailable, "Connection reset by peer")),
+			stream.EXPECT().Recv().Return(nil, status.Error(codes.Unavailable, "Connection reset by peer")),
+		)
+
+		var result wrapperspb.Int64Value
+		var errRun error
+		for range client.RunAction(
+			context.Background(),
+			platformPrivateKey.PublicKey(),
+			&emptypb.Empty{},
+			&remoteexecution_pb.Action_AdditionalData{},
+			7,
+			func(operation *remoteexecution.Operation) {
+				t.Fatal("Operation should not have been created")
+			},
+			&result,
+			&errRun,
+		) {
+			t.Fatal("No events should have been yielded")
+		}
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Connection reset by peer"), errRun)
+	})
+}
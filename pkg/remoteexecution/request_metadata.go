@@ -0,0 +1,40 @@
+package remoteexecution
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+	remoteexecution_pb "github.com/buildbarn/bonanza/pkg/proto/remoteexecution"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// RequestMetadataHeader is the name of the gRPC header in which clients
+// may provide a RequestMetadata message when calling Execute().
+const RequestMetadataHeader = "bonanza.remoteexecution.requestmetadata-bin"
+
+// NewOutgoingContextWithRequestMetadata returns a context that causes
+// RequestMetadata to be attached to all outgoing gRPC calls.
+func NewOutgoingContextWithRequestMetadata(ctx context.Context, requestMetadata *remoteexecution_pb.RequestMetadata) (context.Context, error) {
+	data, err := proto.Marshal(requestMetadata)
+	if err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to marshal request metadata")
+	}
+	return metadata.AppendToOutgoingContext(ctx, RequestMetadataHeader, string(data)), nil
+}
+
+// GetRequestMetadataFromIncomingContext extracts the RequestMetadata
+// that was provided by the client as part of an incoming gRPC call. If
+// the client did not provide any RequestMetadata, an empty message is
+// returned.
+func GetRequestMetadataFromIncomingContext(ctx context.Context) (*remoteexecution_pb.RequestMetadata, error) {
+	var requestMetadata remoteexecution_pb.RequestMetadata
+	if values := metadata.ValueFromIncomingContext(ctx, RequestMetadataHeader); len(values) > 0 {
+		if err := proto.Unmarshal([]byte(values[0]), &requestMetadata); err != nil {
+			return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to unmarshal request metadata")
+		}
+	}
+	return &requestMetadata, nil
+}
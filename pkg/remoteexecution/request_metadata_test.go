@@ -0,0 +1,57 @@
+package remoteexecution_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	remoteexecution_pb "github.com/buildbarn/bonanza/pkg/proto/remoteexecution"
+	"github.com/buildbarn/bonanza/pkg/remoteexecution"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestMetadata(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		// RequestMetadata attached to outgoing calls by the
+		// client should be extractable by the server.
+		requestMetadata := &remoteexecution_pb.RequestMetadata{
+			ToolInvocationId:        "a4f0b7c1-3c8e-4d2a-9f55-1e6f0a7b2c3d",
+			CorrelatedInvocationsId: "5d2e8f4a-6b1c-4e7d-8a9f-0c3b2d1e4f5a",
+		}
+		outgoingCtx, err := remoteexecution.NewOutgoingContextWithRequestMetadata(context.Background(), requestMetadata)
+		require.NoError(t, err)
+		md, ok := metadata.FromOutgoingContext(outgoingCtx)
+		require.True(t, ok)
+		require.Len(t, md.Get(remoteexecution.RequestMetadataHeader), 1)
+
+		extractedRequestMetadata, err := remoteexecution.GetRequestMetadataFromIncomingContext(metadata.NewIncomingContext(context.Background(), md))
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, requestMetadata, extractedRequestMetadata)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		// Clients are not required to provide RequestMetadata.
+		for name, ctx := range map[string]context.Context{
+			"NoMetadata":    context.Background(),
+			"OtherMetadata": metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "bonanza_bazel")),
+		} {
+			t.Run(name, func(t *testing.T) {
+				requestMetadata, err := remoteexecution.GetRequestMetadataFromIncomingContext(ctx)
+				require.NoError(t, err)
+				testutil.RequireEqualProto(t, &remoteexecution_pb.RequestMetadata{}, requestMetadata)
+			})
+		}
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		_, err := remoteexecution.GetRequestMetadataFromIncomingContext(
+			metadata.NewIncomingContext(context.Background(), metadata.Pairs(remoteexecution.RequestMetadataHeader, "\xff\xff\xff\xff")),
+		)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.ErrorContains(t, err, "Failed to unmarshal request metadata")
+	})
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/buildbarn/bb-storage/pkg/auth"
 	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/otel"
 	"github.com/buildbarn/bb-storage/pkg/random"
 	"github.com/buildbarn/bb-storage/pkg/util"
@@ -261,13 +262,23 @@ type InMemoryBuildQueue struct {
 	// platform queues and operations.
 	cleanupQueue cleanupQueue
 
-	// TODO: Re-add authorization from Buildbarn!
+	// Authorizer used to allow/deny access for certain users
+	// to perform Execute and WaitExecution calls.
+	executeAuthorizer auth.Authorizer
+
+	// Authorizer used to allow/deny access for certain users to
+	// perform AddDrain and RemoveDrain calls.
+	modifyDrainsAuthorizer auth.Authorizer
+
+	// Authorizer used to allow/deny access for certain users to
+	// perform KillOperations calls.
+	killOperationsAuthorizer auth.Authorizer
 }
 
 // NewInMemoryBuildQueue creates a new InMemoryBuildQueue that is in the
 // initial state. It does not have any queues, workers or queued
 // execution requests. All of these are created by sending it RPCs.
-func NewInMemoryBuildQueue(clock clock.Clock, uuidGenerator util.UUIDGenerator, randomNumberGenerator random.SingleThreadedGenerator, configuration *InMemoryBuildQueueConfiguration, actionRouter routing.ActionRouter) *InMemoryBuildQueue {
+func NewInMemoryBuildQueue(clock clock.Clock, uuidGenerator util.UUIDGenerator, randomNumberGenerator random.SingleThreadedGenerator, configuration *InMemoryBuildQueueConfiguration, actionRouter routing.ActionRouter, executeAuthorizer, modifyDrainsAuthorizer, killOperationsAuthorizer auth.Authorizer) *InMemoryBuildQueue {
 	inMemoryBuildQueuePrometheusMetrics.Do(func() {
 		prometheus.MustRegister(inMemoryBuildQueueInFlightDeduplicationsTotal)
 
@@ -296,6 +307,9 @@ func NewInMemoryBuildQueue(clock clock.Clock, uuidGenerator util.UUIDGenerator,
 		configuration:                       configuration,
 		platformQueueAbsenceHardFailureTime: clock.Now().Add(configuration.PlatformQueueWithNoWorkersTimeout),
 		actionRouter:                        actionRouter,
+		executeAuthorizer:                   executeAuthorizer,
+		modifyDrainsAuthorizer:              modifyDrainsAuthorizer,
+		killOperationsAuthorizer:            killOperationsAuthorizer,
 		platformQueues:                      map[string]*platformQueue{},
 		operationsNameMap:                   map[string]*operation{},
 		inFlightDeduplicationMap:            map[[sha256.Size]byte]*task{},
@@ -325,12 +339,55 @@ func (bq *InMemoryBuildQueue) RegisterPredeclaredPlatformQueue(publicKeys []*ecd
 		}
 	}
 
+	if len(publicKeys) < 1 {
+		return status.Error(codes.InvalidArgument, "No public keys provided")
+	}
+	pkixPublicKeys := make([][]byte, 0, len(publicKeys))
+	for i, publicKey := range publicKeys {
+		pkixPublicKey, err := x509.MarshalPKIXPublicKey(publicKey)
+		if err != nil {
+			return util.StatusWrapfWithCode(err, codes.InvalidArgument, "Failed to marshal public key at index %d", i)
+		}
+		pkixPublicKeys = append(pkixPublicKeys, pkixPublicKey)
+	}
+	slices.SortFunc(pkixPublicKeys, bytes.Compare)
+	for i := 1; i < len(pkixPublicKeys); i++ {
+		if bytes.Equal(pkixPublicKeys[i-1], pkixPublicKeys[i]) {
+			return status.Errorf(codes.InvalidArgument, "PKIX public key %s is provided multiple times", base64.StdEncoding.EncodeToString(pkixPublicKeys[i]))
+		}
+	}
+
 	bq.enter(bq.clock.Now())
 	defer bq.leave()
 
-	panic("TODO: ADD PUBLIC KEYS!")
+	for _, pkixPublicKey := range pkixPublicKeys {
+		if _, ok := bq.platformQueues[string(pkixPublicKey)]; ok {
+			return status.Errorf(codes.AlreadyExists, "A platform queue for PKIX public key %s already exists", base64.StdEncoding.EncodeToString(pkixPublicKey))
+		}
+	}
 
+	// Compute verification zeros for all public keys up front, so
+	// that workers synchronizing against this platform queue can
+	// use the cached copies.
+	if err := bq.maybeRotateVerificationPrivateKey(); err != nil {
+		return err
+	}
 	pq := newPlatformQueue(workerInvocationStickinessLimits, maximumQueuedBackgroundLearningOperations, backgroundLearningOperationPriority)
+	pq.publicKeys = make([]platformQueuePublicKey, 0, len(pkixPublicKeys))
+	for _, pkixPublicKey := range pkixPublicKeys {
+		verificationZeros, err := bq.computeVerificationZeros(pkixPublicKey)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to compute verification zeros for PKIX public key %s", base64.StdEncoding.EncodeToString(pkixPublicKey))
+		}
+		pq.publicKeys = append(pq.publicKeys, platformQueuePublicKey{
+			pkixPublicKey:     pkixPublicKey,
+			verificationZeros: verificationZeros,
+			predeclared:       true,
+		})
+	}
+	for _, pkixPublicKey := range pkixPublicKeys {
+		bq.platformQueues[string(pkixPublicKey)] = pq
+	}
 	for _, sizeClass := range sizeClasses {
 		pq.addSizeClassQueue(bq, sizeClass, false)
 	}
@@ -345,6 +402,9 @@ func (bq *InMemoryBuildQueue) Execute(in *remoteexecution_pb.ExecuteRequest, out
 	if action == nil {
 		return status.Error(codes.InvalidArgument, "No action provided")
 	}
+	if err := authorizePlatform(ctx, bq.executeAuthorizer, action.PlatformPkixPublicKey); err != nil {
+		return err
+	}
 
 	// Forward the client-provided authentication and request
 	// metadata, so that the worker logs it.
@@ -465,6 +525,15 @@ func (bq *InMemoryBuildQueue) WaitExecution(in *remoteexecution_pb.WaitExecution
 			bq.leave()
 			return status.Errorf(codes.NotFound, "Operation with name %#v not found", in.Name)
 		}
+		platformPkixPublicKey := o.task.desiredState.Action.PlatformPkixPublicKey
+
+		// Ensure that the caller is permitted to access this operation.
+		// This must be done without holding any locks, as the authorizer
+		// may block.
+		bq.leave()
+		if err := authorizePlatform(out.Context(), bq.executeAuthorizer, platformPkixPublicKey); err != nil {
+			return err
+		}
 
 		bq.enter(bq.clock.Now())
 		if bq.operationsNameMap[in.Name] == o {
@@ -498,39 +567,19 @@ func (bq *InMemoryBuildQueue) computeVerificationZeros(pkixPublicKey []byte) ([a
 	return verificationZeros, nil
 }
 
-// Synchronize the state of a worker with the scheduler. This call is
-// used by a worker to report the completion of an operation and to
-// request more work.
-func (bq *InMemoryBuildQueue) Synchronize(ctx context.Context, request *remoteworker_pb.SynchronizeRequest) (*remoteworker_pb.SynchronizeResponse, error) {
-	// Ensure that the list of public keys provided by the worker is
-	// non-empty and properly sorted.
-	publicKeys := request.PublicKeys
-	if len(publicKeys) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Worker provided no public keys")
-	}
-	for i := 1; i < len(publicKeys); i++ {
-		if bytes.Compare(publicKeys[i-1].PkixPublicKey, publicKeys[i].PkixPublicKey) >= 0 {
-			return nil, status.Error(codes.InvalidArgument, "Public keys provided by worker are not sorted")
-		}
-	}
-
-	workerKey := newWorkerKey(request.WorkerId)
-
-	bq.enter(bq.clock.Now())
-	defer bq.leave()
-
-	// Rotate the key used for computing verification zeros if
-	// needed. This key is done periodically, so that accidental
-	// disclosure of verification_zeros does not have a lasting
-	// impact.
+// maybeRotateVerificationPrivateKey rotates the key used for computing
+// verification zeros if needed. This is done periodically, so that
+// accidental disclosure of verification_zeros does not have a lasting
+// impact.
+func (bq *InMemoryBuildQueue) maybeRotateVerificationPrivateKey() error {
 	if !bq.verificationPrivateKeyExpiration.After(bq.now) {
 		privateKey, err := ecdh.X25519().GenerateKey(bq.randomNumberGenerator)
 		if err != nil {
-			return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to generate new verification private key")
+			return util.StatusWrapWithCode(err, codes.Internal, "Failed to generate new verification private key")
 		}
 		marshaledPublicKey, err := x509.MarshalPKIXPublicKey(privateKey.PublicKey())
 		if err != nil {
-			return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal new verification public key")
+			return util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal new verification public key")
 		}
 
 		bq.verificationPrivateKey = privateKey
@@ -549,13 +598,58 @@ func (bq *InMemoryBuildQueue) Synchronize(ctx context.Context, request *remotewo
 				publicKey := &pq.publicKeys[i]
 				publicKey.verificationZeros, err = bq.computeVerificationZeros(publicKey.pkixPublicKey)
 				if err != nil {
-					return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to update cached verification zeros")
+					return util.StatusWrapWithCode(err, codes.Internal, "Failed to update cached verification zeros")
 				}
 			}
 		}
 
 		bq.verificationPrivateKeyExpiration = bq.now.Add(bq.configuration.VerificationPrivateKeyRefreshInterval)
 	}
+	return nil
+}
+
+// authorizePlatform checks whether the caller is permitted to perform
+// an operation against the platform identified by a PKIX public key.
+// As authorizers operate on instance names, the public key is converted
+// to an instance name by encoding it using unpadded URL-safe base64.
+//
+// This function may block, so it must be called without holding the
+// build queue's lock.
+func authorizePlatform(ctx context.Context, authorizer auth.Authorizer, platformPkixPublicKey []byte) error {
+	instanceName, err := digest.NewInstanceName(base64.RawURLEncoding.EncodeToString(platformPkixPublicKey))
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid platform PKIX public key")
+	}
+	if err := auth.AuthorizeSingleInstanceName(ctx, authorizer, instanceName); err != nil {
+		return util.StatusWrap(err, "Authorization")
+	}
+	return nil
+}
+
+// Synchronize the state of a worker with the scheduler. This call is
+// used by a worker to report the completion of an operation and to
+// request more work.
+func (bq *InMemoryBuildQueue) Synchronize(ctx context.Context, request *remoteworker_pb.SynchronizeRequest) (*remoteworker_pb.SynchronizeResponse, error) {
+	// Ensure that the list of public keys provided by the worker is
+	// non-empty and properly sorted.
+	publicKeys := request.PublicKeys
+	if len(publicKeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Worker provided no public keys")
+	}
+	for i := 1; i < len(publicKeys); i++ {
+		if bytes.Compare(publicKeys[i-1].PkixPublicKey, publicKeys[i].PkixPublicKey) >= 0 {
+			return nil, status.Error(codes.InvalidArgument, "Public keys provided by worker are not sorted")
+		}
+	}
+
+	workerKey := newWorkerKey(request.WorkerId)
+
+	bq.enter(bq.clock.Now())
+	defer bq.leave()
+
+	if err := bq.maybeRotateVerificationPrivateKey(); err != nil {
+		return nil, err
+	}
 
 	// Find the platform queue belonging to the provided set of
 	// public keys. If multiple public keys are provided, ensure
@@ -602,8 +696,8 @@ func (bq *InMemoryBuildQueue) Synchronize(ctx context.Context, request *remotewo
 			// verification zeros.
 			cmp := 1
 			for insertionIndex < len(existingPublicKeys) {
-				cmp = bytes.Compare(publicKey.PkixPublicKey, existingPublicKeys[insertionIndex].verificationZeros[:])
-				if cmp >= 0 {
+				cmp = bytes.Compare(publicKey.PkixPublicKey, existingPublicKeys[insertionIndex].pkixPublicKey)
+				if cmp <= 0 {
 					break
 				}
 				insertionIndex++
@@ -687,10 +781,6 @@ func (bq *InMemoryBuildQueue) Synchronize(ctx context.Context, request *remotewo
 		}
 		mergedPublicKeys = append(mergedPublicKeys, existingPublicKeys[previousInsertionIndex:]...)
 		pq.publicKeys = mergedPublicKeys
-
-		// TODO: Add a per worker bitmap that stores which
-		// workers announce which public keys, and grow those
-		// here.
 	}
 
 	if scq == nil {
@@ -705,6 +795,14 @@ func (bq *InMemoryBuildQueue) Synchronize(ctx context.Context, request *remotewo
 			return nil, status.Error(codes.ResourceExhausted, "Worker is already synchronizing with the scheduler")
 		}
 		bq.cleanupQueue.remove(w.cleanupKey)
+
+		// If the worker stopped announcing one or more public
+		// keys, remove them from the platform queue if no
+		// other workers announce them either.
+		if pkixPublicKeys := getPKIXPublicKeys(request.PublicKeys); !slices.EqualFunc(w.pkixPublicKeys, pkixPublicKeys, bytes.Equal) {
+			w.pkixPublicKeys = pkixPublicKeys
+			pq.removeUnannouncedPublicKeys(bq)
+		}
 	} else {
 		// First time we're seeing this worker. As this worker
 		// has never run an action before (that we know about),
@@ -712,6 +810,7 @@ func (bq *InMemoryBuildQueue) Synchronize(ctx context.Context, request *remotewo
 		i := &scq.rootInvocation
 		w = &worker{
 			workerKey:               workerKey,
+			pkixPublicKeys:          getPKIXPublicKeys(request.PublicKeys),
 			lastInvocation:          i,
 			listIndex:               -1,
 			stickinessStartingTimes: make([]time.Time, len(pq.workerInvocationStickinessLimits)),
@@ -859,16 +958,39 @@ func (bq *InMemoryBuildQueue) KillOperations(ctx context.Context, request *build
 
 	switch filter := request.Filter.GetType().(type) {
 	case *buildqueuestate_pb.KillOperationsRequest_Filter_OperationName:
-		bq.enter(bq.clock.Now())
-		o, ok := bq.operationsNameMap[filter.OperationName]
-		if !ok {
+		for {
+			// Extract the platform to which the operation
+			// belongs.
+			bq.enter(bq.clock.Now())
+			o, ok := bq.operationsNameMap[filter.OperationName]
+			if !ok {
+				bq.leave()
+				return nil, status.Errorf(codes.NotFound, "Operation %#v not found", filter.OperationName)
+			}
+			platformPkixPublicKey := o.task.desiredState.Action.PlatformPkixPublicKey
+			bq.leave()
+
+			// Perform authorization checks without holding
+			// any locks.
+			if err := authorizePlatform(ctx, bq.killOperationsAuthorizer, platformPkixPublicKey); err != nil {
+				return nil, err
+			}
+
+			// Kill the operation if it still exists after
+			// reacquiring the lock. Otherwise we retry.
+			bq.enter(bq.clock.Now())
+			if o == bq.operationsNameMap[filter.OperationName] {
+				o.task.fail(bq, "KilledOperationName", failureErr, false)
+				bq.leave()
+				return &emptypb.Empty{}, nil
+			}
 			bq.leave()
-			return nil, status.Errorf(codes.NotFound, "Operation %#v not found", filter.OperationName)
 		}
-		o.task.fail(bq, "KilledOperationName", failureErr, false)
-		bq.leave()
-		return &emptypb.Empty{}, nil
 	case *buildqueuestate_pb.KillOperationsRequest_Filter_SizeClassQueueWithoutWorkers:
+		if err := authorizePlatform(ctx, bq.killOperationsAuthorizer, filter.SizeClassQueueWithoutWorkers.GetPlatformPkixPublicKey()); err != nil {
+			return nil, err
+		}
+
 		bq.enter(bq.clock.Now())
 		defer bq.leave()
 
@@ -1210,6 +1332,10 @@ func (bq *InMemoryBuildQueue) ListDrains(ctx context.Context, request *buildqueu
 }
 
 func (bq *InMemoryBuildQueue) modifyDrain(ctx context.Context, request *buildqueuestate_pb.AddOrRemoveDrainRequest, modifyFunc func(scq *sizeClassQueue, drainKey string)) (*emptypb.Empty, error) {
+	if err := authorizePlatform(ctx, bq.modifyDrainsAuthorizer, request.SizeClassQueueName.GetPlatformPkixPublicKey()); err != nil {
+		return nil, err
+	}
+
 	drainKey, err := json.Marshal(request.WorkerIdPattern)
 	if err != nil {
 		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to marshal worker ID pattern")
@@ -1371,6 +1497,10 @@ func (h platformQueueList) Swap(i, j int) {
 type platformQueuePublicKey struct {
 	pkixPublicKey     []byte
 	verificationZeros [aes.BlockSize]byte
+	// Whether the public key was provided through configuration.
+	// Predeclared public keys are retained, even if no workers
+	// announce them.
+	predeclared bool
 }
 
 // platformQueue is an actual build operations queue that contains a
@@ -1534,6 +1664,48 @@ func (scq *sizeClassQueue) remove(bq *InMemoryBuildQueue) {
 	}
 	pq.sizeClasses = append(pq.sizeClasses[:i], pq.sizeClasses[i+1:]...)
 	pq.sizeClassQueues = append(pq.sizeClassQueues[:i], pq.sizeClassQueues[i+1:]...)
+
+	// Remove the platform queue entirely if it no longer contains
+	// any size class queues, so that workers announcing the same
+	// public keys in the future start with a fresh platform queue.
+	if len(pq.sizeClassQueues) == 0 {
+		for _, publicKey := range pq.publicKeys {
+			delete(bq.platformQueues, string(publicKey.pkixPublicKey))
+		}
+	}
+}
+
+// removeUnannouncedPublicKeys removes all public keys from a platform
+// queue that are neither predeclared, nor announced by any of the
+// workers that are currently associated with the platform queue.
+//
+// If none of the workers announce any public keys (e.g., because all
+// of them disappeared), the public keys are retained. This ensures
+// that operations that are still queued remain reachable, and that
+// workers that reappear get associated with the same platform queue.
+func (pq *platformQueue) removeUnannouncedPublicKeys(bq *InMemoryBuildQueue) {
+	announcedPublicKeys := map[string]struct{}{}
+	for _, scq := range pq.sizeClassQueues {
+		for _, w := range scq.workers {
+			for _, pkixPublicKey := range w.pkixPublicKeys {
+				announcedPublicKeys[string(pkixPublicKey)] = struct{}{}
+			}
+		}
+	}
+	if len(announcedPublicKeys) == 0 {
+		return
+	}
+
+	retainedPublicKeys := pq.publicKeys[:0]
+	for _, publicKey := range pq.publicKeys {
+		if _, ok := announcedPublicKeys[string(publicKey.pkixPublicKey)]; ok || publicKey.predeclared {
+			retainedPublicKeys = append(retainedPublicKeys, publicKey)
+		} else {
+			delete(bq.platformQueues, string(publicKey.pkixPublicKey))
+		}
+	}
+	clear(pq.publicKeys[len(retainedPublicKeys):])
+	pq.publicKeys = retainedPublicKeys
 }
 
 // removeStaleWorker is invoked when Synchronize() isn't being invoked
@@ -1563,7 +1735,7 @@ func (scq *sizeClassQueue) removeStaleWorker(bq *InMemoryBuildQueue, workerKey w
 		})
 	}
 
-	// TODO: Remove public keys!
+	scq.platformQueue.removeUnannouncedPublicKeys(bq)
 }
 
 // getOrCreateInvocation looks up the invocation key in the size class
@@ -2653,9 +2825,24 @@ func (t *task) getCurrentSizeClassQueue() *sizeClassQueue {
 	panic("Task is not associated with any operations")
 }
 
+// getPKIXPublicKeys extracts the PKIX public keys from the list of
+// public keys provided by a worker.
+func getPKIXPublicKeys(publicKeys []*remoteworker_pb.SynchronizeRequest_PublicKey) [][]byte {
+	pkixPublicKeys := make([][]byte, 0, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		pkixPublicKeys = append(pkixPublicKeys, publicKey.PkixPublicKey)
+	}
+	return pkixPublicKeys
+}
+
 // worker state for every node capable of executing operations.
 type worker struct {
 	workerKey workerKey
+	// The PKIX public keys that the worker announced during its
+	// most recent call to Synchronize(). These are used to
+	// determine which public keys of a platform queue are still in
+	// use.
+	pkixPublicKeys [][]byte
 
 	// The task that this worker is currently executing. This field
 	// must be kept in sync with task.currentWorker.
@@ -0,0 +1,501 @@
+package scheduler_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/random"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	buildqueuestate_pb "github.com/buildbarn/bonanza/pkg/proto/buildqueuestate"
+	remoteworker_pb "github.com/buildbarn/bonanza/pkg/proto/remoteworker"
+	"github.com/buildbarn/bonanza/pkg/scheduler"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var inMemoryBuildQueueConfigurationForTesting = scheduler.InMemoryBuildQueueConfiguration{
+	ExecutionUpdateInterval:           time.Minute,
+	OperationWithNoWaitersTimeout:     time.Minute,
+	PlatformQueueWithNoWorkersTimeout: 15 * time.Minute,
+	BusyWorkerSynchronizationInterval: 10 * time.Second,
+	GetIdleWorkerSynchronizationInterval: func() time.Duration {
+		return time.Minute
+	},
+	WorkerTaskRetryCount:                  9,
+	WorkerWithNoSynchronizationsTimeout:   time.Minute,
+	VerificationPrivateKeyRefreshInterval: time.Hour,
+}
+
+func newPublicKeyForTesting(t *testing.T) (*ecdh.PublicKey, []byte) {
+	privateKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	publicKey := privateKey.PublicKey()
+	pkixPublicKey, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	return publicKey, pkixPublicKey
+}
+
+// newAuthorizerForPublicKeys returns an Authorizer that only permits
+// access to platforms that have one of the provided PKIX public keys.
+func newAuthorizerForPublicKeys(pkixPublicKeys ...[]byte) auth.Authorizer {
+	allowed := map[string]struct{}{}
+	for _, pkixPublicKey := range pkixPublicKeys {
+		allowed[base64.RawURLEncoding.EncodeToString(pkixPublicKey)] = struct{}{}
+	}
+	return auth.NewStaticAuthorizer(func(instanceName digest.InstanceName) bool {
+		_, ok := allowed[instanceName.String()]
+		return ok
+	})
+}
+
+func TestInMemoryBuildQueueRegisterPredeclaredPlatformQueue(t *testing.T) {
+	publicKey1, pkixPublicKey1 := newPublicKeyForTesting(t)
+	publicKey2, pkixPublicKey2 := newPublicKeyForTesting(t)
+	publicKey3, _ := newPublicKeyForTesting(t)
+
+	buildQueue := scheduler.NewInMemoryBuildQueue(
+		clock.SystemClock,
+		uuid.NewRandom,
+		random.NewFastSingleThreadedGenerator(),
+		&inMemoryBuildQueueConfigurationForTesting,
+		/* actionRouter = */ nil,
+		auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true }),
+		auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true }),
+		auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true }),
+	)
+
+	t.Run("NoSizeClasses", func(t *testing.T) {
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "No size classes provided"),
+			buildQueue.RegisterPredeclaredPlatformQueue([]*ecdh.PublicKey{publicKey1}, nil, 0, 0, nil),
+		)
+	})
+
+	t.Run("UnsortedSizeClasses", func(t *testing.T) {
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "Size classes must be provided in sorted order"),
+			buildQueue.RegisterPredeclaredPlatformQueue([]*ecdh.PublicKey{publicKey1}, nil, 0, 0, []uint32{4, 2}),
+		)
+	})
+
+	t.Run("NoPublicKeys", func(t *testing.T) {
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "No public keys provided"),
+			buildQueue.RegisterPredeclaredPlatformQueue(nil, nil, 0, 0, []uint32{0}),
+		)
+	})
+
+	t.Run("DuplicatePublicKeys", func(t *testing.T) {
+		testutil.RequireEqualStatus(
+			t,
+			status.Errorf(codes.InvalidArgument, "PKIX public key %s is provided multiple times", base64.StdEncoding.EncodeToString(pkixPublicKey1)),
+			buildQueue.RegisterPredeclaredPlatformQueue([]*ecdh.PublicKey{publicKey1, publicKey2, publicKey1}, nil, 0, 0, []uint32{0}),
+		)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// A single platform queue may be registered under
+		// multiple public keys. Both of them should be reported.
+		require.NoError(t, buildQueue.RegisterPredeclaredPlatformQueue([]*ecdh.PublicKey{publicKey1, publicKey2}, nil, 0, 0, []uint32{0, 4}))
+
+		response, err := buildQueue.ListPlatformQueues(context.Background(), &emptypb.Empty{})
+		require.NoError(t, err)
+		require.Len(t, response.PlatformQueues, 1)
+		require.ElementsMatch(t, [][]byte{pkixPublicKey1, pkixPublicKey2}, response.PlatformQueues[0].PkixPublicKeys)
+
+		sizeClassQueues := response.PlatformQueues[0].SizeClassQueues
+		require.Len(t, sizeClassQueues, 2)
+		require.Equal(t, uint32(0), sizeClassQueues[0].SizeClass)
+		require.Equal(t, uint32(4), sizeClassQueues[1].SizeClass)
+	})
+
+	t.Run("AlreadyExists", func(t *testing.T) {
+		// Platform queues may not overlap with existing ones,
+		// even if only one of the public keys is shared.
+		testutil.RequireEqualStatus(
+			t,
+			status.Errorf(codes.AlreadyExists, "A platform queue for PKIX public key %s already exists", base64.StdEncoding.EncodeToString(pkixPublicKey2)),
+			buildQueue.RegisterPredeclaredPlatformQueue([]*ecdh.PublicKey{publicKey2, publicKey3}, nil, 0, 0, []uint32{0}),
+		)
+	})
+}
+
+func TestInMemoryBuildQueueAuthorization(t *testing.T) {
+	ctx := context.Background()
+
+	publicKey1, pkixPublicKey1 := newPublicKeyForTesting(t)
+	publicKey2, pkixPublicKey2 := newPublicKeyForTesting(t)
+
+	// Only permit modification of drains on the first platform,
+	// and killing operations on the second platform.
+	buildQueue := scheduler.NewInMemoryBuildQueue(
+		clock.SystemClock,
+		uuid.NewRandom,
+		random.NewFastSingleThreadedGenerator(),
+		&inMemoryBuildQueueConfigurationForTesting,
+		/* actionRouter = */ nil,
+		newAuthorizerForPublicKeys(),
+		newAuthorizerForPublicKeys(pkixPublicKey1),
+		newAuthorizerForPublicKeys(pkixPublicKey2),
+	)
+	require.NoError(t, buildQueue.RegisterPredeclaredPlatformQueue([]*ecdh.PublicKey{publicKey1}, nil, 0, 0, []uint32{0}))
+	require.NoError(t, buildQueue.RegisterPredeclaredPlatformQueue([]*ecdh.PublicKey{publicKey2}, nil, 0, 0, []uint32{0}))
+
+	t.Run("AddDrainPermissionDenied", func(t *testing.T) {
+		_, err := buildQueue.AddDrain(ctx, &buildqueuestate_pb.AddOrRemoveDrainRequest{
+			SizeClassQueueName: &buildqueuestate_pb.SizeClassQueueName{
+				PlatformPkixPublicKey: pkixPublicKey2,
+			},
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Permission denied"), err)
+	})
+
+	t.Run("AddAndRemoveDrainSuccess", func(t *testing.T) {
+		request := &buildqueuestate_pb.AddOrRemoveDrainRequest{
+			SizeClassQueueName: &buildqueuestate_pb.SizeClassQueueName{
+				PlatformPkixPublicKey: pkixPublicKey1,
+			},
+			WorkerIdPattern: map[string]string{"hostname": "worker1"},
+		}
+		_, err := buildQueue.AddDrain(ctx, request)
+		require.NoError(t, err)
+
+		response, err := buildQueue.ListPlatformQueues(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+		drainsCount := map[string]uint32{}
+		for _, platformQueue := range response.PlatformQueues {
+			drainsCount[string(platformQueue.PkixPublicKeys[0])] = platformQueue.SizeClassQueues[0].DrainsCount
+		}
+		require.Equal(t, map[string]uint32{
+			string(pkixPublicKey1): 1,
+			string(pkixPublicKey2): 0,
+		}, drainsCount)
+
+		_, err = buildQueue.RemoveDrain(ctx, request)
+		require.NoError(t, err)
+	})
+
+	t.Run("KillOperationsPermissionDenied", func(t *testing.T) {
+		_, err := buildQueue.KillOperations(ctx, &buildqueuestate_pb.KillOperationsRequest{
+			Filter: &buildqueuestate_pb.KillOperationsRequest_Filter{
+				Type: &buildqueuestate_pb.KillOperationsRequest_Filter_SizeClassQueueWithoutWorkers{
+					SizeClassQueueWithoutWorkers: &buildqueuestate_pb.SizeClassQueueName{
+						PlatformPkixPublicKey: pkixPublicKey1,
+					},
+				},
+			},
+			Status: status.New(codes.Unavailable, "Workers went away").Proto(),
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Permission denied"), err)
+	})
+
+	t.Run("KillOperationsSuccess", func(t *testing.T) {
+		_, err := buildQueue.KillOperations(ctx, &buildqueuestate_pb.KillOperationsRequest{
+			Filter: &buildqueuestate_pb.KillOperationsRequest_Filter{
+				Type: &buildqueuestate_pb.KillOperationsRequest_Filter_SizeClassQueueWithoutWorkers{
+					SizeClassQueueWithoutWorkers: &buildqueuestate_pb.SizeClassQueueName{
+						PlatformPkixPublicKey: pkixPublicKey2,
+					},
+				},
+			},
+			Status: status.New(codes.Unavailable, "Workers went away").Proto(),
+		})
+		require.NoError(t, err)
+	})
+}
+
+// workerKeyForTesting is a key pair of a worker, which it can use to
+// prove to the scheduler that it is permitted to pick up work for a
+// given platform.
+type workerKeyForTesting struct {
+	privateKey    *ecdh.PrivateKey
+	pkixPublicKey []byte
+}
+
+func newWorkerKeyForTesting(t *testing.T) workerKeyForTesting {
+	privateKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pkixPublicKey, err := x509.MarshalPKIXPublicKey(privateKey.PublicKey())
+	require.NoError(t, err)
+	return workerKeyForTesting{
+		privateKey:    privateKey,
+		pkixPublicKey: pkixPublicKey,
+	}
+}
+
+// getSynchronizeRequestPublicKeys converts a list of worker keys to
+// the public keys that are announced as part of SynchronizeRequest,
+// computing the verification zeros using the scheduler's verification
+// public key. If no verification public key is known, verification
+// zeros are omitted.
+func getSynchronizeRequestPublicKeys(t *testing.T, verificationPkixPublicKey []byte, workerKeys []workerKeyForTesting) []*remoteworker_pb.SynchronizeRequest_PublicKey {
+	publicKeys := make([]*remoteworker_pb.SynchronizeRequest_PublicKey, 0, len(workerKeys))
+	for _, workerKey := range workerKeys {
+		publicKey := &remoteworker_pb.SynchronizeRequest_PublicKey{
+			PkixPublicKey: workerKey.pkixPublicKey,
+		}
+		if verificationPkixPublicKey != nil {
+			parsedVerificationPublicKey, err := x509.ParsePKIXPublicKey(verificationPkixPublicKey)
+			require.NoError(t, err)
+			sharedSecret, err := workerKey.privateKey.ECDH(parsedVerificationPublicKey.(*ecdh.PublicKey))
+			require.NoError(t, err)
+			blockCipher, err := aes.NewCipher(sharedSecret)
+			require.NoError(t, err)
+			publicKey.VerificationZeros = make([]byte, aes.BlockSize)
+			blockCipher.Encrypt(publicKey.VerificationZeros, publicKey.VerificationZeros)
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+	slices.SortFunc(publicKeys, func(a, b *remoteworker_pb.SynchronizeRequest_PublicKey) int {
+		return bytes.Compare(a.PkixPublicKey, b.PkixPublicKey)
+	})
+	return publicKeys
+}
+
+// getVerificationPkixPublicKey extracts the verification public key
+// from a SynchronizeResponse that requests that the worker recomputes
+// its verification zeros.
+func getVerificationPkixPublicKey(t *testing.T, response *remoteworker_pb.SynchronizeResponse) []byte {
+	verifyingPublicKeys, ok := response.DesiredState.GetWorkerState().(*remoteworker_pb.DesiredState_VerifyingPublicKeys_)
+	require.True(t, ok, "Scheduler did not request verification of public keys")
+	return verifyingPublicKeys.VerifyingPublicKeys.VerificationPkixPublicKey
+}
+
+// listPlatformQueuePublicKeysForTesting returns the public keys of all
+// platform queues known by the scheduler.
+func listPlatformQueuePublicKeysForTesting(t *testing.T, buildQueue *scheduler.InMemoryBuildQueue) [][][]byte {
+	response, err := buildQueue.ListPlatformQueues(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	platformQueuePublicKeys := make([][][]byte, 0, len(response.PlatformQueues))
+	for _, platformQueue := range response.PlatformQueues {
+		platformQueuePublicKeys = append(platformQueuePublicKeys, platformQueue.PkixPublicKeys)
+	}
+	return platformQueuePublicKeys
+}
+
+func sortedPKIXPublicKeys(workerKeys ...workerKeyForTesting) [][]byte {
+	pkixPublicKeys := make([][]byte, 0, len(workerKeys))
+	for _, workerKey := range workerKeys {
+		pkixPublicKeys = append(pkixPublicKeys, workerKey.pkixPublicKey)
+	}
+	slices.SortFunc(pkixPublicKeys, bytes.Compare)
+	return pkixPublicKeys
+}
+
+func TestInMemoryBuildQueueSynchronizePublicKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	clock := NewMockClock(ctrl)
+	clock.EXPECT().Now().Return(time.Unix(1000, 0)).AnyTimes()
+	buildQueue := scheduler.NewInMemoryBuildQueue(
+		clock,
+		uuid.NewRandom,
+		random.NewFastSingleThreadedGenerator(),
+		&inMemoryBuildQueueConfigurationForTesting,
+		/* actionRouter = */ nil,
+		auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true }),
+		auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true }),
+		auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true }),
+	)
+
+	// Workers that don't provide verification zeros are asked to
+	// compute them, using the verification public key of the
+	// scheduler. This key remains stable until it needs to be
+	// rotated.
+	workerKey1 := newWorkerKeyForTesting(t)
+	response, err := buildQueue.Synchronize(ctx, &remoteworker_pb.SynchronizeRequest{
+		WorkerId:        map[string]string{"hostname": "worker1"},
+		PublicKeys:      getSynchronizeRequestPublicKeys(t, nil, []workerKeyForTesting{workerKey1}),
+		CurrentState:    &remoteworker_pb.CurrentState{WorkerState: &remoteworker_pb.CurrentState_Idle{Idle: &emptypb.Empty{}}},
+		PreferBeingIdle: true,
+	})
+	require.NoError(t, err)
+	verificationPkixPublicKey := getVerificationPkixPublicKey(t, response)
+	require.Empty(t, listPlatformQueuePublicKeysForTesting(t, buildQueue))
+
+	synchronize := func(t *testing.T, hostname string, workerKeys ...workerKeyForTesting) {
+		response, err := buildQueue.Synchronize(ctx, &remoteworker_pb.SynchronizeRequest{
+			WorkerId:        map[string]string{"hostname": hostname},
+			PublicKeys:      getSynchronizeRequestPublicKeys(t, verificationPkixPublicKey, workerKeys),
+			CurrentState:    &remoteworker_pb.CurrentState{WorkerState: &remoteworker_pb.CurrentState_Idle{Idle: &emptypb.Empty{}}},
+			PreferBeingIdle: true,
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &remoteworker_pb.DesiredState{
+			WorkerState: &remoteworker_pb.DesiredState_Idle{Idle: &emptypb.Empty{}},
+		}, response.DesiredState)
+	}
+
+	t.Run("InvalidVerificationZeros", func(t *testing.T) {
+		// Verification zeros computed against a different key
+		// should not be accepted.
+		otherWorkerKey := newWorkerKeyForTesting(t)
+		publicKeys := getSynchronizeRequestPublicKeys(t, otherWorkerKey.pkixPublicKey, []workerKeyForTesting{workerKey1})
+		response, err := buildQueue.Synchronize(ctx, &remoteworker_pb.SynchronizeRequest{
+			WorkerId:        map[string]string{"hostname": "worker1"},
+			PublicKeys:      publicKeys,
+			CurrentState:    &remoteworker_pb.CurrentState{WorkerState: &remoteworker_pb.CurrentState_Idle{Idle: &emptypb.Empty{}}},
+			PreferBeingIdle: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, verificationPkixPublicKey, getVerificationPkixPublicKey(t, response))
+		require.Empty(t, listPlatformQueuePublicKeysForTesting(t, buildQueue))
+	})
+
+	t.Run("UnsortedPublicKeys", func(t *testing.T) {
+		publicKeys := getSynchronizeRequestPublicKeys(t, verificationPkixPublicKey, []workerKeyForTesting{workerKey1, newWorkerKeyForTesting(t)})
+		publicKeys[0], publicKeys[1] = publicKeys[1], publicKeys[0]
+		_, err := buildQueue.Synchronize(ctx, &remoteworker_pb.SynchronizeRequest{
+			WorkerId:     map[string]string{"hostname": "worker1"},
+			PublicKeys:   publicKeys,
+			CurrentState: &remoteworker_pb.CurrentState{WorkerState: &remoteworker_pb.CurrentState_Idle{Idle: &emptypb.Empty{}}},
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Public keys provided by worker are not sorted"), err)
+	})
+
+	t.Run("WorkerKeyRotation", func(t *testing.T) {
+		// A worker that has been observed before creates a
+		// platform queue for its public key.
+		synchronize(t, "worker1", workerKey1)
+		require.Equal(t, [][][]byte{sortedPKIXPublicKeys(workerKey1)}, listPlatformQueuePublicKeysForTesting(t, buildQueue))
+
+		// When rotating keys, the worker first announces both
+		// the old and the new key. Both should be associated
+		// with the same platform queue.
+		workerKey2 := newWorkerKeyForTesting(t)
+		synchronize(t, "worker1", workerKey1, workerKey2)
+		require.Equal(t, [][][]byte{sortedPKIXPublicKeys(workerKey1, workerKey2)}, listPlatformQueuePublicKeysForTesting(t, buildQueue))
+
+		// Once the worker stops announcing the old key, it
+		// should be removed from the platform queue, as no
+		// other workers announce it.
+		synchronize(t, "worker1", workerKey2)
+		require.Equal(t, [][][]byte{sortedPKIXPublicKeys(workerKey2)}, listPlatformQueuePublicKeysForTesting(t, buildQueue))
+
+		// This means that workers announcing the old key end
+		// up in a separate platform queue.
+		synchronize(t, "worker2", workerKey1)
+		require.ElementsMatch(t, [][][]byte{
+			sortedPKIXPublicKeys(workerKey1),
+			sortedPKIXPublicKeys(workerKey2),
+		}, listPlatformQueuePublicKeysForTesting(t, buildQueue))
+	})
+
+	t.Run("UnannouncedKeyStillAnnouncedByOtherWorker", func(t *testing.T) {
+		// Public keys should only be removed from the platform
+		// queue if none of its workers announce them.
+		workerKey3 := newWorkerKeyForTesting(t)
+		workerKey4 := newWorkerKeyForTesting(t)
+		synchronize(t, "worker3", workerKey3, workerKey4)
+		synchronize(t, "worker4", workerKey3)
+		synchronize(t, "worker3", workerKey4)
+		require.Contains(t, listPlatformQueuePublicKeysForTesting(t, buildQueue), sortedPKIXPublicKeys(workerKey3, workerKey4))
+
+		// Once the last worker also stops announcing the key,
+		// it should be removed.
+		synchronize(t, "worker4", workerKey4)
+		platformQueuePublicKeys := listPlatformQueuePublicKeysForTesting(t, buildQueue)
+		require.Contains(t, platformQueuePublicKeys, sortedPKIXPublicKeys(workerKey4))
+		require.NotContains(t, platformQueuePublicKeys, sortedPKIXPublicKeys(workerKey3, workerKey4))
+	})
+
+	t.Run("PredeclaredKeyRetained", func(t *testing.T) {
+		// Public keys of predeclared platform queues should
+		// never be removed, even if no workers announce them.
+		workerKey5 := newWorkerKeyForTesting(t)
+		workerKey6 := newWorkerKeyForTesting(t)
+		require.NoError(t, buildQueue.RegisterPredeclaredPlatformQueue([]*ecdh.PublicKey{workerKey5.privateKey.PublicKey()}, nil, 0, 0, []uint32{0}))
+		synchronize(t, "worker5", workerKey5, workerKey6)
+		synchronize(t, "worker5", workerKey6)
+		require.Contains(t, listPlatformQueuePublicKeysForTesting(t, buildQueue), sortedPKIXPublicKeys(workerKey5, workerKey6))
+	})
+
+	t.Run("PublicKeysOfDifferentPlatformQueues", func(t *testing.T) {
+		// A worker cannot announce public keys belonging to
+		// different platform queues, as that would require
+		// merging them.
+		workerKey7 := newWorkerKeyForTesting(t)
+		workerKey8 := newWorkerKeyForTesting(t)
+		synchronize(t, "worker7", workerKey7)
+		synchronize(t, "worker8", workerKey8)
+
+		_, err := buildQueue.Synchronize(ctx, &remoteworker_pb.SynchronizeRequest{
+			WorkerId:     map[string]string{"hostname": "worker9"},
+			PublicKeys:   getSynchronizeRequestPublicKeys(t, verificationPkixPublicKey, []workerKeyForTesting{workerKey7, workerKey8}),
+			CurrentState: &remoteworker_pb.CurrentState{WorkerState: &remoteworker_pb.CurrentState_Idle{Idle: &emptypb.Empty{}}},
+		})
+		testutil.RequirePrefixedStatus(t, status.Error(codes.InvalidArgument, "PKIX public keys "), err)
+	})
+}
+
+func TestInMemoryBuildQueueSynchronizeVerificationKeyRotation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	clock := NewMockClock(ctrl)
+	clock.EXPECT().Now().Return(time.Unix(1000, 0))
+	buildQueue := scheduler.NewInMemoryBuildQueue(
+		clock,
+		uuid.NewRandom,
+		random.NewFastSingleThreadedGenerator(),
+		&inMemoryBuildQueueConfigurationForTesting,
+		/* actionRouter = */ nil,
+		auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true }),
+		auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true }),
+		auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true }),
+	)
+
+	workerKey := newWorkerKeyForTesting(t)
+	synchronize := func(verificationPkixPublicKey []byte) *remoteworker_pb.SynchronizeResponse {
+		response, err := buildQueue.Synchronize(ctx, &remoteworker_pb.SynchronizeRequest{
+			WorkerId:        map[string]string{"hostname": "worker1"},
+			PublicKeys:      getSynchronizeRequestPublicKeys(t, verificationPkixPublicKey, []workerKeyForTesting{workerKey}),
+			CurrentState:    &remoteworker_pb.CurrentState{WorkerState: &remoteworker_pb.CurrentState_Idle{Idle: &emptypb.Empty{}}},
+			PreferBeingIdle: true,
+		})
+		require.NoError(t, err)
+		return response
+	}
+
+	// Let the worker compute verification zeros and register
+	// itself.
+	clock.EXPECT().Now().Return(time.Unix(1000, 0)).Times(2)
+	verificationPkixPublicKey1 := getVerificationPkixPublicKey(t, synchronize(nil))
+	require.IsType(t, &remoteworker_pb.DesiredState_Idle{}, synchronize(verificationPkixPublicKey1).DesiredState.WorkerState)
+
+	// Before the refresh interval has passed, the worker should be
+	// able to continue to use the verification zeros it computed.
+	clock.EXPECT().Now().Return(time.Unix(1000, 0).Add(59 * time.Minute))
+	require.IsType(t, &remoteworker_pb.DesiredState_Idle{}, synchronize(verificationPkixPublicKey1).DesiredState.WorkerState)
+
+	// After the refresh interval has passed, the verification
+	// private key gets rotated. Existing workers should be forced
+	// to recompute their verification zeros, even though their
+	// public keys are already associated with a platform queue.
+	clock.EXPECT().Now().Return(time.Unix(1000, 0).Add(time.Hour))
+	verificationPkixPublicKey2 := getVerificationPkixPublicKey(t, synchronize(verificationPkixPublicKey1))
+	require.NotEqual(t, verificationPkixPublicKey1, verificationPkixPublicKey2)
+
+	clock.EXPECT().Now().Return(time.Unix(1000, 0).Add(time.Hour + time.Second))
+	require.IsType(t, &remoteworker_pb.DesiredState_Idle{}, synchronize(verificationPkixPublicKey2).DesiredState.WorkerState)
+}
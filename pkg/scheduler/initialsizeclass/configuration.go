@@ -1,6 +1,16 @@
 package initialsizeclass
 
 import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/random"
 	"github.com/buildbarn/bb-storage/pkg/util"
 	pb "github.com/buildbarn/bonanza/pkg/proto/configuration/scheduler"
 
@@ -10,7 +20,11 @@ import (
 
 // NewAnalyzerFromConfiguration creates a new initial size class
 // analyzer based on options provided in a configuration file.
-func NewAnalyzerFromConfiguration(configuration *pb.InitialSizeClassAnalyzerConfiguration) (Analyzer, error) {
+//
+// If feedback driven analysis is enabled and statistics are persisted
+// to disk, a goroutine is launched in the provided group that
+// periodically writes them.
+func NewAnalyzerFromConfiguration(configuration *pb.InitialSizeClassAnalyzerConfiguration, group program.Group) (Analyzer, error) {
 	if configuration == nil {
 		return nil, status.Error(codes.InvalidArgument, "No initial size class analyzer configuration provided")
 	}
@@ -20,5 +34,117 @@ func NewAnalyzerFromConfiguration(configuration *pb.InitialSizeClassAnalyzerConf
 		return nil, util.StatusWrap(err, "Invalid maximum execution timeout")
 	}
 	actionTimeoutExtractor := NewActionTimeoutExtractor(maximumExecutionTimeout.AsDuration())
+
+	if fdConfiguration := configuration.FeedbackDriven; fdConfiguration != nil {
+		failureCacheDuration := fdConfiguration.FailureCacheDuration
+		if err := failureCacheDuration.CheckValid(); err != nil {
+			return nil, util.StatusWrap(err, "Invalid failure cache duration")
+		}
+		if fdConfiguration.HistorySize <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "History size must be positive")
+		}
+
+		strategyCalculator := SmallestSizeClassStrategyCalculator
+		if pageRankConfiguration := fdConfiguration.PageRank; pageRankConfiguration != nil {
+			minimumExecutionTimeout := pageRankConfiguration.MinimumExecutionTimeout
+			if err := minimumExecutionTimeout.CheckValid(); err != nil {
+				return nil, util.StatusWrap(err, "Invalid minimum execution timeout")
+			}
+			strategyCalculator = NewPageRankStrategyCalculator(
+				minimumExecutionTimeout.AsDuration(),
+				pageRankConfiguration.AcceptableExecutionTimeIncreaseExponent,
+				pageRankConfiguration.SmallerSizeClassExecutionTimeoutMultiplier,
+				pageRankConfiguration.MaximumConvergenceError)
+		}
+
+		if fdConfiguration.MaximumActionsCount <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "Maximum actions count must be positive")
+		}
+		evictionSet, err := eviction.NewSetFromConfiguration[string](fdConfiguration.CacheReplacementPolicy)
+		if err != nil {
+			return nil, util.StatusWrap(err, "Failed to create eviction set")
+		}
+		store := NewInMemoryPreviousExecutionStatsStore(evictionSet, int(fdConfiguration.MaximumActionsCount))
+
+		if persistentStatePath := fdConfiguration.PersistentStatePath; persistentStatePath != "" {
+			persistentStateFlushInterval := fdConfiguration.PersistentStateFlushInterval
+			if err := persistentStateFlushInterval.CheckValid(); err != nil {
+				return nil, util.StatusWrap(err, "Invalid persistent state flush interval")
+			}
+			if persistentStateFlushInterval.AsDuration() <= 0 {
+				return nil, status.Error(codes.InvalidArgument, "Persistent state flush interval must be positive")
+			}
+			if err := readPersistentStateFromFile(store, persistentStatePath); err != nil {
+				return nil, err
+			}
+
+			group.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+				ticker := time.NewTicker(persistentStateFlushInterval.AsDuration())
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						// Write statistics one final time
+						// upon shutdown.
+						return writePersistentStateToFile(store, persistentStatePath)
+					case <-ticker.C:
+						if err := writePersistentStateToFile(store, persistentStatePath); err != nil {
+							log.Print(err)
+						}
+					}
+				}
+			})
+		}
+
+		return NewFeedbackDrivenAnalyzer(
+			store,
+			random.NewFastSingleThreadedGenerator(),
+			clock.SystemClock,
+			actionTimeoutExtractor,
+			failureCacheDuration.AsDuration(),
+			strategyCalculator,
+			int(fdConfiguration.HistorySize)), nil
+	}
 	return NewFallbackAnalyzer(actionTimeoutExtractor), nil
 }
+
+func readPersistentStateFromFile(store *InMemoryPreviousExecutionStatsStore, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// No statistics have been persisted yet.
+			return nil
+		}
+		return util.StatusWrapf(err, "Failed to open persistent state file %#v", path)
+	}
+	defer f.Close()
+
+	if err := store.ReadPersistentState(f); err != nil {
+		return util.StatusWrapf(err, "Failed to read persistent state file %#v", path)
+	}
+	return nil
+}
+
+func writePersistentStateToFile(store *InMemoryPreviousExecutionStatsStore, path string) error {
+	// Write statistics to a temporary file that is renamed
+	// afterwards, so that the persistent state file remains intact
+	// if the scheduler crashes while writing.
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return util.StatusWrapf(err, "Failed to create temporary file for persistent state file %#v", path)
+	}
+	if err := store.WritePersistentState(f); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return util.StatusWrapf(err, "Failed to write persistent state file %#v", path)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return util.StatusWrapf(err, "Failed to close persistent state file %#v", path)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+		return util.StatusWrapf(err, "Failed to rename persistent state file %#v", path)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package initialsizeclass_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/program"
+	eviction_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/eviction"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	scheduler_pb "github.com/buildbarn/bonanza/pkg/proto/configuration/scheduler"
+	"github.com/buildbarn/bonanza/pkg/scheduler/initialsizeclass"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestNewAnalyzerFromConfiguration(t *testing.T) {
+	newConfiguration := func(persistentStatePath string, persistentStateFlushInterval *durationpb.Duration) *scheduler_pb.InitialSizeClassAnalyzerConfiguration {
+		return &scheduler_pb.InitialSizeClassAnalyzerConfiguration{
+			MaximumExecutionTimeout: &durationpb.Duration{Seconds: 3600},
+			FeedbackDriven: &scheduler_pb.InitialSizeClassFeedbackDrivenAnalyzerConfiguration{
+				FailureCacheDuration:         &durationpb.Duration{Seconds: 86400},
+				HistorySize:                  32,
+				MaximumActionsCount:          1000,
+				CacheReplacementPolicy:       eviction_pb.CacheReplacementPolicy_LEAST_RECENTLY_USED,
+				PersistentStatePath:          persistentStatePath,
+				PersistentStateFlushInterval: persistentStateFlushInterval,
+			},
+		}
+	}
+
+	t.Run("NoConfiguration", func(t *testing.T) {
+		_, err := initialsizeclass.NewAnalyzerFromConfiguration(nil, nil)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "No initial size class analyzer configuration provided"), err)
+	})
+
+	t.Run("MissingPersistentStateFlushInterval", func(t *testing.T) {
+		_, err := initialsizeclass.NewAnalyzerFromConfiguration(
+			newConfiguration(filepath.Join(t.TempDir(), "stats"), nil),
+			nil,
+		)
+		require.ErrorContains(t, err, "Invalid persistent state flush interval")
+	})
+
+	t.Run("ZeroPersistentStateFlushInterval", func(t *testing.T) {
+		// A zero flush interval would cause time.NewTicker()
+		// to panic. It should be rejected upfront.
+		_, err := initialsizeclass.NewAnalyzerFromConfiguration(
+			newConfiguration(filepath.Join(t.TempDir(), "stats"), &durationpb.Duration{}),
+			nil,
+		)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Persistent state flush interval must be positive"), err)
+	})
+
+	t.Run("NegativePersistentStateFlushInterval", func(t *testing.T) {
+		_, err := initialsizeclass.NewAnalyzerFromConfiguration(
+			newConfiguration(filepath.Join(t.TempDir(), "stats"), &durationpb.Duration{Seconds: -300}),
+			nil,
+		)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Persistent state flush interval must be positive"), err)
+	})
+
+	t.Run("NoPersistentState", func(t *testing.T) {
+		// The flush interval is irrelevant if statistics are
+		// not persisted.
+		analyzer, err := initialsizeclass.NewAnalyzerFromConfiguration(newConfiguration("", nil), nil)
+		require.NoError(t, err)
+		require.NotNil(t, analyzer)
+	})
+
+	t.Run("PersistentState", func(t *testing.T) {
+		// Statistics should be written to disk upon shutdown.
+		persistentStatePath := filepath.Join(t.TempDir(), "stats")
+		ctx, cancel := context.WithCancel(context.Background())
+		require.NoError(t, program.RunLocal(ctx, func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			analyzer, err := initialsizeclass.NewAnalyzerFromConfiguration(
+				newConfiguration(persistentStatePath, &durationpb.Duration{Seconds: 300}),
+				dependenciesGroup,
+			)
+			require.NoError(t, err)
+			require.NotNil(t, analyzer)
+			cancel()
+			return nil
+		}))
+
+		_, err := os.Stat(persistentStatePath)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,153 @@
+package initialsizeclass
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/proto/iscc"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	initialsizeclass_pb "github.com/buildbarn/bonanza/pkg/proto/initialsizeclass"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+)
+
+// InMemoryPreviousExecutionStatsStore is an implementation of
+// PreviousExecutionStatsStore that keeps statistics in memory. If the
+// number of actions for which statistics are stored exceeds a
+// configured limit, statistics of actions are discarded according to a
+// cache replacement policy.
+//
+// The contents of the store can be written to and read from a file,
+// making it possible to retain statistics across restarts.
+type InMemoryPreviousExecutionStatsStore struct {
+	lock                sync.Mutex
+	evictionSet         eviction.Set[string]
+	maximumActionsCount int
+	stats               map[string]*iscc.PreviousExecutionStats
+}
+
+var _ PreviousExecutionStatsStore = (*InMemoryPreviousExecutionStatsStore)(nil)
+
+// NewInMemoryPreviousExecutionStatsStore creates an
+// InMemoryPreviousExecutionStatsStore that is initially empty.
+func NewInMemoryPreviousExecutionStatsStore(evictionSet eviction.Set[string], maximumActionsCount int) *InMemoryPreviousExecutionStatsStore {
+	return &InMemoryPreviousExecutionStatsStore{
+		evictionSet:         evictionSet,
+		maximumActionsCount: maximumActionsCount,
+		stats:               map[string]*iscc.PreviousExecutionStats{},
+	}
+}
+
+// Get the statistics of previous executions of an action. If no
+// statistics are present, a handle to an empty message is returned.
+func (s *InMemoryPreviousExecutionStatsStore) Get(ctx context.Context, stableFingerprint []byte) (PreviousExecutionStatsHandle, error) {
+	key := string(stableFingerprint)
+	s.lock.Lock()
+	stats, ok := s.stats[key]
+	if ok {
+		s.evictionSet.Touch(key)
+	}
+	s.lock.Unlock()
+
+	// Messages stored in the map are never modified in place, as
+	// handles replace them entirely. This means that it is safe to
+	// create a copy without holding the lock.
+	if ok {
+		stats = proto.Clone(stats).(*iscc.PreviousExecutionStats)
+	} else {
+		stats = &iscc.PreviousExecutionStats{}
+	}
+	return &inMemoryPreviousExecutionStatsHandle{
+		store: s,
+		key:   key,
+		stats: stats,
+	}, nil
+}
+
+// put statistics of an action into the store, potentially evicting
+// statistics of other actions. This method must be called while
+// holding the lock.
+func (s *InMemoryPreviousExecutionStatsStore) put(key string, stats *iscc.PreviousExecutionStats) {
+	if _, ok := s.stats[key]; ok {
+		s.evictionSet.Touch(key)
+	} else {
+		s.evictionSet.Insert(key)
+	}
+	s.stats[key] = stats
+
+	for len(s.stats) > s.maximumActionsCount {
+		removalKey := s.evictionSet.Peek()
+		delete(s.stats, removalKey)
+		s.evictionSet.Remove()
+	}
+}
+
+// WritePersistentState writes the statistics of all actions contained
+// in the store to a stream. The resulting data can be loaded by
+// calling ReadPersistentState().
+func (s *InMemoryPreviousExecutionStatsStore) WritePersistentState(w io.Writer) error {
+	s.lock.Lock()
+	entries := make([]*initialsizeclass_pb.PreviousExecutionStatsEntry, 0, len(s.stats))
+	for key, stats := range s.stats {
+		entries = append(entries, &initialsizeclass_pb.PreviousExecutionStatsEntry{
+			StableFingerprint: []byte(key),
+			Stats:             stats,
+		})
+	}
+	s.lock.Unlock()
+
+	bw := bufio.NewWriter(w)
+	for _, entry := range entries {
+		if _, err := protodelim.MarshalTo(bw, entry); err != nil {
+			return util.StatusWrap(err, "Failed to write entry")
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadPersistentState loads statistics of actions from a stream that
+// was created using WritePersistentState(), adding them to the store.
+func (s *InMemoryPreviousExecutionStatsStore) ReadPersistentState(r io.Reader) error {
+	br := bufio.NewReader(r)
+	for {
+		var entry initialsizeclass_pb.PreviousExecutionStatsEntry
+		if err := protodelim.UnmarshalFrom(br, &entry); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return util.StatusWrap(err, "Failed to read entry")
+		}
+		stats := entry.Stats
+		if stats == nil {
+			stats = &iscc.PreviousExecutionStats{}
+		}
+
+		s.lock.Lock()
+		s.put(string(entry.StableFingerprint), stats)
+		s.lock.Unlock()
+	}
+}
+
+type inMemoryPreviousExecutionStatsHandle struct {
+	store *InMemoryPreviousExecutionStatsStore
+	key   string
+	stats *iscc.PreviousExecutionStats
+}
+
+func (h *inMemoryPreviousExecutionStatsHandle) GetMutableProto() *iscc.PreviousExecutionStats {
+	return h.stats
+}
+
+func (h *inMemoryPreviousExecutionStatsHandle) Release(isDirty bool) {
+	if isDirty {
+		s := h.store
+		s.lock.Lock()
+		s.put(h.key, h.stats)
+		s.lock.Unlock()
+	}
+	h.stats = nil
+}
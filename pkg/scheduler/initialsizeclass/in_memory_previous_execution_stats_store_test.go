@@ -0,0 +1,110 @@
+package initialsizeclass_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/proto/iscc"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/scheduler/initialsizeclass"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestInMemoryPreviousExecutionStatsStore(t *testing.T) {
+	ctx := context.Background()
+
+	exampleStats := &iscc.PreviousExecutionStats{
+		SizeClasses: map[uint32]*iscc.PerSizeClassStats{
+			8: {
+				PreviousExecutions: []*iscc.PreviousExecution{
+					{Outcome: &iscc.PreviousExecution_Succeeded{Succeeded: &durationpb.Duration{Seconds: 10}}},
+				},
+			},
+		},
+	}
+
+	t.Run("CleanRelease", func(t *testing.T) {
+		// Modifications made through handles that are released
+		// without being marked dirty should be discarded.
+		store := initialsizeclass.NewInMemoryPreviousExecutionStatsStore(eviction.NewLRUSet[string](), 10)
+
+		handle1, err := store.Get(ctx, []byte("action"))
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &iscc.PreviousExecutionStats{}, handle1.GetMutableProto())
+		handle1.GetMutableProto().SizeClasses = exampleStats.SizeClasses
+		handle1.Release(false)
+
+		handle2, err := store.Get(ctx, []byte("action"))
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &iscc.PreviousExecutionStats{}, handle2.GetMutableProto())
+		handle2.Release(false)
+	})
+
+	t.Run("DirtyRelease", func(t *testing.T) {
+		// Modifications made through handles that are released
+		// while being marked dirty should be retained.
+		store := initialsizeclass.NewInMemoryPreviousExecutionStatsStore(eviction.NewLRUSet[string](), 10)
+
+		handle1, err := store.Get(ctx, []byte("action"))
+		require.NoError(t, err)
+		handle1.GetMutableProto().SizeClasses = exampleStats.SizeClasses
+		handle1.Release(true)
+
+		handle2, err := store.Get(ctx, []byte("action"))
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, exampleStats, handle2.GetMutableProto())
+		handle2.Release(false)
+	})
+
+	t.Run("Eviction", func(t *testing.T) {
+		// If the maximum number of actions is exceeded, the
+		// least recently used action should be discarded.
+		store := initialsizeclass.NewInMemoryPreviousExecutionStatsStore(eviction.NewLRUSet[string](), 2)
+
+		for _, action := range []string{"action1", "action2", "action1", "action3"} {
+			handle, err := store.Get(ctx, []byte(action))
+			require.NoError(t, err)
+			handle.GetMutableProto().SizeClasses = exampleStats.SizeClasses
+			handle.Release(true)
+		}
+
+		for action, expectedStats := range map[string]*iscc.PreviousExecutionStats{
+			"action1": exampleStats,
+			"action2": {},
+			"action3": exampleStats,
+		} {
+			handle, err := store.Get(ctx, []byte(action))
+			require.NoError(t, err)
+			testutil.RequireEqualProto(t, expectedStats, handle.GetMutableProto())
+			handle.Release(false)
+		}
+	})
+
+	t.Run("PersistentState", func(t *testing.T) {
+		// Statistics written by one instance of the store
+		// should be loadable by another instance.
+		store1 := initialsizeclass.NewInMemoryPreviousExecutionStatsStore(eviction.NewLRUSet[string](), 10)
+		for _, action := range []string{"action1", "action2"} {
+			handle, err := store1.Get(ctx, []byte(action))
+			require.NoError(t, err)
+			handle.GetMutableProto().SizeClasses = exampleStats.SizeClasses
+			handle.Release(true)
+		}
+
+		var persistentState bytes.Buffer
+		require.NoError(t, store1.WritePersistentState(&persistentState))
+
+		store2 := initialsizeclass.NewInMemoryPreviousExecutionStatsStore(eviction.NewLRUSet[string](), 10)
+		require.NoError(t, store2.ReadPersistentState(&persistentState))
+		for _, action := range []string{"action1", "action2"} {
+			handle, err := store2.Get(ctx, []byte(action))
+			require.NoError(t, err)
+			testutil.RequireEqualProto(t, exampleStats, handle.GetMutableProto())
+			handle.Release(false)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package initialsizeclass
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/proto/iscc"
+)
+
+// PreviousExecutionStatsStore is used by FeedbackDrivenAnalyzer to gain
+// access to statistics of previous executions of actions. Statistics
+// are keyed by the stable fingerprint that clients provide as part of
+// the action's additional data.
+type PreviousExecutionStatsStore interface {
+	Get(ctx context.Context, stableFingerprint []byte) (PreviousExecutionStatsHandle, error)
+}
+
+// PreviousExecutionStatsHandle refers to the statistics of previous
+// executions of a single action, as obtained from a
+// PreviousExecutionStatsStore.
+type PreviousExecutionStatsHandle interface {
+	// GetMutableProto returns the statistics of previous executions
+	// of the action. The message may be modified in place.
+	GetMutableProto() *iscc.PreviousExecutionStats
+
+	// Release the handle. If isDirty is set, any modifications that
+	// were made to the message returned by GetMutableProto() are
+	// written back into the store.
+	Release(isDirty bool)
+}
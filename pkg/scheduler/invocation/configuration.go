@@ -16,6 +16,10 @@ func NewKeyExtractorFromConfiguration(configuration *pb.InvocationKeyExtractorCo
 	switch configuration.Kind.(type) {
 	case *pb.InvocationKeyExtractorConfiguration_AuthenticationMetadata:
 		return AuthenticationMetadataKeyExtractor, nil
+	case *pb.InvocationKeyExtractorConfiguration_ToolInvocationId:
+		return ToolInvocationIDKeyExtractor, nil
+	case *pb.InvocationKeyExtractorConfiguration_CorrelatedInvocationsId:
+		return CorrelatedInvocationsIDKeyExtractor, nil
 	default:
 		return nil, status.Error(codes.InvalidArgument, "Configuration did not contain a supported invocation key extractor type")
 	}
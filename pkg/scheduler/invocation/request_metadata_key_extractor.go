@@ -0,0 +1,59 @@
+package invocation
+
+import (
+	"context"
+
+	remoteexecution_pb "github.com/buildbarn/bonanza/pkg/proto/remoteexecution"
+	"github.com/buildbarn/bonanza/pkg/remoteexecution"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type requestMetadataKeyExtractor struct {
+	filter func(requestMetadata *remoteexecution_pb.RequestMetadata) *remoteexecution_pb.RequestMetadata
+}
+
+func (ke requestMetadataKeyExtractor) ExtractKey(ctx context.Context) (Key, error) {
+	requestMetadata, err := remoteexecution.GetRequestMetadataFromIncomingContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	any, err := anypb.New(ke.filter(requestMetadata))
+	if err != nil {
+		return "", err
+	}
+	return NewKey(any)
+}
+
+// ToolInvocationIDKeyExtractor is an implementation of KeyExtractor
+// that returns a Key that is based on the tool invocation ID that the
+// client provided as part of the RequestMetadata header. This will
+// cause InMemoryBuildQueue to group all operations created by the same
+// invocation of bonanza_bazel together, which ensures fair scheduling
+// between builds.
+//
+// Operations whose clients did not provide a tool invocation ID are
+// all grouped together.
+var ToolInvocationIDKeyExtractor KeyExtractor = requestMetadataKeyExtractor{
+	filter: func(requestMetadata *remoteexecution_pb.RequestMetadata) *remoteexecution_pb.RequestMetadata {
+		return &remoteexecution_pb.RequestMetadata{
+			ToolInvocationId: requestMetadata.ToolInvocationId,
+		}
+	},
+}
+
+// CorrelatedInvocationsIDKeyExtractor is an implementation of
+// KeyExtractor that returns a Key that is based on the correlated
+// invocations ID that the client provided as part of the
+// RequestMetadata header. This will cause InMemoryBuildQueue to group
+// all operations belonging to the same build request together.
+//
+// Operations whose clients did not provide a correlated invocations ID
+// are all grouped together.
+var CorrelatedInvocationsIDKeyExtractor KeyExtractor = requestMetadataKeyExtractor{
+	filter: func(requestMetadata *remoteexecution_pb.RequestMetadata) *remoteexecution_pb.RequestMetadata {
+		return &remoteexecution_pb.RequestMetadata{
+			CorrelatedInvocationsId: requestMetadata.CorrelatedInvocationsId,
+		}
+	},
+}
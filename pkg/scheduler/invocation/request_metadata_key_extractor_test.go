@@ -0,0 +1,87 @@
+package invocation_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution_pb "github.com/buildbarn/bonanza/pkg/proto/remoteexecution"
+	"github.com/buildbarn/bonanza/pkg/remoteexecution"
+	"github.com/buildbarn/bonanza/pkg/scheduler/invocation"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestRequestMetadataKeyExtractors(t *testing.T) {
+	// newIncomingContext returns a context that is identical to the
+	// one received by the scheduler when a client attaches
+	// RequestMetadata to an outgoing call.
+	newIncomingContext := func(t *testing.T, requestMetadata *remoteexecution_pb.RequestMetadata) context.Context {
+		outgoingCtx, err := remoteexecution.NewOutgoingContextWithRequestMetadata(context.Background(), requestMetadata)
+		require.NoError(t, err)
+		md, ok := metadata.FromOutgoingContext(outgoingCtx)
+		require.True(t, ok)
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+	newKey := func(t *testing.T, requestMetadata *remoteexecution_pb.RequestMetadata) invocation.Key {
+		any, err := anypb.New(requestMetadata)
+		require.NoError(t, err)
+		return invocation.MustNewKey(any)
+	}
+
+	ctx := newIncomingContext(t, &remoteexecution_pb.RequestMetadata{
+		ToolInvocationId:        "a4f0b7c1-3c8e-4d2a-9f55-1e6f0a7b2c3d",
+		CorrelatedInvocationsId: "5d2e8f4a-6b1c-4e7d-8a9f-0c3b2d1e4f5a",
+	})
+
+	t.Run("ToolInvocationID", func(t *testing.T) {
+		// Only the tool invocation ID should be part of the
+		// key.
+		key, err := invocation.ToolInvocationIDKeyExtractor.ExtractKey(ctx)
+		require.NoError(t, err)
+		require.Equal(t, newKey(t, &remoteexecution_pb.RequestMetadata{
+			ToolInvocationId: "a4f0b7c1-3c8e-4d2a-9f55-1e6f0a7b2c3d",
+		}), key)
+	})
+
+	t.Run("CorrelatedInvocationsID", func(t *testing.T) {
+		// Only the correlated invocations ID should be part of
+		// the key.
+		key, err := invocation.CorrelatedInvocationsIDKeyExtractor.ExtractKey(ctx)
+		require.NoError(t, err)
+		require.Equal(t, newKey(t, &remoteexecution_pb.RequestMetadata{
+			CorrelatedInvocationsId: "5d2e8f4a-6b1c-4e7d-8a9f-0c3b2d1e4f5a",
+		}), key)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		// Operations of clients that did not provide
+		// RequestMetadata should all be grouped together.
+		for _, keyExtractor := range []invocation.KeyExtractor{
+			invocation.ToolInvocationIDKeyExtractor,
+			invocation.CorrelatedInvocationsIDKeyExtractor,
+		} {
+			key, err := keyExtractor.ExtractKey(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, newKey(t, &remoteexecution_pb.RequestMetadata{}), key)
+		}
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		// Malformed RequestMetadata should be rejected, as
+		// opposed to causing operations to be grouped
+		// incorrectly.
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(remoteexecution.RequestMetadataHeader, "\xff\xff\xff\xff"))
+		for _, keyExtractor := range []invocation.KeyExtractor{
+			invocation.ToolInvocationIDKeyExtractor,
+			invocation.CorrelatedInvocationsIDKeyExtractor,
+		} {
+			_, err := keyExtractor.ExtractKey(ctx)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+			require.ErrorContains(t, err, "Failed to unmarshal request metadata")
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package routing
 
 import (
+	"github.com/buildbarn/bb-storage/pkg/program"
 	"github.com/buildbarn/bb-storage/pkg/util"
 	pb "github.com/buildbarn/bonanza/pkg/proto/configuration/scheduler"
 	"github.com/buildbarn/bonanza/pkg/scheduler/initialsizeclass"
@@ -12,7 +13,7 @@ import (
 
 // NewActionRouterFromConfiguration creates an ActionRouter based on
 // options specified in a configuration file.
-func NewActionRouterFromConfiguration(configuration *pb.ActionRouterConfiguration) (ActionRouter, error) {
+func NewActionRouterFromConfiguration(configuration *pb.ActionRouterConfiguration, group program.Group) (ActionRouter, error) {
 	if configuration == nil {
 		return nil, status.Error(codes.InvalidArgument, "No action router configuration provided")
 	}
@@ -26,7 +27,7 @@ func NewActionRouterFromConfiguration(configuration *pb.ActionRouterConfiguratio
 			}
 			invocationKeyExtractors = append(invocationKeyExtractors, invocationKeyExtractor)
 		}
-		initialSizeClassAnalyzer, err := initialsizeclass.NewAnalyzerFromConfiguration(kind.Simple.InitialSizeClassAnalyzer)
+		initialSizeClassAnalyzer, err := initialsizeclass.NewAnalyzerFromConfiguration(kind.Simple.InitialSizeClassAnalyzer, group)
 		if err != nil {
 			return nil, util.StatusWrap(err, "Failed to create initial size class analyzer")
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/buildbarn/bonanza/pkg/starlark/unpack"
 
 	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
 )
 
 // ModuleExtensionProxy is called into by ParseModuleDotBazel() whenever
@@ -71,6 +72,16 @@ type ChildModuleDotBazelHandler interface {
 	UseRepoRule(repoRuleBzlFile label.ApparentLabel, repoRuleName string) (RepoRuleProxy, error)
 }
 
+// CallPositionRecordingModuleDotBazelHandler may optionally be
+// implemented by handlers that need to know where declarations are
+// made in MODULE.bazel, such as tools that rewrite MODULE.bazel.
+// ParseModuleDotBazel() calls SetCallPosition() with the position of
+// the opening parenthesis of calls to use_extension() and use_repo(),
+// prior to calling into the handler or module extension proxy.
+type CallPositionRecordingModuleDotBazelHandler interface {
+	SetCallPosition(position syntax.Position)
+}
+
 type overrideIgnoringRootModuleDotBazelHandler struct {
 	ChildModuleDotBazelHandler
 }
@@ -151,8 +162,9 @@ var (
 )
 
 // Parse a MODULE.bazel file, and call into ModuleDotBazelHandler for
-// every observed declaration.
-func ParseModuleDotBazel(contents string, filename label.CanonicalLabel, localPathFormat path.Format, handler RootModuleDotBazelHandler) error {
+// every observed declaration. Calls to print() are forwarded to
+// printFunc.
+func ParseModuleDotBazel(contents string, filename label.CanonicalLabel, localPathFormat path.Format, printFunc func(thread *starlark.Thread, msg string), handler RootModuleDotBazelHandler) error {
 	repositoryRuleOverrideFunc := func(targetIdentifier label.CanonicalStarlarkIdentifier) func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
 		return func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
 			if len(args) > 0 {
@@ -182,13 +194,17 @@ func ParseModuleDotBazel(contents string, filename label.CanonicalLabel, localPa
 		}
 	}
 
+	callPositionRecorder, _ := handler.(CallPositionRecordingModuleDotBazelHandler)
+	recordCallPosition := func(thread *starlark.Thread) {
+		if callPositionRecorder != nil {
+			callPositionRecorder.SetCallPosition(thread.CallFrame(1).Pos)
+		}
+	}
+
 	_, err := starlark.ExecFile(
 		&starlark.Thread{
-			Name: "main",
-			Print: func(_ *starlark.Thread, msg string) {
-				// TODO: Provide logging sink.
-				fmt.Println(msg)
-			},
+			Name:  "main",
+			Print: printFunc,
 		},
 		filename.String(),
 		contents,
@@ -371,6 +387,7 @@ func ParseModuleDotBazel(contents string, filename label.CanonicalLabel, localPa
 				); err != nil {
 					return nil, err
 				}
+				recordCallPosition(thread)
 				moduleExtensionProxy, err := handler.UseExtension(
 					extensionBzlFile,
 					extensionName,
@@ -419,6 +436,7 @@ func ParseModuleDotBazel(contents string, filename label.CanonicalLabel, localPa
 					repos[key] = value
 				}
 
+				recordCallPosition(thread)
 				return starlark.None, proxyObject.proxy.UseRepo(repos)
 			}),
 			"use_repo_rule": starlark.NewBuiltin("use_repo_rule", func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
@@ -443,6 +443,7 @@ http_archive(
 `,
 			label.MustNewCanonicalLabel("@@my_module_name+//:MODULE.bazel"),
 			path.UNIXFormat,
+			/* printFunc = */ nil,
 			handler,
 		))
 	})
@@ -467,6 +468,7 @@ local_path_override(
 `,
 			label.MustNewCanonicalLabel("@@my_module_name+//:MODULE.bazel"),
 			/* localPathFormat = */ nil,
+			/* printFunc = */ nil,
 			handler,
 		))
 	})
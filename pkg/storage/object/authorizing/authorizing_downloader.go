@@ -0,0 +1,45 @@
+package authorizing
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+)
+
+// AuthorizeInstanceName checks whether the caller is permitted to
+// access objects stored under a given instance name.
+func AuthorizeInstanceName(ctx context.Context, authorizer auth.Authorizer, instanceName object.InstanceName) error {
+	digestInstanceName, err := instanceName.ToDigestInstanceName()
+	if err != nil {
+		return util.StatusWrap(err, "Invalid instance name")
+	}
+	if err := auth.AuthorizeSingleInstanceName(ctx, authorizer, digestInstanceName); err != nil {
+		return util.StatusWrap(err, "Authorization")
+	}
+	return nil
+}
+
+type authorizingDownloader struct {
+	base       object.Downloader[object.GlobalReference]
+	authorizer auth.Authorizer
+}
+
+// NewAuthorizingDownloader creates a decorator for Downloader that
+// only forwards calls to DownloadObject() if the caller is permitted
+// to read objects from the instance name that is part of the
+// reference.
+func NewAuthorizingDownloader(base object.Downloader[object.GlobalReference], authorizer auth.Authorizer) object.Downloader[object.GlobalReference] {
+	return &authorizingDownloader{
+		base:       base,
+		authorizer: authorizer,
+	}
+}
+
+func (d *authorizingDownloader) DownloadObject(ctx context.Context, reference object.GlobalReference) (*object.Contents, error) {
+	if err := AuthorizeInstanceName(ctx, d.authorizer, reference.InstanceName); err != nil {
+		return nil, err
+	}
+	return d.base.DownloadObject(ctx, reference)
+}
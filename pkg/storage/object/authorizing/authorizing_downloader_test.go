@@ -0,0 +1,54 @@
+package authorizing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	object_pb "github.com/buildbarn/bonanza/pkg/proto/storage/object"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+	"github.com/buildbarn/bonanza/pkg/storage/object/authorizing"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthorizingDownloader(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseDownloader := NewMockDownloaderForTesting(ctrl)
+	authorizer := NewMockAuthorizer(ctrl)
+	downloader := authorizing.NewAuthorizingDownloader(baseDownloader, authorizer)
+
+	reference := object.MustNewSHA256V1GlobalReference("hello/world", "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5, 0, 0, 0)
+
+	t.Run("InvalidInstanceName", func(t *testing.T) {
+		// Instance names that cannot be converted to the format
+		// used by the authorizer should be rejected.
+		_, err := downloader.DownloadObject(ctx, object.MustNewSHA256V1GlobalReference("hello/blobs", "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5, 0, 0, 0))
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Invalid instance name: Instance name contains reserved keyword \"blobs\""), err)
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{digest.MustNewInstanceName("hello/world")}).
+			Return([]error{status.Error(codes.PermissionDenied, "Permission denied")})
+
+		_, err := downloader.DownloadObject(ctx, reference)
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Permission denied"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{digest.MustNewInstanceName("hello/world")}).
+			Return([]error{nil})
+		contents := object.MustNewContents(object_pb.ReferenceFormat_SHA256_V1, nil, []byte("Hello"))
+		baseDownloader.EXPECT().DownloadObject(ctx, reference).Return(contents, nil)
+
+		actualContents, err := downloader.DownloadObject(ctx, reference)
+		require.NoError(t, err)
+		require.Equal(t, contents, actualContents)
+	})
+}
@@ -0,0 +1,30 @@
+package authorizing
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+)
+
+type authorizingUploader[TLease any] struct {
+	base       object.Uploader[object.GlobalReference, TLease]
+	authorizer auth.Authorizer
+}
+
+// NewAuthorizingUploader creates a decorator for Uploader that only
+// forwards calls to UploadObject() if the caller is permitted to write
+// objects to the instance name that is part of the reference.
+func NewAuthorizingUploader[TLease any](base object.Uploader[object.GlobalReference, TLease], authorizer auth.Authorizer) object.Uploader[object.GlobalReference, TLease] {
+	return &authorizingUploader[TLease]{
+		base:       base,
+		authorizer: authorizer,
+	}
+}
+
+func (u *authorizingUploader[TLease]) UploadObject(ctx context.Context, reference object.GlobalReference, contents *object.Contents, childrenLeases []TLease, wantContentsIfIncomplete bool) (object.UploadObjectResult[TLease], error) {
+	if err := AuthorizeInstanceName(ctx, u.authorizer, reference.InstanceName); err != nil {
+		return nil, err
+	}
+	return u.base.UploadObject(ctx, reference, contents, childrenLeases, wantContentsIfIncomplete)
+}
@@ -1,5 +1,9 @@
 package object
 
+import (
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
 type InstanceName struct {
 	value string
 }
@@ -16,3 +20,10 @@ func (in InstanceName) WithLocalReference(localReference LocalReference) GlobalR
 		LocalReference: localReference,
 	}
 }
+
+// ToDigestInstanceName converts an instance name to the type that is
+// used by Buildbarn Storage. This is needed to pass instance names to
+// authorizers.
+func (in InstanceName) ToDigestInstanceName() (digest.InstanceName, error) {
+	return digest.NewInstanceName(in.value)
+}
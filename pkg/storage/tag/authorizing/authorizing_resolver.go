@@ -0,0 +1,34 @@
+package authorizing
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+	object_authorizing "github.com/buildbarn/bonanza/pkg/storage/object/authorizing"
+	"github.com/buildbarn/bonanza/pkg/storage/tag"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type authorizingResolver struct {
+	base       tag.Resolver[object.Namespace]
+	authorizer auth.Authorizer
+}
+
+// NewAuthorizingResolver creates a decorator for tag.Resolver that only
+// forwards calls to ResolveTag() if the caller is permitted to read
+// tags from the instance name that is part of the namespace.
+func NewAuthorizingResolver(base tag.Resolver[object.Namespace], authorizer auth.Authorizer) tag.Resolver[object.Namespace] {
+	return &authorizingResolver{
+		base:       base,
+		authorizer: authorizer,
+	}
+}
+
+func (r *authorizingResolver) ResolveTag(ctx context.Context, namespace object.Namespace, tag *anypb.Any) (object.LocalReference, bool, error) {
+	if err := object_authorizing.AuthorizeInstanceName(ctx, r.authorizer, namespace.InstanceName); err != nil {
+		return object.LocalReference{}, false, err
+	}
+	return r.base.ResolveTag(ctx, namespace, tag)
+}
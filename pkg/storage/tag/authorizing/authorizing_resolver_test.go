@@ -0,0 +1,65 @@
+package authorizing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	object_pb "github.com/buildbarn/bonanza/pkg/proto/storage/object"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+	"github.com/buildbarn/bonanza/pkg/storage/tag/authorizing"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthorizingResolver(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseResolver := NewMockResolverForTesting(ctrl)
+	authorizer := NewMockAuthorizer(ctrl)
+	resolver := authorizing.NewAuthorizingResolver(baseResolver, authorizer)
+
+	namespace := object.MustNewNamespace(&object_pb.Namespace{
+		InstanceName:    "hello/world",
+		ReferenceFormat: object_pb.ReferenceFormat_SHA256_V1,
+	})
+	tag, err := anypb.New(&emptypb.Empty{})
+	require.NoError(t, err)
+
+	t.Run("InvalidInstanceName", func(t *testing.T) {
+		// Instance names that cannot be converted to the format
+		// used by the authorizer should be rejected.
+		_, _, err := resolver.ResolveTag(ctx, object.MustNewNamespace(&object_pb.Namespace{
+			InstanceName:    "hello/blobs",
+			ReferenceFormat: object_pb.ReferenceFormat_SHA256_V1,
+		}), tag)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Invalid instance name: Instance name contains reserved keyword \"blobs\""), err)
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{digest.MustNewInstanceName("hello/world")}).
+			Return([]error{status.Error(codes.PermissionDenied, "Permission denied")})
+
+		_, _, err := resolver.ResolveTag(ctx, namespace, tag)
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Permission denied"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{digest.MustNewInstanceName("hello/world")}).
+			Return([]error{nil})
+		reference := object.MustNewSHA256V1LocalReference("2572ad3fb952a78dffe4988445912245fcb4acd998750f956a3a069911aa2da6", 595814, 58, 12, 7883322)
+		baseResolver.EXPECT().ResolveTag(ctx, namespace, tag).Return(reference, true, nil)
+
+		actualReference, complete, err := resolver.ResolveTag(ctx, namespace, tag)
+		require.NoError(t, err)
+		require.Equal(t, reference, actualReference)
+		require.True(t, complete)
+	})
+}
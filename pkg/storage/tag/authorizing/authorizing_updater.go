@@ -0,0 +1,34 @@
+package authorizing
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+	object_authorizing "github.com/buildbarn/bonanza/pkg/storage/object/authorizing"
+	"github.com/buildbarn/bonanza/pkg/storage/tag"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type authorizingUpdater[TLease any] struct {
+	base       tag.Updater[object.GlobalReference, TLease]
+	authorizer auth.Authorizer
+}
+
+// NewAuthorizingUpdater creates a decorator for tag.Updater that only
+// forwards calls to UpdateTag() if the caller is permitted to write
+// tags to the instance name that is part of the reference.
+func NewAuthorizingUpdater[TLease any](base tag.Updater[object.GlobalReference, TLease], authorizer auth.Authorizer) tag.Updater[object.GlobalReference, TLease] {
+	return &authorizingUpdater[TLease]{
+		base:       base,
+		authorizer: authorizer,
+	}
+}
+
+func (u *authorizingUpdater[TLease]) UpdateTag(ctx context.Context, tag *anypb.Any, reference object.GlobalReference, lease TLease, overwrite bool) error {
+	if err := object_authorizing.AuthorizeInstanceName(ctx, u.authorizer, reference.InstanceName); err != nil {
+		return err
+	}
+	return u.base.UpdateTag(ctx, tag, reference, lease, overwrite)
+}
@@ -0,0 +1,66 @@
+package authorizing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bonanza/pkg/storage/object"
+	"github.com/buildbarn/bonanza/pkg/storage/tag/authorizing"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthorizingUpdater(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseUpdater := NewMockUpdaterForTesting(ctrl)
+	authorizer := NewMockAuthorizer(ctrl)
+	updater := authorizing.NewAuthorizingUpdater(baseUpdater, authorizer)
+
+	tag, err := anypb.New(&emptypb.Empty{})
+	require.NoError(t, err)
+	reference := object.MustNewSHA256V1GlobalReference("hello/world", "2572ad3fb952a78dffe4988445912245fcb4acd998750f956a3a069911aa2da6", 595814, 58, 12, 7883322)
+
+	t.Run("InvalidInstanceName", func(t *testing.T) {
+		// Instance names that cannot be converted to the format
+		// used by the authorizer should be rejected.
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "Invalid instance name: Instance name contains reserved keyword \"blobs\""),
+			updater.UpdateTag(
+				ctx,
+				tag,
+				object.MustNewSHA256V1GlobalReference("hello/blobs", "2572ad3fb952a78dffe4988445912245fcb4acd998750f956a3a069911aa2da6", 595814, 58, 12, 7883322),
+				nil,
+				false,
+			),
+		)
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{digest.MustNewInstanceName("hello/world")}).
+			Return([]error{status.Error(codes.PermissionDenied, "Permission denied")})
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.PermissionDenied, "Authorization: Permission denied"),
+			updater.UpdateTag(ctx, tag, reference, nil, false),
+		)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{digest.MustNewInstanceName("hello/world")}).
+			Return([]error{nil})
+		baseUpdater.EXPECT().UpdateTag(ctx, tag, reference, "lease", true)
+
+		require.NoError(t, updater.UpdateTag(ctx, tag, reference, "lease", true))
+	})
+}
@@ -1,3 +1,4 @@
+AnalysisFailure = provider()
 AnalysisFailureInfo = provider()
 AnalysisTestResultInfo = provider()
 CcLauncherInfo = provider()
@@ -1022,6 +1023,7 @@ exported_toplevels = {
     ),
     "testing": struct(
         ExecutionInfo = ExecutionInfo,
+        analysis_test = testing.analysis_test,
     ),
 }
 